@@ -20,6 +20,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// The domain liveness is always checked; the probe is in addition to it
+type AppHealthProbe int32
+
+const (
+	AppHealthProbe_AppProbeNone       AppHealthProbe = 0
+	AppHealthProbe_AppProbeTCP        AppHealthProbe = 1
+	AppHealthProbe_AppProbeHTTP       AppHealthProbe = 2
+	AppHealthProbe_AppProbeGuestAgent AppHealthProbe = 3
+)
+
+var AppHealthProbe_name = map[int32]string{
+	0: "AppProbeNone",
+	1: "AppProbeTCP",
+	2: "AppProbeHTTP",
+	3: "AppProbeGuestAgent",
+}
+
+var AppHealthProbe_value = map[string]int32{
+	"AppProbeNone":       0,
+	"AppProbeTCP":        1,
+	"AppProbeHTTP":       2,
+	"AppProbeGuestAgent": 3,
+}
+
+func (x AppHealthProbe) String() string {
+	return proto.EnumName(AppHealthProbe_name, int32(x))
+}
+
+func (AppHealthProbe) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{0}
+}
+
+type AppRestartPolicy int32
+
+const (
+	AppRestartPolicy_AppRestartNever     AppRestartPolicy = 0
+	AppRestartPolicy_AppRestartOnFailure AppRestartPolicy = 1
+)
+
+var AppRestartPolicy_name = map[int32]string{
+	0: "AppRestartNever",
+	1: "AppRestartOnFailure",
+}
+
+var AppRestartPolicy_value = map[string]int32{
+	"AppRestartNever":     0,
+	"AppRestartOnFailure": 1,
+}
+
+func (x AppRestartPolicy) String() string {
+	return proto.EnumName(AppRestartPolicy_name, int32(x))
+}
+
+func (AppRestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{1}
+}
+
+type AppStartCondition int32
+
+const (
+	AppStartCondition_AppStartAfterActivated AppStartCondition = 0
+	AppStartCondition_AppStartAfterHealthy   AppStartCondition = 1
+)
+
+var AppStartCondition_name = map[int32]string{
+	0: "AppStartAfterActivated",
+	1: "AppStartAfterHealthy",
+}
+
+var AppStartCondition_value = map[string]int32{
+	"AppStartAfterActivated": 0,
+	"AppStartAfterHealthy":   1,
+}
+
+func (x AppStartCondition) String() string {
+	return proto.EnumName(AppStartCondition_name, int32(x))
+}
+
+func (AppStartCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{2}
+}
+
 type InstanceOpsCmd struct {
 	Counter              uint32   `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
 	OpsTime              string   `protobuf:"bytes,4,opt,name=opsTime,proto3" json:"opsTime,omitempty"`
@@ -94,10 +176,16 @@ type AppInstanceConfig struct {
 	UserData string `protobuf:"bytes,11,opt,name=userData,proto3" json:"userData,omitempty"`
 	// Config flag if the app-instance should be made accessible
 	// through a remote console session established by the device.
-	RemoteConsole        bool     `protobuf:"varint,12,opt,name=remoteConsole,proto3" json:"remoteConsole,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RemoteConsole bool `protobuf:"varint,12,opt,name=remoteConsole,proto3" json:"remoteConsole,omitempty"`
+	// How the device checks the health of the app instance and whether
+	// it restarts the app instance when it fails
+	HealthCheck *AppHealthCheck `protobuf:"bytes,13,opt,name=healthCheck,proto3" json:"healthCheck,omitempty"`
+	// The app instances which need to be up before this one is
+	// booted. When they are halted at the same time this one is halted first.
+	Dependencies         []*AppDependency `protobuf:"bytes,14,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *AppInstanceConfig) Reset()         { *m = AppInstanceConfig{} }
@@ -202,40 +290,248 @@ func (m *AppInstanceConfig) GetRemoteConsole() bool {
 	return false
 }
 
+func (m *AppInstanceConfig) GetHealthCheck() *AppHealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+func (m *AppInstanceConfig) GetDependencies() []*AppDependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+// The times are in seconds. Zero picks the device default.
+type AppHealthCheck struct {
+	Probe                AppHealthProbe   `protobuf:"varint,1,opt,name=probe,proto3,enum=AppHealthProbe" json:"probe,omitempty"`
+	Port                 uint32           `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Path                 string           `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Interval             uint32           `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout              uint32           `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	FailureThreshold     uint32           `protobuf:"varint,6,opt,name=failureThreshold,proto3" json:"failureThreshold,omitempty"`
+	GracePeriod          uint32           `protobuf:"varint,7,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	RestartPolicy        AppRestartPolicy `protobuf:"varint,8,opt,name=restartPolicy,proto3,enum=AppRestartPolicy" json:"restartPolicy,omitempty"`
+	MaxRetries           uint32           `protobuf:"varint,9,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
+	BackoffInitial       uint32           `protobuf:"varint,10,opt,name=backoffInitial,proto3" json:"backoffInitial,omitempty"`
+	BackoffMax           uint32           `protobuf:"varint,11,opt,name=backoffMax,proto3" json:"backoffMax,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AppHealthCheck) Reset()         { *m = AppHealthCheck{} }
+func (m *AppHealthCheck) String() string { return proto.CompactTextString(m) }
+func (*AppHealthCheck) ProtoMessage()    {}
+func (*AppHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{2}
+}
+
+func (m *AppHealthCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppHealthCheck.Unmarshal(m, b)
+}
+func (m *AppHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppHealthCheck.Marshal(b, m, deterministic)
+}
+func (m *AppHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppHealthCheck.Merge(m, src)
+}
+func (m *AppHealthCheck) XXX_Size() int {
+	return xxx_messageInfo_AppHealthCheck.Size(m)
+}
+func (m *AppHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppHealthCheck proto.InternalMessageInfo
+
+func (m *AppHealthCheck) GetProbe() AppHealthProbe {
+	if m != nil {
+		return m.Probe
+	}
+	return AppHealthProbe_AppProbeNone
+}
+
+func (m *AppHealthCheck) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *AppHealthCheck) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetFailureThreshold() uint32 {
+	if m != nil {
+		return m.FailureThreshold
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetGracePeriod() uint32 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetRestartPolicy() AppRestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return AppRestartPolicy_AppRestartNever
+}
+
+func (m *AppHealthCheck) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetBackoffInitial() uint32 {
+	if m != nil {
+		return m.BackoffInitial
+	}
+	return 0
+}
+
+func (m *AppHealthCheck) GetBackoffMax() uint32 {
+	if m != nil {
+		return m.BackoffMax
+	}
+	return 0
+}
+
+type AppDependency struct {
+	Uuid                 string            `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Condition            AppStartCondition `protobuf:"varint,2,opt,name=condition,proto3,enum=AppStartCondition" json:"condition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AppDependency) Reset()         { *m = AppDependency{} }
+func (m *AppDependency) String() string { return proto.CompactTextString(m) }
+func (*AppDependency) ProtoMessage()    {}
+func (*AppDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6183fdf07ef5608d, []int{3}
+}
+
+func (m *AppDependency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppDependency.Unmarshal(m, b)
+}
+func (m *AppDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppDependency.Marshal(b, m, deterministic)
+}
+func (m *AppDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppDependency.Merge(m, src)
+}
+func (m *AppDependency) XXX_Size() int {
+	return xxx_messageInfo_AppDependency.Size(m)
+}
+func (m *AppDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppDependency proto.InternalMessageInfo
+
+func (m *AppDependency) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AppDependency) GetCondition() AppStartCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return AppStartCondition_AppStartAfterActivated
+}
+
 func init() {
+	proto.RegisterEnum("AppHealthProbe", AppHealthProbe_name, AppHealthProbe_value)
+	proto.RegisterEnum("AppRestartPolicy", AppRestartPolicy_name, AppRestartPolicy_value)
+	proto.RegisterEnum("AppStartCondition", AppStartCondition_name, AppStartCondition_value)
 	proto.RegisterType((*InstanceOpsCmd)(nil), "InstanceOpsCmd")
 	proto.RegisterType((*AppInstanceConfig)(nil), "AppInstanceConfig")
+	proto.RegisterType((*AppHealthCheck)(nil), "AppHealthCheck")
+	proto.RegisterType((*AppDependency)(nil), "AppDependency")
 }
 
 func init() { proto.RegisterFile("appconfig.proto", fileDescriptor_6183fdf07ef5608d) }
 
 var fileDescriptor_6183fdf07ef5608d = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0x13, 0x31,
-	0x10, 0xc5, 0x15, 0xd2, 0x26, 0x1b, 0x87, 0x24, 0xc2, 0x27, 0xab, 0x07, 0x58, 0x55, 0x41, 0x5a,
-	0x0e, 0x78, 0x45, 0x39, 0x70, 0x2e, 0x8d, 0x84, 0x7a, 0x01, 0xc9, 0xa2, 0x3d, 0x70, 0x73, 0xed,
-	0xc9, 0x62, 0x11, 0x7b, 0x2c, 0xdb, 0xbb, 0xfc, 0xf9, 0x84, 0x7c, 0x2c, 0xb4, 0xff, 0xa2, 0xb4,
-	0xe2, 0xf8, 0xde, 0xfb, 0x79, 0xc6, 0x1e, 0x0f, 0xd9, 0x48, 0xef, 0x15, 0xba, 0xbd, 0xa9, 0xb8,
-	0x0f, 0x98, 0xf0, 0x62, 0xa3, 0xa1, 0x51, 0x68, 0x2d, 0xba, 0xc1, 0x58, 0xc5, 0x84, 0x41, 0x56,
-	0x30, 0xc8, 0xac, 0xb1, 0x23, 0xe9, 0x20, 0x9d, 0x1e, 0xbd, 0xdc, 0x91, 0xf5, 0xad, 0x8b, 0x49,
-	0x3a, 0x05, 0x5f, 0x7c, 0xbc, 0xb1, 0x9a, 0x32, 0x32, 0x57, 0x58, 0xbb, 0x04, 0x81, 0x3d, 0xcb,
-	0x27, 0xc5, 0x4a, 0x8c, 0xb2, 0x4d, 0xd0, 0xc7, 0xaf, 0xc6, 0x02, 0x3b, 0xcb, 0x27, 0xc5, 0x42,
-	0x8c, 0xf2, 0xf2, 0xef, 0x94, 0xbc, 0xb8, 0xf6, 0x7e, 0xac, 0x74, 0xd3, 0x75, 0xa0, 0x1f, 0xc8,
-	0xba, 0xae, 0x8d, 0x96, 0x4e, 0x37, 0x10, 0xa2, 0x41, 0xc7, 0x26, 0xf9, 0xa4, 0x58, 0x5e, 0x6d,
-	0xf8, 0xdd, 0xdd, 0xed, 0x4e, 0x3a, 0x7d, 0xdf, 0xdb, 0xe2, 0x09, 0x46, 0x73, 0xb2, 0xd4, 0x26,
-	0xfa, 0x83, 0xfc, 0xed, 0xa4, 0x85, 0xee, 0x1a, 0x0b, 0x71, 0x6a, 0xd1, 0x77, 0x64, 0xbd, 0x37,
-	0xbf, 0x40, 0x07, 0x88, 0x58, 0x07, 0x05, 0x91, 0x4d, 0xbb, 0xd2, 0x0b, 0x7e, 0x6f, 0xfb, 0xee,
-	0xe2, 0x09, 0x40, 0x5f, 0x92, 0x99, 0x0e, 0xa6, 0x81, 0xc8, 0xce, 0xf2, 0x69, 0xb1, 0xbc, 0x9a,
-	0xf1, 0x5d, 0x2b, 0xc5, 0xe0, 0xd2, 0x0b, 0x92, 0x49, 0x95, 0x4c, 0x23, 0x13, 0xb0, 0xf3, 0x7c,
-	0x52, 0x64, 0xe2, 0xa8, 0x69, 0x49, 0x88, 0x69, 0x47, 0xb0, 0x97, 0x6d, 0xab, 0x59, 0x77, 0x7e,
-	0xc3, 0x3f, 0x43, 0xfa, 0x89, 0xe1, 0xc7, 0xb5, 0x96, 0x3e, 0x41, 0x10, 0x27, 0x08, 0xdd, 0x92,
-	0x4c, 0xf6, 0x76, 0x64, 0xf3, 0x0e, 0xcf, 0xf8, 0xc8, 0x1d, 0x13, 0xfa, 0x86, 0xcc, 0x03, 0xc4,
-	0x24, 0x43, 0x62, 0x8b, 0x61, 0x32, 0x8f, 0x3f, 0x43, 0x8c, 0x39, 0x7d, 0x4d, 0xce, 0x7d, 0x1d,
-	0x2a, 0x60, 0xe4, 0xff, 0x60, 0x9f, 0xb6, 0x8f, 0xa8, 0x23, 0x84, 0x9d, 0x4c, 0x92, 0x2d, 0xbb,
-	0xb1, 0x1d, 0x35, 0xdd, 0x92, 0x55, 0x00, 0x8b, 0xa9, 0xfd, 0x9e, 0x88, 0x07, 0x60, 0xcf, 0xbb,
-	0x57, 0x3e, 0x36, 0x3f, 0x7e, 0x22, 0xaf, 0x14, 0x5a, 0xfe, 0x07, 0x34, 0x68, 0xc9, 0xd5, 0x01,
-	0x6b, 0xcd, 0xdb, 0x12, 0x8d, 0x51, 0xc3, 0x3a, 0x7d, 0xdb, 0x56, 0x26, 0x7d, 0xaf, 0x1f, 0xb8,
-	0x42, 0x5b, 0x1e, 0xf6, 0x6f, 0x41, 0x57, 0x50, 0x42, 0x03, 0xa5, 0xf4, 0xa6, 0xac, 0xb0, 0xec,
-	0xf7, 0xeb, 0x61, 0xd6, 0xc1, 0xef, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xeb, 0x68, 0x49,
-	0xae, 0x02, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xc1, 0x6e, 0xe3, 0x36,
+	0x10, 0x86, 0xe3, 0x24, 0x9b, 0xd8, 0xe3, 0xc8, 0x56, 0xb8, 0xc5, 0x96, 0xc8, 0x61, 0x6b, 0x04,
+	0x69, 0xe1, 0x06, 0xa8, 0xdc, 0x4d, 0x0f, 0x7b, 0xad, 0x6b, 0xa3, 0xbb, 0x39, 0x34, 0x6b, 0xb0,
+	0xce, 0x1e, 0x0a, 0xf4, 0xc0, 0x88, 0x63, 0x9b, 0x88, 0x44, 0x12, 0x14, 0xa5, 0x26, 0x3d, 0xf7,
+	0x7d, 0xfa, 0x8a, 0x05, 0x69, 0x29, 0x91, 0xb3, 0xbd, 0x71, 0xbe, 0xf9, 0x49, 0x6a, 0xe6, 0x1f,
+	0x0a, 0x86, 0xdc, 0x98, 0x54, 0xab, 0x95, 0x5c, 0x27, 0xc6, 0x6a, 0xa7, 0xcf, 0x86, 0x02, 0xab,
+	0x54, 0xe7, 0xb9, 0x56, 0x35, 0x88, 0x0a, 0xa7, 0x2d, 0x5f, 0x63, 0x1d, 0x76, 0xab, 0xbc, 0x51,
+	0x2a, 0x74, 0xed, 0xad, 0xe7, 0x73, 0x18, 0x5c, 0xab, 0xc2, 0x71, 0x95, 0xe2, 0x27, 0x53, 0xcc,
+	0x72, 0x41, 0x28, 0x1c, 0xa7, 0xba, 0x54, 0x0e, 0x2d, 0xdd, 0x1f, 0x75, 0xc6, 0x11, 0x6b, 0x42,
+	0x9f, 0xd1, 0xa6, 0x58, 0xca, 0x1c, 0xe9, 0xe1, 0xa8, 0x33, 0xee, 0xb1, 0x26, 0x3c, 0xff, 0xf7,
+	0x10, 0x4e, 0xa7, 0xc6, 0x34, 0x27, 0xcd, 0xc2, 0x0d, 0xe4, 0x3d, 0x0c, 0xca, 0x52, 0x0a, 0xae,
+	0x44, 0x85, 0xb6, 0x90, 0x5a, 0xd1, 0xce, 0xa8, 0x33, 0xee, 0x5f, 0x0d, 0x93, 0xdb, 0xdb, 0xeb,
+	0x39, 0x57, 0xe2, 0xf3, 0x16, 0xb3, 0x17, 0x32, 0x32, 0x82, 0xbe, 0x90, 0x85, 0xc9, 0xf8, 0xa3,
+	0xe2, 0x39, 0x86, 0xcf, 0xe8, 0xb1, 0x36, 0x22, 0xef, 0x60, 0xb0, 0x92, 0x0f, 0x28, 0x2c, 0x16,
+	0xba, 0xb4, 0x29, 0x16, 0xf4, 0x20, 0x1c, 0xdd, 0x4b, 0x3e, 0xe7, 0xdb, 0xdb, 0xd9, 0x0b, 0x01,
+	0x79, 0x0b, 0x47, 0xc2, 0xca, 0x0a, 0x0b, 0x7a, 0x38, 0x3a, 0x18, 0xf7, 0xaf, 0x8e, 0x92, 0xb9,
+	0x0f, 0x59, 0x4d, 0xc9, 0x19, 0x74, 0x79, 0xea, 0x64, 0xc5, 0x1d, 0xd2, 0x57, 0xa3, 0xce, 0xb8,
+	0xcb, 0x9e, 0x62, 0x32, 0x01, 0x90, 0xbe, 0x05, 0x2b, 0xee, 0xaf, 0x3a, 0x0a, 0xfb, 0x87, 0xc9,
+	0x0d, 0xba, 0xbf, 0xb4, 0xbd, 0x9f, 0x0a, 0x6e, 0x1c, 0x5a, 0xd6, 0x92, 0x90, 0x0b, 0xe8, 0xf2,
+	0x2d, 0x2e, 0xe8, 0x71, 0x90, 0x77, 0x93, 0x46, 0xf7, 0x94, 0x21, 0xdf, 0xc3, 0xb1, 0xc5, 0xc2,
+	0x71, 0xeb, 0x68, 0xaf, 0xee, 0xcc, 0xae, 0x19, 0xac, 0xc9, 0x93, 0x6f, 0xe1, 0x95, 0x29, 0xed,
+	0x1a, 0x29, 0xfc, 0xbf, 0x70, 0x9b, 0xf5, 0x45, 0x94, 0x05, 0xda, 0x39, 0x77, 0x9c, 0xf6, 0x43,
+	0xdb, 0x9e, 0x62, 0x72, 0x01, 0x91, 0xc5, 0x5c, 0x3b, 0x6f, 0x4f, 0xa1, 0x33, 0xa4, 0x27, 0xa1,
+	0xca, 0x5d, 0x48, 0xde, 0x41, 0x7f, 0x83, 0x3c, 0x73, 0x9b, 0xd9, 0x06, 0xd3, 0x7b, 0x1a, 0xd5,
+	0xd7, 0x4d, 0x8d, 0xf9, 0xf8, 0x8c, 0x59, 0x5b, 0x43, 0xae, 0xe0, 0x44, 0xa0, 0x41, 0x25, 0x50,
+	0xa5, 0x12, 0x0b, 0x3a, 0x08, 0x05, 0x0f, 0xfc, 0x9e, 0x79, 0xc3, 0x1f, 0xd9, 0x8e, 0xe6, 0xfc,
+	0x9f, 0x03, 0x18, 0xec, 0x9e, 0x19, 0x4a, 0xb4, 0xfa, 0x0e, 0xc3, 0x94, 0x0c, 0xda, 0x77, 0x2e,
+	0x3c, 0x66, 0xdb, 0x2c, 0x21, 0x70, 0x68, 0xb4, 0x75, 0xf5, 0x70, 0x86, 0x75, 0x60, 0xdc, 0x6d,
+	0xc2, 0x10, 0xf4, 0x58, 0x58, 0xfb, 0x56, 0x04, 0x43, 0x2a, 0x9e, 0x85, 0x71, 0x8d, 0xd8, 0x53,
+	0xec, 0x27, 0xd9, 0xc9, 0x1c, 0x75, 0xe9, 0x82, 0xd5, 0x11, 0x6b, 0x42, 0x72, 0x09, 0xf1, 0x8a,
+	0xcb, 0xac, 0xb4, 0xb8, 0xdc, 0x58, 0x2c, 0x36, 0x3a, 0x13, 0xf4, 0x28, 0x48, 0xbe, 0xe0, 0x7e,
+	0x4c, 0xd7, 0x96, 0xa7, 0xb8, 0x40, 0x2b, 0xb5, 0xa0, 0xc7, 0x41, 0xd6, 0x46, 0xe4, 0x3d, 0x44,
+	0xb5, 0x81, 0x0b, 0x9d, 0xc9, 0xf4, 0x91, 0x76, 0x43, 0x69, 0xa7, 0xbe, 0x34, 0xd6, 0x4e, 0xb0,
+	0x5d, 0x1d, 0x79, 0x0b, 0x90, 0xf3, 0x07, 0x86, 0xce, 0xfa, 0x86, 0xf6, 0xc2, 0xc9, 0x2d, 0x42,
+	0xbe, 0x83, 0xc1, 0x1d, 0x4f, 0xef, 0xf5, 0x6a, 0x75, 0xad, 0xa4, 0x93, 0x3c, 0x0b, 0x73, 0x11,
+	0xb1, 0x17, 0xd4, 0x9f, 0x53, 0x93, 0xdf, 0xf8, 0x43, 0x98, 0x88, 0x88, 0xb5, 0xc8, 0xf9, 0x2d,
+	0x44, 0x3b, 0x2e, 0xf9, 0x4e, 0xfa, 0xc7, 0x18, 0x3c, 0xe8, 0xb1, 0xb0, 0x26, 0x3f, 0x42, 0x2f,
+	0xd5, 0x4a, 0x48, 0xe7, 0x9f, 0xf0, 0x7e, 0xa8, 0x80, 0xf8, 0x0a, 0x7e, 0xf7, 0x1f, 0x3c, 0x6b,
+	0x32, 0xec, 0x59, 0x74, 0xf9, 0x67, 0xcb, 0xdc, 0x60, 0x1e, 0x89, 0xe1, 0x64, 0x6a, 0x4c, 0x58,
+	0xdf, 0x68, 0x85, 0xf1, 0x1e, 0x19, 0x42, 0xbf, 0x21, 0xcb, 0xd9, 0x22, 0xee, 0xb4, 0x25, 0x1f,
+	0x97, 0xcb, 0x45, 0xbc, 0x4f, 0xde, 0x00, 0x69, 0xc8, 0x87, 0x12, 0x0b, 0x37, 0x5d, 0xa3, 0x72,
+	0xf1, 0xc1, 0xe5, 0xcf, 0x10, 0xbf, 0x6c, 0x20, 0x79, 0x0d, 0xc3, 0x67, 0x76, 0x83, 0x15, 0xda,
+	0x78, 0x8f, 0x7c, 0x0d, 0xaf, 0x9f, 0xe1, 0x27, 0xf5, 0xeb, 0xd6, 0xc1, 0xb8, 0x73, 0x79, 0x0d,
+	0xa7, 0x5f, 0x14, 0x40, 0xce, 0xe0, 0x4d, 0x03, 0xa7, 0x2b, 0x87, 0x76, 0x5a, 0x3f, 0x7f, 0x11,
+	0xef, 0x11, 0x0a, 0x5f, 0xed, 0xe4, 0xb6, 0xb5, 0x3d, 0xc6, 0x9d, 0x5f, 0x3e, 0xc0, 0x37, 0xa9,
+	0xce, 0x93, 0xbf, 0x51, 0xa0, 0xe0, 0x49, 0x9a, 0xe9, 0x52, 0x24, 0xfe, 0xcd, 0x55, 0x32, 0xad,
+	0xff, 0xbf, 0x7f, 0x5c, 0xac, 0xa5, 0xdb, 0x94, 0x77, 0x49, 0xaa, 0xf3, 0x49, 0xb6, 0xfa, 0x01,
+	0xc5, 0x1a, 0x27, 0x58, 0xe1, 0x84, 0x1b, 0x39, 0x59, 0xeb, 0xc9, 0xf6, 0x87, 0x7c, 0x77, 0x14,
+	0xc4, 0x3f, 0xfd, 0x37, 0x00, 0x36, 0x6b, 0x8c, 0x2c, 0xdf, 0x05, 0x00, 0x00,
 }
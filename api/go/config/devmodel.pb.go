@@ -127,6 +127,31 @@ func (PhyIoMemberUsage) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9fb58492383773ea, []int{2}
 }
 
+type BondMode int32
+
+const (
+	BondMode_BondModeActiveBackup BondMode = 0
+	BondMode_BondMode8023ad       BondMode = 1
+)
+
+var BondMode_name = map[int32]string{
+	0: "BondModeActiveBackup",
+	1: "BondMode8023ad",
+}
+
+var BondMode_value = map[string]int32{
+	"BondModeActiveBackup": 0,
+	"BondMode8023ad":       1,
+}
+
+func (x BondMode) String() string {
+	return proto.EnumName(BondMode_name, int32(x))
+}
+
+func (BondMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9fb58492383773ea, []int{3}
+}
+
 // Deprecate; replace by level 2 specification
 type SWAdapterParams struct {
 	AType SWAdapterType `protobuf:"varint,1,opt,name=aType,proto3,enum=SWAdapterType" json:"aType,omitempty"`
@@ -213,10 +238,16 @@ type SystemAdapter struct {
 	Addr string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
 	// alias/logical name which will be reported to zedcloud
 	// and used for app instances
-	LogicalName          string   `protobuf:"bytes,6,opt,name=logicalName,proto3" json:"logicalName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LogicalName string `protobuf:"bytes,6,opt,name=logicalName,proto3" json:"logicalName,omitempty"`
+	// Set for an 802.1Q sub-interface. The name is then the name of the
+	// sub-interface which the device creates on top of the lower layer.
+	Vlan *VlanAdapter `protobuf:"bytes,7,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	// Set for a link aggregation. The name is then the name of the bond
+	// interface which the device creates from the lower layers.
+	Bond                 *BondAdapter `protobuf:"bytes,8,opt,name=bond,proto3" json:"bond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *SystemAdapter) Reset()         { *m = SystemAdapter{} }
@@ -286,6 +317,20 @@ func (m *SystemAdapter) GetLogicalName() string {
 	return ""
 }
 
+func (m *SystemAdapter) GetVlan() *VlanAdapter {
+	if m != nil {
+		return m.Vlan
+	}
+	return nil
+}
+
+func (m *SystemAdapter) GetBond() *BondAdapter {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
 // Given additional details for EVE softwar to how to treat this
 // interface. Example policies could be limit use of LTE interface
 // or only use Eth1 only if Eth0 is not available etc
@@ -448,64 +493,191 @@ func (m *PhysicalIO) GetCbattr() map[string]string {
 	return nil
 }
 
+type VlanAdapter struct {
+	// name of the SystemAdapter or interface carrying the tagged traffic
+	LowerLayerName string `protobuf:"bytes,1,opt,name=lowerLayerName,proto3" json:"lowerLayerName,omitempty"`
+	// 1 to 4094
+	VlanId               uint32   `protobuf:"varint,2,opt,name=vlanId,proto3" json:"vlanId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanAdapter) Reset()         { *m = VlanAdapter{} }
+func (m *VlanAdapter) String() string { return proto.CompactTextString(m) }
+func (*VlanAdapter) ProtoMessage()    {}
+func (*VlanAdapter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb58492383773ea, []int{4}
+}
+
+func (m *VlanAdapter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanAdapter.Unmarshal(m, b)
+}
+func (m *VlanAdapter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanAdapter.Marshal(b, m, deterministic)
+}
+func (m *VlanAdapter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanAdapter.Merge(m, src)
+}
+func (m *VlanAdapter) XXX_Size() int {
+	return xxx_messageInfo_VlanAdapter.Size(m)
+}
+func (m *VlanAdapter) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanAdapter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanAdapter proto.InternalMessageInfo
+
+func (m *VlanAdapter) GetLowerLayerName() string {
+	if m != nil {
+		return m.LowerLayerName
+	}
+	return ""
+}
+
+func (m *VlanAdapter) GetVlanId() uint32 {
+	if m != nil {
+		return m.VlanId
+	}
+	return 0
+}
+
+type BondAdapter struct {
+	// names of the SystemAdapters or interfaces which are aggregated
+	LowerLayerNames []string `protobuf:"bytes,1,rep,name=lowerLayerNames,proto3" json:"lowerLayerNames,omitempty"`
+	Mode            BondMode `protobuf:"varint,2,opt,name=mode,proto3,enum=BondMode" json:"mode,omitempty"`
+	// link monitoring interval in milliseconds; zero picks 100
+	MiiMonitor uint32 `protobuf:"varint,3,opt,name=miiMonitor,proto3" json:"miiMonitor,omitempty"`
+	// preferred lower layer for active-backup
+	Primary              string   `protobuf:"bytes,4,opt,name=primary,proto3" json:"primary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BondAdapter) Reset()         { *m = BondAdapter{} }
+func (m *BondAdapter) String() string { return proto.CompactTextString(m) }
+func (*BondAdapter) ProtoMessage()    {}
+func (*BondAdapter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb58492383773ea, []int{5}
+}
+
+func (m *BondAdapter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BondAdapter.Unmarshal(m, b)
+}
+func (m *BondAdapter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BondAdapter.Marshal(b, m, deterministic)
+}
+func (m *BondAdapter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondAdapter.Merge(m, src)
+}
+func (m *BondAdapter) XXX_Size() int {
+	return xxx_messageInfo_BondAdapter.Size(m)
+}
+func (m *BondAdapter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondAdapter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondAdapter proto.InternalMessageInfo
+
+func (m *BondAdapter) GetLowerLayerNames() []string {
+	if m != nil {
+		return m.LowerLayerNames
+	}
+	return nil
+}
+
+func (m *BondAdapter) GetMode() BondMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BondMode_BondModeActiveBackup
+}
+
+func (m *BondAdapter) GetMiiMonitor() uint32 {
+	if m != nil {
+		return m.MiiMonitor
+	}
+	return 0
+}
+
+func (m *BondAdapter) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("SWAdapterType", SWAdapterType_name, SWAdapterType_value)
 	proto.RegisterEnum("PhyIoType", PhyIoType_name, PhyIoType_value)
 	proto.RegisterEnum("PhyIoMemberUsage", PhyIoMemberUsage_name, PhyIoMemberUsage_value)
+	proto.RegisterEnum("BondMode", BondMode_name, BondMode_value)
 	proto.RegisterType((*SWAdapterParams)(nil), "sWAdapterParams")
 	proto.RegisterType((*SystemAdapter)(nil), "SystemAdapter")
 	proto.RegisterType((*PhyIOUsagePolicy)(nil), "PhyIOUsagePolicy")
 	proto.RegisterType((*PhysicalIO)(nil), "PhysicalIO")
 	proto.RegisterMapType((map[string]string)(nil), "PhysicalIO.CbattrEntry")
 	proto.RegisterMapType((map[string]string)(nil), "PhysicalIO.PhyaddrsEntry")
+	proto.RegisterType((*VlanAdapter)(nil), "VlanAdapter")
+	proto.RegisterType((*BondAdapter)(nil), "BondAdapter")
 }
 
 func init() { proto.RegisterFile("devmodel.proto", fileDescriptor_9fb58492383773ea) }
 
 var fileDescriptor_9fb58492383773ea = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe2, 0x46,
-	0x14, 0x8e, 0x31, 0x10, 0x38, 0x04, 0x32, 0x99, 0x46, 0x8d, 0x1b, 0x55, 0xad, 0x85, 0x22, 0x15,
-	0x45, 0xad, 0x91, 0x88, 0x2a, 0xf5, 0xe7, 0x8a, 0x84, 0x28, 0xb5, 0x54, 0x7e, 0xe4, 0x2c, 0x1b,
-	0x69, 0xef, 0x06, 0xcf, 0x60, 0xac, 0xd8, 0x1e, 0x6b, 0x6c, 0xb3, 0xf2, 0xbe, 0x4a, 0x1e, 0x63,
-	0xdf, 0x62, 0x5f, 0x6a, 0x57, 0xe3, 0xf1, 0x82, 0xc9, 0x5e, 0xed, 0xdd, 0x39, 0xdf, 0x77, 0x7e,
-	0xbf, 0x33, 0x36, 0xf4, 0x28, 0xdb, 0x86, 0x9c, 0xb2, 0xc0, 0x8a, 0x05, 0x4f, 0x79, 0xff, 0x45,
-	0x83, 0xd3, 0xe4, 0x69, 0x4c, 0x49, 0x9c, 0x32, 0xb1, 0x20, 0x82, 0x84, 0x09, 0xbe, 0x82, 0x06,
-	0x79, 0x93, 0xc7, 0xcc, 0xd0, 0x4c, 0x6d, 0xd0, 0x1b, 0xf5, 0xac, 0x5d, 0x80, 0x44, 0x1d, 0x45,
-	0xe2, 0xdf, 0xe1, 0x2c, 0x8b, 0x28, 0x13, 0x01, 0xc9, 0xed, 0x28, 0x65, 0x62, 0x4d, 0x5c, 0x66,
-	0xb4, 0x4c, 0x6d, 0xd0, 0x76, 0xbe, 0x25, 0xf0, 0x8f, 0xd0, 0xdc, 0x06, 0x24, 0xb2, 0xa9, 0xd1,
-	0x36, 0xb5, 0x41, 0xd7, 0x29, 0x3d, 0xfc, 0x33, 0xb4, 0x57, 0x3c, 0xa2, 0x9e, 0xe0, 0x59, 0x6c,
-	0x80, 0xa9, 0x0f, 0xda, 0xce, 0x1e, 0xe8, 0x7f, 0xd4, 0xa0, 0xfb, 0x98, 0x27, 0x29, 0x0b, 0xcb,
-	0x01, 0x30, 0x86, 0x7a, 0x44, 0x42, 0x35, 0x5a, 0xdb, 0x29, 0x6c, 0xfc, 0x0b, 0xc0, 0x5a, 0x30,
-	0xb6, 0x8c, 0x03, 0x3f, 0x7a, 0x36, 0x6a, 0xa6, 0x36, 0x68, 0x39, 0x15, 0x44, 0xf6, 0xce, 0x14,
-	0xa7, 0x17, 0x5c, 0xe9, 0x61, 0x13, 0x3a, 0x11, 0x4b, 0xdf, 0x73, 0xf1, 0xbc, 0x5c, 0xda, 0x13,
-	0xa3, 0x5e, 0x94, 0xac, 0x42, 0xb2, 0x1b, 0xa1, 0x54, 0x18, 0x0d, 0xd5, 0x4d, 0xda, 0x32, 0x2b,
-	0xe0, 0x9e, 0xef, 0x92, 0x60, 0x26, 0x07, 0x69, 0xaa, 0xac, 0x0a, 0xd4, 0x1f, 0x01, 0x5a, 0x6c,
-	0x72, 0x7b, 0xbe, 0x4c, 0x88, 0xc7, 0x16, 0x3c, 0xf0, 0xdd, 0xfc, 0xd5, 0x8c, 0xda, 0xeb, 0x19,
-	0xfb, 0x9f, 0x74, 0x80, 0xc5, 0x26, 0x4f, 0x64, 0x11, 0x7b, 0x8e, 0x4d, 0x68, 0xc4, 0xe9, 0xfe,
-	0x04, 0x60, 0xc9, 0x82, 0x5c, 0xc9, 0x5f, 0x10, 0xf8, 0x12, 0x5a, 0xf1, 0x26, 0x0f, 0xc8, 0x8a,
-	0x05, 0xc5, 0xca, 0x6d, 0x67, 0xe7, 0xe3, 0x3f, 0x0b, 0x4e, 0x4e, 0x9b, 0x18, 0xba, 0xa9, 0x0f,
-	0x3a, 0xa3, 0x9f, 0xac, 0x7d, 0x71, 0x69, 0x16, 0xdc, 0x7d, 0x94, 0x8a, 0xdc, 0xd9, 0x85, 0xe2,
-	0x3e, 0x9c, 0x94, 0x6b, 0xa8, 0xb2, 0x4a, 0x90, 0x03, 0x4c, 0xde, 0x8b, 0x24, 0x89, 0xef, 0x45,
-	0x9e, 0x88, 0x4b, 0x59, 0xf6, 0x00, 0xfe, 0x0d, 0x1a, 0x99, 0x5c, 0xba, 0x50, 0xa5, 0x37, 0x3a,
-	0x53, 0x63, 0x4f, 0x59, 0xb8, 0x62, 0xa2, 0x50, 0xc3, 0x51, 0x3c, 0xbe, 0x81, 0x4e, 0xb6, 0x57,
-	0xc7, 0x38, 0x36, 0xb5, 0x41, 0xa7, 0x0c, 0xaf, 0xca, 0xe6, 0x54, 0xa3, 0xf0, 0x10, 0x9a, 0xee,
-	0x8a, 0xa4, 0xa9, 0x30, 0x5a, 0xc5, 0x52, 0x17, 0xd5, 0xa5, 0xee, 0x0a, 0x46, 0xad, 0x54, 0x86,
-	0x5d, 0xfe, 0x0b, 0xdd, 0x83, 0x5d, 0x31, 0x02, 0xfd, 0x99, 0xe5, 0xe5, 0xe3, 0x91, 0x26, 0x3e,
-	0x87, 0xc6, 0x96, 0x04, 0x19, 0x2b, 0x35, 0x54, 0xce, 0x3f, 0xb5, 0xbf, 0xb4, 0xcb, 0xbf, 0xa1,
-	0x53, 0xa9, 0xf9, 0x3d, 0xa9, 0xd7, 0x43, 0xe8, 0x1e, 0x7c, 0x32, 0x18, 0xa0, 0x69, 0x3f, 0xcc,
-	0xe6, 0xce, 0x3d, 0x3a, 0xc2, 0x2d, 0xa8, 0xbf, 0xfd, 0x7f, 0x3c, 0x43, 0x9a, 0xb4, 0x6e, 0xe7,
-	0xb3, 0x09, 0xaa, 0x5d, 0xbf, 0x68, 0xd0, 0xde, 0x5d, 0x18, 0x77, 0x4b, 0x67, 0xc6, 0x79, 0x8c,
-	0x8e, 0xf0, 0x29, 0x74, 0x94, 0xcb, 0xd2, 0xfb, 0x74, 0x83, 0x34, 0x7c, 0x02, 0xad, 0x02, 0x58,
-	0x3e, 0xde, 0xa2, 0xda, 0xce, 0xbb, 0x9b, 0x4f, 0x91, 0x8e, 0x7b, 0xc5, 0x33, 0xb2, 0xf9, 0x38,
-	0xa3, 0x3e, 0x47, 0x75, 0x8c, 0xe0, 0xe4, 0x6b, 0xf2, 0x93, 0xec, 0xda, 0x38, 0x40, 0x9e, 0xc6,
-	0x33, 0xd4, 0xdc, 0xf5, 0xfb, 0x6f, 0x32, 0xb5, 0xd1, 0x31, 0x3e, 0x2d, 0x4b, 0xcc, 0xd3, 0x0d,
-	0x13, 0xe8, 0xb3, 0x76, 0xed, 0xab, 0xf7, 0x5c, 0xbd, 0x23, 0xc6, 0xd0, 0x53, 0x33, 0x48, 0x6f,
-	0xc6, 0x23, 0x86, 0x8e, 0x0e, 0xb1, 0xa9, 0x17, 0xa6, 0x48, 0xc3, 0xe7, 0x65, 0x6e, 0x81, 0x3d,
-	0x6e, 0x88, 0x60, 0x14, 0xd5, 0xf0, 0x05, 0xfc, 0xb0, 0x47, 0x27, 0x8c, 0xfa, 0x2e, 0x49, 0x19,
-	0x45, 0xfa, 0xed, 0x03, 0xfc, 0xea, 0xf2, 0xd0, 0xfa, 0xc0, 0x28, 0xa3, 0xc4, 0x72, 0x03, 0x9e,
-	0x51, 0x2b, 0x4b, 0x98, 0xd8, 0xfa, 0x2e, 0x53, 0x7f, 0xac, 0x77, 0x57, 0x9e, 0x9f, 0x6e, 0xb2,
-	0x95, 0xe5, 0xf2, 0x70, 0x18, 0xac, 0xff, 0x60, 0xd4, 0x63, 0x43, 0xb6, 0x65, 0x43, 0x12, 0xfb,
-	0x43, 0x8f, 0x0f, 0x5d, 0x1e, 0xad, 0x7d, 0x6f, 0xd5, 0x2c, 0x82, 0x6f, 0xbe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xd3, 0xb6, 0xd8, 0x5a, 0xf0, 0x04, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4b, 0x6f, 0xe3, 0x36,
+	0x10, 0x0e, 0xfd, 0x8a, 0x3d, 0x8e, 0x1d, 0x86, 0x0d, 0xba, 0x6a, 0xd0, 0x87, 0x60, 0x2c, 0x5a,
+	0x23, 0x68, 0xe5, 0xc2, 0x41, 0x81, 0xb4, 0x3d, 0xd9, 0x9b, 0x60, 0x6b, 0x60, 0xfd, 0x80, 0x52,
+	0x6f, 0x80, 0xde, 0x68, 0x91, 0x91, 0x05, 0x4b, 0xa2, 0x40, 0x49, 0x5e, 0xa8, 0xbf, 0xa2, 0xf7,
+	0xfd, 0x47, 0xfd, 0x43, 0x3d, 0xb6, 0x20, 0x25, 0xdb, 0xb2, 0x7b, 0xda, 0x1b, 0xe7, 0xfb, 0x86,
+	0xf3, 0xf8, 0x38, 0x23, 0x41, 0x97, 0xf1, 0x6d, 0x20, 0x18, 0xf7, 0xad, 0x48, 0x8a, 0x44, 0xf4,
+	0x3e, 0x22, 0xb8, 0x8c, 0x9f, 0x47, 0x8c, 0x46, 0x09, 0x97, 0x0b, 0x2a, 0x69, 0x10, 0x93, 0xd7,
+	0x50, 0xa7, 0xbf, 0x67, 0x11, 0x37, 0x90, 0x89, 0xfa, 0xdd, 0x61, 0xd7, 0xda, 0x3b, 0x28, 0xd4,
+	0xce, 0x49, 0xf2, 0x3d, 0x5c, 0xa5, 0x21, 0xe3, 0xd2, 0xa7, 0xd9, 0x24, 0x4c, 0xb8, 0x7c, 0xa1,
+	0x0e, 0x37, 0x9a, 0x26, 0xea, 0xb7, 0xec, 0xff, 0x13, 0xe4, 0x73, 0x68, 0x6c, 0x7d, 0x1a, 0x4e,
+	0x98, 0xd1, 0x32, 0x51, 0xbf, 0x63, 0x17, 0x16, 0xf9, 0x12, 0x5a, 0x2b, 0x11, 0x32, 0x57, 0x8a,
+	0x34, 0x32, 0xc0, 0xac, 0xf6, 0x5b, 0xf6, 0x01, 0xe8, 0xfd, 0x83, 0xa0, 0xf3, 0x94, 0xc5, 0x09,
+	0x0f, 0x8a, 0x02, 0x08, 0x81, 0x5a, 0x48, 0x83, 0xbc, 0xb4, 0x96, 0xad, 0xcf, 0xe4, 0x6b, 0x80,
+	0x17, 0xc9, 0xf9, 0x32, 0xf2, 0xbd, 0x70, 0x63, 0x54, 0x4c, 0xd4, 0x6f, 0xda, 0x25, 0x44, 0xe5,
+	0x4e, 0x73, 0xae, 0xaa, 0xb9, 0xc2, 0x22, 0x26, 0xb4, 0x43, 0x9e, 0x7c, 0x10, 0x72, 0xb3, 0x5c,
+	0x4e, 0x1e, 0x8c, 0x9a, 0x0e, 0x59, 0x86, 0x54, 0x36, 0xca, 0x98, 0x34, 0xea, 0x79, 0x36, 0x75,
+	0x56, 0xb7, 0x7c, 0xe1, 0x7a, 0x0e, 0xf5, 0x67, 0xaa, 0x90, 0x46, 0x7e, 0xab, 0x04, 0x11, 0x13,
+	0x6a, 0xaa, 0x3b, 0xe3, 0xdc, 0x44, 0xfd, 0xf6, 0xf0, 0xc2, 0x7a, 0xef, 0xd3, 0xb0, 0xa8, 0xdf,
+	0xd6, 0x8c, 0xf2, 0x50, 0x4d, 0x1a, 0xcd, 0xc2, 0x63, 0x2c, 0x42, 0xb6, 0xf7, 0x50, 0x4c, 0x6f,
+	0x08, 0x78, 0xb1, 0xce, 0x26, 0xf3, 0x65, 0x4c, 0x5d, 0xbe, 0x10, 0xbe, 0xe7, 0x64, 0x27, 0x7d,
+	0xa2, 0xd3, 0x3e, 0x7b, 0x7f, 0x57, 0x01, 0x16, 0xeb, 0x2c, 0x56, 0x85, 0x4c, 0xe6, 0xc4, 0x84,
+	0x7a, 0x94, 0x1c, 0x9e, 0x11, 0x2c, 0x15, 0x50, 0xe4, 0x4f, 0xa8, 0x09, 0x72, 0x03, 0xcd, 0x68,
+	0x9d, 0xf9, 0x74, 0xc5, 0x7d, 0x2d, 0x5b, 0xcb, 0xde, 0xdb, 0xe4, 0x27, 0xcd, 0xa9, 0x8e, 0x63,
+	0xa3, 0x6a, 0x56, 0xfb, 0xed, 0xe1, 0x17, 0xd6, 0x21, 0xb8, 0xb5, 0x28, 0xb8, 0xc7, 0x30, 0x91,
+	0x99, 0xbd, 0x77, 0x25, 0x3d, 0xb8, 0x28, 0xa4, 0xc8, 0xc3, 0xe6, 0xa2, 0x1e, 0x61, 0xea, 0xcd,
+	0x69, 0x1c, 0x7b, 0x6e, 0xe8, 0xca, 0xa8, 0x90, 0xf6, 0x00, 0x90, 0xef, 0xa0, 0x9e, 0xaa, 0xa6,
+	0xb5, 0xb2, 0xdd, 0xe1, 0x55, 0x5e, 0xf6, 0x94, 0x07, 0x2b, 0x2e, 0xb5, 0x1a, 0x76, 0xce, 0x93,
+	0x3b, 0x68, 0xa7, 0x07, 0x75, 0x0a, 0xb5, 0xaf, 0xac, 0x53, 0xd9, 0xec, 0xb2, 0x17, 0x19, 0x40,
+	0xc3, 0x59, 0xd1, 0x24, 0x91, 0x46, 0x53, 0x37, 0xf5, 0xaa, 0xdc, 0xd4, 0x1b, 0xcd, 0xe4, 0x2d,
+	0x15, 0x6e, 0x37, 0xbf, 0x42, 0xe7, 0xa8, 0x57, 0x82, 0xa1, 0xba, 0xe1, 0x59, 0x31, 0x80, 0xea,
+	0x48, 0xae, 0xa1, 0xbe, 0xa5, 0x7e, 0xca, 0x0b, 0x0d, 0x73, 0xe3, 0x97, 0xca, 0x3d, 0xba, 0xf9,
+	0x19, 0xda, 0xa5, 0x98, 0x9f, 0x72, 0xb5, 0x37, 0x85, 0x76, 0x69, 0x6e, 0xc8, 0xb7, 0xd0, 0xf5,
+	0xc5, 0x07, 0x2e, 0xdf, 0xd1, 0x8c, 0xcb, 0xd9, 0x61, 0x03, 0x4e, 0xd0, 0xd2, 0x9e, 0x55, 0xca,
+	0x7b, 0xd6, 0xfb, 0x0b, 0x41, 0xbb, 0x34, 0x65, 0xa4, 0x0f, 0x97, 0xc7, 0x37, 0x63, 0x03, 0xe9,
+	0xed, 0x3b, 0x85, 0xc9, 0x57, 0x50, 0x53, 0x1f, 0x0c, 0x1d, 0xaf, 0x3b, 0x6c, 0xe9, 0x59, 0x9d,
+	0x0a, 0xc6, 0x6d, 0x0d, 0xab, 0xa1, 0x0c, 0x3c, 0x6f, 0x2a, 0x42, 0x2f, 0x11, 0x52, 0x2f, 0x58,
+	0xc7, 0x2e, 0x21, 0xc4, 0x80, 0xf3, 0x48, 0x7a, 0x01, 0x95, 0x59, 0x31, 0x0b, 0x3b, 0xf3, 0x76,
+	0x00, 0x9d, 0xa3, 0x0f, 0x0b, 0x01, 0x68, 0x4c, 0xde, 0xce, 0xe6, 0xf6, 0x23, 0x3e, 0x23, 0x4d,
+	0xa8, 0xbd, 0x7f, 0x37, 0x9a, 0x61, 0xa4, 0x4e, 0xe3, 0xf9, 0xec, 0x01, 0x57, 0x6e, 0x3f, 0x22,
+	0x68, 0xed, 0x67, 0x98, 0x74, 0x0a, 0x63, 0x26, 0x44, 0x84, 0xcf, 0xc8, 0x25, 0xb4, 0x73, 0x93,
+	0x27, 0x8f, 0xc9, 0x1a, 0x23, 0x72, 0x01, 0x4d, 0x0d, 0x2c, 0x9f, 0xc6, 0xb8, 0xb2, 0xb7, 0xde,
+	0xcc, 0xa7, 0xb8, 0x4a, 0xba, 0x7a, 0x51, 0x26, 0x62, 0x94, 0x32, 0x4f, 0xe0, 0x1a, 0xc1, 0x70,
+	0xb1, 0xbb, 0xfc, 0xac, 0xb2, 0xd6, 0x8f, 0x90, 0xe7, 0xd1, 0x0c, 0x37, 0xf6, 0xf9, 0x7e, 0x7b,
+	0x98, 0x4e, 0xf0, 0x39, 0xb9, 0x2c, 0x42, 0xcc, 0x93, 0x35, 0x97, 0xf8, 0x5f, 0x74, 0xeb, 0x01,
+	0x3e, 0x9d, 0x54, 0x42, 0xa0, 0x9b, 0xd7, 0xa0, 0xac, 0x99, 0x08, 0x39, 0x3e, 0x3b, 0xc6, 0xa6,
+	0x6e, 0x90, 0x60, 0x44, 0xae, 0x01, 0x1f, 0xb0, 0xa7, 0x35, 0x95, 0x9c, 0xe1, 0x0a, 0x79, 0x05,
+	0x9f, 0x1d, 0xd0, 0x07, 0xce, 0x3c, 0x87, 0x26, 0x9c, 0xe1, 0xea, 0xed, 0x3d, 0x34, 0x77, 0xaf,
+	0x40, 0x0c, 0xb8, 0xde, 0x9d, 0x47, 0x4e, 0xe2, 0x6d, 0xf9, 0x98, 0x3a, 0x9b, 0x34, 0xca, 0x13,
+	0xed, 0x98, 0xfb, 0x1f, 0x87, 0x77, 0x94, 0x61, 0x34, 0x7e, 0x0b, 0xdf, 0x38, 0x22, 0xb0, 0xfe,
+	0xe4, 0x8c, 0x33, 0x6a, 0x39, 0xbe, 0x48, 0x99, 0x95, 0xc6, 0x5c, 0x6e, 0x3d, 0x87, 0xe7, 0x7f,
+	0x84, 0x3f, 0x5e, 0xbb, 0x5e, 0xb2, 0x4e, 0x57, 0x96, 0x23, 0x82, 0x81, 0xff, 0xf2, 0x03, 0x67,
+	0x2e, 0x1f, 0xf0, 0x2d, 0x1f, 0xd0, 0xc8, 0x1b, 0xb8, 0x62, 0xe0, 0x88, 0xf0, 0xc5, 0x73, 0x57,
+	0x0d, 0xed, 0x7c, 0xf7, 0xdf, 0x00, 0x45, 0xe6, 0x5a, 0x46, 0x50, 0x06, 0x00, 0x00,
 }
@@ -384,10 +384,19 @@ type NetworkInstanceConfig struct {
 	// network ip specification
 	Ip *Ipspec `protobuf:"bytes,40,opt,name=ip,proto3" json:"ip,omitempty"`
 	// static DNS entry, if we are running DNS/DHCP service
-	Dns                  []*ZnetStaticDNSEntry `protobuf:"bytes,41,rep,name=dns,proto3" json:"dns,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Dns []*ZnetStaticDNSEntry `protobuf:"bytes,41,rep,name=dns,proto3" json:"dns,omitempty"`
+	// vlanId - For Switch and Local the network instance is attached to
+	//    this VLAN on the port instead of the untagged traffic.
+	//    Zero means untagged.
+	VlanId uint32 `protobuf:"varint,42,opt,name=vlanId,proto3" json:"vlanId,omitempty"`
+	// ip6 - Optional IPv6 specification which makes a Local network
+	//    instance with the IPV4 ipType dual-stack. The subnet must be a /64.
+	//    The applications get their EUI-64 address using SLAAC or DHCPv6
+	//    and reach the port using NAT66.
+	Ip6                  *Ipspec  `protobuf:"bytes,43,opt,name=ip6,proto3" json:"ip6,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *NetworkInstanceConfig) Reset()         { *m = NetworkInstanceConfig{} }
@@ -478,6 +487,20 @@ func (m *NetworkInstanceConfig) GetDns() []*ZnetStaticDNSEntry {
 	return nil
 }
 
+func (m *NetworkInstanceConfig) GetVlanId() uint32 {
+	if m != nil {
+		return m.VlanId
+	}
+	return 0
+}
+
+func (m *NetworkInstanceConfig) GetIp6() *Ipspec {
+	if m != nil {
+		return m.Ip6
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ZNetworkInstType", ZNetworkInstType_name, ZNetworkInstType_value)
 	proto.RegisterEnum("AddressType", AddressType_name, AddressType_value)
@@ -492,55 +515,57 @@ func init() {
 func init() { proto.RegisterFile("netinst.proto", fileDescriptor_5d61ed8cf2f4078e) }
 
 var fileDescriptor_5d61ed8cf2f4078e = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x6f, 0x6f, 0xdb, 0x36,
-	0x10, 0xc6, 0x23, 0xdb, 0x71, 0xec, 0xf3, 0x9f, 0x30, 0x4c, 0x87, 0xaa, 0x59, 0xd1, 0x19, 0x46,
-	0xd6, 0xb9, 0x01, 0x22, 0x0f, 0xd9, 0xd0, 0x01, 0x7b, 0xd7, 0xa5, 0x5b, 0x67, 0x20, 0x75, 0x02,
-	0x39, 0xcd, 0x80, 0xbc, 0x63, 0xa5, 0xb3, 0x43, 0x4c, 0x22, 0x39, 0x8a, 0x76, 0xe3, 0x7c, 0x9c,
-	0x7d, 0x84, 0xed, 0x93, 0xed, 0x13, 0x6c, 0x20, 0x25, 0x79, 0xb6, 0xb7, 0xf6, 0x9d, 0xee, 0x77,
-	0x8f, 0x4e, 0x77, 0x8f, 0x8e, 0x84, 0x8e, 0x40, 0xc3, 0x45, 0x66, 0x02, 0xa5, 0xa5, 0x91, 0x47,
-	0xfb, 0x31, 0x2e, 0x22, 0x99, 0xa6, 0x52, 0x14, 0xa0, 0x2d, 0xd0, 0x44, 0x69, 0x11, 0xf5, 0x7f,
-	0xf7, 0xe0, 0xf3, 0x31, 0x9a, 0x0f, 0x52, 0xff, 0x3a, 0x12, 0x99, 0x61, 0x22, 0xc2, 0x4b, 0xc5,
-	0x7e, 0x9b, 0xe3, 0xb9, 0x14, 0x53, 0x3e, 0xa3, 0x3e, 0xec, 0xc9, 0xc8, 0x3d, 0xfa, 0x5e, 0xcf,
-	0x1b, 0x34, 0xc3, 0x32, 0xa4, 0xdf, 0x03, 0x24, 0x3c, 0x53, 0xb9, 0xce, 0xaf, 0xf4, 0xbc, 0x41,
-	0xeb, 0xec, 0x28, 0xd8, 0xaa, 0x75, 0xb1, 0x52, 0x84, 0x6b, 0x6a, 0x7a, 0x0a, 0x35, 0xb3, 0x54,
-	0xe8, 0x57, 0x7b, 0xde, 0xa0, 0x7b, 0xf6, 0x24, 0xb8, 0x2d, 0x5e, 0x5b, 0xff, 0xf4, 0xf5, 0x52,
-	0x61, 0xe8, 0x64, 0x7d, 0x03, 0xdd, 0xdb, 0x68, 0x82, 0x7a, 0xc1, 0x23, 0xbc, 0x92, 0x5c, 0x18,
-	0xfa, 0x1c, 0xea, 0x0f, 0xd9, 0xf5, 0xbf, 0x25, 0xba, 0xc1, 0x4a, 0xe0, 0xde, 0x2b, 0xb2, 0xf4,
-	0x08, 0x1a, 0x63, 0x96, 0xe2, 0xa5, 0x1e, 0xa9, 0xa2, 0xff, 0x55, 0x4c, 0x9f, 0x01, 0x9c, 0x6b,
-	0x8c, 0x51, 0x18, 0xce, 0x12, 0x37, 0x40, 0x33, 0x5c, 0x23, 0xfd, 0x3f, 0x2a, 0xf0, 0xe4, 0xa3,
-	0xe3, 0xd0, 0x17, 0xb0, 0x67, 0xa3, 0xb7, 0x93, 0xcc, 0xf7, 0x7a, 0xd5, 0x41, 0xeb, 0x6c, 0x3f,
-	0xd8, 0xec, 0x31, 0x2c, 0xf3, 0xf4, 0x39, 0x74, 0xed, 0x63, 0x59, 0x64, 0x14, 0xbb, 0x8f, 0x75,
-	0xc2, 0x2d, 0x6a, 0x9b, 0x65, 0x49, 0x22, 0x23, 0x66, 0xf2, 0xb1, 0x1a, 0xe1, 0x2a, 0xa6, 0xc7,
-	0xd0, 0xc1, 0x7b, 0x25, 0xb5, 0x51, 0x9a, 0x2f, 0xac, 0xa0, 0xe6, 0x04, 0x9b, 0x90, 0x9e, 0x00,
-	0x29, 0xde, 0xe0, 0x52, 0x28, 0x8d, 0x53, 0x7e, 0xef, 0xef, 0xf6, 0xbc, 0x41, 0x3b, 0xfc, 0x0f,
-	0xa7, 0x5f, 0xc3, 0xe1, 0x36, 0x4b, 0x50, 0xf8, 0x75, 0xd7, 0xda, 0xff, 0xa5, 0x68, 0x1f, 0xda,
-	0x78, 0xaf, 0x50, 0xf3, 0x14, 0x85, 0x61, 0x89, 0xff, 0xc8, 0xb5, 0xb0, 0xc1, 0xfa, 0x7f, 0x55,
-	0xe0, 0xb3, 0x2d, 0xd3, 0x0a, 0xc3, 0xbe, 0x83, 0xee, 0x7c, 0xce, 0x63, 0x26, 0xe2, 0x05, 0xea,
-	0x8c, 0x4b, 0xe1, 0x7e, 0x88, 0xf5, 0xed, 0xdd, 0xbb, 0xd1, 0x6b, 0x26, 0xe2, 0x9b, 0x1c, 0x87,
-	0x5b, 0x32, 0xda, 0x83, 0x56, 0xcc, 0x33, 0x95, 0xb0, 0xa5, 0x60, 0x29, 0x16, 0x3f, 0x6a, 0x1d,
-	0xd1, 0x53, 0x68, 0xd8, 0x8d, 0x77, 0xfb, 0x50, 0x73, 0xfb, 0x70, 0xb0, 0x5a, 0xa9, 0x51, 0x91,
-	0x08, 0x57, 0x12, 0xe7, 0x73, 0x64, 0x72, 0x1b, 0x77, 0x0b, 0x9f, 0x8b, 0x98, 0x3e, 0x85, 0x9a,
-	0x35, 0xd4, 0xcd, 0xd6, 0x3a, 0x6b, 0x04, 0xaf, 0x62, 0xa6, 0x0c, 0xea, 0xd0, 0x51, 0x1a, 0x40,
-	0x35, 0x9a, 0xce, 0xfc, 0x67, 0x2e, 0xf9, 0x34, 0xf8, 0xc4, 0xc1, 0x09, 0xad, 0x90, 0x1e, 0x43,
-	0x9d, 0x2b, 0xd7, 0xd6, 0x57, 0xae, 0xad, 0x76, 0xf0, 0x2a, 0x8e, 0x35, 0x66, 0x59, 0xbe, 0xa4,
-	0x79, 0x8e, 0x3e, 0x86, 0x0a, 0x57, 0xfe, 0xc0, 0x15, 0xdd, 0x0b, 0xb8, 0xca, 0x14, 0x46, 0x61,
-	0x85, 0x2b, 0xfa, 0x25, 0x54, 0x63, 0x91, 0xf9, 0x2f, 0xdc, 0x7e, 0x1d, 0x06, 0xb7, 0x02, 0xcd,
-	0xc4, 0x30, 0xc3, 0xa3, 0xd7, 0xe3, 0xc9, 0x8f, 0xc2, 0xe8, 0x65, 0x68, 0xf3, 0x27, 0x7f, 0x7a,
-	0x40, 0xb6, 0xc7, 0xa5, 0x07, 0xd0, 0xb1, 0xcc, 0xc6, 0x3f, 0x71, 0x9d, 0x19, 0xb2, 0x43, 0x29,
-	0x74, 0x6d, 0x09, 0x8b, 0x26, 0x1f, 0xb8, 0x89, 0xee, 0x88, 0xe7, 0x64, 0x05, 0xbb, 0x90, 0x11,
-	0x4b, 0x48, 0x65, 0x1d, 0x9d, 0x27, 0x72, 0x1e, 0x93, 0x2a, 0x25, 0xd0, 0x2e, 0xd1, 0x5b, 0xcc,
-	0xee, 0x48, 0x8d, 0x3e, 0x02, 0x52, 0x92, 0x9f, 0xa5, 0xc0, 0xe5, 0x95, 0x34, 0x64, 0x97, 0x3e,
-	0x86, 0xc3, 0x92, 0x5e, 0x6b, 0x26, 0x32, 0xc5, 0x34, 0x0a, 0x43, 0xea, 0xf4, 0x00, 0xda, 0x65,
-	0x37, 0x17, 0x2c, 0x33, 0xe4, 0x6f, 0xef, 0xe4, 0x17, 0x68, 0xad, 0x99, 0x41, 0x9b, 0xb0, 0x5b,
-	0xf6, 0xd9, 0x80, 0xda, 0xe8, 0xea, 0xe6, 0x5b, 0xe2, 0x15, 0x4f, 0x2f, 0x49, 0x85, 0x76, 0xed,
-	0x61, 0x5d, 0x2a, 0x23, 0x5d, 0xa6, 0xba, 0x11, 0xbf, 0x24, 0x35, 0xda, 0x84, 0x5a, 0x59, 0xf8,
-	0x1c, 0xfc, 0x8f, 0xdd, 0x27, 0xce, 0x82, 0x31, 0x9a, 0xcb, 0x1c, 0xdd, 0x5c, 0x8d, 0xc9, 0x0e,
-	0x3d, 0x84, 0xfd, 0x35, 0x66, 0xcf, 0x24, 0xf1, 0x4e, 0xde, 0x40, 0x67, 0xe3, 0x46, 0xb1, 0x03,
-	0x3f, 0x44, 0xd6, 0x8e, 0x91, 0x58, 0xb0, 0x84, 0xc7, 0x13, 0xbd, 0x20, 0x3b, 0xb4, 0x03, 0xcd,
-	0x94, 0x29, 0xab, 0x43, 0x9d, 0xbb, 0x99, 0xcd, 0x95, 0x5d, 0x95, 0x02, 0x55, 0x7e, 0x78, 0x03,
-	0x5f, 0x44, 0x32, 0x0d, 0x1e, 0x30, 0xc6, 0x98, 0x05, 0xae, 0x42, 0x30, 0xcf, 0xf2, 0xc2, 0xf9,
-	0x1d, 0x7c, 0x7b, 0x3c, 0xe3, 0xe6, 0x6e, 0xfe, 0x3e, 0x88, 0x64, 0x3a, 0x4c, 0xa6, 0xa7, 0x18,
-	0xcf, 0x70, 0x88, 0x0b, 0x1c, 0x32, 0xc5, 0x87, 0x33, 0x39, 0xcc, 0xef, 0xdb, 0xf7, 0x75, 0x27,
-	0xfe, 0xe6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xae, 0x3c, 0xaf, 0xe0, 0x05, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x6f, 0x6f, 0x23, 0xb5,
+	0x13, 0xc7, 0xbb, 0x49, 0x9a, 0x26, 0x93, 0x3f, 0x75, 0xdd, 0xfb, 0xfd, 0x6e, 0x5b, 0x4e, 0x47,
+	0x14, 0x95, 0x23, 0x17, 0xd4, 0x0d, 0x2a, 0xa8, 0x48, 0x3c, 0x3b, 0x7a, 0x70, 0x44, 0xea, 0xa5,
+	0xd5, 0xa6, 0x57, 0xa4, 0x3c, 0xf3, 0xed, 0x4e, 0x53, 0x8b, 0x8d, 0x6d, 0xbc, 0x4e, 0xae, 0xe9,
+	0xcb, 0x41, 0xbc, 0x02, 0x78, 0x81, 0x20, 0x7b, 0x77, 0x43, 0x12, 0x38, 0x9e, 0xed, 0x7c, 0xe6,
+	0xeb, 0xd9, 0xf1, 0xd7, 0x63, 0x43, 0x4b, 0xa0, 0xe1, 0x22, 0x35, 0x81, 0xd2, 0xd2, 0xc8, 0xe3,
+	0xfd, 0x18, 0x17, 0x91, 0x9c, 0xcd, 0xa4, 0xc8, 0x41, 0x53, 0xa0, 0x89, 0x66, 0x79, 0xd4, 0xfd,
+	0xd5, 0x83, 0x4f, 0x46, 0x68, 0x3e, 0x48, 0xfd, 0xf3, 0x50, 0xa4, 0x86, 0x89, 0x08, 0xaf, 0x14,
+	0xfb, 0x65, 0x8e, 0x17, 0x52, 0xdc, 0xf1, 0x29, 0xf5, 0x61, 0x4f, 0x46, 0xee, 0xd3, 0xf7, 0x3a,
+	0x5e, 0xaf, 0x1e, 0x16, 0x21, 0xfd, 0x16, 0x20, 0xe1, 0xa9, 0xca, 0x74, 0x7e, 0xa9, 0xe3, 0xf5,
+	0x1a, 0x67, 0xc7, 0xc1, 0x56, 0xad, 0xcb, 0x95, 0x22, 0x5c, 0x53, 0xd3, 0x53, 0xa8, 0x98, 0xa5,
+	0x42, 0xbf, 0xdc, 0xf1, 0x7a, 0xed, 0xb3, 0xa3, 0x60, 0x92, 0x2f, 0x5b, 0xff, 0xf5, 0xcd, 0x52,
+	0x61, 0xe8, 0x64, 0x5d, 0x03, 0xed, 0x49, 0x34, 0x46, 0xbd, 0xe0, 0x11, 0x5e, 0x4b, 0x2e, 0x0c,
+	0x7d, 0x01, 0xd5, 0xc7, 0xf4, 0xe6, 0xef, 0x12, 0xed, 0x60, 0x25, 0x70, 0xeb, 0xf2, 0x2c, 0x3d,
+	0x86, 0xda, 0x88, 0xcd, 0xf0, 0x4a, 0x0f, 0x55, 0xde, 0xff, 0x2a, 0xa6, 0xcf, 0x01, 0x2e, 0x34,
+	0xc6, 0x28, 0x0c, 0x67, 0x89, 0xdb, 0x40, 0x3d, 0x5c, 0x23, 0xdd, 0xdf, 0x4b, 0x70, 0xf4, 0xd1,
+	0xed, 0xd0, 0x97, 0xb0, 0x67, 0xa3, 0xb7, 0xe3, 0xd4, 0xf7, 0x3a, 0xe5, 0x5e, 0xe3, 0x6c, 0x3f,
+	0xd8, 0xec, 0x31, 0x2c, 0xf2, 0xf4, 0x05, 0xb4, 0xed, 0x67, 0x51, 0x64, 0x18, 0xbb, 0x9f, 0xb5,
+	0xc2, 0x2d, 0x6a, 0x9b, 0x65, 0x49, 0x22, 0x23, 0x66, 0xb2, 0x6d, 0xd5, 0xc2, 0x55, 0x4c, 0x4f,
+	0xa0, 0x85, 0x0f, 0x4a, 0x6a, 0xa3, 0x34, 0x5f, 0x58, 0x41, 0xc5, 0x09, 0x36, 0x21, 0xed, 0x03,
+	0xc9, 0x57, 0x70, 0x29, 0x94, 0xc6, 0x3b, 0xfe, 0xe0, 0xef, 0x76, 0xbc, 0x5e, 0x33, 0xfc, 0x07,
+	0xa7, 0x5f, 0xc2, 0xe1, 0x36, 0x4b, 0x50, 0xf8, 0x55, 0xd7, 0xda, 0xbf, 0xa5, 0x68, 0x17, 0x9a,
+	0xf8, 0xa0, 0x50, 0xf3, 0x19, 0x0a, 0xc3, 0x12, 0xff, 0x89, 0x6b, 0x61, 0x83, 0x75, 0x7f, 0x2b,
+	0xc3, 0xff, 0xb6, 0x4c, 0xcb, 0x0d, 0xfb, 0x06, 0xda, 0xf3, 0x39, 0x8f, 0x99, 0x88, 0x17, 0xa8,
+	0x53, 0x2e, 0x85, 0x3b, 0x10, 0xeb, 0xdb, 0xbb, 0x77, 0xc3, 0xd7, 0x4c, 0xc4, 0xb7, 0x19, 0x0e,
+	0xb7, 0x64, 0xb4, 0x03, 0x8d, 0x98, 0xa7, 0x2a, 0x61, 0x4b, 0xc1, 0x66, 0x98, 0x1f, 0xd4, 0x3a,
+	0xa2, 0xa7, 0x50, 0xb3, 0x13, 0xef, 0xe6, 0xa1, 0xe2, 0xe6, 0xe1, 0x20, 0x98, 0xac, 0x75, 0xe1,
+	0x46, 0x62, 0x25, 0x71, 0x3e, 0x47, 0x26, 0xb3, 0x71, 0x37, 0xf7, 0x39, 0x8f, 0xe9, 0x33, 0xa8,
+	0x58, 0x43, 0xdd, 0xde, 0x1a, 0x67, 0xb5, 0xe0, 0x55, 0xcc, 0x94, 0x41, 0x1d, 0x3a, 0x4a, 0x03,
+	0x28, 0x47, 0x77, 0x53, 0xff, 0xb9, 0x4b, 0x3e, 0x0b, 0xfe, 0xe3, 0xe2, 0x84, 0x56, 0x48, 0x4f,
+	0xa0, 0xca, 0x95, 0x6b, 0xeb, 0x73, 0xd7, 0x56, 0x33, 0x78, 0x15, 0xc7, 0x1a, 0xd3, 0x34, 0x1b,
+	0xd2, 0x2c, 0x47, 0x9f, 0x42, 0x89, 0x2b, 0xbf, 0xe7, 0x8a, 0xee, 0x05, 0x5c, 0xa5, 0x0a, 0xa3,
+	0xb0, 0xc4, 0x15, 0xfd, 0x0c, 0xca, 0xb1, 0x48, 0xfd, 0x97, 0x6e, 0xbe, 0x0e, 0x83, 0x89, 0x40,
+	0x33, 0x36, 0xcc, 0xf0, 0xe8, 0xf5, 0x68, 0xfc, 0xbd, 0x30, 0x7a, 0x19, 0xda, 0x3c, 0xfd, 0x3f,
+	0x54, 0x17, 0x09, 0x13, 0xc3, 0xd8, 0xef, 0xbb, 0xc3, 0xcb, 0x23, 0x7a, 0x04, 0x65, 0xae, 0xce,
+	0xfd, 0x2f, 0x36, 0x0b, 0x5b, 0xd6, 0xff, 0xc3, 0x03, 0xb2, 0xed, 0x10, 0x3d, 0x80, 0x96, 0x65,
+	0x36, 0xfe, 0x81, 0xeb, 0xd4, 0x90, 0x1d, 0x4a, 0xa1, 0x3d, 0x11, 0x19, 0x1a, 0x7f, 0xe0, 0x26,
+	0xba, 0x27, 0x9e, 0x93, 0xe5, 0xec, 0x52, 0x46, 0x2c, 0x21, 0xa5, 0x75, 0x74, 0x91, 0xc8, 0x79,
+	0x4c, 0xca, 0x94, 0x40, 0xb3, 0x40, 0x6f, 0x31, 0xbd, 0x27, 0x15, 0xfa, 0x04, 0x48, 0x41, 0x7e,
+	0x94, 0x02, 0x97, 0xd7, 0xd2, 0x90, 0x5d, 0xfa, 0x14, 0x0e, 0x0b, 0x7a, 0xa3, 0x99, 0x48, 0x15,
+	0xd3, 0x28, 0x0c, 0xa9, 0xd2, 0x03, 0x68, 0x16, 0xdd, 0x5c, 0xb2, 0xd4, 0x90, 0x3f, 0xbd, 0xfe,
+	0x4f, 0xd0, 0x58, 0xf3, 0x8f, 0xd6, 0x61, 0xb7, 0xe8, 0xb3, 0x06, 0x95, 0xe1, 0xf5, 0xed, 0xd7,
+	0xc4, 0xcb, 0xbf, 0xce, 0x49, 0x89, 0xb6, 0xed, 0xfd, 0x5e, 0x2a, 0x23, 0x5d, 0xa6, 0xbc, 0x11,
+	0x9f, 0x93, 0x0a, 0xad, 0x43, 0xa5, 0x28, 0x7c, 0x01, 0xfe, 0xc7, 0x9e, 0x20, 0x67, 0xc1, 0x08,
+	0xcd, 0x55, 0x86, 0x6e, 0xaf, 0x47, 0x64, 0x87, 0x1e, 0xc2, 0xfe, 0x1a, 0xb3, 0xd7, 0x98, 0x78,
+	0xfd, 0x37, 0xd0, 0xda, 0x78, 0x84, 0xec, 0x86, 0x1f, 0x23, 0x6b, 0xc7, 0x50, 0x2c, 0x58, 0xc2,
+	0xe3, 0xb1, 0x5e, 0x90, 0x1d, 0xda, 0x82, 0xfa, 0x8c, 0x29, 0xab, 0x43, 0x9d, 0xb9, 0x99, 0xce,
+	0x95, 0x9d, 0xae, 0x1c, 0x95, 0xbe, 0x7b, 0x03, 0x9f, 0x46, 0x72, 0x16, 0x3c, 0x62, 0x8c, 0x31,
+	0x0b, 0x5c, 0x85, 0x60, 0x9e, 0x66, 0x85, 0xb3, 0x67, 0x7b, 0x72, 0x32, 0xe5, 0xe6, 0x7e, 0xfe,
+	0x3e, 0x88, 0xe4, 0x6c, 0x90, 0xdc, 0x9d, 0x62, 0x3c, 0xc5, 0x01, 0x2e, 0x70, 0xc0, 0x14, 0x1f,
+	0x4c, 0xe5, 0x20, 0x7b, 0xa2, 0xdf, 0x57, 0x9d, 0xf8, 0xab, 0xbf, 0x06, 0x00, 0x78, 0x49, 0xbe,
+	0x0d, 0x13, 0x06, 0x00, 0x00,
 }
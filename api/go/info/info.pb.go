@@ -349,6 +349,43 @@ func (ZInfoVpnState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f140d5b28dddb141, []int{7}
 }
 
+type ZAppHealthState int32
+
+const (
+	ZAppHealthState_APP_HEALTH_UNKNOWN    ZAppHealthState = 0
+	ZAppHealthState_APP_HEALTH_STARTING   ZAppHealthState = 1
+	ZAppHealthState_APP_HEALTH_HEALTHY    ZAppHealthState = 2
+	ZAppHealthState_APP_HEALTH_UNHEALTHY  ZAppHealthState = 3
+	ZAppHealthState_APP_HEALTH_RESTARTING ZAppHealthState = 4
+	ZAppHealthState_APP_HEALTH_FAILED     ZAppHealthState = 5
+)
+
+var ZAppHealthState_name = map[int32]string{
+	0: "APP_HEALTH_UNKNOWN",
+	1: "APP_HEALTH_STARTING",
+	2: "APP_HEALTH_HEALTHY",
+	3: "APP_HEALTH_UNHEALTHY",
+	4: "APP_HEALTH_RESTARTING",
+	5: "APP_HEALTH_FAILED",
+}
+
+var ZAppHealthState_value = map[string]int32{
+	"APP_HEALTH_UNKNOWN":    0,
+	"APP_HEALTH_STARTING":   1,
+	"APP_HEALTH_HEALTHY":    2,
+	"APP_HEALTH_UNHEALTHY":  3,
+	"APP_HEALTH_RESTARTING": 4,
+	"APP_HEALTH_FAILED":     5,
+}
+
+func (x ZAppHealthState) String() string {
+	return proto.EnumName(ZAppHealthState_name, int32(x))
+}
+
+func (ZAppHealthState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f140d5b28dddb141, []int{8}
+}
+
 // Open-ended metrics from different part of the device such as LTE modem
 // metrics.
 type DeprecatedMetricItem struct {
@@ -1582,18 +1619,20 @@ type DevicePort struct {
 	IsMgmt bool   `protobuf:"varint,3,opt,name=isMgmt,proto3" json:"isMgmt,omitempty"`
 	Free   bool   `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
 	// DhcpConfig
-	DhcpType             uint32       `protobuf:"varint,11,opt,name=dhcpType,proto3" json:"dhcpType,omitempty"`
-	Subnet               string       `protobuf:"bytes,12,opt,name=subnet,proto3" json:"subnet,omitempty"`
-	Gateway              string       `protobuf:"bytes,13,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Domainname           string       `protobuf:"bytes,14,opt,name=domainname,proto3" json:"domainname,omitempty"`
-	NtpServer            string       `protobuf:"bytes,15,opt,name=ntpServer,proto3" json:"ntpServer,omitempty"`
-	DnsServers           []string     `protobuf:"bytes,16,rep,name=dnsServers,proto3" json:"dnsServers,omitempty"`
-	DhcpRangeLow         string       `protobuf:"bytes,17,opt,name=dhcpRangeLow,proto3" json:"dhcpRangeLow,omitempty"`
-	DhcpRangeHigh        string       `protobuf:"bytes,18,opt,name=dhcpRangeHigh,proto3" json:"dhcpRangeHigh,omitempty"`
-	Proxy                *ProxyStatus `protobuf:"bytes,21,opt,name=proxy,proto3" json:"proxy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	DhcpType      uint32       `protobuf:"varint,11,opt,name=dhcpType,proto3" json:"dhcpType,omitempty"`
+	Subnet        string       `protobuf:"bytes,12,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway       string       `protobuf:"bytes,13,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Domainname    string       `protobuf:"bytes,14,opt,name=domainname,proto3" json:"domainname,omitempty"`
+	NtpServer     string       `protobuf:"bytes,15,opt,name=ntpServer,proto3" json:"ntpServer,omitempty"`
+	DnsServers    []string     `protobuf:"bytes,16,rep,name=dnsServers,proto3" json:"dnsServers,omitempty"`
+	DhcpRangeLow  string       `protobuf:"bytes,17,opt,name=dhcpRangeLow,proto3" json:"dhcpRangeLow,omitempty"`
+	DhcpRangeHigh string       `protobuf:"bytes,18,opt,name=dhcpRangeHigh,proto3" json:"dhcpRangeHigh,omitempty"`
+	Proxy         *ProxyStatus `protobuf:"bytes,21,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// Set for a bond; the link state of the aggregated interfaces
+	BondMembers          []*BondMemberInfo `protobuf:"bytes,22,rep,name=bondMembers,proto3" json:"bondMembers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DevicePort) Reset()         { *m = DevicePort{} }
@@ -1712,6 +1751,13 @@ func (m *DevicePort) GetProxy() *ProxyStatus {
 	return nil
 }
 
+func (m *DevicePort) GetBondMembers() []*BondMemberInfo {
+	if m != nil {
+		return m.BondMembers
+	}
+	return nil
+}
+
 type ProxyStatus struct {
 	Proxies              []*ProxyEntry `protobuf:"bytes,1,rep,name=proxies,proto3" json:"proxies,omitempty"`
 	Exceptions           string        `protobuf:"bytes,2,opt,name=exceptions,proto3" json:"exceptions,omitempty"`
@@ -2059,6 +2105,7 @@ type ZInfoApp struct {
 	AppErr               []*ErrorInfo         `protobuf:"bytes,14,rep,name=appErr,proto3" json:"appErr,omitempty"`
 	State                ZSwState             `protobuf:"varint,15,opt,name=state,proto3,enum=ZSwState" json:"state,omitempty"`
 	Network              []*ZInfoNetwork      `protobuf:"bytes,16,rep,name=network,proto3" json:"network,omitempty"`
+	Health               *ZInfoAppHealth      `protobuf:"bytes,17,opt,name=health,proto3" json:"health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2159,6 +2206,13 @@ func (m *ZInfoApp) GetNetwork() []*ZInfoNetwork {
 	return nil
 }
 
+func (m *ZInfoApp) GetHealth() *ZInfoAppHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
 // tunnel link details
 type ZInfoVpnLinkInfo struct {
 	SpiId                string   `protobuf:"bytes,1,opt,name=spiId,proto3" json:"spiId,omitempty"`
@@ -3139,6 +3193,149 @@ func (*ZInfoMsg) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Outcome of the health checks of an app instance
+type ZInfoAppHealth struct {
+	State                ZAppHealthState      `protobuf:"varint,1,opt,name=state,proto3,enum=ZAppHealthState" json:"state,omitempty"`
+	LastProbeTime        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=lastProbeTime,proto3" json:"lastProbeTime,omitempty"`
+	LastProbeError       string               `protobuf:"bytes,3,opt,name=lastProbeError,proto3" json:"lastProbeError,omitempty"`
+	ConsecutiveFailures  uint32               `protobuf:"varint,4,opt,name=consecutiveFailures,proto3" json:"consecutiveFailures,omitempty"`
+	RestartCount         uint32               `protobuf:"varint,5,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	LastRestartTime      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastRestartTime,proto3" json:"lastRestartTime,omitempty"`
+	NextRestartTime      *timestamp.Timestamp `protobuf:"bytes,7,opt,name=nextRestartTime,proto3" json:"nextRestartTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ZInfoAppHealth) Reset()         { *m = ZInfoAppHealth{} }
+func (m *ZInfoAppHealth) String() string { return proto.CompactTextString(m) }
+func (*ZInfoAppHealth) ProtoMessage()    {}
+func (*ZInfoAppHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f140d5b28dddb141, []int{32}
+}
+
+func (m *ZInfoAppHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZInfoAppHealth.Unmarshal(m, b)
+}
+func (m *ZInfoAppHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZInfoAppHealth.Marshal(b, m, deterministic)
+}
+func (m *ZInfoAppHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZInfoAppHealth.Merge(m, src)
+}
+func (m *ZInfoAppHealth) XXX_Size() int {
+	return xxx_messageInfo_ZInfoAppHealth.Size(m)
+}
+func (m *ZInfoAppHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZInfoAppHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZInfoAppHealth proto.InternalMessageInfo
+
+func (m *ZInfoAppHealth) GetState() ZAppHealthState {
+	if m != nil {
+		return m.State
+	}
+	return ZAppHealthState_APP_HEALTH_UNKNOWN
+}
+
+func (m *ZInfoAppHealth) GetLastProbeTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastProbeTime
+	}
+	return nil
+}
+
+func (m *ZInfoAppHealth) GetLastProbeError() string {
+	if m != nil {
+		return m.LastProbeError
+	}
+	return ""
+}
+
+func (m *ZInfoAppHealth) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *ZInfoAppHealth) GetRestartCount() uint32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
+func (m *ZInfoAppHealth) GetLastRestartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastRestartTime
+	}
+	return nil
+}
+
+func (m *ZInfoAppHealth) GetNextRestartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRestartTime
+	}
+	return nil
+}
+
+type BondMemberInfo struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Up                   bool     `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`
+	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BondMemberInfo) Reset()         { *m = BondMemberInfo{} }
+func (m *BondMemberInfo) String() string { return proto.CompactTextString(m) }
+func (*BondMemberInfo) ProtoMessage()    {}
+func (*BondMemberInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f140d5b28dddb141, []int{33}
+}
+
+func (m *BondMemberInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BondMemberInfo.Unmarshal(m, b)
+}
+func (m *BondMemberInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BondMemberInfo.Marshal(b, m, deterministic)
+}
+func (m *BondMemberInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondMemberInfo.Merge(m, src)
+}
+func (m *BondMemberInfo) XXX_Size() int {
+	return xxx_messageInfo_BondMemberInfo.Size(m)
+}
+func (m *BondMemberInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondMemberInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondMemberInfo proto.InternalMessageInfo
+
+func (m *BondMemberInfo) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *BondMemberInfo) GetUp() bool {
+	if m != nil {
+		return m.Up
+	}
+	return false
+}
+
+func (m *BondMemberInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("DepMetricItemType", DepMetricItemType_name, DepMetricItemType_value)
 	proto.RegisterEnum("ZInfoTypes", ZInfoTypes_name, ZInfoTypes_value)
@@ -3148,6 +3345,7 @@ func init() {
 	proto.RegisterEnum("BaseOsStatus", BaseOsStatus_name, BaseOsStatus_value)
 	proto.RegisterEnum("BaseOsSubStatus", BaseOsSubStatus_name, BaseOsSubStatus_value)
 	proto.RegisterEnum("ZInfoVpnState", ZInfoVpnState_name, ZInfoVpnState_value)
+	proto.RegisterEnum("ZAppHealthState", ZAppHealthState_name, ZAppHealthState_value)
 	proto.RegisterType((*DeprecatedMetricItem)(nil), "deprecatedMetricItem")
 	proto.RegisterType((*ZmetIPAssignmentEntry)(nil), "ZmetIPAssignmentEntry")
 	proto.RegisterType((*ZmetVifInfo)(nil), "ZmetVifInfo")
@@ -3180,229 +3378,244 @@ func init() {
 	proto.RegisterType((*ZInfoLisp)(nil), "ZInfoLisp")
 	proto.RegisterType((*ZInfoNetworkInstance)(nil), "ZInfoNetworkInstance")
 	proto.RegisterType((*ZInfoMsg)(nil), "ZInfoMsg")
+	proto.RegisterType((*ZInfoAppHealth)(nil), "ZInfoAppHealth")
+	proto.RegisterType((*BondMemberInfo)(nil), "BondMemberInfo")
 }
 
 func init() { proto.RegisterFile("info.proto", fileDescriptor_f140d5b28dddb141) }
 
 var fileDescriptor_f140d5b28dddb141 = []byte{
-	// 3495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4f, 0x6f, 0xe3, 0x48,
-	0x76, 0xb7, 0x64, 0x49, 0x96, 0x9e, 0x2c, 0x9b, 0xae, 0x71, 0xf7, 0x68, 0x67, 0x07, 0xdd, 0x6e,
-	0xce, 0x66, 0xd7, 0x31, 0x76, 0xe4, 0xa0, 0x77, 0x33, 0x19, 0x2c, 0x36, 0x41, 0x64, 0x4b, 0xdd,
-	0x26, 0x5a, 0xa6, 0x8d, 0x92, 0xed, 0xc6, 0x18, 0x48, 0x1a, 0x34, 0x59, 0x92, 0x89, 0x96, 0x48,
-	0x2e, 0x59, 0xb4, 0xc7, 0x7b, 0x5e, 0x20, 0x40, 0x10, 0x20, 0x08, 0x72, 0xc8, 0x27, 0xc8, 0x57,
-	0x48, 0x72, 0xc9, 0x35, 0xa7, 0x9c, 0x83, 0x1c, 0x82, 0x1c, 0xf2, 0x21, 0x72, 0x4c, 0x16, 0xef,
-	0x55, 0xf1, 0x8f, 0x24, 0x77, 0xf7, 0xee, 0x8d, 0xef, 0xf7, 0x5e, 0xfd, 0x79, 0x7f, 0xea, 0xbd,
-	0x57, 0x45, 0x00, 0x3f, 0x98, 0x84, 0xbd, 0x28, 0x0e, 0x65, 0xf8, 0xc5, 0xf3, 0x69, 0x18, 0x4e,
-	0x67, 0xe2, 0x90, 0xa8, 0x9b, 0x74, 0x72, 0x28, 0xfd, 0xb9, 0x48, 0xa4, 0x33, 0x8f, 0x94, 0x80,
-	0xf9, 0x77, 0x55, 0xd8, 0xf5, 0x44, 0x14, 0x0b, 0xd7, 0x91, 0xc2, 0x3b, 0x15, 0x32, 0xf6, 0x5d,
-	0x4b, 0x8a, 0x39, 0x33, 0x60, 0xfd, 0xbd, 0x78, 0xe8, 0x56, 0xf6, 0x2a, 0xfb, 0x2d, 0x8e, 0x9f,
-	0xec, 0xc7, 0x50, 0x93, 0x0f, 0x91, 0xe8, 0x56, 0xf7, 0x2a, 0xfb, 0x5b, 0x2f, 0x59, 0x6f, 0x20,
-	0xa2, 0x42, 0xfe, 0xe2, 0x21, 0x12, 0x9c, 0xf8, 0xec, 0x19, 0xb4, 0x6e, 0xc2, 0x70, 0x76, 0xe5,
-	0xcc, 0x52, 0xd1, 0x5d, 0xdf, 0xab, 0xec, 0x37, 0x4f, 0xd6, 0x78, 0x01, 0x31, 0x13, 0xda, 0xa9,
-	0x1f, 0xc8, 0x9f, 0xbd, 0x54, 0x12, 0xb5, 0xbd, 0xca, 0x7e, 0xe7, 0x64, 0x8d, 0x97, 0xc1, 0x4c,
-	0xe6, 0x9b, 0x9f, 0x2b, 0x99, 0xfa, 0x5e, 0x65, 0xbf, 0x96, 0xc9, 0x68, 0x90, 0xed, 0x01, 0x4c,
-	0x66, 0xa1, 0x23, 0x95, 0x48, 0x63, 0xaf, 0xb2, 0x5f, 0x3d, 0x59, 0xe3, 0x25, 0x0c, 0x67, 0x49,
-	0x64, 0xec, 0x07, 0x53, 0x25, 0xb2, 0x81, 0xba, 0xe0, 0x2c, 0x25, 0xf0, 0x68, 0x07, 0xb6, 0xe7,
-	0xb9, 0x16, 0x04, 0x99, 0x97, 0xf0, 0xe4, 0x7a, 0x2e, 0xa4, 0x75, 0xde, 0x4f, 0x12, 0x7f, 0x1a,
-	0xcc, 0x45, 0x20, 0x87, 0x81, 0x8c, 0x1f, 0xd8, 0x33, 0x80, 0xb9, 0xe3, 0xf6, 0x3d, 0x2f, 0x16,
-	0x49, 0xa2, 0x4d, 0x53, 0x42, 0xd8, 0x97, 0xd0, 0xf2, 0xa3, 0x8c, 0x5d, 0xdd, 0x5b, 0xdf, 0x6f,
-	0xf1, 0x02, 0x30, 0xff, 0x02, 0xda, 0x38, 0xed, 0x95, 0x3f, 0xb1, 0x82, 0x49, 0xc8, 0xba, 0xb0,
-	0x71, 0xe7, 0x4f, 0x6c, 0x67, 0x2e, 0xf4, 0x4c, 0x19, 0xb9, 0xb4, 0x4c, 0x75, 0x65, 0x99, 0x5d,
-	0xa8, 0x3b, 0x51, 0x64, 0x0d, 0xc8, 0xb8, 0x2d, 0xae, 0x08, 0xf3, 0x3f, 0x2b, 0xd0, 0xba, 0xf6,
-	0xc3, 0xa3, 0x34, 0xf0, 0x66, 0x82, 0x3d, 0xd7, 0xce, 0xaa, 0x90, 0xb3, 0xda, 0x3d, 0xeb, 0xfc,
-	0xf6, 0xc1, 0x0a, 0x4b, 0x5e, 0x62, 0x50, 0x0b, 0x70, 0x6d, 0x35, 0x3d, 0x7d, 0xe3, 0x96, 0xe6,
-	0x62, 0x7e, 0x23, 0xe2, 0xa4, 0xbb, 0x4e, 0xbb, 0xcf, 0x48, 0xf6, 0x23, 0xe8, 0xa4, 0x89, 0xf0,
-	0x8e, 0x1e, 0xfa, 0x51, 0x74, 0x79, 0x69, 0x0d, 0xc8, 0x6b, 0x2d, 0xbe, 0x08, 0x32, 0x13, 0x36,
-	0x15, 0x70, 0xe4, 0x24, 0xe2, 0x6c, 0x4c, 0x6e, 0x6b, 0xf2, 0x05, 0x8c, 0xbd, 0x84, 0x8e, 0x1f,
-	0x6a, 0x4d, 0x46, 0x7e, 0x22, 0xbb, 0x8d, 0xbd, 0xf5, 0xfd, 0xf6, 0xcb, 0xcd, 0x9e, 0x95, 0xa1,
-	0x22, 0xe1, 0x8b, 0x22, 0xe6, 0xd7, 0xd0, 0x2e, 0x71, 0x3f, 0xe5, 0x06, 0xf3, 0x9f, 0xab, 0xb0,
-	0x73, 0x8d, 0x36, 0x3e, 0x75, 0x82, 0x74, 0xe2, 0xb8, 0x32, 0x8d, 0x45, 0x8c, 0x9b, 0x9b, 0x97,
-	0x68, 0x3d, 0x6e, 0x01, 0x63, 0x7b, 0xd0, 0x8e, 0xe2, 0xd0, 0x4b, 0x5d, 0x69, 0x17, 0xb6, 0x29,
-	0x43, 0xe4, 0x35, 0x11, 0x27, 0x7e, 0x18, 0x68, 0xeb, 0x67, 0x24, 0xce, 0x9f, 0x88, 0xd8, 0x77,
-	0x66, 0x76, 0x8a, 0x36, 0xd3, 0x16, 0x5a, 0xc0, 0xd0, 0xe8, 0x64, 0xbd, 0xba, 0x32, 0x3a, 0x19,
-	0xed, 0x19, 0x80, 0x1b, 0xce, 0x23, 0x47, 0xfa, 0x37, 0x33, 0x15, 0xc6, 0x2d, 0x5e, 0x42, 0x90,
-	0x7f, 0xe3, 0x87, 0xc9, 0x95, 0x08, 0xbc, 0x30, 0x56, 0x31, 0xcc, 0x4b, 0x08, 0xee, 0x59, 0x51,
-	0x6a, 0x57, 0x4d, 0xb5, 0xe7, 0x12, 0xc4, 0xf6, 0x61, 0x1b, 0x49, 0x2e, 0x66, 0xc2, 0x49, 0xc4,
-	0xc0, 0x91, 0xa2, 0xdb, 0x22, 0xa9, 0x65, 0xd8, 0xfc, 0xaf, 0x2a, 0x6c, 0x92, 0xe5, 0x6c, 0x21,
-	0xef, 0xc3, 0xf8, 0x3d, 0x45, 0x84, 0x32, 0x6c, 0xa6, 0xae, 0x26, 0x91, 0xe3, 0x89, 0x3b, 0x32,
-	0x93, 0xd2, 0x34, 0x23, 0x91, 0x63, 0x9d, 0xa3, 0x4c, 0xd2, 0xad, 0xab, 0x28, 0xd2, 0x24, 0xfb,
-	0x31, 0x6c, 0x79, 0x62, 0xe2, 0xa4, 0x33, 0xc9, 0xc3, 0x54, 0x62, 0x98, 0x35, 0x48, 0x60, 0x09,
-	0x65, 0x3f, 0x84, 0x75, 0x2f, 0x48, 0x48, 0xd7, 0xf6, 0xcb, 0x56, 0x8f, 0x76, 0x34, 0xb0, 0xc7,
-	0x1c, 0x51, 0xb6, 0x05, 0xd5, 0x34, 0x22, 0x35, 0x9b, 0xbc, 0x9a, 0x46, 0xec, 0x2b, 0x68, 0xce,
-	0x42, 0xd7, 0x91, 0xa8, 0x7c, 0x8b, 0x46, 0x6c, 0xf4, 0x5e, 0x8b, 0x70, 0x14, 0xba, 0x3c, 0x67,
-	0xb0, 0xa7, 0xd0, 0x48, 0xa3, 0x99, 0x1f, 0xbc, 0xef, 0x02, 0x0d, 0xd4, 0x14, 0x3b, 0x00, 0x08,
-	0x94, 0xaa, 0xc3, 0x38, 0xee, 0xb6, 0x69, 0x38, 0xf4, 0x86, 0x71, 0x1c, 0xc6, 0xb8, 0x28, 0x2f,
-	0x71, 0xf1, 0x74, 0xe3, 0x7c, 0x33, 0xd2, 0x79, 0x93, 0x74, 0x2e, 0x00, 0x66, 0x42, 0x3d, 0x8a,
-	0xc3, 0xef, 0x1f, 0xba, 0x1d, 0x9a, 0x64, 0xb3, 0x77, 0x8e, 0xd4, 0x58, 0x3a, 0x32, 0x4d, 0xb8,
-	0x62, 0x99, 0xff, 0x56, 0x81, 0x86, 0xda, 0x1a, 0x7a, 0xf5, 0x32, 0xf0, 0x44, 0x3c, 0x73, 0x1e,
-	0xac, 0xf3, 0x2c, 0x86, 0x0b, 0x84, 0x7d, 0x01, 0xcd, 0x93, 0x30, 0x91, 0xa5, 0x23, 0x9a, 0xd3,
-	0x18, 0x45, 0xc7, 0xbe, 0x7c, 0xd0, 0x1e, 0xa1, 0x6f, 0x54, 0x90, 0x8b, 0x29, 0xda, 0x40, 0x79,
-	0x43, 0x53, 0xe8, 0x8c, 0xe3, 0x30, 0xc5, 0xec, 0xa5, 0x83, 0x2e, 0x23, 0x31, 0xc1, 0x8f, 0x42,
-	0x57, 0x07, 0x1c, 0x7e, 0x22, 0x72, 0x16, 0x4f, 0x75, 0x88, 0xe1, 0x27, 0xce, 0x7a, 0x1e, 0x26,
-	0xd2, 0x99, 0xe9, 0xb0, 0xd2, 0x94, 0x39, 0x81, 0x66, 0xe6, 0x14, 0xd4, 0x64, 0x60, 0x8f, 0x13,
-	0x11, 0xe3, 0x41, 0xe8, 0x56, 0xc8, 0xa1, 0x25, 0x04, 0xcd, 0x36, 0xb0, 0xc7, 0x5e, 0x38, 0x77,
-	0xfc, 0x40, 0xab, 0x52, 0x00, 0x9a, 0x9b, 0x08, 0x27, 0x76, 0x6f, 0x75, 0xd2, 0x29, 0x00, 0xf3,
-	0x3f, 0x2a, 0xb0, 0x41, 0x0b, 0x8d, 0xdf, 0xa2, 0x64, 0x72, 0x9f, 0x45, 0xb9, 0x9e, 0x27, 0x07,
-	0x70, 0xa7, 0xc9, 0xfd, 0x89, 0x93, 0xdc, 0x6a, 0xab, 0x68, 0x8a, 0x3d, 0x87, 0x7a, 0x22, 0x31,
-	0xe2, 0x6b, 0x94, 0x08, 0x5b, 0xbd, 0xeb, 0xf1, 0x3d, 0x3a, 0x45, 0x70, 0x85, 0xe3, 0x40, 0xe9,
-	0xc4, 0x53, 0x21, 0xb5, 0x25, 0x34, 0x85, 0x46, 0xbe, 0xf3, 0xc4, 0x9d, 0xb6, 0x06, 0x7d, 0xb3,
-	0x03, 0x30, 0xbc, 0xf0, 0x3e, 0x98, 0x85, 0x8e, 0x77, 0x1e, 0x87, 0x53, 0x4a, 0x3f, 0x68, 0x98,
-	0x0e, 0x5f, 0xc1, 0xa9, 0x16, 0xcc, 0x9d, 0xa9, 0xa0, 0x68, 0x51, 0xc7, 0xad, 0x00, 0xcc, 0x29,
-	0xb4, 0xf2, 0x20, 0xc3, 0x13, 0xec, 0x89, 0xc4, 0x8d, 0xfd, 0x88, 0x82, 0x58, 0x05, 0x43, 0x19,
-	0x62, 0xdf, 0x42, 0x2b, 0x2f, 0xdc, 0xa4, 0x7b, 0xfb, 0xe5, 0x17, 0x3d, 0x55, 0xda, 0x7b, 0x59,
-	0x69, 0xef, 0x5d, 0x64, 0x12, 0xbc, 0x10, 0x36, 0xff, 0x6a, 0x03, 0xda, 0xca, 0x55, 0xe2, 0xce,
-	0x77, 0xb1, 0x68, 0xb6, 0xe7, 0x8e, 0x7b, 0xeb, 0x07, 0xa2, 0x8f, 0x16, 0x57, 0xc1, 0x52, 0x86,
-	0x30, 0x62, 0xdc, 0x28, 0x25, 0xae, 0x8e, 0x18, 0x4d, 0x62, 0x4c, 0x46, 0x33, 0x47, 0x4e, 0xc2,
-	0x78, 0xae, 0x8d, 0x95, 0xd3, 0x54, 0x4e, 0xdc, 0x28, 0x25, 0x73, 0x75, 0x38, 0x7d, 0xa3, 0x69,
-	0xe7, 0x62, 0x1e, 0xc6, 0x0f, 0x64, 0xa4, 0x1a, 0xd7, 0x14, 0xae, 0x90, 0xc8, 0x30, 0x76, 0xa6,
-	0xca, 0x30, 0x35, 0x9e, 0x91, 0x6c, 0x1f, 0xea, 0x73, 0xec, 0x5e, 0xf4, 0x49, 0x64, 0xbd, 0x95,
-	0x34, 0xce, 0x95, 0x00, 0xfb, 0x09, 0x6c, 0xe8, 0xa3, 0xd9, 0xed, 0x50, 0x01, 0xe9, 0xf4, 0xca,
-	0x89, 0x8b, 0x67, 0x5c, 0xf6, 0x0b, 0x60, 0x0e, 0x95, 0x71, 0xe7, 0x66, 0x26, 0xfa, 0x9e, 0x13,
-	0x51, 0xde, 0xd9, 0xa6, 0x31, 0xd0, 0xcb, 0x0b, 0x26, 0x7f, 0x44, 0x2a, 0xcb, 0x43, 0xc6, 0xa3,
-	0x79, 0xe8, 0x10, 0x9b, 0x0b, 0xda, 0x36, 0x95, 0xb1, 0x9d, 0xf2, 0x2e, 0xc6, 0x8a, 0xc1, 0xcb,
-	0x12, 0xec, 0x1b, 0x68, 0xde, 0x84, 0xa1, 0x44, 0x37, 0x75, 0xd9, 0x27, 0x7d, 0x98, 0xcb, 0xb2,
-	0xaf, 0x30, 0xb4, 0x69, 0x8d, 0xcf, 0x68, 0x8d, 0x76, 0x2f, 0x73, 0xe8, 0xf8, 0x2d, 0xd7, 0xac,
-	0x2c, 0x5f, 0x50, 0xb4, 0xed, 0x16, 0xf9, 0x82, 0x52, 0xd3, 0x9f, 0x40, 0xbb, 0x68, 0x71, 0x92,
-	0xee, 0x13, 0x9a, 0xe5, 0x49, 0xef, 0xb1, 0xb6, 0x8f, 0x97, 0x25, 0x31, 0xde, 0x67, 0x4e, 0x22,
-	0xb9, 0xc0, 0xbd, 0x70, 0xe1, 0x24, 0x61, 0xd0, 0x7d, 0x4a, 0x93, 0xaf, 0xe0, 0xec, 0x08, 0xb6,
-	0x0a, 0x8c, 0x74, 0xfc, 0xfc, 0x93, 0x3a, 0x2e, 0x8d, 0x60, 0xdf, 0x42, 0x27, 0x79, 0x48, 0xa4,
-	0x98, 0x6b, 0x0f, 0x74, 0xbb, 0x3a, 0x0c, 0xc6, 0x65, 0x94, 0x12, 0xf3, 0xa2, 0x20, 0x56, 0x96,
-	0x18, 0x27, 0x8d, 0x25, 0xa5, 0x37, 0x11, 0x77, 0x7f, 0x40, 0x81, 0xb8, 0x84, 0xb2, 0x3f, 0x86,
-	0xd6, 0xc9, 0xf8, 0x54, 0x65, 0xe5, 0xee, 0x17, 0x94, 0x12, 0x3e, 0xef, 0x9d, 0xdc, 0x8f, 0x85,
-	0x9b, 0xc6, 0xbe, 0x7c, 0x38, 0x0d, 0xbd, 0x74, 0x26, 0x74, 0xd2, 0x2e, 0x24, 0x31, 0x62, 0x4f,
-	0xc6, 0xa7, 0xb8, 0x70, 0xf7, 0x87, 0xea, 0x4c, 0x68, 0x12, 0x6b, 0x6b, 0xa1, 0xc4, 0x58, 0x3a,
-	0xee, 0xfb, 0xee, 0x97, 0xaa, 0xb6, 0x2e, 0xc1, 0xe6, 0x0d, 0xec, 0xac, 0xa8, 0x81, 0x4d, 0x83,
-	0x9b, 0xc6, 0xb1, 0x08, 0xa4, 0x15, 0x78, 0xe2, 0x7b, 0x3a, 0xfb, 0x1d, 0xbe, 0x80, 0xb1, 0x3f,
-	0x84, 0x46, 0xa2, 0x36, 0x5c, 0x25, 0xcf, 0xed, 0xf4, 0xd4, 0x59, 0x3e, 0x0f, 0x63, 0xa9, 0xb7,
-	0xaa, 0x05, 0xcc, 0x7f, 0xad, 0x82, 0xb1, 0xcc, 0x2c, 0xb7, 0x2c, 0x6a, 0xfa, 0xbc, 0x65, 0xd1,
-	0x3d, 0x7e, 0xb5, 0xe8, 0xf1, 0xff, 0x0c, 0x36, 0x31, 0x77, 0x9c, 0xc7, 0x7e, 0x18, 0x67, 0x25,
-	0xe6, 0xe3, 0x3e, 0x5c, 0x90, 0x67, 0xbf, 0x00, 0x40, 0xbd, 0x5f, 0x39, 0xfe, 0x4c, 0x78, 0x94,
-	0x5d, 0x3e, 0x3e, 0xba, 0x24, 0xcd, 0xfe, 0x1c, 0x3a, 0x48, 0x8d, 0x53, 0xd7, 0x15, 0xc2, 0x13,
-	0x1e, 0xa5, 0x9f, 0x8f, 0x0f, 0x5f, 0x1c, 0xc0, 0x5e, 0x40, 0x3d, 0x0a, 0x63, 0x99, 0xe8, 0x9e,
-	0xb2, 0x5d, 0x32, 0x14, 0x57, 0x1c, 0x2a, 0xe2, 0x4e, 0x22, 0x29, 0xf9, 0xea, 0xdc, 0x5e, 0x00,
-	0xe6, 0xff, 0x55, 0x01, 0x8a, 0x31, 0x98, 0xc0, 0xfc, 0x49, 0x50, 0x74, 0xe8, 0x9a, 0x7a, 0xb4,
-	0x77, 0x46, 0xd9, 0xe4, 0x74, 0x3a, 0x97, 0xea, 0xca, 0xc3, 0x35, 0x85, 0xb2, 0x93, 0x58, 0xa8,
-	0xfa, 0xd3, 0xe4, 0xf4, 0x8d, 0x87, 0xd5, 0xbb, 0x75, 0x23, 0xec, 0xc6, 0x29, 0xd3, 0x75, 0x78,
-	0x4e, 0x53, 0x21, 0x4b, 0x6f, 0x02, 0x21, 0x75, 0x8b, 0xa1, 0x29, 0xf4, 0xe2, 0xd4, 0x91, 0xe2,
-	0xde, 0x51, 0x1d, 0x46, 0x8b, 0x67, 0x24, 0x16, 0x60, 0x55, 0x4c, 0x69, 0x4f, 0x5b, 0xaa, 0x95,
-	0x28, 0x10, 0x54, 0x39, 0x90, 0xd1, 0x98, 0xca, 0x71, 0x77, 0x5b, 0xa9, 0x9c, 0x03, 0x34, 0x3a,
-	0x48, 0xc6, 0xba, 0x7c, 0x1b, 0xaa, 0x7c, 0x17, 0x08, 0x46, 0x28, 0xee, 0x8d, 0x3b, 0xc1, 0x54,
-	0x8c, 0xc2, 0xfb, 0xee, 0x8e, 0x6a, 0x6b, 0xcb, 0x18, 0xde, 0x0e, 0x72, 0xfa, 0xc4, 0x9f, 0xde,
-	0x52, 0x7a, 0x6b, 0xf1, 0x45, 0xb0, 0xe8, 0x90, 0x9e, 0x7c, 0xb8, 0x43, 0xfa, 0x9f, 0x0a, 0xb4,
-	0x4b, 0x30, 0xfb, 0x03, 0xd8, 0x40, 0x86, 0x2f, 0x54, 0x67, 0x81, 0x3e, 0x25, 0x36, 0xdd, 0xc7,
-	0x78, 0xc6, 0x43, 0x25, 0xc4, 0xf7, 0xae, 0xa0, 0x62, 0x99, 0xdf, 0x98, 0x0a, 0x04, 0x8d, 0x17,
-	0x39, 0xee, 0xc4, 0x9f, 0x89, 0xac, 0x8d, 0xd5, 0x24, 0xeb, 0x01, 0xd3, 0x95, 0x42, 0xcf, 0x8b,
-	0x05, 0x40, 0x3b, 0xeb, 0x11, 0x0e, 0x9e, 0xf7, 0x32, 0x7a, 0xc9, 0x47, 0xba, 0x4a, 0x2e, 0xc3,
-	0xb8, 0xe6, 0x7d, 0xe4, 0x78, 0x28, 0xa1, 0x8a, 0x65, 0x46, 0x9a, 0x23, 0x80, 0x42, 0x09, 0x0c,
-	0x90, 0xfc, 0xa6, 0xd6, 0xd1, 0x97, 0x33, 0x0c, 0x02, 0xe5, 0xaf, 0xaa, 0x0e, 0x02, 0xe5, 0x2c,
-	0x06, 0x35, 0x0c, 0x63, 0x52, 0xa2, 0xc3, 0xe9, 0xdb, 0xfc, 0x9b, 0x1a, 0x40, 0x51, 0x10, 0xd0,
-	0xdb, 0x8e, 0x2b, 0xfd, 0x3b, 0xcc, 0xeb, 0x34, 0xba, 0xc9, 0x0b, 0x00, 0xf3, 0x64, 0xe4, 0xc4,
-	0xd2, 0x47, 0xb3, 0x8c, 0x9c, 0x1b, 0x31, 0xd3, 0xf6, 0x58, 0x42, 0x51, 0xcd, 0x1c, 0x51, 0x07,
-	0x42, 0xb7, 0x0a, 0xcb, 0xf0, 0xc2, 0x8c, 0xd4, 0x58, 0x69, 0x7b, 0x2c, 0xa1, 0xec, 0x45, 0x9e,
-	0xc5, 0x1a, 0xcb, 0x9d, 0x98, 0x66, 0xd0, 0x0d, 0xea, 0x36, 0x8c, 0x65, 0xd6, 0xe4, 0x6d, 0xe8,
-	0x1b, 0x54, 0x09, 0xc3, 0xfe, 0x65, 0x16, 0x06, 0xd3, 0xa5, 0xdb, 0x4e, 0x09, 0x62, 0x7b, 0x50,
-	0x4f, 0xee, 0xb1, 0x9b, 0x6f, 0xad, 0x74, 0xf3, 0x8a, 0xf1, 0x68, 0x1b, 0x07, 0x1f, 0x68, 0xe3,
-	0xbe, 0x06, 0x48, 0x13, 0x11, 0xeb, 0x8a, 0xd1, 0xa6, 0xad, 0x77, 0x7a, 0x74, 0x97, 0x4d, 0x74,
-	0xe8, 0x96, 0x04, 0x48, 0x85, 0xf4, 0x46, 0x11, 0x63, 0x19, 0xeb, 0x33, 0xbc, 0x80, 0xb1, 0x1e,
-	0xb4, 0x72, 0x9a, 0xce, 0xf2, 0xd6, 0x4b, 0x23, 0x9b, 0x31, 0xc3, 0x79, 0x21, 0xc2, 0x7e, 0x0a,
-	0x3b, 0x39, 0x91, 0xef, 0x77, 0x8b, 0xf6, 0xbb, 0xca, 0x30, 0x7f, 0x53, 0xd1, 0x57, 0x38, 0xdd,
-	0x83, 0x60, 0x2c, 0x79, 0xca, 0x83, 0x3a, 0x89, 0x29, 0x0a, 0x03, 0x65, 0x8e, 0x55, 0xf1, 0xdc,
-	0x91, 0xb7, 0x59, 0x3f, 0x9d, 0x03, 0x6c, 0x17, 0xea, 0x32, 0xc4, 0xc6, 0x7f, 0x9d, 0x3a, 0x34,
-	0x45, 0x60, 0x58, 0x64, 0x1d, 0x4d, 0x76, 0xe5, 0x52, 0x47, 0x65, 0x19, 0x36, 0x7f, 0xb3, 0xae,
-	0xaf, 0x08, 0xfd, 0x28, 0xc2, 0xc9, 0xfa, 0xf4, 0x60, 0xa1, 0x76, 0xa0, 0x08, 0x3c, 0xb4, 0x4e,
-	0x14, 0x2d, 0x76, 0xf4, 0x25, 0x84, 0x1a, 0x7e, 0x55, 0x30, 0xa3, 0x88, 0x82, 0xa6, 0xc9, 0x0b,
-	0x00, 0x8f, 0x57, 0x3f, 0x8a, 0xa8, 0xdf, 0x51, 0x71, 0x92, 0x91, 0xec, 0xa7, 0xb0, 0x99, 0x84,
-	0x13, 0x79, 0xef, 0xc4, 0xaa, 0x33, 0x6b, 0x52, 0xe2, 0x68, 0xea, 0xce, 0xec, 0x2d, 0x5f, 0xe0,
-	0x2e, 0x74, 0x65, 0x9b, 0xbf, 0x47, 0x57, 0xf6, 0x0d, 0x18, 0xaa, 0x63, 0x14, 0x5e, 0xde, 0x55,
-	0x76, 0x56, 0xba, 0xca, 0x15, 0x19, 0x66, 0x42, 0xc3, 0x89, 0x22, 0x8c, 0xcf, 0x2d, 0x2d, 0x5d,
-	0xc4, 0xa7, 0xe6, 0x14, 0x97, 0x96, 0xed, 0x0f, 0x5c, 0x5a, 0x4a, 0xdd, 0xaf, 0xf1, 0xb1, 0xee,
-	0xd7, 0xfc, 0x4b, 0x30, 0x88, 0x71, 0x15, 0x05, 0x23, 0x3f, 0x78, 0x4f, 0x3d, 0xc7, 0x2e, 0xd4,
-	0x93, 0xc8, 0xb7, 0xbc, 0xcc, 0x1b, 0x44, 0xe8, 0xba, 0x63, 0x0b, 0x99, 0xa7, 0x1c, 0xa2, 0xd0,
-	0x0b, 0x9e, 0x1f, 0x0b, 0x57, 0x66, 0x4f, 0x1e, 0x4d, 0x5e, 0x00, 0xe6, 0xff, 0x66, 0xd1, 0xa6,
-	0x17, 0xc0, 0xdb, 0xb9, 0x9f, 0xcd, 0x5c, 0xf5, 0xbd, 0x47, 0x4b, 0xe5, 0x2e, 0xd4, 0x63, 0xf1,
-	0x2b, 0xcb, 0xcb, 0xde, 0xaf, 0x88, 0xc0, 0xa2, 0xe8, 0x07, 0x89, 0x72, 0x44, 0x8d, 0x82, 0x2e,
-	0xa7, 0xd1, 0xd9, 0x22, 0x89, 0xa8, 0xff, 0xd2, 0x77, 0x12, 0x4d, 0xb2, 0x1f, 0x65, 0xa6, 0x52,
-	0x59, 0x65, 0xab, 0x97, 0xed, 0x66, 0xc9, 0x5e, 0xf5, 0x19, 0x8d, 0x06, 0xf2, 0xf0, 0x4e, 0x6f,
-	0xd9, 0x28, 0x5c, 0xf1, 0x51, 0x90, 0x5c, 0xa1, 0x2f, 0x20, 0x8f, 0x09, 0x12, 0xdf, 0xb4, 0x0b,
-	0xc3, 0x0e, 0x03, 0xef, 0x3c, 0xf4, 0x03, 0xb9, 0xa2, 0x3b, 0xb6, 0x04, 0xf4, 0xfa, 0x97, 0x99,
-	0x54, 0x51, 0x8f, 0x66, 0xf1, 0x7f, 0xa8, 0x16, 0x86, 0x3c, 0x0e, 0x83, 0xe0, 0x77, 0x32, 0xe4,
-	0x87, 0x1f, 0xa3, 0xc8, 0x60, 0x65, 0x5b, 0x66, 0x24, 0xce, 0xe3, 0xbf, 0x17, 0x49, 0xf6, 0x04,
-	0x85, 0xdf, 0xbf, 0xaf, 0x11, 0x37, 0x96, 0x6c, 0x93, 0x19, 0x60, 0xc5, 0x88, 0xcd, 0x0f, 0x0a,
-	0xaa, 0xee, 0xf7, 0x2b, 0xa8, 0xcf, 0xfc, 0xe0, 0x3d, 0x66, 0xdf, 0x52, 0x10, 0x6b, 0x6b, 0x73,
-	0xc5, 0x33, 0xff, 0xbe, 0xa2, 0x33, 0xc9, 0x55, 0xa4, 0xdf, 0x71, 0x48, 0xad, 0x8a, 0xba, 0x52,
-	0x2a, 0x8a, 0x1e, 0xee, 0xc2, 0x99, 0xef, 0xd2, 0x2b, 0x63, 0x56, 0xf7, 0xca, 0x10, 0xdd, 0x65,
-	0xfc, 0x44, 0x8a, 0xc0, 0x0f, 0xa6, 0x56, 0xa4, 0x9e, 0xa7, 0xd4, 0x7b, 0xc3, 0x0a, 0xce, 0x5e,
-	0x40, 0xcd, 0x0d, 0x83, 0x60, 0x65, 0x5b, 0xe8, 0x18, 0x4e, 0x2c, 0xf3, 0x4f, 0xa1, 0xc5, 0x67,
-	0xa1, 0xab, 0x6a, 0x1b, 0x83, 0x1a, 0x12, 0xda, 0x5b, 0xf4, 0x8d, 0xe7, 0x86, 0x0b, 0xc7, 0xbd,
-	0xa5, 0x7e, 0x42, 0xd7, 0xe1, 0x1c, 0x30, 0x8f, 0xa1, 0x73, 0xea, 0x44, 0xc7, 0x8e, 0x7b, 0x2b,
-	0x86, 0xd9, 0x6b, 0xcc, 0x30, 0x4f, 0x90, 0xf8, 0x89, 0x75, 0x0c, 0x27, 0xca, 0xba, 0x7e, 0xe8,
-	0xe5, 0xeb, 0x71, 0xc5, 0x30, 0xbf, 0x83, 0xf6, 0xc0, 0x91, 0xce, 0x8d, 0x93, 0x88, 0x53, 0x27,
-	0xc2, 0x29, 0x2c, 0x3d, 0x45, 0x8d, 0xe3, 0x27, 0xfb, 0x16, 0xb6, 0xcb, 0xab, 0x60, 0x17, 0xa5,
-	0x26, 0xdb, 0xea, 0x2d, 0xac, 0xce, 0x97, 0xc5, 0x4c, 0x1b, 0x9a, 0x03, 0xe1, 0x3a, 0xd1, 0x1b,
-	0xf1, 0xf0, 0xa8, 0x76, 0x0c, 0x6a, 0xd8, 0x21, 0x93, 0x62, 0x35, 0x4e, 0xdf, 0x78, 0x80, 0xdf,
-	0x88, 0x07, 0xba, 0x69, 0xe9, 0xaa, 0x91, 0xd3, 0xe6, 0xbf, 0x57, 0xa0, 0x45, 0x56, 0x1c, 0xf9,
-	0x49, 0x84, 0xfd, 0xa2, 0x25, 0xe3, 0xe3, 0xf8, 0x21, 0x92, 0x21, 0x4d, 0xa3, 0xf6, 0xbc, 0x08,
-	0x62, 0x7d, 0x18, 0xca, 0xd8, 0x76, 0x64, 0x69, 0xa5, 0x12, 0x82, 0x7c, 0x0b, 0x2f, 0x75, 0x13,
-	0xc7, 0x15, 0x99, 0x2f, 0x4b, 0x08, 0xfb, 0x23, 0xd8, 0x2c, 0x99, 0x27, 0xe9, 0xd6, 0xf4, 0x43,
-	0x73, 0x09, 0xe4, 0x0b, 0x12, 0xec, 0x27, 0xd0, 0xca, 0xb4, 0x56, 0x6f, 0x97, 0x78, 0xeb, 0xcf,
-	0x10, 0x5e, 0xf0, 0xcc, 0xff, 0xae, 0xc3, 0x6e, 0x39, 0xe1, 0x5a, 0x41, 0x22, 0x9d, 0x40, 0x15,
-	0x55, 0x9d, 0x7a, 0xad, 0x41, 0x56, 0x54, 0x73, 0x00, 0x7b, 0x25, 0x4d, 0x5c, 0x2d, 0x1c, 0xdb,
-	0x25, 0x34, 0x4f, 0x85, 0xd8, 0x16, 0xd6, 0xd5, 0xfd, 0x20, 0xa3, 0xe9, 0xb1, 0xc8, 0x4f, 0xa2,
-	0x99, 0xf3, 0x40, 0xe9, 0xa0, 0xa1, 0x1f, 0x8b, 0x0a, 0x68, 0xb1, 0x03, 0xdc, 0x58, 0xee, 0x00,
-	0x7f, 0x09, 0x6d, 0x75, 0x66, 0xc6, 0xf4, 0x98, 0xd4, 0xfc, 0x64, 0xc9, 0x2b, 0x8b, 0xaf, 0xd4,
-	0x56, 0xd5, 0x63, 0x7d, 0xa8, 0xb6, 0x7e, 0x09, 0xad, 0x9b, 0xd8, 0xf7, 0xa6, 0xc2, 0x4e, 0xe7,
-	0xf4, 0x2a, 0xd1, 0xe1, 0x05, 0x40, 0x0f, 0xdb, 0x8a, 0x40, 0x45, 0x9e, 0xe8, 0x87, 0xed, 0x1c,
-	0xc1, 0x5e, 0x4a, 0x51, 0xea, 0xf9, 0x58, 0xbf, 0x3c, 0x2c, 0x60, 0xec, 0x97, 0xd0, 0xf1, 0xa3,
-	0xe2, 0x37, 0x4d, 0xd2, 0xfd, 0x9c, 0xbc, 0xf6, 0xb4, 0xf7, 0xe8, 0x0f, 0x1c, 0xbe, 0x28, 0x5c,
-	0x5e, 0x61, 0x2c, 0x64, 0xd2, 0xed, 0x52, 0x0c, 0x2d, 0x60, 0x6c, 0x0f, 0x6a, 0x77, 0xfe, 0x24,
-	0xe9, 0xfe, 0x40, 0x47, 0x4f, 0xe9, 0x17, 0x0e, 0x27, 0x0e, 0xe6, 0x5a, 0x3f, 0xba, 0xfb, 0xf9,
-	0xd0, 0xf7, 0xe8, 0x45, 0xa1, 0xc9, 0x33, 0xf2, 0xd1, 0x1e, 0xe1, 0xd9, 0xef, 0xd0, 0x23, 0xbc,
-	0x80, 0xfa, 0x1d, 0x3d, 0x83, 0x3d, 0x2f, 0xbf, 0x3c, 0x5d, 0x45, 0xc1, 0xc9, 0x1a, 0x57, 0x1c,
-	0xbc, 0x4c, 0xcd, 0x48, 0x64, 0x4f, 0x77, 0xb9, 0xf9, 0xe9, 0x42, 0x19, 0x62, 0x2d, 0x3d, 0x6e,
-	0xef, 0xaf, 0xb4, 0x1b, 0x25, 0xee, 0x51, 0x07, 0xda, 0x88, 0x1d, 0x87, 0x81, 0x14, 0x81, 0x34,
-	0xff, 0xba, 0xaa, 0x93, 0xee, 0x69, 0x32, 0xc5, 0xed, 0xfc, 0x7a, 0xe1, 0x67, 0x12, 0x71, 0x30,
-	0x1a, 0x13, 0xae, 0x38, 0x58, 0xd2, 0x3d, 0x71, 0x67, 0x79, 0x3a, 0xe6, 0x15, 0x81, 0x75, 0xc5,
-	0xa3, 0x4d, 0xae, 0xeb, 0x1b, 0x5f, 0xe9, 0x25, 0x12, 0xb7, 0x49, 0x4c, 0x9c, 0xde, 0xf1, 0xb3,
-	0xd2, 0x9e, 0x6b, 0xdb, 0x8f, 0x48, 0x13, 0xe2, 0xb0, 0x43, 0x68, 0x04, 0x3e, 0xc9, 0xa8, 0x16,
-	0xed, 0x49, 0xef, 0xb1, 0xd3, 0x77, 0xb2, 0xc6, 0xb5, 0x18, 0x46, 0xb9, 0x23, 0x8b, 0x28, 0x6f,
-	0x7c, 0x3a, 0xca, 0x4b, 0xe2, 0x4b, 0xc6, 0x38, 0x48, 0x61, 0x67, 0xe5, 0x5f, 0x27, 0x7b, 0x0a,
-	0x6c, 0x01, 0x3c, 0x93, 0xb7, 0x22, 0x36, 0xd6, 0x56, 0xf0, 0xd7, 0x4e, 0x3a, 0x15, 0x46, 0x85,
-	0x75, 0x61, 0x77, 0x01, 0xd7, 0x2f, 0x52, 0x46, 0x75, 0x65, 0x04, 0xe5, 0x78, 0x63, 0xfd, 0xe0,
-	0xb5, 0xbe, 0xd7, 0x91, 0xa1, 0x59, 0x0b, 0xea, 0xd7, 0xbe, 0x1d, 0x46, 0xc6, 0x1a, 0xdb, 0x84,
-	0xe6, 0xb5, 0xaf, 0xac, 0x68, 0x54, 0x14, 0xa3, 0x1f, 0x45, 0xc6, 0x3a, 0x7b, 0x02, 0x3b, 0xd7,
-	0xfe, 0x92, 0x51, 0x8c, 0xc6, 0xc1, 0x3f, 0x56, 0x00, 0x8a, 0xff, 0x7f, 0x6c, 0x2b, 0xa3, 0xec,
-	0x90, 0xa6, 0x33, 0x60, 0x53, 0xd3, 0x42, 0x0e, 0xe5, 0xad, 0x51, 0x61, 0x1d, 0x68, 0x29, 0xe4,
-	0x72, 0x7c, 0x64, 0x54, 0x0b, 0xf2, 0xf8, 0xec, 0xd4, 0x58, 0x67, 0xdb, 0xd0, 0x56, 0x64, 0x3f,
-	0xf5, 0xfc, 0xd0, 0xa8, 0xb1, 0x1d, 0xe8, 0xe4, 0x13, 0xbc, 0x1d, 0xf5, 0x6d, 0xa3, 0xbe, 0x08,
-	0xbd, 0xed, 0xdb, 0x46, 0xa3, 0x58, 0xf6, 0x64, 0x70, 0x6a, 0x19, 0x1b, 0xcc, 0xc8, 0xa6, 0x51,
-	0x96, 0xfb, 0xff, 0xca, 0xc1, 0xbf, 0x60, 0xa5, 0xd7, 0x9d, 0x2e, 0x6b, 0xc3, 0x86, 0x65, 0x5f,
-	0xf5, 0x47, 0xd6, 0xc0, 0x58, 0x53, 0x84, 0x75, 0x61, 0xf5, 0x47, 0x46, 0x85, 0xed, 0x82, 0x31,
-	0x38, 0x7b, 0x6b, 0x8f, 0xce, 0xfa, 0x83, 0x77, 0xe3, 0x8b, 0x3e, 0xbf, 0x18, 0x0e, 0x8c, 0x2a,
-	0x4e, 0x9f, 0xa1, 0xc3, 0x81, 0xb1, 0x8e, 0x9b, 0x1e, 0x0c, 0x47, 0xd6, 0xd5, 0x90, 0x0f, 0x07,
-	0x46, 0x8d, 0x74, 0xb0, 0xc7, 0x17, 0xfd, 0xd1, 0x68, 0x38, 0x30, 0xea, 0x38, 0xe1, 0xd1, 0xd9,
-	0xd9, 0x85, 0x65, 0xbf, 0x36, 0x1a, 0x48, 0xf0, 0x4b, 0xdb, 0x46, 0x62, 0x03, 0x89, 0x93, 0xfe,
-	0x88, 0x38, 0x4d, 0x06, 0xd0, 0x40, 0x62, 0x38, 0x30, 0x5a, 0xb8, 0x00, 0x1f, 0xd2, 0x7a, 0xc8,
-	0x03, 0x14, 0x3c, 0xbf, 0xe4, 0xaf, 0x91, 0x68, 0x1f, 0xd8, 0xf0, 0xf4, 0xf1, 0x57, 0x44, 0x14,
-	0xbb, 0xb4, 0xdf, 0xd8, 0x67, 0x6f, 0x6d, 0xe5, 0x39, 0xfb, 0xec, 0xe2, 0xd5, 0xd9, 0xa5, 0x3d,
-	0x30, 0x2a, 0x48, 0x0d, 0xac, 0x71, 0xff, 0x68, 0x44, 0x0a, 0xb4, 0x61, 0x63, 0x68, 0x2b, 0x62,
-	0xfd, 0xe0, 0x57, 0xb0, 0x59, 0xbe, 0x63, 0xb2, 0x26, 0xd4, 0xec, 0x33, 0x7b, 0x68, 0xac, 0xa1,
-	0xf5, 0x33, 0x3d, 0x71, 0xe9, 0x0a, 0x9a, 0x3a, 0x37, 0xc7, 0x00, 0x65, 0xaa, 0x38, 0xf1, 0xe5,
-	0xf9, 0xa0, 0x4f, 0x1b, 0x5d, 0xa7, 0x1d, 0x20, 0x45, 0x76, 0xd8, 0x84, 0xe6, 0xab, 0xfe, 0x68,
-	0x74, 0xd4, 0x3f, 0x7e, 0x63, 0xd4, 0x51, 0xbf, 0x57, 0x7d, 0x0b, 0x97, 0x6c, 0x1c, 0xfc, 0x53,
-	0x05, 0xb6, 0x97, 0x6e, 0xa1, 0x8c, 0xc1, 0x16, 0x2e, 0xfb, 0x6e, 0x7c, 0x79, 0x34, 0xbe, 0xe8,
-	0x5f, 0x5c, 0x8e, 0x8d, 0x35, 0xf6, 0x39, 0x7c, 0x96, 0xaf, 0x67, 0xd9, 0xe7, 0xfc, 0xec, 0x35,
-	0x1f, 0x8e, 0xc7, 0x46, 0x05, 0xa3, 0xef, 0x6a, 0xc8, 0xad, 0x57, 0xdf, 0x95, 0xe1, 0x2a, 0xca,
-	0xab, 0xe5, 0xdf, 0x69, 0x17, 0x5a, 0xd7, 0x6a, 0x5f, 0xbb, 0x60, 0x68, 0x06, 0x1f, 0x66, 0xce,
-	0xa8, 0xe1, 0x92, 0x1a, 0xbd, 0x18, 0x8e, 0x09, 0xab, 0xb3, 0x2f, 0xa1, 0xab, 0x31, 0x7b, 0x38,
-	0x1c, 0x10, 0xe3, 0xdd, 0xf1, 0x99, 0xfd, 0xca, 0xe2, 0xa7, 0x46, 0xe3, 0xe0, 0x6f, 0x2b, 0xd0,
-	0x59, 0x68, 0x58, 0xd1, 0x46, 0x57, 0xe7, 0xf6, 0xbb, 0x22, 0x7e, 0x72, 0x20, 0x8b, 0x21, 0x06,
-	0x5b, 0x08, 0x1c, 0x9f, 0xd9, 0xf6, 0xf0, 0x98, 0x56, 0xa9, 0xb2, 0xcf, 0x60, 0x1b, 0x31, 0xf4,
-	0xf1, 0xd1, 0xc8, 0x1a, 0x9f, 0x50, 0x18, 0xed, 0x40, 0x47, 0x8d, 0xcc, 0x62, 0xa7, 0x96, 0x4d,
-	0xc6, 0x87, 0x6f, 0x86, 0xdf, 0x51, 0x30, 0x69, 0x60, 0x30, 0x1c, 0x0d, 0xd1, 0xc8, 0x70, 0x34,
-	0x84, 0xe7, 0x6e, 0x38, 0xef, 0xfd, 0x5a, 0x78, 0xc2, 0x73, 0x7a, 0xee, 0x2c, 0x4c, 0xbd, 0x5e,
-	0x9a, 0x88, 0x18, 0x4f, 0xac, 0x4a, 0x3e, 0xd7, 0xe6, 0xd4, 0x97, 0xb7, 0xe9, 0x4d, 0xcf, 0x0d,
-	0xe7, 0x87, 0xb3, 0xc9, 0xd7, 0xc2, 0x9b, 0x8a, 0x43, 0x71, 0x27, 0x0e, 0x9d, 0xc8, 0x3f, 0x9c,
-	0x86, 0x87, 0x98, 0xc4, 0x6e, 0x1a, 0x24, 0xfa, 0xb3, 0xdf, 0x06, 0x00, 0x00, 0xff, 0xff, 0x8b,
-	0x3d, 0x09, 0x6a, 0xd1, 0x21, 0x00, 0x00,
+	// 3711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4f, 0x6f, 0xe3, 0x58,
+	0x72, 0xb7, 0x64, 0x49, 0x96, 0x4a, 0x96, 0x4d, 0xbf, 0xfe, 0xa7, 0x99, 0x1d, 0x4c, 0x7b, 0x38,
+	0x9b, 0x19, 0xc7, 0xd8, 0x51, 0x6f, 0x7a, 0x37, 0x93, 0xc1, 0x62, 0x13, 0x44, 0xb6, 0xd4, 0x6d,
+	0xa1, 0x65, 0x5a, 0x78, 0xb2, 0xdd, 0x18, 0x03, 0x49, 0x83, 0x26, 0x9f, 0x65, 0xa2, 0x25, 0x92,
+	0x4b, 0x3e, 0xd9, 0xe3, 0x3d, 0x2f, 0x10, 0x20, 0x08, 0x10, 0x04, 0x39, 0xe4, 0x13, 0x24, 0xb7,
+	0x1c, 0x72, 0x49, 0x72, 0xc9, 0x35, 0xa7, 0x9c, 0x83, 0x1c, 0x82, 0x1c, 0xf2, 0x21, 0x72, 0xcd,
+	0xa2, 0xea, 0xbd, 0x47, 0x52, 0x92, 0x7b, 0x3d, 0x73, 0x12, 0xeb, 0x57, 0xf5, 0xfe, 0x55, 0xd5,
+	0xab, 0xaa, 0x57, 0x02, 0x08, 0xc2, 0xab, 0xa8, 0x13, 0x27, 0x91, 0x8c, 0x3e, 0x7e, 0x3e, 0x89,
+	0xa2, 0xc9, 0x54, 0xbc, 0x20, 0xea, 0x72, 0x7e, 0xf5, 0x42, 0x06, 0x33, 0x91, 0x4a, 0x77, 0x16,
+	0x2b, 0x01, 0xfb, 0x6f, 0xca, 0xf0, 0xd8, 0x17, 0x71, 0x22, 0x3c, 0x57, 0x0a, 0xff, 0x58, 0xc8,
+	0x24, 0xf0, 0x06, 0x52, 0xcc, 0x98, 0x05, 0xeb, 0xef, 0xc5, 0x5d, 0xbb, 0xb4, 0x5b, 0xda, 0x6b,
+	0x70, 0xfc, 0x64, 0x5f, 0x40, 0x45, 0xde, 0xc5, 0xa2, 0x5d, 0xde, 0x2d, 0xed, 0x6d, 0xbd, 0x64,
+	0x9d, 0x9e, 0x88, 0x73, 0xf9, 0xd3, 0xbb, 0x58, 0x70, 0xe2, 0xb3, 0x4f, 0xa1, 0x71, 0x19, 0x45,
+	0xd3, 0x73, 0x77, 0x3a, 0x17, 0xed, 0xf5, 0xdd, 0xd2, 0x5e, 0xfd, 0x68, 0x8d, 0xe7, 0x10, 0xb3,
+	0xa1, 0x39, 0x0f, 0x42, 0xf9, 0xb3, 0x97, 0x4a, 0xa2, 0xb2, 0x5b, 0xda, 0x6b, 0x1d, 0xad, 0xf1,
+	0x22, 0x68, 0x64, 0xbe, 0xfe, 0xb9, 0x92, 0xa9, 0xee, 0x96, 0xf6, 0x2a, 0x46, 0x46, 0x83, 0x6c,
+	0x17, 0xe0, 0x6a, 0x1a, 0xb9, 0x52, 0x89, 0xd4, 0x76, 0x4b, 0x7b, 0xe5, 0xa3, 0x35, 0x5e, 0xc0,
+	0x70, 0x96, 0x54, 0x26, 0x41, 0x38, 0x51, 0x22, 0x1b, 0x78, 0x16, 0x9c, 0xa5, 0x00, 0x1e, 0xec,
+	0xc0, 0xf6, 0x2c, 0x3b, 0x05, 0x41, 0xf6, 0x19, 0x3c, 0xb9, 0x98, 0x09, 0x39, 0x18, 0x75, 0xd3,
+	0x34, 0x98, 0x84, 0x33, 0x11, 0xca, 0x7e, 0x28, 0x93, 0x3b, 0xf6, 0x29, 0xc0, 0xcc, 0xf5, 0xba,
+	0xbe, 0x9f, 0x88, 0x34, 0xd5, 0xaa, 0x29, 0x20, 0xec, 0x13, 0x68, 0x04, 0xb1, 0x61, 0x97, 0x77,
+	0xd7, 0xf7, 0x1a, 0x3c, 0x07, 0xec, 0x3f, 0x83, 0x26, 0x4e, 0x7b, 0x1e, 0x5c, 0x0d, 0xc2, 0xab,
+	0x88, 0xb5, 0x61, 0xe3, 0x26, 0xb8, 0x72, 0xdc, 0x99, 0xd0, 0x33, 0x19, 0x72, 0x69, 0x99, 0xf2,
+	0xca, 0x32, 0x8f, 0xa1, 0xea, 0xc6, 0xf1, 0xa0, 0x47, 0xca, 0x6d, 0x70, 0x45, 0xd8, 0xff, 0x55,
+	0x82, 0xc6, 0x45, 0x10, 0x1d, 0xcc, 0x43, 0x7f, 0x2a, 0xd8, 0x73, 0x6d, 0xac, 0x12, 0x19, 0xab,
+	0xd9, 0x19, 0x8c, 0xae, 0xef, 0x06, 0x51, 0xc1, 0x4a, 0x0c, 0x2a, 0x21, 0xae, 0xad, 0xa6, 0xa7,
+	0x6f, 0xdc, 0xd2, 0x4c, 0xcc, 0x2e, 0x45, 0x92, 0xb6, 0xd7, 0x69, 0xf7, 0x86, 0x64, 0x3f, 0x86,
+	0xd6, 0x3c, 0x15, 0xfe, 0xc1, 0x5d, 0x37, 0x8e, 0xcf, 0xce, 0x06, 0x3d, 0xb2, 0x5a, 0x83, 0x2f,
+	0x82, 0xcc, 0x86, 0x4d, 0x05, 0x1c, 0xb8, 0xa9, 0x38, 0x19, 0x93, 0xd9, 0xea, 0x7c, 0x01, 0x63,
+	0x2f, 0xa1, 0x15, 0x44, 0xfa, 0x24, 0xc3, 0x20, 0x95, 0xed, 0xda, 0xee, 0xfa, 0x5e, 0xf3, 0xe5,
+	0x66, 0x67, 0x60, 0x50, 0x91, 0xf2, 0x45, 0x11, 0xfb, 0x2b, 0x68, 0x16, 0xb8, 0x0f, 0x99, 0xc1,
+	0xfe, 0x97, 0x32, 0xec, 0x5c, 0xa0, 0x8e, 0x8f, 0xdd, 0x70, 0x7e, 0xe5, 0x7a, 0x72, 0x9e, 0x88,
+	0x04, 0x37, 0x37, 0x2b, 0xd0, 0x7a, 0xdc, 0x02, 0xc6, 0x76, 0xa1, 0x19, 0x27, 0x91, 0x3f, 0xf7,
+	0xa4, 0x93, 0xeb, 0xa6, 0x08, 0x91, 0xd5, 0x44, 0x92, 0x06, 0x51, 0xa8, 0xb5, 0x6f, 0x48, 0x9c,
+	0x3f, 0x15, 0x49, 0xe0, 0x4e, 0x9d, 0x39, 0xea, 0x4c, 0x6b, 0x68, 0x01, 0x43, 0xa5, 0x93, 0xf6,
+	0xaa, 0x4a, 0xe9, 0xf8, 0x8d, 0xa7, 0xf1, 0xa2, 0x59, 0xec, 0xca, 0xe0, 0x72, 0xaa, 0xdc, 0xb8,
+	0xc1, 0x0b, 0x08, 0xf2, 0x2f, 0x83, 0x28, 0x3d, 0x17, 0xa1, 0x1f, 0x25, 0xca, 0x87, 0x79, 0x01,
+	0xc1, 0x3d, 0x2b, 0x4a, 0xed, 0xaa, 0xae, 0xf6, 0x5c, 0x80, 0xd8, 0x1e, 0x6c, 0x23, 0xc9, 0xc5,
+	0x54, 0xb8, 0xa9, 0xe8, 0xb9, 0x52, 0xb4, 0x1b, 0x24, 0xb5, 0x0c, 0xdb, 0xff, 0x5d, 0x86, 0x4d,
+	0xd2, 0x9c, 0x23, 0xe4, 0x6d, 0x94, 0xbc, 0x27, 0x8f, 0x50, 0x8a, 0x35, 0xc7, 0xd5, 0x24, 0x72,
+	0x7c, 0x71, 0x43, 0x6a, 0x52, 0x27, 0x35, 0x24, 0x72, 0x06, 0x23, 0x94, 0x49, 0xdb, 0x55, 0xe5,
+	0x45, 0x9a, 0x64, 0x5f, 0xc0, 0x96, 0x2f, 0xae, 0xdc, 0xf9, 0x54, 0xf2, 0x68, 0x2e, 0xd1, 0xcd,
+	0x6a, 0x24, 0xb0, 0x84, 0xb2, 0x1f, 0xc1, 0xba, 0x1f, 0xa6, 0x74, 0xd6, 0xe6, 0xcb, 0x46, 0x87,
+	0x76, 0xd4, 0x73, 0xc6, 0x1c, 0x51, 0xb6, 0x05, 0xe5, 0x79, 0x4c, 0xc7, 0xac, 0xf3, 0xf2, 0x3c,
+	0x66, 0x9f, 0x43, 0x7d, 0x1a, 0x79, 0xae, 0xc4, 0xc3, 0x37, 0x68, 0xc4, 0x46, 0xe7, 0xb5, 0x88,
+	0x86, 0x91, 0xc7, 0x33, 0x06, 0x7b, 0x0a, 0xb5, 0x79, 0x3c, 0x0d, 0xc2, 0xf7, 0x6d, 0xa0, 0x81,
+	0x9a, 0x62, 0xfb, 0x00, 0xa1, 0x3a, 0x6a, 0x3f, 0x49, 0xda, 0x4d, 0x1a, 0x0e, 0x9d, 0x7e, 0x92,
+	0x44, 0x09, 0x2e, 0xca, 0x0b, 0x5c, 0xbc, 0xdd, 0x38, 0xdf, 0x94, 0xce, 0xbc, 0x49, 0x67, 0xce,
+	0x01, 0x66, 0x43, 0x35, 0x4e, 0xa2, 0xef, 0xee, 0xda, 0x2d, 0x9a, 0x64, 0xb3, 0x33, 0x42, 0x6a,
+	0x2c, 0x5d, 0x39, 0x4f, 0xb9, 0x62, 0xd9, 0xff, 0x5e, 0x82, 0x9a, 0xda, 0x1a, 0x5a, 0xf5, 0x2c,
+	0xf4, 0x45, 0x32, 0x75, 0xef, 0x06, 0x23, 0xe3, 0xc3, 0x39, 0xc2, 0x3e, 0x86, 0xfa, 0x51, 0x94,
+	0xca, 0xc2, 0x15, 0xcd, 0x68, 0xf4, 0xa2, 0xc3, 0x40, 0xde, 0x69, 0x8b, 0xd0, 0x37, 0x1e, 0x90,
+	0x8b, 0x09, 0xea, 0x40, 0x59, 0x43, 0x53, 0x68, 0x8c, 0xc3, 0x68, 0x8e, 0xd1, 0x4b, 0x3b, 0x9d,
+	0x21, 0x31, 0xc0, 0x0f, 0x23, 0x4f, 0x3b, 0x1c, 0x7e, 0x22, 0x72, 0x92, 0x4c, 0xb4, 0x8b, 0xe1,
+	0x27, 0xce, 0x3a, 0x8a, 0x52, 0xe9, 0x4e, 0xb5, 0x5b, 0x69, 0xca, 0xbe, 0x82, 0xba, 0x31, 0x0a,
+	0x9e, 0xa4, 0xe7, 0x8c, 0x53, 0x91, 0xe0, 0x45, 0x68, 0x97, 0xc8, 0xa0, 0x05, 0x04, 0xd5, 0xd6,
+	0x73, 0xc6, 0x7e, 0x34, 0x73, 0x83, 0x50, 0x1f, 0x25, 0x07, 0x34, 0x37, 0x15, 0x6e, 0xe2, 0x5d,
+	0xeb, 0xa0, 0x93, 0x03, 0xf6, 0x7f, 0x96, 0x60, 0x83, 0x16, 0x1a, 0xbf, 0x45, 0xc9, 0xf4, 0xd6,
+	0x78, 0xb9, 0x9e, 0x27, 0x03, 0x70, 0xa7, 0xe9, 0xed, 0x91, 0x9b, 0x5e, 0x6b, 0xad, 0x68, 0x8a,
+	0x3d, 0x87, 0x6a, 0x2a, 0xd1, 0xe3, 0x2b, 0x14, 0x08, 0x1b, 0x9d, 0x8b, 0xf1, 0x2d, 0x1a, 0x45,
+	0x70, 0x85, 0xe3, 0x40, 0xe9, 0x26, 0x13, 0x21, 0xb5, 0x26, 0x34, 0x85, 0x4a, 0xbe, 0xf1, 0xc5,
+	0x8d, 0xd6, 0x06, 0x7d, 0xb3, 0x7d, 0xb0, 0xfc, 0xe8, 0x36, 0x9c, 0x46, 0xae, 0x3f, 0x4a, 0xa2,
+	0x09, 0x85, 0x1f, 0x54, 0x4c, 0x8b, 0xaf, 0xe0, 0x94, 0x0b, 0x66, 0xee, 0x44, 0x90, 0xb7, 0xa8,
+	0xeb, 0x96, 0x03, 0xf6, 0x04, 0x1a, 0x99, 0x93, 0xe1, 0x0d, 0xf6, 0x45, 0xea, 0x25, 0x41, 0x4c,
+	0x4e, 0xac, 0x9c, 0xa1, 0x08, 0xb1, 0x6f, 0xa0, 0x91, 0x25, 0x6e, 0x3a, 0x7b, 0xf3, 0xe5, 0xc7,
+	0x1d, 0x95, 0xda, 0x3b, 0x26, 0xb5, 0x77, 0x4e, 0x8d, 0x04, 0xcf, 0x85, 0xed, 0xbf, 0xd8, 0x80,
+	0xa6, 0x32, 0x95, 0xb8, 0x09, 0x3c, 0x4c, 0x9a, 0xcd, 0x99, 0xeb, 0x5d, 0x07, 0xa1, 0xe8, 0xa2,
+	0xc6, 0x95, 0xb3, 0x14, 0x21, 0xf4, 0x18, 0x2f, 0x9e, 0x13, 0x57, 0x7b, 0x8c, 0x26, 0xd1, 0x27,
+	0xe3, 0xa9, 0x2b, 0xaf, 0xa2, 0x64, 0xa6, 0x95, 0x95, 0xd1, 0x94, 0x4e, 0xbc, 0x78, 0x4e, 0xea,
+	0x6a, 0x71, 0xfa, 0x46, 0xd5, 0xce, 0xc4, 0x2c, 0x4a, 0xee, 0x48, 0x49, 0x15, 0xae, 0x29, 0x5c,
+	0x21, 0x95, 0x51, 0xe2, 0x4e, 0x94, 0x62, 0x2a, 0xdc, 0x90, 0x6c, 0x0f, 0xaa, 0x33, 0xac, 0x5e,
+	0xf4, 0x4d, 0x64, 0x9d, 0x95, 0x30, 0xce, 0x95, 0x00, 0xfb, 0x12, 0x36, 0xf4, 0xd5, 0x6c, 0xb7,
+	0x28, 0x81, 0xb4, 0x3a, 0xc5, 0xc0, 0xc5, 0x0d, 0x97, 0xfd, 0x02, 0x98, 0x4b, 0x69, 0xdc, 0xbd,
+	0x9c, 0x8a, 0xae, 0xef, 0xc6, 0x14, 0x77, 0xb6, 0x69, 0x0c, 0x74, 0xb2, 0x84, 0xc9, 0xef, 0x91,
+	0x32, 0x71, 0xc8, 0xba, 0x37, 0x0e, 0xbd, 0x80, 0xa6, 0xde, 0x36, 0xa5, 0xb1, 0x9d, 0xe2, 0x2e,
+	0xc6, 0x8a, 0xc1, 0x8b, 0x12, 0xec, 0x6b, 0xa8, 0x5f, 0x46, 0x91, 0x44, 0x33, 0xb5, 0xd9, 0x83,
+	0x36, 0xcc, 0x64, 0xd9, 0xe7, 0xe8, 0xda, 0xb4, 0xc6, 0x23, 0x5a, 0xa3, 0xd9, 0x31, 0x06, 0x1d,
+	0xbf, 0xe5, 0x9a, 0x65, 0xe2, 0x05, 0x79, 0xdb, 0xe3, 0x3c, 0x5e, 0x20, 0xcd, 0xfe, 0x08, 0x9a,
+	0x79, 0x89, 0x93, 0xb6, 0x9f, 0xd0, 0x2c, 0x4f, 0x3a, 0xf7, 0x95, 0x7d, 0xbc, 0x28, 0x89, 0xfe,
+	0x3e, 0x75, 0x53, 0xc9, 0x05, 0xee, 0x85, 0x0b, 0x37, 0x8d, 0xc2, 0xf6, 0x53, 0x9a, 0x7c, 0x05,
+	0x67, 0x07, 0xb0, 0x95, 0x63, 0x74, 0xc6, 0x67, 0x0f, 0x9e, 0x71, 0x69, 0x04, 0xfb, 0x06, 0x5a,
+	0xe9, 0x5d, 0x2a, 0xc5, 0x4c, 0x5b, 0xa0, 0xdd, 0xd6, 0x6e, 0x30, 0x2e, 0xa2, 0x14, 0x98, 0x17,
+	0x05, 0x31, 0xb3, 0x24, 0x38, 0x69, 0x22, 0x29, 0xbc, 0x89, 0xa4, 0xfd, 0x11, 0x39, 0xe2, 0x12,
+	0xca, 0xfe, 0x10, 0x1a, 0x47, 0xe3, 0x63, 0x15, 0x95, 0xdb, 0x1f, 0x53, 0x48, 0x78, 0xd6, 0x39,
+	0xba, 0x1d, 0x0b, 0x6f, 0x9e, 0x04, 0xf2, 0xee, 0x38, 0xf2, 0xe7, 0x53, 0xa1, 0xd8, 0x3c, 0x97,
+	0x44, 0x8f, 0x3d, 0x1a, 0x1f, 0xe3, 0xc2, 0xed, 0x1f, 0xa9, 0x3b, 0xa1, 0x49, 0xcc, 0xad, 0xf9,
+	0x21, 0xc6, 0xd2, 0xf5, 0xde, 0xb7, 0x3f, 0x51, 0xb9, 0x75, 0x09, 0xb6, 0x2f, 0x61, 0x67, 0xe5,
+	0x18, 0x58, 0x34, 0x78, 0xf3, 0x24, 0x11, 0xa1, 0x1c, 0x84, 0xbe, 0xf8, 0x8e, 0xee, 0x7e, 0x8b,
+	0x2f, 0x60, 0xec, 0xf7, 0xa1, 0x96, 0xaa, 0x0d, 0x97, 0xc9, 0x72, 0x3b, 0x1d, 0x75, 0x97, 0x47,
+	0x51, 0x22, 0xf5, 0x56, 0xb5, 0x80, 0xfd, 0x6f, 0x65, 0xb0, 0x96, 0x99, 0xc5, 0x92, 0x45, 0x4d,
+	0x6f, 0x48, 0x53, 0xe3, 0x97, 0xf3, 0x1a, 0xff, 0x4f, 0x60, 0x13, 0x63, 0xc7, 0x28, 0x09, 0xa2,
+	0xc4, 0xa4, 0x98, 0xdf, 0x6d, 0xc3, 0x05, 0x79, 0xf6, 0x0b, 0x00, 0x3c, 0xf7, 0x2b, 0x37, 0x98,
+	0x0a, 0xbf, 0x5d, 0x79, 0x70, 0x74, 0x41, 0x9a, 0xfd, 0x29, 0xb4, 0x90, 0x1a, 0xcf, 0x3d, 0x4f,
+	0x08, 0x5f, 0xf8, 0xed, 0xea, 0x83, 0xc3, 0x17, 0x07, 0xb0, 0xcf, 0xa0, 0x1a, 0x47, 0x89, 0x4c,
+	0x75, 0x4d, 0xd9, 0x2c, 0x28, 0x8a, 0x2b, 0x0e, 0x25, 0x71, 0x37, 0x95, 0x14, 0x7c, 0x75, 0x6c,
+	0xcf, 0x01, 0xfb, 0x9f, 0xd6, 0x01, 0xf2, 0x31, 0x18, 0xc0, 0x82, 0xab, 0x30, 0xaf, 0xd0, 0x35,
+	0x75, 0x6f, 0xed, 0x8c, 0xb2, 0xe9, 0xf1, 0x64, 0x26, 0xd5, 0x93, 0x87, 0x6b, 0x0a, 0x65, 0xaf,
+	0x12, 0xa1, 0xf2, 0x4f, 0x9d, 0xd3, 0x37, 0x5e, 0x56, 0xff, 0xda, 0x8b, 0xb1, 0x1a, 0xa7, 0x48,
+	0xd7, 0xe2, 0x19, 0x4d, 0x89, 0x6c, 0x7e, 0x19, 0x0a, 0xa9, 0x4b, 0x0c, 0x4d, 0xa1, 0x15, 0x27,
+	0xae, 0x14, 0xb7, 0xae, 0xaa, 0x30, 0x1a, 0xdc, 0x90, 0x98, 0x80, 0x55, 0x32, 0xa5, 0x3d, 0x6d,
+	0x11, 0xb3, 0x80, 0xe0, 0x91, 0x43, 0x19, 0x8f, 0x29, 0x1d, 0xb7, 0xb7, 0xd5, 0x91, 0x33, 0x80,
+	0x46, 0x87, 0xe9, 0x58, 0xa7, 0x6f, 0x4b, 0xa5, 0xef, 0x1c, 0x41, 0x0f, 0xc5, 0xbd, 0x71, 0x37,
+	0x9c, 0x88, 0x61, 0x74, 0xdb, 0xde, 0x51, 0x65, 0x6d, 0x11, 0xc3, 0xd7, 0x41, 0x46, 0x1f, 0x05,
+	0x93, 0x6b, 0x0a, 0x6f, 0x0d, 0xbe, 0x08, 0xe6, 0x15, 0xd2, 0x93, 0x0f, 0x56, 0x48, 0xec, 0x0f,
+	0xa0, 0x79, 0x19, 0x85, 0xfe, 0xb1, 0x7e, 0x85, 0x3c, 0x25, 0x3b, 0x6e, 0x77, 0x0e, 0x32, 0x8c,
+	0x2e, 0x7f, 0x51, 0xc6, 0xfe, 0xdf, 0x12, 0x34, 0x0b, 0x33, 0xb1, 0xdf, 0x83, 0x0d, 0x9c, 0x2b,
+	0x10, 0xaa, 0x18, 0x41, 0x37, 0x20, 0x36, 0x3d, 0xe1, 0xb8, 0xe1, 0xe1, 0xb9, 0xc5, 0x77, 0x9e,
+	0xa0, 0xfc, 0x9a, 0x3d, 0xb2, 0x72, 0x04, 0xf5, 0x1d, 0xbb, 0xde, 0x55, 0x30, 0x15, 0xa6, 0xf2,
+	0xd5, 0x24, 0xeb, 0x00, 0xd3, 0xc9, 0x45, 0xcf, 0x8b, 0x39, 0x43, 0xdb, 0xf7, 0x1e, 0x0e, 0x86,
+	0x88, 0x22, 0x7a, 0xc6, 0x87, 0x3a, 0xb1, 0x2e, 0xc3, 0xb8, 0xe6, 0x6d, 0xec, 0xfa, 0x28, 0xa1,
+	0xf2, 0xab, 0x21, 0xed, 0x21, 0x40, 0x7e, 0x08, 0xf4, 0xa9, 0xec, 0x71, 0xd7, 0xd2, 0xef, 0x39,
+	0xf4, 0x1b, 0x65, 0xe2, 0xb2, 0xf6, 0x1b, 0xa2, 0x50, 0x16, 0x3d, 0x9f, 0x0e, 0xd1, 0xe2, 0xf4,
+	0x6d, 0xff, 0x55, 0x05, 0x20, 0xcf, 0x21, 0xe8, 0x20, 0xae, 0x27, 0x83, 0x1b, 0x4c, 0x05, 0x34,
+	0xba, 0xce, 0x73, 0x00, 0x43, 0x6b, 0xec, 0x26, 0x32, 0x40, 0xb5, 0x0c, 0xdd, 0x4b, 0x31, 0xd5,
+	0xfa, 0x58, 0x42, 0xf1, 0x98, 0x19, 0xa2, 0xee, 0x90, 0xae, 0x2e, 0x96, 0xe1, 0x85, 0x19, 0xa9,
+	0x16, 0xd3, 0xfa, 0x58, 0x42, 0xd9, 0x67, 0x59, 0xe0, 0xab, 0x2d, 0x17, 0x6f, 0x9a, 0x41, 0x8f,
+	0xae, 0xeb, 0x28, 0x91, 0xa6, 0x2e, 0xdc, 0xd0, 0x8f, 0xae, 0x02, 0x86, 0x25, 0xcf, 0x34, 0x0a,
+	0x27, 0x4b, 0x0f, 0xa4, 0x02, 0xc4, 0x76, 0xa1, 0x9a, 0xde, 0xe2, 0x03, 0xa0, 0xb1, 0xf2, 0x00,
+	0x50, 0x8c, 0x7b, 0x2b, 0x3f, 0xf8, 0x40, 0xe5, 0xf7, 0x15, 0xc0, 0x3c, 0x15, 0x89, 0x4e, 0x32,
+	0x4d, 0xda, 0x7a, 0xab, 0x43, 0xcf, 0xdf, 0x54, 0x81, 0xbc, 0x20, 0x40, 0x47, 0x98, 0x5f, 0x2a,
+	0x62, 0x2c, 0x13, 0x7d, 0xed, 0x17, 0x30, 0xd6, 0x81, 0x46, 0x46, 0xd3, 0xf5, 0xdf, 0x7a, 0x69,
+	0x99, 0x19, 0x0d, 0xce, 0x73, 0x11, 0xf6, 0x13, 0xd8, 0xc9, 0x88, 0x6c, 0xbf, 0x5b, 0xb4, 0xdf,
+	0x55, 0x86, 0xfd, 0x9b, 0x12, 0x6c, 0x16, 0xcb, 0x16, 0xf4, 0x25, 0x5f, 0x59, 0x50, 0xc7, 0x3d,
+	0x45, 0xa1, 0xa3, 0xcc, 0x30, 0x91, 0x8e, 0x5c, 0x79, 0x6d, 0x4a, 0xf0, 0x0c, 0xc0, 0xb6, 0x84,
+	0x8c, 0xa4, 0xab, 0xfc, 0xa3, 0xc2, 0x15, 0x81, 0x6e, 0x61, 0x8a, 0x20, 0xf3, 0x4a, 0x53, 0x57,
+	0x65, 0x19, 0xb6, 0xff, 0x71, 0x5d, 0xbf, 0x2a, 0xba, 0x71, 0x8c, 0x93, 0x75, 0xa9, 0xc7, 0xa1,
+	0x76, 0xa0, 0x08, 0xbc, 0xb4, 0x6e, 0x1c, 0x2f, 0x3e, 0x02, 0x0a, 0x08, 0xbd, 0x11, 0x54, 0x8e,
+	0x8d, 0x63, 0x72, 0x9a, 0x3a, 0xcf, 0x01, 0xbc, 0x5e, 0xdd, 0x38, 0xa6, 0x12, 0x49, 0xf9, 0x89,
+	0x21, 0xd9, 0x4f, 0x60, 0x33, 0x8d, 0xae, 0xe4, 0xad, 0x9b, 0xa8, 0x62, 0xae, 0x4e, 0x81, 0xa3,
+	0xae, 0x8b, 0xb9, 0xb7, 0x7c, 0x81, 0xbb, 0x50, 0xc8, 0x6d, 0xfe, 0x80, 0x42, 0xee, 0x6b, 0xb0,
+	0x54, 0x91, 0x29, 0xfc, 0xac, 0x10, 0x6d, 0xad, 0x14, 0xa2, 0x2b, 0x32, 0xcc, 0x86, 0x9a, 0x1b,
+	0xc7, 0xe8, 0x9f, 0x5b, 0xbb, 0xeb, 0x4b, 0xfe, 0xa9, 0x39, 0xf9, 0x3b, 0x67, 0xfb, 0x03, 0xef,
+	0x9c, 0x42, 0xc1, 0x6c, 0xfd, 0xce, 0x82, 0xf9, 0x4b, 0xa8, 0x5d, 0x0b, 0x77, 0x2a, 0xaf, 0x29,
+	0xd4, 0x63, 0xf4, 0x35, 0x46, 0x39, 0x22, 0x98, 0x6b, 0xb6, 0xfd, 0xe7, 0x60, 0x11, 0xe7, 0x3c,
+	0x0e, 0x87, 0x41, 0xf8, 0x1e, 0x3f, 0xd1, 0x6c, 0x69, 0x1c, 0x0c, 0x7c, 0x63, 0x36, 0x22, 0x74,
+	0x4e, 0x73, 0x84, 0xcc, 0x62, 0x13, 0x51, 0x68, 0x2e, 0x3f, 0x48, 0x84, 0x27, 0x4d, 0x3b, 0xa5,
+	0xce, 0x73, 0xc0, 0xfe, 0x3f, 0xe3, 0x96, 0x7a, 0x01, 0x7c, 0xf9, 0x07, 0x66, 0xe6, 0x72, 0xe0,
+	0xdf, 0x9b, 0x86, 0x1f, 0x43, 0x35, 0x11, 0xbf, 0x1a, 0xf8, 0xa6, 0x37, 0x46, 0x04, 0x26, 0xdc,
+	0x20, 0x4c, 0x95, 0xc5, 0x2a, 0xe4, 0x9d, 0x19, 0x8d, 0x5e, 0x21, 0xd2, 0x18, 0xd7, 0x31, 0xef,
+	0x1d, 0x4d, 0xb2, 0x1f, 0x1b, 0x9d, 0xaa, 0xf0, 0xb3, 0xd5, 0x31, 0xbb, 0x59, 0x52, 0x6c, 0x75,
+	0x4a, 0xa3, 0x81, 0xd4, 0xb5, 0xd3, 0x59, 0x56, 0x0a, 0x57, 0x7c, 0x14, 0x24, 0x9b, 0xb5, 0x9b,
+	0x1f, 0x14, 0x24, 0xbe, 0xed, 0xe4, 0x8a, 0xed, 0x87, 0xfe, 0x28, 0x0a, 0x42, 0xb9, 0x72, 0x76,
+	0x2c, 0x37, 0xa8, 0xb3, 0x68, 0x54, 0xaa, 0xa8, 0x7b, 0xc3, 0xfd, 0xdf, 0x95, 0x73, 0x45, 0x1e,
+	0x46, 0x61, 0xf8, 0xbd, 0x14, 0xf9, 0xe1, 0x46, 0x17, 0x29, 0xac, 0xa8, 0x4b, 0x43, 0xe2, 0x3c,
+	0xc1, 0x7b, 0x91, 0x9a, 0xf6, 0x16, 0x7e, 0xff, 0x50, 0x25, 0x6e, 0x2c, 0xe9, 0xc6, 0x28, 0x60,
+	0x45, 0x89, 0xf5, 0x0f, 0x0a, 0x12, 0x9f, 0x7d, 0x0e, 0x55, 0xec, 0xf0, 0x60, 0x98, 0x2e, 0x78,
+	0xbb, 0xd6, 0x36, 0x57, 0x3c, 0xfb, 0x6f, 0x4b, 0x3a, 0xe4, 0x9c, 0xc7, 0xba, 0x47, 0x44, 0xc7,
+	0x2a, 0xa9, 0xe7, 0xaa, 0xa2, 0xa8, 0x29, 0x18, 0x4d, 0x03, 0x8f, 0x3a, 0x98, 0x26, 0x41, 0x16,
+	0x21, 0x7a, 0x27, 0x05, 0xa9, 0x14, 0x61, 0x10, 0x4e, 0x06, 0xb1, 0x6a, 0x7d, 0xa9, 0x5e, 0xc6,
+	0x0a, 0xce, 0x3e, 0x83, 0x8a, 0x17, 0x85, 0xe1, 0xca, 0xb6, 0xd0, 0x30, 0x9c, 0x58, 0xf6, 0x1f,
+	0x43, 0x83, 0x4f, 0x23, 0x4f, 0x25, 0x41, 0x06, 0x15, 0x24, 0xb4, 0xb5, 0xe8, 0x1b, 0xef, 0x0d,
+	0x17, 0xae, 0x77, 0x4d, 0x85, 0x87, 0x4e, 0xd8, 0x19, 0x60, 0x1f, 0x42, 0xeb, 0xd8, 0x8d, 0x0f,
+	0x5d, 0xef, 0x5a, 0xf4, 0x4d, 0xa7, 0xa7, 0x9f, 0x45, 0x52, 0xfc, 0xc4, 0x84, 0x87, 0x13, 0x99,
+	0x17, 0x05, 0x74, 0xb2, 0xf5, 0xb8, 0x62, 0xd8, 0xdf, 0x42, 0xb3, 0xe7, 0x4a, 0xf7, 0xd2, 0x4d,
+	0xc5, 0xb1, 0x1b, 0xe3, 0x14, 0x03, 0x3d, 0x45, 0x85, 0xe3, 0x27, 0xfb, 0x06, 0xb6, 0x8b, 0xab,
+	0x04, 0xc2, 0x4c, 0xb6, 0xd5, 0x59, 0x58, 0x9d, 0x2f, 0x8b, 0xd9, 0x0e, 0xd4, 0x7b, 0xc2, 0x73,
+	0xe3, 0x37, 0xe2, 0xee, 0xde, 0xd3, 0x31, 0xa8, 0x60, 0xf5, 0x4d, 0x07, 0xab, 0x70, 0xfa, 0xc6,
+	0x0b, 0xfc, 0x46, 0xdc, 0xd1, 0x2b, 0x4e, 0xa7, 0x97, 0x8c, 0xb6, 0xff, 0x03, 0x1b, 0xdf, 0xa8,
+	0xc5, 0x61, 0x90, 0xc6, 0x58, 0x8b, 0x0e, 0x64, 0x72, 0x98, 0xdc, 0xc5, 0x32, 0xa2, 0x69, 0xd4,
+	0x9e, 0x17, 0x41, 0x4c, 0x24, 0x7d, 0x99, 0x38, 0xae, 0x2c, 0xac, 0x54, 0x40, 0x90, 0x3f, 0xc0,
+	0x07, 0xe3, 0x95, 0xeb, 0x09, 0x63, 0xcb, 0x02, 0xc2, 0x7e, 0x0a, 0x9b, 0x05, 0xf5, 0xa4, 0xed,
+	0x8a, 0x6e, 0x62, 0x17, 0x40, 0xbe, 0x20, 0xc1, 0xbe, 0x84, 0x86, 0x39, 0xb5, 0xea, 0x8b, 0x62,
+	0x47, 0xc1, 0x20, 0x3c, 0xe7, 0xd9, 0xff, 0x53, 0x85, 0xc7, 0xc5, 0xc8, 0x3c, 0x08, 0x53, 0xe9,
+	0x86, 0x2a, 0xfb, 0xea, 0x18, 0x3d, 0xe8, 0x99, 0xec, 0x9b, 0x01, 0x58, 0x54, 0x69, 0xe2, 0x7c,
+	0xe1, 0xda, 0x2e, 0xa1, 0x59, 0x28, 0xc4, 0xfa, 0xb1, 0xaa, 0xde, 0x1e, 0x86, 0xa6, 0x46, 0x54,
+	0x90, 0xc6, 0x53, 0xf7, 0x8e, 0xc2, 0x41, 0x4d, 0x37, 0xa2, 0x72, 0x68, 0xb1, 0x54, 0xdc, 0x58,
+	0x2e, 0x15, 0x7f, 0x09, 0x4d, 0x75, 0x67, 0xc6, 0xd4, 0xa8, 0xaa, 0x3f, 0x98, 0x1b, 0x8b, 0xe2,
+	0x2b, 0x49, 0x58, 0x15, 0x63, 0x1f, 0x4a, 0xc2, 0x9f, 0x40, 0xe3, 0x32, 0x09, 0xfc, 0x89, 0x70,
+	0xe6, 0x33, 0xea, 0x78, 0xb4, 0x78, 0x0e, 0x50, 0xd3, 0x5c, 0x11, 0x78, 0x90, 0x27, 0xba, 0x69,
+	0x9e, 0x21, 0x58, 0x74, 0x29, 0x4a, 0xb5, 0xa6, 0x75, 0x57, 0x63, 0x01, 0x63, 0xbf, 0x84, 0x56,
+	0x10, 0xe7, 0x7f, 0x01, 0xa5, 0xed, 0x67, 0x64, 0xb5, 0xa7, 0x9d, 0x7b, 0xff, 0x1c, 0xe2, 0x8b,
+	0xc2, 0xc5, 0x15, 0xc6, 0x42, 0xa6, 0xed, 0x36, 0xf9, 0xd0, 0x02, 0xc6, 0x76, 0xa1, 0x72, 0x13,
+	0x5c, 0xa5, 0xed, 0x8f, 0xb4, 0xf7, 0x14, 0xfe, 0x1e, 0xe2, 0xc4, 0xc1, 0x58, 0x1b, 0xc4, 0x37,
+	0x3f, 0xef, 0x07, 0x3e, 0x75, 0x2b, 0xea, 0xdc, 0x90, 0xf7, 0x16, 0x13, 0x9f, 0x7e, 0x8f, 0x62,
+	0xe2, 0x33, 0xa8, 0xde, 0x50, 0x8b, 0xed, 0x79, 0xb1, 0xab, 0x75, 0x1e, 0x87, 0x47, 0x6b, 0x5c,
+	0x71, 0xf0, 0xa1, 0x36, 0x25, 0x91, 0x5d, 0x5d, 0x0e, 0x67, 0xb7, 0x0b, 0x65, 0x88, 0xb5, 0xd4,
+	0x38, 0xdf, 0x5b, 0xa9, 0x4b, 0x0a, 0xdc, 0x83, 0x16, 0x34, 0x11, 0x3b, 0x8c, 0x42, 0x29, 0x42,
+	0x69, 0xff, 0x65, 0x59, 0x07, 0xdd, 0xe3, 0x74, 0x82, 0xdb, 0xf9, 0xf5, 0xc2, 0x1f, 0x55, 0xc4,
+	0x41, 0x6f, 0x4c, 0xb9, 0xe2, 0x60, 0x4a, 0xf7, 0xc5, 0xcd, 0xc0, 0xd7, 0x3e, 0xaf, 0x08, 0xcc,
+	0x2b, 0x3e, 0x6d, 0x72, 0x5d, 0xbf, 0x26, 0x0b, 0x5d, 0x4e, 0xdc, 0x26, 0x31, 0x71, 0x7a, 0x37,
+	0x30, 0xa9, 0x3d, 0x3b, 0x2d, 0xd6, 0x32, 0x6b, 0x5c, 0x71, 0xd8, 0x0b, 0xa8, 0x85, 0x01, 0xc9,
+	0xa8, 0x5a, 0xee, 0x49, 0xe7, 0xbe, 0xdb, 0x77, 0xb4, 0xc6, 0xb5, 0x18, 0x7a, 0xb9, 0x2b, 0x73,
+	0x2f, 0xaf, 0x3d, 0xec, 0xe5, 0x05, 0xf1, 0x65, 0x65, 0xfc, 0x66, 0x1d, 0xb6, 0x16, 0xeb, 0x2b,
+	0xf6, 0x85, 0xc9, 0x98, 0x25, 0x5d, 0xe8, 0x5f, 0x64, 0xbc, 0x85, 0x9c, 0xa9, 0xfb, 0x25, 0xa3,
+	0x24, 0xba, 0x14, 0x94, 0xb6, 0xca, 0xdf, 0xaf, 0x5f, 0x92, 0x0d, 0xc0, 0x98, 0x91, 0x01, 0xaa,
+	0x23, 0xa2, 0x63, 0xc6, 0x22, 0xca, 0x7e, 0x0a, 0x8f, 0xbc, 0x28, 0x4c, 0x85, 0x37, 0x97, 0xc1,
+	0x8d, 0xc0, 0x76, 0xcd, 0x3c, 0x11, 0xa9, 0xfa, 0xe7, 0x96, 0xdf, 0xc7, 0x42, 0xef, 0x2f, 0x76,
+	0xde, 0x74, 0xa4, 0x59, 0xc0, 0x58, 0xcf, 0xb4, 0xce, 0x08, 0xa3, 0x13, 0x3c, 0xac, 0xcb, 0xe5,
+	0x21, 0x38, 0x4b, 0x28, 0xbe, 0x5b, 0x98, 0x65, 0xe3, 0xe1, 0x59, 0x96, 0x86, 0xd8, 0x23, 0xd8,
+	0x5a, 0xec, 0x31, 0x7c, 0xb0, 0xf7, 0xa3, 0xfe, 0x7e, 0x2a, 0x67, 0x7f, 0x3f, 0x3d, 0x85, 0x1a,
+	0x05, 0x40, 0x61, 0xfa, 0x3e, 0x8a, 0xda, 0x9f, 0xc3, 0xce, 0xca, 0x1f, 0xe4, 0xec, 0x29, 0xb0,
+	0x05, 0xf0, 0x44, 0x5e, 0x8b, 0xc4, 0x5a, 0x5b, 0xc1, 0x5f, 0xbb, 0xf3, 0x89, 0xb0, 0x4a, 0xac,
+	0x0d, 0x8f, 0x17, 0x70, 0xdd, 0xc6, 0xb4, 0xca, 0x2b, 0x23, 0xc8, 0x33, 0xac, 0xf5, 0xfd, 0xd7,
+	0xfa, 0x65, 0x4f, 0x37, 0x88, 0x35, 0xa0, 0x7a, 0x11, 0x38, 0x51, 0x6c, 0xad, 0xb1, 0x4d, 0xa8,
+	0x5f, 0x04, 0xea, 0x7a, 0x58, 0x25, 0xc5, 0xe8, 0xc6, 0xb1, 0xb5, 0xce, 0x9e, 0xc0, 0xce, 0x45,
+	0xb0, 0xe4, 0xed, 0x56, 0x6d, 0xff, 0xef, 0x4b, 0x00, 0xf9, 0x9f, 0xc6, 0x6c, 0xcb, 0x50, 0x4e,
+	0x44, 0xd3, 0x59, 0xb0, 0xa9, 0x69, 0x21, 0xfb, 0xf2, 0xda, 0x2a, 0xb1, 0x16, 0x34, 0x14, 0x72,
+	0x36, 0x3e, 0xb0, 0xca, 0x39, 0x79, 0x78, 0x72, 0x6c, 0xad, 0xb3, 0x6d, 0x68, 0x2a, 0xb2, 0x3b,
+	0xf7, 0x83, 0xc8, 0xaa, 0xb0, 0x1d, 0x68, 0x65, 0x13, 0xbc, 0x1d, 0x76, 0x1d, 0xab, 0xba, 0x08,
+	0xbd, 0xed, 0x3a, 0x56, 0x2d, 0x5f, 0xf6, 0xa8, 0x77, 0x3c, 0xb0, 0x36, 0x98, 0x65, 0xa6, 0x51,
+	0x9a, 0xfb, 0xff, 0xd2, 0xfe, 0xbf, 0x62, 0x09, 0xa7, 0xdf, 0x3a, 0xac, 0x09, 0x1b, 0x03, 0xe7,
+	0xbc, 0x3b, 0x1c, 0xf4, 0xac, 0x35, 0x45, 0x0c, 0x4e, 0x07, 0xdd, 0xa1, 0x55, 0x62, 0x8f, 0xc1,
+	0xea, 0x9d, 0xbc, 0x75, 0x86, 0x27, 0xdd, 0xde, 0xbb, 0xf1, 0x69, 0x97, 0x9f, 0xf6, 0x7b, 0x56,
+	0x19, 0xa7, 0x37, 0x68, 0xbf, 0x67, 0xad, 0xe3, 0xa6, 0x7b, 0xfd, 0xe1, 0xe0, 0xbc, 0xcf, 0xfb,
+	0x3d, 0xab, 0x42, 0x67, 0x70, 0xc6, 0xa7, 0xdd, 0xe1, 0xb0, 0xdf, 0xb3, 0xaa, 0x38, 0xe1, 0xc1,
+	0xc9, 0xc9, 0xe9, 0xc0, 0x79, 0x6d, 0xd5, 0x90, 0xe0, 0x67, 0x8e, 0x83, 0xc4, 0x06, 0x12, 0x47,
+	0xdd, 0x21, 0x71, 0xea, 0x0c, 0xa0, 0x86, 0x44, 0xbf, 0x67, 0x35, 0x70, 0x01, 0xde, 0xa7, 0xf5,
+	0x90, 0x07, 0x28, 0x38, 0x3a, 0xe3, 0xaf, 0x91, 0x68, 0xee, 0x3b, 0xf0, 0xf4, 0xfe, 0xd6, 0x33,
+	0x8a, 0x9d, 0x39, 0x6f, 0x9c, 0x93, 0xb7, 0x8e, 0xb2, 0x9c, 0x73, 0x72, 0xfa, 0xea, 0xe4, 0xcc,
+	0xe9, 0x59, 0x25, 0xa4, 0x7a, 0x83, 0x71, 0xf7, 0x60, 0x48, 0x07, 0x68, 0xc2, 0x46, 0xdf, 0x51,
+	0xc4, 0xfa, 0xfe, 0xaf, 0x60, 0xb3, 0xd8, 0x65, 0x60, 0x75, 0xa8, 0x38, 0x27, 0x4e, 0xdf, 0x5a,
+	0x43, 0xed, 0x9b, 0x73, 0xe2, 0xd2, 0x25, 0x54, 0x75, 0xa6, 0x8e, 0x1e, 0xca, 0x94, 0x71, 0xe2,
+	0xb3, 0x51, 0xaf, 0x4b, 0x1b, 0x5d, 0xa7, 0x1d, 0x20, 0x45, 0x7a, 0xd8, 0x84, 0xfa, 0xab, 0xee,
+	0x70, 0x78, 0xd0, 0x3d, 0x7c, 0x63, 0x55, 0xf1, 0x7c, 0xaf, 0xba, 0x03, 0x5c, 0xb2, 0xb6, 0xff,
+	0xcf, 0x25, 0xd8, 0x5e, 0xea, 0x43, 0x30, 0x06, 0x5b, 0xb8, 0xec, 0xbb, 0xf1, 0xd9, 0xc1, 0xf8,
+	0xb4, 0x7b, 0x7a, 0x36, 0xb6, 0xd6, 0xd8, 0x33, 0x78, 0x94, 0xad, 0x37, 0x70, 0x46, 0xfc, 0xe4,
+	0x35, 0xef, 0x8f, 0xc7, 0x56, 0x09, 0xbd, 0xef, 0xbc, 0xcf, 0x07, 0xaf, 0xbe, 0x2d, 0xc2, 0x65,
+	0x94, 0x57, 0xcb, 0xbf, 0xd3, 0x26, 0x1c, 0x5c, 0xa8, 0x7d, 0x3d, 0x06, 0x4b, 0x33, 0x78, 0xdf,
+	0x18, 0xa3, 0x82, 0x4b, 0x6a, 0xf4, 0xb4, 0x3f, 0x26, 0xac, 0xca, 0x3e, 0x81, 0xb6, 0xc6, 0x9c,
+	0x7e, 0xbf, 0x47, 0x8c, 0x77, 0x87, 0x27, 0xce, 0xab, 0x01, 0x3f, 0xb6, 0x6a, 0xfb, 0x7f, 0x5d,
+	0x82, 0xd6, 0xc2, 0x4b, 0x04, 0x75, 0x74, 0x3e, 0x72, 0xde, 0xe5, 0xfe, 0x93, 0x01, 0xc6, 0x87,
+	0x18, 0x6c, 0x21, 0x70, 0x78, 0xe2, 0x38, 0xfd, 0x43, 0x5a, 0xa5, 0xcc, 0x1e, 0xc1, 0x36, 0x62,
+	0x68, 0xe3, 0x83, 0xe1, 0x60, 0x7c, 0x44, 0x6e, 0xb4, 0x03, 0x2d, 0x35, 0xd2, 0xf8, 0x4e, 0xc5,
+	0x4c, 0xc6, 0xfb, 0x6f, 0xfa, 0xdf, 0x92, 0x33, 0x69, 0xa0, 0xd7, 0x1f, 0xf6, 0x51, 0xc9, 0xb0,
+	0xff, 0x0f, 0x25, 0xd8, 0x5e, 0x8a, 0xf4, 0x78, 0xcb, 0xbb, 0xa3, 0xd1, 0xbb, 0xa3, 0x7e, 0x77,
+	0x78, 0x7a, 0xf4, 0x2e, 0x77, 0x89, 0x67, 0xf0, 0xa8, 0x80, 0x67, 0xfe, 0x55, 0x5a, 0x1a, 0xa0,
+	0x7e, 0xbe, 0xb5, 0xca, 0x18, 0x48, 0x16, 0x26, 0x32, 0x9c, 0x75, 0xf6, 0x11, 0x3c, 0x29, 0x70,
+	0x0a, 0xce, 0x5a, 0x41, 0xdb, 0x14, 0x58, 0xda, 0xe6, 0xd5, 0x83, 0x3e, 0x3c, 0xf7, 0xa2, 0x59,
+	0xe7, 0xd7, 0xd8, 0x72, 0x77, 0x3b, 0xde, 0x34, 0x9a, 0xfb, 0x1d, 0xec, 0x67, 0x61, 0x68, 0x51,
+	0xc1, 0xf6, 0xc2, 0x9e, 0x04, 0xf2, 0x7a, 0x7e, 0xd9, 0xf1, 0xa2, 0xd9, 0x8b, 0xe9, 0xd5, 0x57,
+	0xc2, 0x9f, 0x88, 0x17, 0xe2, 0x46, 0xbc, 0x70, 0xe3, 0xe0, 0xc5, 0x24, 0x7a, 0x81, 0x69, 0xf4,
+	0xb2, 0x46, 0xa2, 0x3f, 0xfb, 0xed, 0x00, 0xbe, 0x9c, 0xa5, 0x17, 0xaf, 0x24, 0x00, 0x00,
 }
@@ -151,18 +151,19 @@ type NetworkMetric struct {
 	RxDrops uint64 `protobuf:"varint,5,opt,name=rxDrops,proto3" json:"rxDrops,omitempty"`
 	// deprecated = 6;
 	// deprecated = 7;
-	TxPkts               uint64   `protobuf:"varint,8,opt,name=txPkts,proto3" json:"txPkts,omitempty"`
-	RxPkts               uint64   `protobuf:"varint,9,opt,name=rxPkts,proto3" json:"rxPkts,omitempty"`
-	TxErrors             uint64   `protobuf:"varint,10,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
-	RxErrors             uint64   `protobuf:"varint,11,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
-	TxAclDrops           uint64   `protobuf:"varint,12,opt,name=txAclDrops,proto3" json:"txAclDrops,omitempty"`
-	RxAclDrops           uint64   `protobuf:"varint,13,opt,name=rxAclDrops,proto3" json:"rxAclDrops,omitempty"`
-	TxAclRateLimitDrops  uint64   `protobuf:"varint,14,opt,name=txAclRateLimitDrops,proto3" json:"txAclRateLimitDrops,omitempty"`
-	RxAclRateLimitDrops  uint64   `protobuf:"varint,15,opt,name=rxAclRateLimitDrops,proto3" json:"rxAclRateLimitDrops,omitempty"`
-	LocalName            string   `protobuf:"bytes,16,opt,name=localName,proto3" json:"localName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TxPkts               uint64       `protobuf:"varint,8,opt,name=txPkts,proto3" json:"txPkts,omitempty"`
+	RxPkts               uint64       `protobuf:"varint,9,opt,name=rxPkts,proto3" json:"rxPkts,omitempty"`
+	TxErrors             uint64       `protobuf:"varint,10,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
+	RxErrors             uint64       `protobuf:"varint,11,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
+	TxAclDrops           uint64       `protobuf:"varint,12,opt,name=txAclDrops,proto3" json:"txAclDrops,omitempty"`
+	RxAclDrops           uint64       `protobuf:"varint,13,opt,name=rxAclDrops,proto3" json:"rxAclDrops,omitempty"`
+	TxAclRateLimitDrops  uint64       `protobuf:"varint,14,opt,name=txAclRateLimitDrops,proto3" json:"txAclRateLimitDrops,omitempty"`
+	RxAclRateLimitDrops  uint64       `protobuf:"varint,15,opt,name=rxAclRateLimitDrops,proto3" json:"rxAclRateLimitDrops,omitempty"`
+	LocalName            string       `protobuf:"bytes,16,opt,name=localName,proto3" json:"localName,omitempty"`
+	Rate                 *NetworkRate `protobuf:"bytes,17,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *NetworkMetric) Reset()         { *m = NetworkMetric{} }
@@ -288,6 +289,13 @@ func (m *NetworkMetric) GetLocalName() string {
 	return ""
 }
 
+func (m *NetworkMetric) GetRate() *NetworkRate {
+	if m != nil {
+		return m.Rate
+	}
+	return nil
+}
+
 // Failures and successes for commuication to zedcloud
 // for each management port
 type ZedcloudMetric struct {
@@ -859,14 +867,19 @@ func (m *DiskMetric) GetFree() uint64 {
 }
 
 type AppDiskMetric struct {
-	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
-	Provisioned          uint64   `protobuf:"varint,2,opt,name=provisioned,proto3" json:"provisioned,omitempty"`
-	Used                 uint64   `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
-	DiskType             string   `protobuf:"bytes,4,opt,name=diskType,proto3" json:"diskType,omitempty"`
-	Dirty                bool     `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Disk                 string      `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Provisioned          uint64      `protobuf:"varint,2,opt,name=provisioned,proto3" json:"provisioned,omitempty"`
+	Used                 uint64      `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	DiskType             string      `protobuf:"bytes,4,opt,name=diskType,proto3" json:"diskType,omitempty"`
+	Dirty                bool        `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	ReadBytes            uint64      `protobuf:"varint,6,opt,name=readBytes,proto3" json:"readBytes,omitempty"`
+	WriteBytes           uint64      `protobuf:"varint,7,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	ReadCount            uint64      `protobuf:"varint,8,opt,name=readCount,proto3" json:"readCount,omitempty"`
+	WriteCount           uint64      `protobuf:"varint,9,opt,name=writeCount,proto3" json:"writeCount,omitempty"`
+	Rate                 *DiskIoRate `protobuf:"bytes,10,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *AppDiskMetric) Reset()         { *m = AppDiskMetric{} }
@@ -929,17 +942,54 @@ func (m *AppDiskMetric) GetDirty() bool {
 	return false
 }
 
+func (m *AppDiskMetric) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *AppDiskMetric) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *AppDiskMetric) GetReadCount() uint64 {
+	if m != nil {
+		return m.ReadCount
+	}
+	return 0
+}
+
+func (m *AppDiskMetric) GetWriteCount() uint64 {
+	if m != nil {
+		return m.WriteCount
+	}
+	return 0
+}
+
+func (m *AppDiskMetric) GetRate() *DiskIoRate {
+	if m != nil {
+		return m.Rate
+	}
+	return nil
+}
+
 type AppMetric struct {
-	AppID                string           `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
-	AppVersion           string           `protobuf:"bytes,10,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
-	AppName              string           `protobuf:"bytes,2,opt,name=AppName,proto3" json:"AppName,omitempty"`
-	Cpu                  *AppCpuMetric    `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Memory               *MemoryMetric    `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
-	Network              []*NetworkMetric `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
-	Disk                 []*AppDiskMetric `protobuf:"bytes,6,rep,name=disk,proto3" json:"disk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	AppID      string           `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	AppVersion string           `protobuf:"bytes,10,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	AppName    string           `protobuf:"bytes,2,opt,name=AppName,proto3" json:"AppName,omitempty"`
+	Cpu        *AppCpuMetric    `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory     *MemoryMetric    `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Network    []*NetworkMetric `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
+	Disk       []*AppDiskMetric `protobuf:"bytes,6,rep,name=disk,proto3" json:"disk,omitempty"`
+	// Oldest first; the last hour at one minute resolution
+	History              []*AppMetricSample `protobuf:"bytes,7,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *AppMetric) Reset()         { *m = AppMetric{} }
@@ -1016,6 +1066,13 @@ func (m *AppMetric) GetDisk() []*AppDiskMetric {
 	return nil
 }
 
+func (m *AppMetric) GetHistory() []*AppMetricSample {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 // Lisp stats
 type PktStat struct {
 	Packets              uint64   `protobuf:"varint,1,opt,name=Packets,proto3" json:"Packets,omitempty"`
@@ -2239,6 +2296,208 @@ func (*ZMetricMsg) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Per second rates for a disk, or the sum over the disks of an app instance
+type DiskIoRate struct {
+	ReadBytes            float64  `protobuf:"fixed64,1,opt,name=readBytes,proto3" json:"readBytes,omitempty"`
+	WriteBytes           float64  `protobuf:"fixed64,2,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	ReadOps              float64  `protobuf:"fixed64,3,opt,name=readOps,proto3" json:"readOps,omitempty"`
+	WriteOps             float64  `protobuf:"fixed64,4,opt,name=writeOps,proto3" json:"writeOps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskIoRate) Reset()         { *m = DiskIoRate{} }
+func (m *DiskIoRate) String() string { return proto.CompactTextString(m) }
+func (*DiskIoRate) ProtoMessage()    {}
+func (*DiskIoRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6039342a2ba47b72, []int{25}
+}
+
+func (m *DiskIoRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskIoRate.Unmarshal(m, b)
+}
+func (m *DiskIoRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskIoRate.Marshal(b, m, deterministic)
+}
+func (m *DiskIoRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskIoRate.Merge(m, src)
+}
+func (m *DiskIoRate) XXX_Size() int {
+	return xxx_messageInfo_DiskIoRate.Size(m)
+}
+func (m *DiskIoRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskIoRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskIoRate proto.InternalMessageInfo
+
+func (m *DiskIoRate) GetReadBytes() float64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *DiskIoRate) GetWriteBytes() float64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *DiskIoRate) GetReadOps() float64 {
+	if m != nil {
+		return m.ReadOps
+	}
+	return 0
+}
+
+func (m *DiskIoRate) GetWriteOps() float64 {
+	if m != nil {
+		return m.WriteOps
+	}
+	return 0
+}
+
+// Per second rates as seen by the app instance for an interface, or the
+// sum over its interfaces
+type NetworkRate struct {
+	TxBytes              float64  `protobuf:"fixed64,1,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+	RxBytes              float64  `protobuf:"fixed64,2,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	TxPkts               float64  `protobuf:"fixed64,3,opt,name=txPkts,proto3" json:"txPkts,omitempty"`
+	RxPkts               float64  `protobuf:"fixed64,4,opt,name=rxPkts,proto3" json:"rxPkts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkRate) Reset()         { *m = NetworkRate{} }
+func (m *NetworkRate) String() string { return proto.CompactTextString(m) }
+func (*NetworkRate) ProtoMessage()    {}
+func (*NetworkRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6039342a2ba47b72, []int{26}
+}
+
+func (m *NetworkRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkRate.Unmarshal(m, b)
+}
+func (m *NetworkRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkRate.Marshal(b, m, deterministic)
+}
+func (m *NetworkRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkRate.Merge(m, src)
+}
+func (m *NetworkRate) XXX_Size() int {
+	return xxx_messageInfo_NetworkRate.Size(m)
+}
+func (m *NetworkRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkRate proto.InternalMessageInfo
+
+func (m *NetworkRate) GetTxBytes() float64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
+func (m *NetworkRate) GetRxBytes() float64 {
+	if m != nil {
+		return m.RxBytes
+	}
+	return 0
+}
+
+func (m *NetworkRate) GetTxPkts() float64 {
+	if m != nil {
+		return m.TxPkts
+	}
+	return 0
+}
+
+func (m *NetworkRate) GetRxPkts() float64 {
+	if m != nil {
+		return m.RxPkts
+	}
+	return 0
+}
+
+// Usage of an app instance over an interval, kept on the device so that
+// the controller can look at the recent past
+type AppMetricSample struct {
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,1,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Interval             uint32               `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	CpuPercent           float64              `protobuf:"fixed64,3,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	Disk                 *DiskIoRate          `protobuf:"bytes,4,opt,name=disk,proto3" json:"disk,omitempty"`
+	Network              *NetworkRate         `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AppMetricSample) Reset()         { *m = AppMetricSample{} }
+func (m *AppMetricSample) String() string { return proto.CompactTextString(m) }
+func (*AppMetricSample) ProtoMessage()    {}
+func (*AppMetricSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6039342a2ba47b72, []int{27}
+}
+
+func (m *AppMetricSample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppMetricSample.Unmarshal(m, b)
+}
+func (m *AppMetricSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppMetricSample.Marshal(b, m, deterministic)
+}
+func (m *AppMetricSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMetricSample.Merge(m, src)
+}
+func (m *AppMetricSample) XXX_Size() int {
+	return xxx_messageInfo_AppMetricSample.Size(m)
+}
+func (m *AppMetricSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMetricSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppMetricSample proto.InternalMessageInfo
+
+func (m *AppMetricSample) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *AppMetricSample) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *AppMetricSample) GetCpuPercent() float64 {
+	if m != nil {
+		return m.CpuPercent
+	}
+	return 0
+}
+
+func (m *AppMetricSample) GetDisk() *DiskIoRate {
+	if m != nil {
+		return m.Disk
+	}
+	return nil
+}
+
+func (m *AppMetricSample) GetNetwork() *NetworkRate {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ZmetricTypes", ZmetricTypes_name, ZmetricTypes_value)
 	proto.RegisterEnum("MetricItemType", MetricItemType_name, MetricItemType_value)
@@ -2267,147 +2526,162 @@ func init() {
 	proto.RegisterType((*ZMetricNetworkStats)(nil), "ZMetricNetworkStats")
 	proto.RegisterType((*ZMetricNetworkInstance)(nil), "ZMetricNetworkInstance")
 	proto.RegisterType((*ZMetricMsg)(nil), "ZMetricMsg")
+	proto.RegisterType((*DiskIoRate)(nil), "diskIoRate")
+	proto.RegisterType((*NetworkRate)(nil), "networkRate")
+	proto.RegisterType((*AppMetricSample)(nil), "appMetricSample")
 }
 
 func init() { proto.RegisterFile("metrics.proto", fileDescriptor_6039342a2ba47b72) }
 
 var fileDescriptor_6039342a2ba47b72 = []byte{
-	// 2188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0xf1, 0x17, 0x3f, 0x45, 0x16, 0x49, 0x91, 0x6e, 0x7b, 0xf5, 0x27, 0x8c, 0xfd, 0x5b, 0xca, 0xc4,
-	0xeb, 0x08, 0xde, 0xec, 0x28, 0x90, 0x17, 0xc6, 0x26, 0xf0, 0xc5, 0xfa, 0x58, 0x8b, 0x88, 0x25,
-	0x0b, 0x2d, 0xc3, 0x08, 0x0c, 0xe4, 0xd0, 0x9a, 0x69, 0xd1, 0x13, 0xce, 0x17, 0x7a, 0x7a, 0x68,
-	0x71, 0x4f, 0x39, 0xe4, 0x94, 0xbd, 0x27, 0x8f, 0x90, 0x6b, 0x6e, 0x7b, 0xcb, 0x6b, 0x04, 0x79,
-	0x81, 0xdc, 0x73, 0xcb, 0x21, 0x87, 0xa0, 0xba, 0x7b, 0x3e, 0x45, 0x3b, 0x7e, 0x80, 0xbd, 0x4d,
-	0xd5, 0xef, 0x57, 0x35, 0xd5, 0x55, 0xd5, 0xd5, 0x3d, 0x03, 0xa3, 0x80, 0x4b, 0xe1, 0x39, 0x89,
-	0x1d, 0x8b, 0x48, 0x46, 0xf7, 0x77, 0xe6, 0x51, 0x34, 0xf7, 0xf9, 0xbe, 0x92, 0xae, 0xd2, 0xeb,
-	0x7d, 0xe9, 0x05, 0x3c, 0x91, 0x2c, 0x88, 0x35, 0xc1, 0xfa, 0x53, 0x03, 0x86, 0x01, 0x0f, 0x22,
-	0xb1, 0x3a, 0x53, 0x86, 0x64, 0x0a, 0x9b, 0x69, 0xc2, 0xdd, 0x33, 0x1e, 0x4c, 0x9b, 0xbb, 0x8d,
-	0xbd, 0x11, 0xcd, 0x44, 0x72, 0x1f, 0x7a, 0x6c, 0xc9, 0x3c, 0x1f, 0xa1, 0x96, 0x82, 0x72, 0x99,
-	0x3c, 0x82, 0x2d, 0xa4, 0x5d, 0x70, 0xe1, 0xf0, 0x50, 0xb2, 0x39, 0x9f, 0xb6, 0x77, 0x1b, 0x7b,
-	0x0d, 0x5a, 0xd3, 0x92, 0x3d, 0x18, 0x2b, 0x9b, 0x12, 0xb1, 0xa3, 0x88, 0x75, 0xb5, 0xf5, 0x43,
-	0x0b, 0x46, 0x21, 0x97, 0xef, 0x23, 0xb1, 0x30, 0x91, 0xdd, 0x83, 0x8e, 0x77, 0xce, 0x02, 0x3e,
-	0x6d, 0xec, 0x36, 0xf6, 0xfa, 0x54, 0x0b, 0x18, 0xaf, 0xbc, 0x39, 0x5c, 0x49, 0x9e, 0xa8, 0x78,
-	0xdb, 0x34, 0x13, 0x11, 0x11, 0x06, 0x69, 0x69, 0x44, 0x14, 0x88, 0xbc, 0x39, 0x16, 0x51, 0x9c,
-	0xa8, 0x30, 0x95, 0x8d, 0x12, 0xb5, 0x8d, 0x46, 0x3a, 0x99, 0x8d, 0x46, 0xb6, 0xa1, 0x2b, 0x6f,
-	0x2e, 0x16, 0x32, 0x99, 0xf6, 0x14, 0x60, 0x24, 0xd4, 0x0b, 0xad, 0xef, 0x6b, 0xbd, 0x96, 0x30,
-	0x5b, 0xf2, 0xe6, 0x44, 0x88, 0x48, 0x24, 0x53, 0x50, 0x48, 0x2e, 0x23, 0x26, 0x32, 0x6c, 0xa0,
-	0xb1, 0x4c, 0x26, 0x0f, 0x00, 0xe4, 0xcd, 0x73, 0xc7, 0xd7, 0x41, 0x0c, 0x15, 0x5a, 0xd2, 0x20,
-	0x2e, 0x0a, 0x7c, 0xa4, 0xf1, 0x42, 0x43, 0x7e, 0x01, 0x77, 0x15, 0x9b, 0x32, 0xc9, 0x5f, 0x7a,
-	0x81, 0x27, 0x35, 0x71, 0x4b, 0x11, 0xd7, 0x41, 0x68, 0x21, 0xd6, 0x58, 0x8c, 0xb5, 0xc5, 0x1a,
-	0x88, 0x7c, 0x0e, 0x7d, 0x3f, 0x72, 0x98, 0xaf, 0xaa, 0x31, 0x51, 0xd5, 0x28, 0x14, 0xd6, 0xf7,
-	0x4d, 0xd8, 0xfa, 0x8e, 0xbb, 0x8e, 0x1f, 0xa5, 0xae, 0x29, 0xdd, 0x36, 0x74, 0xbd, 0xeb, 0x52,
-	0xed, 0x8c, 0x84, 0x89, 0xb8, 0x66, 0x9e, 0x9f, 0x8a, 0xbc, 0x7a, 0xb9, 0x8c, 0xa5, 0x48, 0x52,
-	0xc7, 0xe1, 0x49, 0x5e, 0x3e, 0x23, 0x92, 0x67, 0x30, 0xf0, 0x59, 0x22, 0xbf, 0xd5, 0x4c, 0x55,
-	0xc2, 0xc1, 0xc1, 0x7d, 0x5b, 0xb7, 0xba, 0x9d, 0xb5, 0xba, 0xfd, 0x3a, 0x6b, 0x75, 0x5a, 0xa6,
-	0x67, 0xd6, 0x97, 0xc6, 0x77, 0xe7, 0xd3, 0xac, 0x0d, 0x9d, 0xec, 0x03, 0xa4, 0xc2, 0xd7, 0xcb,
-	0x4a, 0xa6, 0xdd, 0xdd, 0xd6, 0xde, 0xe0, 0x60, 0x6c, 0xa7, 0xc2, 0x2f, 0x2d, 0x97, 0x96, 0x28,
-	0xd6, 0x7f, 0x1a, 0xb0, 0x55, 0x85, 0xc9, 0x04, 0x5a, 0xa9, 0xf0, 0x4d, 0x2a, 0xf0, 0x91, 0xec,
-	0xc2, 0x40, 0x8a, 0xd5, 0x59, 0x32, 0x3f, 0x8a, 0xd2, 0x50, 0xaa, 0x54, 0xb4, 0x68, 0x59, 0x45,
-	0x2c, 0x18, 0x4a, 0xb1, 0xc2, 0xf6, 0xd5, 0x94, 0x96, 0xa2, 0x54, 0x74, 0xc8, 0x49, 0x78, 0x28,
-	0x73, 0x37, 0x6d, 0xcd, 0x29, 0xeb, 0xc8, 0x43, 0x18, 0xa1, 0x5c, 0x38, 0xea, 0x28, 0x52, 0x55,
-	0x89, 0x9e, 0x04, 0x77, 0x96, 0xb9, 0xa7, 0xae, 0xf6, 0x54, 0xd6, 0xa1, 0x27, 0x94, 0x0b, 0x4f,
-	0x9b, 0xda, 0x53, 0x45, 0x69, 0xfd, 0x06, 0x86, 0x2c, 0x8e, 0x8f, 0xe2, 0xd4, 0xac, 0xfd, 0x00,
-	0xba, 0x69, 0x8c, 0xb9, 0xfd, 0x84, 0xb2, 0x19, 0x26, 0x6e, 0x7c, 0x19, 0x49, 0xe6, 0x9b, 0x2d,
-	0xa9, 0x05, 0xeb, 0x6f, 0x2d, 0x18, 0xba, 0x7c, 0xe9, 0x39, 0xdc, 0xb8, 0xfe, 0x02, 0xba, 0x7a,
-	0x92, 0xa9, 0xfc, 0x0d, 0x0e, 0x46, 0x76, 0x79, 0xb0, 0x51, 0x03, 0x92, 0x3d, 0xd8, 0x34, 0x73,
-	0x65, 0xda, 0x52, 0xe5, 0xdb, 0xb2, 0x2b, 0x73, 0x86, 0x66, 0x30, 0xf9, 0x12, 0x7a, 0x59, 0x1f,
-	0x4f, 0xdb, 0xa6, 0xd2, 0xd5, 0xc6, 0xa6, 0x39, 0x81, 0xec, 0x40, 0xdb, 0xf5, 0x92, 0x85, 0x69,
-	0x89, 0x81, 0x8d, 0x82, 0x21, 0x29, 0x80, 0x7c, 0x09, 0x7d, 0x27, 0x4b, 0x83, 0xca, 0x15, 0x46,
-	0x58, 0xce, 0x0d, 0x2d, 0x70, 0xf2, 0x15, 0x0c, 0xf4, 0x20, 0x9f, 0x49, 0x1e, 0xe0, 0xc8, 0xd1,
-	0x4e, 0xcf, 0x72, 0x1d, 0x2d, 0xe3, 0xe4, 0x57, 0x30, 0x15, 0x69, 0x88, 0xb3, 0xfd, 0x52, 0x46,
-	0x82, 0xcd, 0xf9, 0xab, 0x25, 0x17, 0xef, 0x38, 0x73, 0xcf, 0x0e, 0xcd, 0x58, 0xfa, 0x20, 0x8e,
-	0xdb, 0x9f, 0xc5, 0x31, 0x4d, 0xc3, 0xd7, 0x05, 0x7c, 0x76, 0x68, 0x66, 0xd6, 0x3a, 0x88, 0x9c,
-	0xc0, 0x76, 0xb2, 0x4a, 0x24, 0x0f, 0x2e, 0xb9, 0xc0, 0xfc, 0x27, 0x67, 0x3a, 0xcf, 0x87, 0x6a,
-	0x98, 0xdd, 0x4a, 0xfc, 0x07, 0xc8, 0xd6, 0x1f, 0x9a, 0x00, 0xc5, 0x82, 0x70, 0x57, 0x2c, 0xf8,
-	0x2a, 0xdb, 0x15, 0x0b, 0xbe, 0x22, 0x3f, 0x85, 0xb6, 0x5c, 0xc5, 0x5c, 0x95, 0x73, 0xeb, 0x60,
-	0x5c, 0x5a, 0xfd, 0xeb, 0x55, 0xcc, 0xa9, 0x02, 0xc9, 0x03, 0xe8, 0x5f, 0x45, 0x91, 0xff, 0x86,
-	0xf9, 0x29, 0x57, 0xbb, 0xa2, 0x77, 0xba, 0x41, 0x0b, 0x15, 0xb1, 0x60, 0x90, 0x7a, 0xa1, 0x7c,
-	0x72, 0xa0, 0x19, 0xd8, 0x75, 0xa3, 0xd3, 0x0d, 0x5a, 0x56, 0x66, 0x9c, 0xa7, 0x5f, 0x6b, 0x8e,
-	0x6a, 0xb3, 0x8c, 0x63, 0x94, 0x64, 0x17, 0xe0, 0xda, 0x8f, 0x98, 0xd4, 0x14, 0xdc, 0x10, 0xcd,
-	0xd3, 0x0d, 0x5a, 0xd2, 0xa1, 0x97, 0x44, 0x0a, 0x2f, 0x9c, 0x6b, 0x0a, 0x96, 0xb8, 0x8f, 0x5e,
-	0x4a, 0xca, 0xc3, 0x3b, 0x30, 0x2e, 0xea, 0xa6, 0x54, 0xd6, 0xbf, 0x1b, 0x00, 0x45, 0xb3, 0x10,
-	0x62, 0xfa, 0x48, 0xe7, 0x41, 0xb7, 0xce, 0xe7, 0xd0, 0x0f, 0x70, 0x37, 0x5d, 0x30, 0xf9, 0x4e,
-	0x65, 0xa3, 0x4f, 0x0b, 0x05, 0xa2, 0x82, 0x33, 0xb7, 0x7c, 0xd2, 0x15, 0x0a, 0x3c, 0x2f, 0xde,
-	0x0b, 0x4f, 0x72, 0x0d, 0xeb, 0xe3, 0xae, 0xa4, 0xc9, 0xac, 0x8b, 0x61, 0x60, 0xac, 0xf5, 0x26,
-	0xcf, 0xac, 0x8b, 0x31, 0x90, 0x59, 0x6b, 0x3c, 0xdf, 0x9a, 0x9b, 0xa5, 0xad, 0x89, 0x6b, 0xc0,
-	0x73, 0xdf, 0x9c, 0x94, 0xea, 0x19, 0x75, 0xd7, 0x82, 0x73, 0xd3, 0x8e, 0xea, 0xd9, 0xfa, 0xbe,
-	0x01, 0x23, 0x16, 0xc7, 0xc7, 0x1f, 0x5f, 0xfd, 0x2e, 0x0c, 0x62, 0x11, 0x2d, 0xbd, 0xc4, 0x8b,
-	0x42, 0xee, 0x9a, 0x73, 0xa2, 0xac, 0xca, 0xdf, 0xd7, 0x2a, 0xbd, 0xef, 0x3e, 0xf4, 0xd0, 0x1a,
-	0x3b, 0x45, 0xad, 0xba, 0x4f, 0x73, 0x19, 0xa3, 0x76, 0x3d, 0x21, 0x57, 0x6a, 0xbd, 0x3d, 0xaa,
-	0x05, 0xeb, 0x5f, 0x0d, 0xe8, 0xb3, 0x38, 0x2e, 0x6e, 0x1b, 0xcf, 0xe3, 0x78, 0x76, 0x9c, 0xdd,
-	0x36, 0x94, 0x80, 0xf9, 0x60, 0x71, 0xfc, 0x86, 0x0b, 0x7c, 0xb3, 0xda, 0x23, 0x7d, 0x5a, 0xd2,
-	0xe0, 0xa1, 0xf5, 0x3c, 0x8e, 0xd5, 0x49, 0xa7, 0xeb, 0x94, 0x89, 0x64, 0x07, 0x5a, 0x4e, 0x9c,
-	0xaa, 0x10, 0x6f, 0x6d, 0x7c, 0x44, 0x4a, 0xe3, 0xab, 0xfd, 0x89, 0xe3, 0xab, 0xf3, 0xf1, 0xf1,
-	0x65, 0x55, 0x26, 0xd2, 0x96, 0x5d, 0xc9, 0xb4, 0xce, 0xad, 0xf5, 0x4b, 0xd8, 0xbc, 0x58, 0xc8,
-	0x4b, 0xc9, 0x24, 0x86, 0x7e, 0xc1, 0x9c, 0x05, 0x97, 0x89, 0x5a, 0x72, 0x9b, 0x66, 0x22, 0xa6,
-	0xa2, 0x7c, 0xc1, 0xd2, 0x82, 0xf5, 0x1e, 0xfa, 0xd4, 0x8f, 0x1c, 0xb4, 0x4d, 0xb0, 0x02, 0x28,
-	0x64, 0x75, 0xc3, 0x67, 0xf2, 0x00, 0x3a, 0x0a, 0x34, 0xe3, 0xb8, 0x67, 0x9b, 0x37, 0x51, 0xad,
-	0x26, 0x4f, 0x61, 0xfb, 0x92, 0x3b, 0x51, 0xe8, 0x26, 0x97, 0x5e, 0xe8, 0xf0, 0x97, 0x2c, 0x91,
-	0xfa, 0x8d, 0xa6, 0x8e, 0x1f, 0x40, 0xad, 0x6b, 0xe8, 0x9d, 0x78, 0xae, 0xf6, 0x31, 0x81, 0xd6,
-	0xcc, 0xd4, 0xa8, 0x4d, 0xf1, 0x11, 0x35, 0x27, 0xb3, 0x63, 0x93, 0x7d, 0x7c, 0x24, 0x4f, 0x61,
-	0x92, 0x07, 0x7a, 0x12, 0x4a, 0xe1, 0xa9, 0x6d, 0x82, 0x39, 0x01, 0x3b, 0x07, 0xe8, 0x2d, 0x8e,
-	0xf5, 0xcf, 0x36, 0x0c, 0xde, 0xea, 0x6c, 0xbd, 0xf4, 0x92, 0x98, 0x3c, 0x81, 0x71, 0xf6, 0xde,
-	0xcc, 0x4d, 0x43, 0xb9, 0xe9, 0xdb, 0x99, 0x9e, 0xd6, 0x19, 0xe4, 0x1b, 0x20, 0x33, 0x29, 0x74,
-	0xe4, 0x97, 0x3c, 0x74, 0xd5, 0x2d, 0xef, 0x56, 0x46, 0xd6, 0x70, 0xc8, 0x01, 0x8c, 0x67, 0xe1,
-	0x92, 0xf9, 0x9e, 0x7b, 0xe2, 0x19, 0xb3, 0x56, 0xcd, 0xac, 0x4e, 0x20, 0x3f, 0x87, 0xe1, 0x79,
-	0x74, 0xcc, 0x1d, 0xb1, 0x8a, 0xe5, 0xaf, 0x79, 0xd6, 0x49, 0x85, 0x41, 0x05, 0x25, 0x5f, 0xc3,
-	0xe4, 0x55, 0x2a, 0xb9, 0x38, 0xe5, 0xcc, 0xe5, 0x42, 0xbf, 0xa2, 0x53, 0xb3, 0xb8, 0xc5, 0xc0,
-	0xb8, 0x0e, 0x99, 0x3b, 0x0b, 0x43, 0x2e, 0xb2, 0x7d, 0xd0, 0xad, 0xc7, 0x55, 0x23, 0x90, 0xc7,
-	0x30, 0x78, 0x11, 0x45, 0x6e, 0xd6, 0x5f, 0x9b, 0x35, 0x7e, 0x19, 0x24, 0x0f, 0xa1, 0x37, 0x3b,
-	0x7a, 0xa3, 0xa3, 0xe9, 0xd5, 0x88, 0x39, 0x82, 0x51, 0x60, 0x51, 0xca, 0xa1, 0xf7, 0xeb, 0x51,
-	0xd4, 0x08, 0xc4, 0x86, 0xd1, 0xd1, 0x3b, 0xee, 0x2c, 0x2e, 0xd3, 0x40, 0x5b, 0x40, 0xcd, 0xa2,
-	0x0a, 0x63, 0xed, 0x8e, 0xb9, 0xc3, 0x62, 0xca, 0x67, 0xe1, 0xef, 0xb8, 0x23, 0xb5, 0xd1, 0xa0,
-	0x5e, 0xbb, 0xdb, 0x1c, 0xac, 0x83, 0xc9, 0xb3, 0xb6, 0x19, 0xd6, 0xeb, 0x50, 0x46, 0xad, 0xbf,
-	0x34, 0xf2, 0x46, 0x3b, 0x8a, 0xc2, 0x90, 0xec, 0x42, 0x77, 0x16, 0xaa, 0x4f, 0x8a, 0x46, 0xcd,
-	0xce, 0xe8, 0x89, 0x05, 0x9b, 0xaf, 0x52, 0xa9, 0x28, 0xf5, 0x56, 0xca, 0x00, 0xe4, 0x9c, 0x08,
-	0xa1, 0x38, 0xf5, 0xbe, 0xc9, 0x00, 0x95, 0x11, 0x26, 0x3c, 0x95, 0x20, 0xc5, 0x6c, 0xdf, 0xca,
-	0x48, 0x19, 0xb6, 0xfe, 0xda, 0x00, 0x30, 0x91, 0xbe, 0x89, 0x43, 0xb2, 0x07, 0x3d, 0x0c, 0x18,
-	0x99, 0x26, 0xd4, 0xa1, 0x5d, 0x5a, 0x08, 0xcd, 0x51, 0xf2, 0x08, 0x36, 0x67, 0x0b, 0xae, 0x88,
-	0xcd, 0x35, 0xc4, 0x0c, 0x44, 0x8f, 0xe7, 0x4c, 0xbe, 0x56, 0xc4, 0xd6, 0x3a, 0x8f, 0x19, 0x8a,
-	0x1e, 0x4f, 0x92, 0x58, 0x11, 0xdb, 0xeb, 0x3c, 0x1a, 0xd0, 0x1a, 0xe5, 0xb9, 0x3d, 0x8f, 0x42,
-	0x6e, 0xfd, 0x16, 0xc6, 0x46, 0xfc, 0xd6, 0x8f, 0xde, 0xbf, 0xf4, 0xc2, 0x05, 0x99, 0x42, 0x37,
-	0x49, 0xaf, 0xce, 0xb9, 0x5e, 0x03, 0x1e, 0xd9, 0x46, 0x26, 0x04, 0x5a, 0xdc, 0xd3, 0x27, 0x0e,
-	0xaa, 0x51, 0xc0, 0x61, 0x98, 0xc4, 0xde, 0x4c, 0x1f, 0x36, 0x7d, 0xaa, 0x85, 0xc3, 0x2e, 0xb4,
-	0xd1, 0x97, 0xf5, 0xe7, 0x06, 0xdc, 0x2d, 0xf9, 0x3f, 0x09, 0xdd, 0x8b, 0xc8, 0x0b, 0x71, 0xb8,
-	0x76, 0xbd, 0xf8, 0xb9, 0xeb, 0x8a, 0xe2, 0x1d, 0x5a, 0x26, 0xf7, 0xa0, 0x2d, 0x70, 0x72, 0x66,
-	0x2f, 0x51, 0x12, 0x79, 0x08, 0x6d, 0xdf, 0x0b, 0xb3, 0x11, 0x3f, 0xb1, 0x6b, 0x31, 0x53, 0x85,
-	0xe2, 0x84, 0x4d, 0xd4, 0x84, 0xad, 0x37, 0xb2, 0x56, 0x1f, 0x02, 0xf4, 0x4e, 0x42, 0x37, 0xc6,
-	0x08, 0xac, 0x7f, 0x14, 0x4d, 0x86, 0x5e, 0xc8, 0x16, 0x34, 0x3d, 0xd7, 0xcc, 0xeb, 0xa6, 0xa7,
-	0xce, 0xd0, 0xb0, 0x38, 0xb6, 0xd4, 0x33, 0xea, 0xd4, 0x05, 0x4c, 0x7f, 0xed, 0xeb, 0xfb, 0xd6,
-	0x04, 0x5a, 0x9e, 0xe7, 0x9a, 0x8b, 0x04, 0x3e, 0xe2, 0xc1, 0xc1, 0x13, 0xa9, 0xee, 0xf4, 0xe6,
-	0x9b, 0xd9, 0x88, 0xe4, 0x00, 0xfa, 0x7e, 0x96, 0x02, 0x13, 0xe3, 0x3d, 0x7b, 0x4d, 0x7a, 0x68,
-	0x41, 0x43, 0x1b, 0x91, 0xdb, 0x0c, 0xd4, 0xf2, 0x3f, 0x60, 0x93, 0xd3, 0xac, 0x1f, 0xda, 0x70,
-	0xa7, 0x34, 0xa9, 0x5f, 0xf8, 0xd1, 0x15, 0xf3, 0x7f, 0x1c, 0xbd, 0x3f, 0x8e, 0xde, 0xff, 0x39,
-	0x7a, 0x7f, 0xdf, 0x80, 0xe1, 0xb9, 0xbe, 0x2f, 0xe9, 0x0b, 0x05, 0x7e, 0x67, 0xe3, 0x1d, 0xb6,
-	0x7a, 0x15, 0xaa, 0xe8, 0xc8, 0x36, 0x74, 0xb9, 0xfe, 0x79, 0xa3, 0x2f, 0x44, 0x46, 0x52, 0xd7,
-	0x4a, 0xf5, 0xeb, 0x44, 0xdf, 0x5f, 0xb4, 0xa0, 0x7e, 0xe8, 0xa0, 0x75, 0xe5, 0x02, 0x5e, 0x68,
-	0xac, 0xcb, 0x7c, 0x62, 0x54, 0x02, 0xf9, 0x7f, 0x68, 0x8a, 0x1b, 0x33, 0x55, 0x47, 0x76, 0x19,
-	0xa2, 0x4d, 0x71, 0x83, 0xb0, 0xbc, 0xc9, 0x3f, 0x74, 0xab, 0xb0, 0xbc, 0xb1, 0xfe, 0xd8, 0x86,
-	0xed, 0xaa, 0xd7, 0x59, 0x98, 0x48, 0x16, 0x3a, 0x1c, 0x2f, 0xfc, 0xe6, 0x86, 0x98, 0x5f, 0x93,
-	0x0a, 0x05, 0x79, 0x04, 0x5b, 0x46, 0xc8, 0x3a, 0x4c, 0xcf, 0xb9, 0x9a, 0x16, 0xaf, 0xd7, 0x5e,
-	0x98, 0x48, 0x75, 0xbd, 0xee, 0xe8, 0x9f, 0x81, 0x99, 0x8c, 0x17, 0x76, 0xd7, 0x4b, 0x62, 0x9f,
-	0xad, 0xd4, 0x44, 0xe9, 0x2a, 0x07, 0x65, 0x15, 0xc6, 0xc0, 0x1c, 0xe9, 0x2d, 0x99, 0xe4, 0xae,
-	0x6a, 0xc9, 0x1e, 0x2d, 0x14, 0xe5, 0x2b, 0x2e, 0x7c, 0xfc, 0x8a, 0xfb, 0x13, 0x68, 0x2f, 0xe3,
-	0x30, 0x98, 0xde, 0x53, 0x79, 0x18, 0xd8, 0xc5, 0xd9, 0x84, 0x93, 0x14, 0x21, 0xf2, 0x10, 0x3a,
-	0xbe, 0x97, 0xc4, 0xc1, 0xf4, 0xb3, 0xea, 0x29, 0xa1, 0x3a, 0x74, 0x83, 0x6a, 0x10, 0x59, 0x61,
-	0x14, 0xf2, 0x60, 0xba, 0x5d, 0x65, 0xe1, 0x99, 0x81, 0x2c, 0x05, 0x92, 0xc7, 0xd0, 0xbf, 0xf6,
-	0xa3, 0xf7, 0xfa, 0x56, 0xfb, 0x40, 0x85, 0x36, 0x2c, 0xcf, 0x26, 0x5a, 0xc0, 0xe4, 0x19, 0x8c,
-	0xfd, 0x7c, 0x16, 0x69, 0x8b, 0x1d, 0xe5, 0x9b, 0xd8, 0xb7, 0x46, 0x15, 0xad, 0x53, 0xc9, 0x37,
-	0x30, 0x0c, 0x4b, 0x35, 0x9d, 0xee, 0x55, 0x87, 0x67, 0xa5, 0xde, 0x15, 0x26, 0x7e, 0x61, 0x66,
-	0xa5, 0x3e, 0x8a, 0x42, 0xc9, 0x43, 0x69, 0xfd, 0xbd, 0x38, 0xb5, 0xcf, 0x92, 0xb9, 0x6a, 0x53,
-	0xbe, 0x2c, 0xbe, 0x6c, 0x94, 0x40, 0x9e, 0xc1, 0x80, 0x49, 0xfd, 0x9d, 0xcf, 0x82, 0xd8, 0xcc,
-	0xbb, 0x8f, 0xfe, 0x16, 0x2b, 0xd1, 0xc9, 0x0e, 0x34, 0xdd, 0x20, 0xff, 0x70, 0x29, 0xff, 0x96,
-	0x39, 0xdd, 0xa0, 0x4d, 0x37, 0x20, 0xf7, 0xa1, 0xc9, 0x02, 0x73, 0x9c, 0x81, 0x9d, 0x7f, 0x66,
-	0xd1, 0x26, 0x0b, 0xc8, 0xcf, 0xa0, 0x19, 0x06, 0xd3, 0x4d, 0x85, 0xfd, 0x9f, 0xbd, 0xbe, 0x6d,
-	0x69, 0x33, 0x0c, 0x0e, 0xc7, 0x30, 0xca, 0x8f, 0x78, 0x5c, 0xd9, 0xe3, 0x03, 0x18, 0xbe, 0xd5,
-	0xdf, 0xd3, 0xd8, 0x78, 0x09, 0xe9, 0x43, 0xe7, 0x6d, 0x70, 0x1e, 0xc5, 0x93, 0x0d, 0x32, 0x84,
-	0xde, 0xdb, 0xe0, 0x58, 0x05, 0x32, 0x69, 0x68, 0xe0, 0x79, 0x1c, 0x4f, 0x5a, 0x8f, 0xaf, 0x61,
-	0xab, 0xfa, 0x23, 0x81, 0xdc, 0x85, 0x71, 0xa1, 0x79, 0x25, 0xdf, 0x71, 0x31, 0xd9, 0xa8, 0x2a,
-	0x5f, 0xb0, 0x74, 0x8e, 0x6e, 0x3e, 0x83, 0x3b, 0x85, 0x52, 0x7d, 0x01, 0x73, 0x31, 0x69, 0x56,
-	0xb9, 0x58, 0x06, 0x3e, 0x69, 0x1d, 0x9e, 0xc2, 0x8e, 0x13, 0x05, 0xf6, 0x77, 0xdc, 0xe5, 0x2e,
-	0xb3, 0xd5, 0x4f, 0x22, 0x3b, 0x4d, 0xf4, 0x4f, 0x10, 0x9d, 0xcf, 0xb7, 0x5f, 0xcc, 0x3d, 0xf9,
-	0x2e, 0xbd, 0xb2, 0x9d, 0x28, 0xd8, 0xf7, 0xaf, 0xbf, 0xe2, 0xee, 0x9c, 0xef, 0xf3, 0x25, 0xdf,
-	0x67, 0xb1, 0xb7, 0x3f, 0x8f, 0xf6, 0xcd, 0xaf, 0xfc, 0xab, 0xae, 0x62, 0x3f, 0xf9, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0xc0, 0x3c, 0x29, 0xdc, 0x17, 0x00, 0x00,
+	// 2380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x73, 0x1c, 0x49,
+	0x11, 0xd6, 0x3c, 0x34, 0x8f, 0x9c, 0x19, 0xcd, 0xb8, 0xec, 0x15, 0x13, 0x0e, 0xb0, 0x86, 0xc6,
+	0xbb, 0x28, 0xbc, 0x6c, 0x8b, 0x90, 0x1d, 0x8e, 0x85, 0xd8, 0x8b, 0xf5, 0x58, 0x6b, 0x02, 0xeb,
+	0x11, 0x25, 0x87, 0x83, 0x70, 0x04, 0x87, 0x52, 0x77, 0x49, 0x6a, 0xd4, 0x2f, 0xaa, 0xab, 0x65,
+	0x0d, 0xa7, 0x3d, 0x70, 0x82, 0x3b, 0x9c, 0x38, 0xc3, 0x91, 0x1b, 0x37, 0x7e, 0x01, 0x77, 0x62,
+	0xff, 0x00, 0xbf, 0x81, 0x03, 0x87, 0x8d, 0xac, 0xaa, 0x7e, 0x6a, 0x2c, 0xfb, 0x07, 0xec, 0x6d,
+	0x32, 0xbf, 0x2f, 0xb3, 0xab, 0xb2, 0xb2, 0xb2, 0xaa, 0x72, 0x60, 0x14, 0x70, 0x29, 0x3c, 0x27,
+	0xb1, 0x63, 0x11, 0xc9, 0xe8, 0xe1, 0xc6, 0x45, 0x14, 0x5d, 0xf8, 0x7c, 0x4b, 0x49, 0x67, 0xe9,
+	0xf9, 0x96, 0xf4, 0x02, 0x9e, 0x48, 0x16, 0xc4, 0x9a, 0x60, 0xfd, 0xb9, 0x01, 0xc3, 0x80, 0x07,
+	0x91, 0x58, 0x1c, 0x2a, 0x43, 0x32, 0x85, 0x6e, 0x9a, 0x70, 0xf7, 0x90, 0x07, 0xd3, 0xe6, 0xac,
+	0xb1, 0x39, 0xa2, 0x99, 0x48, 0x1e, 0x42, 0x8f, 0x5d, 0x33, 0xcf, 0x47, 0xa8, 0xa5, 0xa0, 0x5c,
+	0x26, 0x9f, 0xc1, 0x1a, 0xd2, 0x4e, 0xb8, 0x70, 0x78, 0x28, 0xd9, 0x05, 0x9f, 0xb6, 0x67, 0x8d,
+	0xcd, 0x06, 0xad, 0x69, 0xc9, 0x26, 0x8c, 0x95, 0x4d, 0x89, 0xb8, 0xaa, 0x88, 0x75, 0xb5, 0xf5,
+	0x6d, 0x0b, 0x46, 0x21, 0x97, 0xef, 0x22, 0x71, 0x65, 0x46, 0xf6, 0x00, 0x56, 0xbd, 0x23, 0x16,
+	0xf0, 0x69, 0x63, 0xd6, 0xd8, 0xec, 0x53, 0x2d, 0xe0, 0x78, 0xe5, 0xcd, 0xce, 0x42, 0xf2, 0x44,
+	0x8d, 0xb7, 0x4d, 0x33, 0x11, 0x11, 0x61, 0x90, 0x96, 0x46, 0x44, 0x81, 0xc8, 0x9b, 0x3d, 0x11,
+	0xc5, 0xc9, 0xb4, 0x9d, 0xd9, 0x28, 0x51, 0xdb, 0x68, 0x64, 0x35, 0xb3, 0xd1, 0xc8, 0x3a, 0x74,
+	0xe4, 0xcd, 0xc9, 0x95, 0x4c, 0xa6, 0x3d, 0x05, 0x18, 0x09, 0xf5, 0x42, 0xeb, 0xfb, 0x5a, 0xaf,
+	0x25, 0x8c, 0x96, 0xbc, 0xd9, 0x17, 0x22, 0x12, 0xc9, 0x14, 0x14, 0x92, 0xcb, 0x88, 0x89, 0x0c,
+	0x1b, 0x68, 0x2c, 0x93, 0xc9, 0x23, 0x00, 0x79, 0xf3, 0xc2, 0xf1, 0xf5, 0x20, 0x86, 0x0a, 0x2d,
+	0x69, 0x10, 0x17, 0x05, 0x3e, 0xd2, 0x78, 0xa1, 0x21, 0x3f, 0x87, 0xfb, 0x8a, 0x4d, 0x99, 0xe4,
+	0xaf, 0xbc, 0xc0, 0x93, 0x9a, 0xb8, 0xa6, 0x88, 0xcb, 0x20, 0xb4, 0x10, 0x4b, 0x2c, 0xc6, 0xda,
+	0x62, 0x09, 0x44, 0x7e, 0x08, 0x7d, 0x3f, 0x72, 0x98, 0xaf, 0x56, 0x63, 0xa2, 0x56, 0xa3, 0x50,
+	0x90, 0x19, 0xb4, 0x05, 0x93, 0x7c, 0x7a, 0x6f, 0xd6, 0xd8, 0x1c, 0x6c, 0x0f, 0x6d, 0xb3, 0x8a,
+	0xe8, 0x83, 0x2a, 0xc4, 0xfa, 0x53, 0x13, 0xd6, 0x7e, 0xcf, 0x5d, 0xc7, 0x8f, 0x52, 0xd7, 0x2c,
+	0xee, 0x3a, 0x74, 0xbc, 0xf3, 0xd2, 0xea, 0x1a, 0x09, 0x43, 0x75, 0xce, 0x3c, 0x3f, 0x15, 0xf9,
+	0xfa, 0xe6, 0x32, 0x2e, 0x56, 0x92, 0x3a, 0x0e, 0x4f, 0xf2, 0x05, 0x36, 0x22, 0xf9, 0x0a, 0x06,
+	0x3e, 0x4b, 0xe4, 0xd7, 0x9a, 0xa9, 0x16, 0x79, 0xb0, 0xfd, 0xd0, 0xd6, 0x9b, 0xc1, 0xce, 0x36,
+	0x83, 0xfd, 0x3a, 0xdb, 0x0c, 0xb4, 0x4c, 0xcf, 0xac, 0x4f, 0x8d, 0xef, 0xd5, 0x8f, 0xb3, 0x36,
+	0x74, 0xb2, 0x05, 0x90, 0x0a, 0x5f, 0x4f, 0x2b, 0x99, 0x76, 0x66, 0xad, 0xcd, 0xc1, 0xf6, 0xd8,
+	0x4e, 0x85, 0x5f, 0x9a, 0x2e, 0x2d, 0x51, 0xac, 0xff, 0x37, 0x60, 0xad, 0x0a, 0x93, 0x09, 0xb4,
+	0x52, 0xe1, 0x9b, 0x50, 0xe0, 0x4f, 0x32, 0x83, 0x81, 0x14, 0x8b, 0xc3, 0xe4, 0x62, 0x37, 0x4a,
+	0x43, 0xa9, 0x42, 0xd1, 0xa2, 0x65, 0x15, 0xb1, 0x60, 0x28, 0xc5, 0x02, 0x13, 0x5c, 0x53, 0x5a,
+	0x8a, 0x52, 0xd1, 0x21, 0x27, 0xe1, 0xa1, 0xcc, 0xdd, 0xb4, 0x35, 0xa7, 0xac, 0x23, 0x8f, 0x61,
+	0x84, 0x72, 0xe1, 0x68, 0x55, 0x91, 0xaa, 0x4a, 0xf4, 0x24, 0xb8, 0x73, 0x9d, 0x7b, 0xea, 0x68,
+	0x4f, 0x65, 0x1d, 0x7a, 0x42, 0xb9, 0xf0, 0xd4, 0xd5, 0x9e, 0x2a, 0x4a, 0xeb, 0xd7, 0x30, 0x64,
+	0x71, 0xbc, 0x1b, 0xa7, 0x66, 0xee, 0xdb, 0xd0, 0x49, 0x63, 0x8c, 0xed, 0x47, 0x2c, 0x9b, 0x61,
+	0x62, 0x69, 0x90, 0x91, 0x64, 0xbe, 0xd9, 0xb4, 0x5a, 0xb0, 0xfe, 0xd5, 0x82, 0xa1, 0xcb, 0xaf,
+	0x3d, 0x87, 0x1b, 0xd7, 0x9f, 0x42, 0x47, 0xd7, 0x3a, 0x15, 0xbf, 0xc1, 0xf6, 0xc8, 0x2e, 0x97,
+	0x3e, 0x6a, 0x40, 0xb2, 0x09, 0x5d, 0x93, 0xb3, 0xd3, 0x96, 0x5a, 0xbe, 0x35, 0xbb, 0x52, 0x89,
+	0x68, 0x06, 0x93, 0xcf, 0xa1, 0x97, 0xe5, 0xf1, 0xb4, 0x6d, 0x56, 0xba, 0x9a, 0xd8, 0x34, 0x27,
+	0x90, 0x0d, 0x68, 0xbb, 0x5e, 0x72, 0x65, 0x52, 0x62, 0x60, 0xa3, 0x60, 0x48, 0x0a, 0x20, 0x9f,
+	0x43, 0xdf, 0xc9, 0xc2, 0x30, 0xed, 0x9a, 0x11, 0x96, 0x63, 0x43, 0x0b, 0x9c, 0x7c, 0x01, 0x03,
+	0x5d, 0xea, 0xe7, 0x92, 0x07, 0x58, 0x94, 0xb4, 0xd3, 0xc3, 0x5c, 0x47, 0xcb, 0x38, 0xf9, 0x25,
+	0x4c, 0x45, 0x1a, 0x62, 0xf5, 0x3f, 0x95, 0x91, 0x60, 0x17, 0xfc, 0xf8, 0x9a, 0x8b, 0x4b, 0xce,
+	0xdc, 0xc3, 0x1d, 0x53, 0xb8, 0xde, 0x8b, 0x63, 0x81, 0x60, 0x71, 0x4c, 0xd3, 0xf0, 0x75, 0x01,
+	0x1f, 0xee, 0x98, 0xaa, 0xb6, 0x0c, 0x22, 0xfb, 0xb0, 0x9e, 0x2c, 0x12, 0xc9, 0x83, 0x53, 0x2e,
+	0x30, 0xfe, 0xc9, 0xa1, 0x8e, 0xf3, 0xce, 0x74, 0x60, 0xa6, 0x55, 0x09, 0xfc, 0x7b, 0xc8, 0xd6,
+	0x1f, 0x9a, 0x00, 0xc5, 0x84, 0x70, 0x57, 0x5c, 0xf1, 0x45, 0xb6, 0x2b, 0xae, 0xf8, 0x82, 0xfc,
+	0x04, 0xda, 0x72, 0x11, 0x73, 0xb5, 0x9c, 0x6b, 0xdb, 0xe3, 0xd2, 0xec, 0x5f, 0x2f, 0x62, 0x4e,
+	0x15, 0x48, 0x1e, 0x41, 0xff, 0x2c, 0x8a, 0xfc, 0x37, 0xcc, 0x4f, 0xb9, 0xda, 0x15, 0xbd, 0x83,
+	0x15, 0x5a, 0xa8, 0x88, 0x05, 0x83, 0xd4, 0x0b, 0xe5, 0xd3, 0x6d, 0xcd, 0xc0, 0xac, 0x1b, 0x1d,
+	0xac, 0xd0, 0xb2, 0x32, 0xe3, 0x3c, 0x7f, 0xa6, 0x39, 0x2a, 0xcd, 0x32, 0x8e, 0x51, 0x92, 0x19,
+	0xc0, 0xb9, 0x1f, 0x31, 0xa9, 0x29, 0xb8, 0x21, 0x9a, 0x07, 0x2b, 0xb4, 0xa4, 0x43, 0x2f, 0x89,
+	0x14, 0x5e, 0x78, 0xa1, 0x29, 0xb8, 0xc4, 0x7d, 0xf4, 0x52, 0x52, 0xee, 0xdc, 0x83, 0x71, 0xb1,
+	0x6e, 0x4a, 0x65, 0xfd, 0xaf, 0x01, 0x50, 0x24, 0x0b, 0x21, 0x26, 0x8f, 0x74, 0x1c, 0xd4, 0x6f,
+	0xac, 0xc8, 0x01, 0xee, 0xa6, 0x13, 0x26, 0x2f, 0x55, 0x34, 0xfa, 0xb4, 0x50, 0x20, 0x2a, 0x38,
+	0x73, 0xcb, 0x67, 0x61, 0xa1, 0xc0, 0x13, 0xe5, 0x9d, 0xf0, 0x24, 0xd7, 0xb0, 0x3e, 0x10, 0x4b,
+	0x9a, 0xcc, 0xba, 0x28, 0x06, 0x6d, 0x5a, 0x28, 0x72, 0xeb, 0xa2, 0x0c, 0xb4, 0x69, 0x49, 0x53,
+	0x6c, 0xcd, 0x6e, 0x69, 0x6b, 0xe2, 0x1c, 0xf0, 0x66, 0x60, 0xce, 0x52, 0xf5, 0x1b, 0x75, 0xe7,
+	0x82, 0x73, 0x93, 0x8e, 0xea, 0xb7, 0xf5, 0xf7, 0x26, 0x8c, 0x58, 0x1c, 0xef, 0xdd, 0x3d, 0xfb,
+	0x19, 0x0c, 0x62, 0x11, 0x5d, 0x7b, 0x89, 0x17, 0x85, 0xdc, 0x35, 0xe7, 0x44, 0x59, 0x95, 0x7f,
+	0xaf, 0x55, 0xfa, 0xde, 0x43, 0xe8, 0xa1, 0x35, 0x66, 0x8a, 0x9a, 0x75, 0x9f, 0xe6, 0x32, 0x8e,
+	0xda, 0xf5, 0x84, 0x5c, 0xa8, 0xf9, 0xf6, 0xa8, 0x16, 0xaa, 0x71, 0xec, 0xdc, 0x1d, 0xc7, 0xee,
+	0xdd, 0x71, 0xec, 0xdd, 0x1d, 0xc7, 0xfe, 0xad, 0x38, 0x6e, 0x98, 0x53, 0x15, 0x66, 0x8d, 0xbc,
+	0x7a, 0xcc, 0xa3, 0xd2, 0xa1, 0xfa, 0xd7, 0x26, 0xf4, 0x59, 0x1c, 0x17, 0x97, 0xa5, 0x17, 0x71,
+	0x3c, 0xdf, 0xcb, 0x2e, 0x4b, 0x4a, 0xc0, 0x8f, 0xb0, 0x38, 0x7e, 0xc3, 0x05, 0x86, 0x45, 0xb9,
+	0xea, 0xd3, 0x92, 0x06, 0x4f, 0xd4, 0x17, 0x71, 0xac, 0x8e, 0x61, 0x9d, 0x44, 0x99, 0x48, 0x36,
+	0xa0, 0xe5, 0xc4, 0xe9, 0xb4, 0x65, 0xb6, 0x6f, 0xa5, 0x2a, 0x21, 0x52, 0xaa, 0xad, 0xed, 0x8f,
+	0xac, 0xad, 0xab, 0x77, 0xd7, 0x56, 0xab, 0x52, 0x2e, 0xd7, 0xec, 0x4a, 0x1a, 0x98, 0x85, 0x7f,
+	0x02, 0xdd, 0x4b, 0x2f, 0x91, 0xf8, 0xd5, 0xae, 0xa2, 0x4d, 0xec, 0x3c, 0x04, 0xa7, 0x2c, 0x88,
+	0x7d, 0x4e, 0x33, 0x82, 0xf5, 0x0b, 0xe8, 0x9e, 0x5c, 0xc9, 0x53, 0xc9, 0x24, 0x4e, 0xf3, 0x84,
+	0x39, 0x57, 0x5c, 0x26, 0x2a, 0x3c, 0x6d, 0x9a, 0x89, 0x18, 0xb6, 0xf2, 0x5d, 0x52, 0x0b, 0xd6,
+	0x3b, 0xe8, 0x53, 0x3f, 0x72, 0xd0, 0x36, 0xc1, 0x54, 0x42, 0x21, 0x4b, 0x40, 0xfc, 0x4d, 0x1e,
+	0xc1, 0xaa, 0x02, 0xcd, 0xb9, 0xd2, 0xb3, 0xcd, 0x97, 0xa8, 0x56, 0x93, 0xe7, 0xb0, 0x7e, 0xca,
+	0x9d, 0x28, 0x74, 0x93, 0x53, 0x2f, 0x74, 0xf8, 0x2b, 0x96, 0x48, 0xfd, 0x45, 0x93, 0x90, 0xef,
+	0x41, 0xad, 0x73, 0xe8, 0xed, 0x7b, 0xae, 0xf6, 0x31, 0x81, 0xd6, 0xdc, 0xac, 0x67, 0x9b, 0xe2,
+	0x4f, 0xd4, 0xec, 0xcf, 0xf7, 0xcc, 0x4a, 0xe1, 0x4f, 0xf2, 0x1c, 0x26, 0xf9, 0x40, 0xf7, 0x43,
+	0x29, 0x3c, 0xb5, 0xdf, 0x31, 0x30, 0x60, 0xe7, 0x00, 0xbd, 0xc5, 0xb1, 0xfe, 0xdb, 0x86, 0xc1,
+	0x5b, 0x1d, 0xb6, 0x57, 0x5e, 0x12, 0x93, 0xa7, 0x30, 0xce, 0xbe, 0x9b, 0xb9, 0x69, 0x28, 0x37,
+	0x7d, 0x3b, 0xd3, 0xd3, 0x3a, 0x83, 0x7c, 0x09, 0x64, 0x2e, 0x85, 0x1e, 0xf9, 0x29, 0x0f, 0x5d,
+	0x75, 0xa1, 0xbd, 0x15, 0x91, 0x25, 0x1c, 0xb2, 0x0d, 0xe3, 0x79, 0x78, 0xcd, 0x7c, 0xcf, 0xdd,
+	0xf7, 0x8c, 0x59, 0xab, 0x66, 0x56, 0x27, 0x90, 0x9f, 0xc1, 0xf0, 0x28, 0xda, 0xe3, 0x8e, 0x58,
+	0xc4, 0xf2, 0x57, 0x3c, 0xcb, 0xba, 0xc2, 0xa0, 0x82, 0x92, 0x67, 0x30, 0x39, 0x4e, 0x25, 0x17,
+	0x07, 0x9c, 0xb9, 0x5c, 0xe8, 0x4f, 0xac, 0xd6, 0x2c, 0x6e, 0x31, 0x70, 0x5c, 0x3b, 0xcc, 0x9d,
+	0x87, 0x21, 0x17, 0xd9, 0x9e, 0xe9, 0xd4, 0xc7, 0x55, 0x23, 0x90, 0x27, 0x30, 0x78, 0x19, 0x45,
+	0x6e, 0x96, 0x5f, 0xdd, 0x1a, 0xbf, 0x0c, 0x92, 0xc7, 0xd0, 0x9b, 0xef, 0xbe, 0xd1, 0xa3, 0xe9,
+	0xd5, 0x88, 0x39, 0x82, 0xa3, 0xc0, 0x45, 0x29, 0x0f, 0xbd, 0x5f, 0x1f, 0x45, 0x8d, 0x40, 0x6c,
+	0x18, 0xed, 0x5e, 0x72, 0xe7, 0xea, 0x34, 0x0d, 0xb4, 0x05, 0xd4, 0x2c, 0xaa, 0x30, 0xae, 0xdd,
+	0x1e, 0x77, 0x58, 0x4c, 0xf9, 0x3c, 0xfc, 0x2d, 0x77, 0xa4, 0x36, 0x1a, 0xd4, 0xd7, 0xee, 0x36,
+	0x07, 0xd7, 0xc1, 0xc4, 0x59, 0xdb, 0x0c, 0xeb, 0xeb, 0x50, 0x46, 0xad, 0xbf, 0x35, 0xf2, 0x44,
+	0xdb, 0x8d, 0xc2, 0x90, 0xcc, 0xa0, 0x33, 0x0f, 0xd5, 0xeb, 0xa9, 0x51, 0xb3, 0x33, 0x7a, 0x62,
+	0x41, 0xf7, 0x38, 0x95, 0x8a, 0x52, 0x4f, 0xa5, 0x0c, 0x40, 0xce, 0xbe, 0x10, 0x8a, 0x53, 0xcf,
+	0x9b, 0x0c, 0x50, 0x11, 0x61, 0xc2, 0xe3, 0xc2, 0x28, 0x6e, 0x25, 0x4c, 0x15, 0xb6, 0xfe, 0xd1,
+	0x00, 0x30, 0x23, 0x7d, 0x13, 0x87, 0x64, 0x13, 0x7a, 0x38, 0x60, 0x64, 0x9a, 0xa1, 0x0e, 0xed,
+	0xd2, 0x44, 0x68, 0x8e, 0x92, 0xcf, 0xa0, 0x3b, 0xbf, 0xe2, 0x8a, 0xd8, 0x5c, 0x42, 0xcc, 0x40,
+	0xf4, 0x78, 0xc4, 0xe4, 0x6b, 0x45, 0x6c, 0x2d, 0xf3, 0x98, 0xa1, 0xe8, 0x71, 0x3f, 0x89, 0x15,
+	0xb1, 0xbd, 0xcc, 0xa3, 0x01, 0xad, 0x51, 0x1e, 0xdb, 0xa3, 0x28, 0xe4, 0xd6, 0x6f, 0x60, 0x6c,
+	0xc4, 0xaf, 0xfd, 0xe8, 0xdd, 0x2b, 0x2f, 0xbc, 0x22, 0x53, 0xe8, 0x24, 0xe9, 0xd9, 0x11, 0xd7,
+	0x73, 0xc0, 0xbb, 0x87, 0x91, 0x09, 0x81, 0x16, 0xf7, 0xf4, 0xd1, 0x89, 0x6a, 0x14, 0xb0, 0x18,
+	0x26, 0xb1, 0x37, 0xd7, 0xa7, 0x66, 0x9f, 0x6a, 0x61, 0xa7, 0x03, 0x6d, 0xf4, 0x65, 0xfd, 0xa5,
+	0x01, 0xf7, 0x4b, 0xfe, 0xf7, 0x43, 0xf7, 0x24, 0xf2, 0x42, 0x2c, 0xae, 0x1d, 0x2f, 0x7e, 0xe1,
+	0xba, 0xa2, 0xf8, 0x86, 0x96, 0xc9, 0x03, 0x68, 0x0b, 0xac, 0x9c, 0xd9, 0x47, 0x94, 0x44, 0x1e,
+	0x43, 0xdb, 0xf7, 0xc2, 0xec, 0x38, 0x98, 0xd8, 0xb5, 0x31, 0x53, 0x85, 0x62, 0x85, 0x4d, 0x54,
+	0x85, 0xad, 0x27, 0xb2, 0x56, 0xef, 0x00, 0xf4, 0xf6, 0x43, 0x37, 0xc6, 0x11, 0x58, 0xdf, 0x16,
+	0x49, 0x86, 0x5e, 0xc8, 0x1a, 0x34, 0x3d, 0xd7, 0xd4, 0xeb, 0xa6, 0xa7, 0x2e, 0x03, 0x61, 0x71,
+	0xc4, 0xa9, 0xdf, 0xa8, 0x53, 0x37, 0x49, 0xdd, 0xd8, 0x50, 0xbf, 0xb1, 0xbe, 0x7a, 0x9e, 0x6b,
+	0x6e, 0x44, 0xf8, 0x13, 0x0f, 0x0e, 0x9e, 0x48, 0xf5, 0x38, 0x31, 0xed, 0x01, 0x23, 0x92, 0x6d,
+	0xe8, 0xfb, 0x59, 0x08, 0xcc, 0x18, 0x1f, 0xd8, 0x4b, 0xc2, 0x43, 0x0b, 0x1a, 0xda, 0x88, 0xdc,
+	0x66, 0x30, 0x6b, 0xbd, 0xdf, 0x26, 0xa7, 0x59, 0xff, 0x6c, 0xc3, 0xbd, 0x52, 0xa5, 0x7e, 0xe9,
+	0x47, 0x67, 0xcc, 0xff, 0xbe, 0xf4, 0x7e, 0x5f, 0x7a, 0x3f, 0x58, 0x7a, 0xbf, 0x69, 0xc0, 0xf0,
+	0x48, 0xdf, 0xad, 0xf4, 0x85, 0x02, 0x1b, 0x06, 0x78, 0x19, 0xaf, 0x5e, 0x85, 0x2a, 0x3a, 0x6c,
+	0xcb, 0x70, 0xdd, 0xa7, 0xd2, 0x17, 0x22, 0x23, 0xa9, 0xfb, 0xb1, 0xea, 0x12, 0xe9, 0xfb, 0x8b,
+	0x16, 0x54, 0xef, 0x0a, 0xad, 0x2b, 0x2f, 0x89, 0x42, 0x63, 0x9d, 0xe6, 0x15, 0xa3, 0x32, 0x90,
+	0x1f, 0x41, 0x53, 0xdc, 0x98, 0xaa, 0x3a, 0xb2, 0xcb, 0x10, 0x6d, 0x8a, 0x1b, 0x84, 0xe5, 0xcd,
+	0xb4, 0xb9, 0x14, 0x96, 0x37, 0xd6, 0x1f, 0xdb, 0xb0, 0x5e, 0xf5, 0x3a, 0x0f, 0x13, 0xc9, 0x42,
+	0x87, 0xe3, 0x8d, 0xdb, 0xdc, 0x26, 0xf3, 0x6b, 0x52, 0xa1, 0xc0, 0x9e, 0xa5, 0x11, 0xb2, 0x0c,
+	0xd3, 0x75, 0xae, 0xa6, 0xc5, 0x77, 0x82, 0x17, 0x26, 0x52, 0xbd, 0x13, 0x56, 0x75, 0xdf, 0x33,
+	0x93, 0xf1, 0xe5, 0xe1, 0x7a, 0x49, 0xec, 0xb3, 0x85, 0xaa, 0x28, 0x1d, 0xe5, 0xa0, 0xac, 0xc2,
+	0x31, 0x30, 0x47, 0x7a, 0xd7, 0x4c, 0x72, 0x57, 0xa5, 0x64, 0x8f, 0x16, 0x8a, 0xf2, 0x75, 0x18,
+	0xee, 0xbe, 0x0e, 0xff, 0x18, 0xda, 0xd7, 0x71, 0x18, 0x4c, 0x1f, 0x98, 0xfb, 0x7f, 0x71, 0x36,
+	0x61, 0x25, 0x45, 0x88, 0x3c, 0x86, 0x55, 0xdf, 0x4b, 0xe2, 0x60, 0xfa, 0x49, 0xf5, 0x94, 0x50,
+	0x19, 0xba, 0x42, 0x35, 0x88, 0xac, 0x30, 0x0a, 0x79, 0x30, 0x5d, 0xaf, 0xb2, 0xf0, 0xcc, 0x40,
+	0x96, 0x02, 0xc9, 0x13, 0xe8, 0x9f, 0xfb, 0xd1, 0x3b, 0x7d, 0xab, 0x7d, 0x34, 0x6b, 0x95, 0x99,
+	0x58, 0x9b, 0x68, 0x01, 0x93, 0xaf, 0x60, 0xec, 0xe7, 0xb5, 0x48, 0x5b, 0x6c, 0x28, 0xdf, 0xc4,
+	0xbe, 0x55, 0xaa, 0x68, 0x9d, 0x4a, 0xbe, 0x84, 0x61, 0x58, 0x5a, 0xd3, 0xe9, 0x66, 0xb5, 0x78,
+	0x56, 0xd6, 0xbb, 0xc2, 0xc4, 0xa7, 0x72, 0xb6, 0xd4, 0xbb, 0x51, 0x28, 0x79, 0x28, 0xad, 0xff,
+	0x14, 0xa7, 0xf6, 0x61, 0x72, 0xa1, 0xd2, 0x94, 0x5f, 0x17, 0xaf, 0x20, 0x25, 0x60, 0x7f, 0x8f,
+	0x49, 0xdd, 0xb0, 0x60, 0x41, 0x3c, 0x6d, 0x7d, 0xb0, 0xcd, 0x54, 0xa6, 0x93, 0x0d, 0x68, 0xba,
+	0x41, 0xfe, 0xc8, 0x29, 0xf7, 0x97, 0x0e, 0x56, 0x68, 0xd3, 0xc5, 0x3e, 0x79, 0x93, 0x05, 0xe6,
+	0x38, 0x83, 0xe2, 0x3d, 0x42, 0x9b, 0x2c, 0x20, 0x3f, 0x85, 0x66, 0x18, 0x98, 0xb7, 0xca, 0x0f,
+	0xec, 0xe5, 0x69, 0x4b, 0x9b, 0x61, 0xb0, 0x33, 0x86, 0x51, 0x7e, 0xc4, 0xab, 0x99, 0x7d, 0x63,
+	0x9a, 0x00, 0xfa, 0xcd, 0x57, 0x7d, 0x8a, 0x36, 0x54, 0x0b, 0xfd, 0xbd, 0x4f, 0xd1, 0xa6, 0x82,
+	0x4b, 0x1a, 0xd5, 0xe6, 0xe6, 0xcc, 0x3d, 0x36, 0x1b, 0xb8, 0x41, 0x33, 0x11, 0x93, 0x5d, 0xf1,
+	0x8e, 0x4d, 0x6f, 0xbc, 0x41, 0x73, 0xd9, 0xfa, 0x1d, 0x0c, 0x4a, 0xbd, 0xdc, 0x72, 0xe7, 0x5d,
+	0x0f, 0x60, 0x59, 0xe7, 0xbd, 0x69, 0xdc, 0x1b, 0xa4, 0xe8, 0xa2, 0xeb, 0xef, 0xde, 0xee, 0xa2,
+	0xeb, 0x8f, 0x1a, 0xc9, 0xfa, 0x77, 0x03, 0xc6, 0xb5, 0x17, 0x1d, 0x79, 0x06, 0x5d, 0x1e, 0xba,
+	0xea, 0x10, 0x6e, 0x7c, 0x70, 0xe9, 0x32, 0xaa, 0xde, 0xc5, 0x92, 0x8b, 0x6b, 0xe6, 0x9b, 0x3f,
+	0x36, 0x72, 0x19, 0xc3, 0xe5, 0xc4, 0xa9, 0xf9, 0xf3, 0xc1, 0x8c, 0xac, 0xa4, 0xc9, 0x3b, 0x77,
+	0xed, 0x25, 0x6f, 0x6f, 0xfc, 0x8d, 0x37, 0xb4, 0xe2, 0x55, 0x7b, 0xbb, 0xeb, 0x9d, 0x81, 0x4f,
+	0xb6, 0x61, 0xf8, 0x56, 0x77, 0x77, 0xb0, 0x7a, 0x24, 0xa4, 0x0f, 0xab, 0x6f, 0x83, 0xa3, 0x28,
+	0x9e, 0xac, 0x90, 0x21, 0xf4, 0xde, 0x06, 0x7b, 0x2a, 0x9b, 0x26, 0x0d, 0x0d, 0xbc, 0x88, 0xe3,
+	0x49, 0xeb, 0xc9, 0x39, 0xac, 0x55, 0xdb, 0x5a, 0xe4, 0x3e, 0x8c, 0x0b, 0xcd, 0xb1, 0xbc, 0xe4,
+	0x62, 0xb2, 0x52, 0x55, 0xbe, 0x64, 0xe9, 0x05, 0xba, 0xf9, 0x04, 0xee, 0x15, 0x4a, 0xd5, 0x47,
+	0xe0, 0x62, 0xd2, 0xac, 0x72, 0x71, 0x2f, 0xf1, 0x49, 0x6b, 0xe7, 0x00, 0x36, 0x9c, 0x28, 0xc0,
+	0xf6, 0x25, 0x77, 0x99, 0xad, 0x5a, 0x96, 0x76, 0x9a, 0xe8, 0x96, 0x9c, 0x8e, 0xec, 0xdb, 0x4f,
+	0x2f, 0x3c, 0x79, 0x99, 0x9e, 0xd9, 0x4e, 0x14, 0x6c, 0xf9, 0xe7, 0x5f, 0x70, 0xf7, 0x82, 0x6f,
+	0xf1, 0x6b, 0xbe, 0xc5, 0x62, 0x6f, 0xeb, 0x22, 0xda, 0xd2, 0x33, 0x4b, 0xce, 0x3a, 0x8a, 0xfd,
+	0xf4, 0xbb, 0x01, 0x00, 0xa7, 0x6b, 0x01, 0x80, 0x8c, 0x1a, 0x00, 0x00,
 }
@@ -3,6 +3,7 @@
 
 import sys
 _b=sys.version_info[0]<3 and (lambda x:x) or (lambda x:x.encode('latin1'))
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -23,10 +24,95 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='',
   syntax='proto3',
   serialized_options=_b('\n\037com.zededa.cloud.uservice.protoZ$github.com/lf-edge/eve/api/go/config'),
-  serialized_pb=_b('\n\x0f\x61ppconfig.proto\x1a\x0f\x64\x65vcommon.proto\x1a\rstorage.proto\x1a\x08vm.proto\x1a\x0fnetconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\xca\x02\n\x11\x41ppInstanceConfig\x12\'\n\x0euuidandversion\x18\x01 \x01(\x0b\x32\x0f.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12!\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\t.VmConfig\x12\x16\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x06.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12#\n\ninterfaces\x18\x06 \x03(\x0b\x32\x0f.NetworkAdapter\x12\x1a\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x08.Adapter\x12 \n\x07restart\x18\t \x01(\x0b\x32\x0f.InstanceOpsCmd\x12\x1e\n\x05purge\x18\n \x01(\x0b\x32\x0f.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x42G\n\x1f\x63om.zededa.cloud.uservice.protoZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
+  serialized_pb=_b('\n\x0f\x61ppconfig.proto\x1a\x0f\x64\x65vcommon.proto\x1a\rstorage.proto\x1a\x08vm.proto\x1a\x0fnetconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\x96\x03\n\x11\x41ppInstanceConfig\x12\'\n\x0euuidandversion\x18\x01 \x01(\x0b\x32\x0f.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12!\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\t.VmConfig\x12\x16\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x06.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12#\n\ninterfaces\x18\x06 \x03(\x0b\x32\x0f.NetworkAdapter\x12\x1a\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x08.Adapter\x12 \n\x07restart\x18\t \x01(\x0b\x32\x0f.InstanceOpsCmd\x12\x1e\n\x05purge\x18\n \x01(\x0b\x32\x0f.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12$\n\x0bhealthCheck\x18\r \x01(\x0b\x32\x0f.AppHealthCheck\x12$\n\x0c\x64\x65pendencies\x18\x0e \x03(\x0b\x32\x0e.AppDependency\"\x88\x02\n\x0e\x41ppHealthCheck\x12\x1e\n\x05probe\x18\x01 \x01(\x0e\x32\x0f.AppHealthProbe\x12\x0c\n\x04port\x18\x02 \x01(\r\x12\x0c\n\x04path\x18\x03 \x01(\t\x12\x10\n\x08interval\x18\x04 \x01(\r\x12\x0f\n\x07timeout\x18\x05 \x01(\r\x12\x18\n\x10\x66\x61ilureThreshold\x18\x06 \x01(\r\x12\x13\n\x0bgracePeriod\x18\x07 \x01(\r\x12(\n\rrestartPolicy\x18\x08 \x01(\x0e\x32\x11.AppRestartPolicy\x12\x12\n\nmaxRetries\x18\t \x01(\r\x12\x16\n\x0e\x62\x61\x63koffInitial\x18\n \x01(\r\x12\x12\n\nbackoffMax\x18\x0b \x01(\r\"D\n\rAppDependency\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12%\n\tcondition\x18\x02 \x01(\x0e\x32\x12.AppStartCondition*]\n\x0e\x41ppHealthProbe\x12\x10\n\x0c\x41ppProbeNone\x10\x00\x12\x0f\n\x0b\x41ppProbeTCP\x10\x01\x12\x10\n\x0c\x41ppProbeHTTP\x10\x02\x12\x16\n\x12\x41ppProbeGuestAgent\x10\x03*@\n\x10\x41ppRestartPolicy\x12\x13\n\x0f\x41ppRestartNever\x10\x00\x12\x17\n\x13\x41ppRestartOnFailure\x10\x01*I\n\x11\x41ppStartCondition\x12\x1a\n\x16\x41ppStartAfterActivated\x10\x00\x12\x18\n\x14\x41ppStartAfterHealthy\x10\x01\x42G\n\x1f\x63om.zededa.cloud.uservice.protoZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
   ,
   dependencies=[devcommon__pb2.DESCRIPTOR,storage__pb2.DESCRIPTOR,vm__pb2.DESCRIPTOR,netconfig__pb2.DESCRIPTOR,])
 
+_APPHEALTHPROBE = _descriptor.EnumDescriptor(
+  name='AppHealthProbe',
+  full_name='AppHealthProbe',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='AppProbeNone', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='AppProbeTCP', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='AppProbeHTTP', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='AppProbeGuestAgent', index=3, number=3,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=876,
+  serialized_end=969,
+)
+_sym_db.RegisterEnumDescriptor(_APPHEALTHPROBE)
+
+AppHealthProbe = enum_type_wrapper.EnumTypeWrapper(_APPHEALTHPROBE)
+_APPRESTARTPOLICY = _descriptor.EnumDescriptor(
+  name='AppRestartPolicy',
+  full_name='AppRestartPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='AppRestartNever', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='AppRestartOnFailure', index=1, number=1,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=971,
+  serialized_end=1035,
+)
+_sym_db.RegisterEnumDescriptor(_APPRESTARTPOLICY)
+
+AppRestartPolicy = enum_type_wrapper.EnumTypeWrapper(_APPRESTARTPOLICY)
+_APPSTARTCONDITION = _descriptor.EnumDescriptor(
+  name='AppStartCondition',
+  full_name='AppStartCondition',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='AppStartAfterActivated', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='AppStartAfterHealthy', index=1, number=1,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1037,
+  serialized_end=1110,
+)
+_sym_db.RegisterEnumDescriptor(_APPSTARTCONDITION)
+
+AppStartCondition = enum_type_wrapper.EnumTypeWrapper(_APPSTARTCONDITION)
+AppProbeNone = 0
+AppProbeTCP = 1
+AppProbeHTTP = 2
+AppProbeGuestAgent = 3
+AppRestartNever = 0
+AppRestartOnFailure = 1
+AppStartAfterActivated = 0
+AppStartAfterHealthy = 1
 
 
 
@@ -152,6 +238,20 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='healthCheck', full_name='AppInstanceConfig.healthCheck', index=11,
+      number=13, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dependencies', full_name='AppInstanceConfig.dependencies', index=12,
+      number=14, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -165,7 +265,146 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=131,
-  serialized_end=461,
+  serialized_end=537,
+)
+
+
+_APPHEALTHCHECK = _descriptor.Descriptor(
+  name='AppHealthCheck',
+  full_name='AppHealthCheck',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='probe', full_name='AppHealthCheck.probe', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='AppHealthCheck.port', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='path', full_name='AppHealthCheck.path', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='interval', full_name='AppHealthCheck.interval', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='timeout', full_name='AppHealthCheck.timeout', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='failureThreshold', full_name='AppHealthCheck.failureThreshold', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='gracePeriod', full_name='AppHealthCheck.gracePeriod', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='restartPolicy', full_name='AppHealthCheck.restartPolicy', index=7,
+      number=8, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='maxRetries', full_name='AppHealthCheck.maxRetries', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='backoffInitial', full_name='AppHealthCheck.backoffInitial', index=9,
+      number=10, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='backoffMax', full_name='AppHealthCheck.backoffMax', index=10,
+      number=11, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=540,
+  serialized_end=804,
+)
+
+
+_APPDEPENDENCY = _descriptor.Descriptor(
+  name='AppDependency',
+  full_name='AppDependency',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='AppDependency.uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='condition', full_name='AppDependency.condition', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=806,
+  serialized_end=874,
 )
 
 _APPINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = devcommon__pb2._UUIDANDVERSION
@@ -175,8 +414,18 @@ _APPINSTANCECONFIG.fields_by_name['interfaces'].message_type = netconfig__pb2._N
 _APPINSTANCECONFIG.fields_by_name['adapters'].message_type = devcommon__pb2._ADAPTER
 _APPINSTANCECONFIG.fields_by_name['restart'].message_type = _INSTANCEOPSCMD
 _APPINSTANCECONFIG.fields_by_name['purge'].message_type = _INSTANCEOPSCMD
+_APPINSTANCECONFIG.fields_by_name['healthCheck'].message_type = _APPHEALTHCHECK
+_APPINSTANCECONFIG.fields_by_name['dependencies'].message_type = _APPDEPENDENCY
+_APPHEALTHCHECK.fields_by_name['probe'].enum_type = _APPHEALTHPROBE
+_APPHEALTHCHECK.fields_by_name['restartPolicy'].enum_type = _APPRESTARTPOLICY
+_APPDEPENDENCY.fields_by_name['condition'].enum_type = _APPSTARTCONDITION
 DESCRIPTOR.message_types_by_name['InstanceOpsCmd'] = _INSTANCEOPSCMD
 DESCRIPTOR.message_types_by_name['AppInstanceConfig'] = _APPINSTANCECONFIG
+DESCRIPTOR.message_types_by_name['AppHealthCheck'] = _APPHEALTHCHECK
+DESCRIPTOR.message_types_by_name['AppDependency'] = _APPDEPENDENCY
+DESCRIPTOR.enum_types_by_name['AppHealthProbe'] = _APPHEALTHPROBE
+DESCRIPTOR.enum_types_by_name['AppRestartPolicy'] = _APPRESTARTPOLICY
+DESCRIPTOR.enum_types_by_name['AppStartCondition'] = _APPSTARTCONDITION
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_message.Message,), dict(
@@ -193,6 +442,20 @@ AppInstanceConfig = _reflection.GeneratedProtocolMessageType('AppInstanceConfig'
   ))
 _sym_db.RegisterMessage(AppInstanceConfig)
 
+AppHealthCheck = _reflection.GeneratedProtocolMessageType('AppHealthCheck', (_message.Message,), dict(
+  DESCRIPTOR = _APPHEALTHCHECK,
+  __module__ = 'appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:AppHealthCheck)
+  ))
+_sym_db.RegisterMessage(AppHealthCheck)
+
+AppDependency = _reflection.GeneratedProtocolMessageType('AppDependency', (_message.Message,), dict(
+  DESCRIPTOR = _APPDEPENDENCY,
+  __module__ = 'appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:AppDependency)
+  ))
+_sym_db.RegisterMessage(AppDependency)
+
 
 DESCRIPTOR._options = None
 # @@protoc_insertion_point(module_scope)
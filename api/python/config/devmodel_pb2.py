@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='',
   syntax='proto3',
   serialized_options=_b('\n\037com.zededa.cloud.uservice.protoZ$github.com/lf-edge/eve/api/go/config'),
-  serialized_pb=_b('\n\x0e\x64\x65vmodel.proto\"n\n\x0fsWAdapterParams\x12\x1d\n\x05\x61Type\x18\x01 \x01(\x0e\x32\x0e.sWAdapterType\x12\x19\n\x11underlayInterface\x18\x08 \x01(\t\x12\x0e\n\x06vlanId\x18\t \x01(\r\x12\x11\n\tbondgroup\x18\n \x03(\t\"y\n\rSystemAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nfreeUplink\x18\x02 \x01(\x08\x12\x0e\n\x06uplink\x18\x03 \x01(\x08\x12\x13\n\x0bnetworkUUID\x18\x04 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x05 \x01(\t\x12\x13\n\x0blogicalName\x18\x06 \x01(\t\"&\n\x10PhyIOUsagePolicy\x12\x12\n\nfreeUplink\x18\x01 \x01(\x08\"\xe2\x02\n\nPhysicalIO\x12\x19\n\x05ptype\x18\x01 \x01(\x0e\x32\n.PhyIoType\x12\x10\n\x08phylabel\x18\x02 \x01(\t\x12+\n\x08phyaddrs\x18\x03 \x03(\x0b\x32\x19.PhysicalIO.PhyaddrsEntry\x12\x14\n\x0clogicallabel\x18\x04 \x01(\t\x12\x11\n\tassigngrp\x18\x05 \x01(\t\x12 \n\x05usage\x18\x06 \x01(\x0e\x32\x11.PhyIoMemberUsage\x12&\n\x0busagePolicy\x18\x07 \x01(\x0b\x32\x11.PhyIOUsagePolicy\x12\'\n\x06\x63\x62\x61ttr\x18\x08 \x03(\x0b\x32\x17.PhysicalIO.CbattrEntry\x1a/\n\rPhyaddrsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0b\x43\x62\x61ttrEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01*/\n\rsWAdapterType\x12\n\n\x06IGNORE\x10\x00\x12\x08\n\x04VLAN\x10\x01\x12\x08\n\x04\x42OND\x10\x02*\x9b\x01\n\tPhyIoType\x12\r\n\tPhyIoNoop\x10\x00\x12\x0f\n\x0bPhyIoNetEth\x10\x01\x12\x0c\n\x08PhyIoUSB\x10\x02\x12\x0c\n\x08PhyIoCOM\x10\x03\x12\x0e\n\nPhyIoAudio\x10\x04\x12\x10\n\x0cPhyIoNetWLAN\x10\x05\x12\x10\n\x0cPhyIoNetWWAN\x10\x06\x12\r\n\tPhyIoHDMI\x10\x07\x12\x0f\n\nPhyIoOther\x10\xff\x01*i\n\x10PhyIoMemberUsage\x12\x12\n\x0ePhyIoUsageNone\x10\x00\x12\x12\n\x0ePhyIoUsageMgmt\x10\x01\x12\x14\n\x10PhyIoUsageShared\x10\x02\x12\x17\n\x13PhyIoUsageDedicated\x10\x03\x42G\n\x1f\x63om.zededa.cloud.uservice.protoZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
+  serialized_pb=_b('\n\x0e\x64\x65vmodel.proto\"n\n\x0fsWAdapterParams\x12\x1d\n\x05\x61Type\x18\x01 \x01(\x0e\x32\x0e.sWAdapterType\x12\x19\n\x11underlayInterface\x18\x08 \x01(\t\x12\x0e\n\x06vlanId\x18\t \x01(\r\x12\x11\n\tbondgroup\x18\n \x03(\t\"\xb1\x01\n\rSystemAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nfreeUplink\x18\x02 \x01(\x08\x12\x0e\n\x06uplink\x18\x03 \x01(\x08\x12\x13\n\x0bnetworkUUID\x18\x04 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x05 \x01(\t\x12\x13\n\x0blogicalName\x18\x06 \x01(\t\x12\x1a\n\x04vlan\x18\x07 \x01(\x0b\x32\x0c.VlanAdapter\x12\x1a\n\x04\x62ond\x18\x08 \x01(\x0b\x32\x0c.BondAdapter\"&\n\x10PhyIOUsagePolicy\x12\x12\n\nfreeUplink\x18\x01 \x01(\x08\"\xe2\x02\n\nPhysicalIO\x12\x19\n\x05ptype\x18\x01 \x01(\x0e\x32\n.PhyIoType\x12\x10\n\x08phylabel\x18\x02 \x01(\t\x12+\n\x08phyaddrs\x18\x03 \x03(\x0b\x32\x19.PhysicalIO.PhyaddrsEntry\x12\x14\n\x0clogicallabel\x18\x04 \x01(\t\x12\x11\n\tassigngrp\x18\x05 \x01(\t\x12 \n\x05usage\x18\x06 \x01(\x0e\x32\x11.PhyIoMemberUsage\x12&\n\x0busagePolicy\x18\x07 \x01(\x0b\x32\x11.PhyIOUsagePolicy\x12\'\n\x06\x63\x62\x61ttr\x18\x08 \x03(\x0b\x32\x17.PhysicalIO.CbattrEntry\x1a/\n\rPhyaddrsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0b\x43\x62\x61ttrEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"5\n\x0bVlanAdapter\x12\x16\n\x0elowerLayerName\x18\x01 \x01(\t\x12\x0e\n\x06vlanId\x18\x02 \x01(\r\"d\n\x0b\x42ondAdapter\x12\x17\n\x0flowerLayerNames\x18\x01 \x03(\t\x12\x17\n\x04mode\x18\x02 \x01(\x0e\x32\t.BondMode\x12\x12\n\nmiiMonitor\x18\x03 \x01(\r\x12\x0f\n\x07primary\x18\x04 \x01(\t*/\n\rsWAdapterType\x12\n\n\x06IGNORE\x10\x00\x12\x08\n\x04VLAN\x10\x01\x12\x08\n\x04\x42OND\x10\x02*\x9b\x01\n\tPhyIoType\x12\r\n\tPhyIoNoop\x10\x00\x12\x0f\n\x0bPhyIoNetEth\x10\x01\x12\x0c\n\x08PhyIoUSB\x10\x02\x12\x0c\n\x08PhyIoCOM\x10\x03\x12\x0e\n\nPhyIoAudio\x10\x04\x12\x10\n\x0cPhyIoNetWLAN\x10\x05\x12\x10\n\x0cPhyIoNetWWAN\x10\x06\x12\r\n\tPhyIoHDMI\x10\x07\x12\x0f\n\nPhyIoOther\x10\xff\x01*i\n\x10PhyIoMemberUsage\x12\x12\n\x0ePhyIoUsageNone\x10\x00\x12\x12\n\x0ePhyIoUsageMgmt\x10\x01\x12\x14\n\x10PhyIoUsageShared\x10\x02\x12\x17\n\x13PhyIoUsageDedicated\x10\x03*8\n\x08\x42ondMode\x12\x18\n\x14\x42ondModeActiveBackup\x10\x00\x12\x12\n\x0e\x42ondMode8023ad\x10\x01\x42G\n\x1f\x63om.zededa.cloud.uservice.protoZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
 )
 
 _SWADAPTERTYPE = _descriptor.EnumDescriptor(
@@ -44,8 +44,8 @@ _SWADAPTERTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=650,
-  serialized_end=697,
+  serialized_start=864,
+  serialized_end=911,
 )
 _sym_db.RegisterEnumDescriptor(_SWADAPTERTYPE)
 
@@ -95,8 +95,8 @@ _PHYIOTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=700,
-  serialized_end=855,
+  serialized_start=914,
+  serialized_end=1069,
 )
 _sym_db.RegisterEnumDescriptor(_PHYIOTYPE)
 
@@ -126,12 +126,35 @@ _PHYIOMEMBERUSAGE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=857,
-  serialized_end=962,
+  serialized_start=1071,
+  serialized_end=1176,
 )
 _sym_db.RegisterEnumDescriptor(_PHYIOMEMBERUSAGE)
 
 PhyIoMemberUsage = enum_type_wrapper.EnumTypeWrapper(_PHYIOMEMBERUSAGE)
+_BONDMODE = _descriptor.EnumDescriptor(
+  name='BondMode',
+  full_name='BondMode',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='BondModeActiveBackup', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BondMode8023ad', index=1, number=1,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1178,
+  serialized_end=1234,
+)
+_sym_db.RegisterEnumDescriptor(_BONDMODE)
+
+BondMode = enum_type_wrapper.EnumTypeWrapper(_BONDMODE)
 IGNORE = 0
 VLAN = 1
 BOND = 2
@@ -148,6 +171,8 @@ PhyIoUsageNone = 0
 PhyIoUsageMgmt = 1
 PhyIoUsageShared = 2
 PhyIoUsageDedicated = 3
+BondModeActiveBackup = 0
+BondMode8023ad = 1
 
 
 
@@ -252,6 +277,20 @@ _SYSTEMADAPTER = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan', full_name='SystemAdapter.vlan', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bond', full_name='SystemAdapter.bond', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -264,8 +303,8 @@ _SYSTEMADAPTER = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=130,
-  serialized_end=251,
+  serialized_start=131,
+  serialized_end=308,
 )
 
 
@@ -295,8 +334,8 @@ _PHYIOUSAGEPOLICY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=253,
-  serialized_end=291,
+  serialized_start=310,
+  serialized_end=348,
 )
 
 
@@ -333,8 +372,8 @@ _PHYSICALIO_PHYADDRSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=554,
-  serialized_end=601,
+  serialized_start=611,
+  serialized_end=658,
 )
 
 _PHYSICALIO_CBATTRENTRY = _descriptor.Descriptor(
@@ -370,8 +409,8 @@ _PHYSICALIO_CBATTRENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=603,
-  serialized_end=648,
+  serialized_start=660,
+  serialized_end=705,
 )
 
 _PHYSICALIO = _descriptor.Descriptor(
@@ -449,11 +488,103 @@ _PHYSICALIO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=294,
-  serialized_end=648,
+  serialized_start=351,
+  serialized_end=705,
+)
+
+
+_VLANADAPTER = _descriptor.Descriptor(
+  name='VlanAdapter',
+  full_name='VlanAdapter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='lowerLayerName', full_name='VlanAdapter.lowerLayerName', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlanId', full_name='VlanAdapter.vlanId', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=707,
+  serialized_end=760,
+)
+
+
+_BONDADAPTER = _descriptor.Descriptor(
+  name='BondAdapter',
+  full_name='BondAdapter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='lowerLayerNames', full_name='BondAdapter.lowerLayerNames', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='BondAdapter.mode', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='miiMonitor', full_name='BondAdapter.miiMonitor', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='primary', full_name='BondAdapter.primary', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=762,
+  serialized_end=862,
 )
 
 _SWADAPTERPARAMS.fields_by_name['aType'].enum_type = _SWADAPTERTYPE
+_SYSTEMADAPTER.fields_by_name['vlan'].message_type = _VLANADAPTER
+_SYSTEMADAPTER.fields_by_name['bond'].message_type = _BONDADAPTER
 _PHYSICALIO_PHYADDRSENTRY.containing_type = _PHYSICALIO
 _PHYSICALIO_CBATTRENTRY.containing_type = _PHYSICALIO
 _PHYSICALIO.fields_by_name['ptype'].enum_type = _PHYIOTYPE
@@ -461,13 +592,17 @@ _PHYSICALIO.fields_by_name['phyaddrs'].message_type = _PHYSICALIO_PHYADDRSENTRY
 _PHYSICALIO.fields_by_name['usage'].enum_type = _PHYIOMEMBERUSAGE
 _PHYSICALIO.fields_by_name['usagePolicy'].message_type = _PHYIOUSAGEPOLICY
 _PHYSICALIO.fields_by_name['cbattr'].message_type = _PHYSICALIO_CBATTRENTRY
+_BONDADAPTER.fields_by_name['mode'].enum_type = _BONDMODE
 DESCRIPTOR.message_types_by_name['sWAdapterParams'] = _SWADAPTERPARAMS
 DESCRIPTOR.message_types_by_name['SystemAdapter'] = _SYSTEMADAPTER
 DESCRIPTOR.message_types_by_name['PhyIOUsagePolicy'] = _PHYIOUSAGEPOLICY
 DESCRIPTOR.message_types_by_name['PhysicalIO'] = _PHYSICALIO
+DESCRIPTOR.message_types_by_name['VlanAdapter'] = _VLANADAPTER
+DESCRIPTOR.message_types_by_name['BondAdapter'] = _BONDADAPTER
 DESCRIPTOR.enum_types_by_name['sWAdapterType'] = _SWADAPTERTYPE
 DESCRIPTOR.enum_types_by_name['PhyIoType'] = _PHYIOTYPE
 DESCRIPTOR.enum_types_by_name['PhyIoMemberUsage'] = _PHYIOMEMBERUSAGE
+DESCRIPTOR.enum_types_by_name['BondMode'] = _BONDMODE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 sWAdapterParams = _reflection.GeneratedProtocolMessageType('sWAdapterParams', (_message.Message,), dict(
@@ -511,6 +646,20 @@ PhysicalIO = _reflection.GeneratedProtocolMessageType('PhysicalIO', (_message.Me
   # @@protoc_insertion_point(class_scope:PhysicalIO)
   ))
 _sym_db.RegisterMessage(PhysicalIO)
+
+VlanAdapter = _reflection.GeneratedProtocolMessageType('VlanAdapter', (_message.Message,), dict(
+  DESCRIPTOR = _VLANADAPTER,
+  __module__ = 'devmodel_pb2'
+  # @@protoc_insertion_point(class_scope:VlanAdapter)
+  ))
+_sym_db.RegisterMessage(VlanAdapter)
+
+BondAdapter = _reflection.GeneratedProtocolMessageType('BondAdapter', (_message.Message,), dict(
+  DESCRIPTOR = _BONDADAPTER,
+  __module__ = 'devmodel_pb2'
+  # @@protoc_insertion_point(class_scope:BondAdapter)
+  ))
+_sym_db.RegisterMessage(BondAdapter)
 _sym_db.RegisterMessage(PhysicalIO.PhyaddrsEntry)
 _sym_db.RegisterMessage(PhysicalIO.CbattrEntry)
 
@@ -48,6 +48,7 @@ func initImpl(agentName string, logdir string, redirect bool,
 				TimestampFormat: time.RFC3339Nano,
 			}
 			log.SetFormatter(&formatter)
+			addFieldHook(agentName)
 		}
 		log.SetReportCaller(true)
 		log.RegisterExitHandler(printStack)
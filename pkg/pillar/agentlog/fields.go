@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Fields added to the JSON log output so that logmanager can forward
+// them as LogEntry fields and tags without parsing the message text.
+
+package agentlog
+
+import (
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Field names in the JSON log output
+const (
+	AgentField     = "agent"
+	PidField       = "pid"
+	AppUUIDField   = "appuuid"
+	ObjectKeyField = "objkey"
+)
+
+// fieldHook adds the agent name and pid to every entry
+type fieldHook struct {
+	agentName string
+	pid       int
+}
+
+func (hook fieldHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (hook fieldHook) Fire(entry *log.Entry) error {
+	entry.Data[AgentField] = hook.agentName
+	entry.Data[PidField] = hook.pid
+	return nil
+}
+
+// WithApp returns an entry which logs the app instance UUID
+func WithApp(appUUID string) *log.Entry {
+	return log.WithField(AppUUIDField, appUUID)
+}
+
+// WithObject returns an entry which logs the pubsub key of the object
+// being processed
+func WithObject(key string) *log.Entry {
+	return log.WithField(ObjectKeyField, key)
+}
+
+// WithAppObject returns an entry with both the app instance UUID and
+// the object key
+func WithAppObject(appUUID string, key string) *log.Entry {
+	return log.WithFields(log.Fields{
+		AppUUIDField:   appUUID,
+		ObjectKeyField: key,
+	})
+}
+
+func addFieldHook(agentName string) {
+	log.AddHook(fieldHook{agentName: agentName, pid: os.Getpid()})
+}
@@ -5,14 +5,38 @@ package agentlog
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
 )
 
-// Extend this structure with optional specific tags from
-// log.WithFields
+// Loginfo is what we extract from a line of JSON output from logrus.
+// Any fields from log.WithFields which we do not know about end up in
+// Fields.
 type Loginfo struct {
-	Level string `json:"level"`
-	Time  string `json:"time"` // RFC3339 with Nanoseconds
-	Msg   string `json:"msg"`
+	Level     string            `json:"level"`
+	Time      string            `json:"time"` // RFC3339 with Nanoseconds
+	Msg       string            `json:"msg"`
+	Agent     string            `json:"agent"`
+	Pid       int               `json:"pid"`
+	AppUUID   string            `json:"appuuid"`
+	ObjectKey string            `json:"objkey"`
+	Fields    map[string]string `json:"-"`
+}
+
+// Fields which are part of Loginfo or dropped. The caller which
+// SetReportCaller adds to every line is not passed on as tags.
+var loginfoFields = map[string]bool{
+	"level":          true,
+	"time":           true,
+	"msg":            true,
+	AgentField:       true,
+	PidField:         true,
+	AppUUIDField:     true,
+	ObjectKeyField:   true,
+	log.FieldKeyFile: true,
+	log.FieldKeyFunc: true,
 }
 
 // Returns loginfo, ok
@@ -21,5 +45,25 @@ func ParseLoginfo(line string) (Loginfo, bool) {
 	if err := json.Unmarshal([]byte(line), &output); err != nil {
 		return output, false
 	}
+	// UseNumber so that large integers are not reformatted as floats
+	var all map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+	if err := dec.Decode(&all); err != nil {
+		return output, false
+	}
+	for k, v := range all {
+		if loginfoFields[k] {
+			continue
+		}
+		if output.Fields == nil {
+			output.Fields = make(map[string]string)
+		}
+		if s, ok := v.(string); ok {
+			output.Fields[k] = s
+		} else {
+			output.Fields[k] = fmt.Sprint(v)
+		}
+	}
 	return output, true
 }
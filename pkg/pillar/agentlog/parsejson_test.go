@@ -0,0 +1,79 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLoginfo(t *testing.T) {
+	testMatrix := map[string]struct {
+		line     string
+		ok       bool
+		expected Loginfo
+	}{
+		"Not json": {
+			line: `time="2019-10-01T10:00:00Z" level=info msg=hello`,
+			ok:   false,
+		},
+		"No fields": {
+			line: `{"level":"info","msg":"hello","time":"2019-10-01T10:00:00.1Z"}`,
+			ok:   true,
+			expected: Loginfo{
+				Level: "info",
+				Time:  "2019-10-01T10:00:00.1Z",
+				Msg:   "hello",
+			},
+		},
+		"Agent fields": {
+			line: `{"agent":"zedmanager","appuuid":"6f6f6f6f-0000-0000-0000-000000000001","level":"warning","msg":"hello","objkey":"key1","pid":123,"time":"2019-10-01T10:00:00Z"}`,
+			ok:   true,
+			expected: Loginfo{
+				Level:     "warning",
+				Time:      "2019-10-01T10:00:00Z",
+				Msg:       "hello",
+				Agent:     "zedmanager",
+				Pid:       123,
+				AppUUID:   "6f6f6f6f-0000-0000-0000-000000000001",
+				ObjectKey: "key1",
+			},
+		},
+		"Other fields": {
+			line: `{"agent":"nim","file":"/nim.go:10","func":"main.Run","level":"info","msg":"hello","pid":7,"size":12345678901,"time":"2019-10-01T10:00:00Z"}`,
+			ok:   true,
+			expected: Loginfo{
+				Level: "info",
+				Time:  "2019-10-01T10:00:00Z",
+				Msg:   "hello",
+				Agent: "nim",
+				Pid:   7,
+				Fields: map[string]string{
+					"size": "12345678901",
+				},
+			},
+		},
+		"Only the caller": {
+			line: `{"agent":"nim","file":"/nim.go:10","func":"main.Run","level":"info","msg":"hello","pid":7,"time":"2019-10-01T10:00:00Z"}`,
+			ok:   true,
+			expected: Loginfo{
+				Level: "info",
+				Time:  "2019-10-01T10:00:00Z",
+				Msg:   "hello",
+				Agent: "nim",
+				Pid:   7,
+			},
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		loginfo, ok := ParseLoginfo(test.line)
+		assert.Equal(t, test.ok, ok)
+		if ok {
+			assert.Equal(t, test.expected, loginfo)
+		}
+	}
+}
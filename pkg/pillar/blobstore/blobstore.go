@@ -0,0 +1,350 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Content addressed store for downloaded objects keyed by their sha256.
+// The verifier adds an object once its sha256 has been verified, hence the
+// same content referenced by an app image and a base OS is stored and
+// verified once. The agents which use a blob hold a reference to it and
+// unreferenced blobs are garbage collected after a while.
+//
+// Layout under the store directory:
+//	sha256/<sha>		the content; read-only
+//	refs/<sha>/<holder>	one empty file per reference
+//	lock			serializes the agents using the store
+// The modification time of refs/<sha> is when the blob was last used.
+
+package blobstore
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultDir is where the agents keep the shared store
+const DefaultDir = "/persist/downloads/blobs"
+
+type Store struct {
+	dir string
+}
+
+// New returns the store in dir creating the directories if needed
+func New(dir string) (*Store, error) {
+	for _, sub := range []string{"sha256", "refs"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0700); err != nil {
+			return nil, err
+		}
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Dir returns the directory of the store
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+func checkSha(sha string) error {
+	if len(sha) != 64 {
+		return fmt.Errorf("bad sha256 length %d: %s", len(sha), sha)
+	}
+	if _, err := hex.DecodeString(sha); err != nil {
+		return fmt.Errorf("bad sha256 %s: %s", sha, err)
+	}
+	return nil
+}
+
+// Path returns where the blob with the sha256 is or would be
+func (s *Store) Path(sha string) string {
+	return filepath.Join(s.dir, "sha256", strings.ToLower(sha))
+}
+
+func (s *Store) refsDir(sha string) string {
+	return filepath.Join(s.dir, "refs", strings.ToLower(sha))
+}
+
+// Holder names are file names
+func holderFilename(holder string) string {
+	return strings.Replace(holder, "/", " ", -1)
+}
+
+// Has returns true if the store has the blob
+func (s *Store) Has(sha string) bool {
+	if checkSha(sha) != nil {
+		return false
+	}
+	_, err := os.Stat(s.Path(sha))
+	return err == nil
+}
+
+// Size returns the size of the blob
+func (s *Store) Size(sha string) (int64, error) {
+	info, err := os.Stat(s.Path(sha))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// List returns the sha256 of all the blobs
+func (s *Store) List() []string {
+	var shas []string
+	locations, err := ioutil.ReadDir(filepath.Join(s.dir, "sha256"))
+	if err != nil {
+		log.Errorf("blobstore List: %s", err)
+		return shas
+	}
+	for _, location := range locations {
+		if checkSha(location.Name()) == nil {
+			shas = append(shas, location.Name())
+		}
+	}
+	return shas
+}
+
+// lock serializes the agents using the store
+func (s *Store) lock() (func(), error) {
+	f, err := os.OpenFile(filepath.Join(s.dir, "lock"),
+		os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
+
+// Add moves the verified file into the store and returns the path of
+// the blob. If the store already has the content the file is removed.
+func (s *Store) Add(sha string, filename string) (string, error) {
+	sha = strings.ToLower(sha)
+	if err := checkSha(sha); err != nil {
+		return "", err
+	}
+	unlock, err := s.lock()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	path := s.Path(sha)
+	if _, err := os.Stat(path); err == nil {
+		log.Infof("blobstore Add: already have %s; removing %s",
+			sha, filename)
+		if err := os.Remove(filename); err != nil {
+			return "", err
+		}
+	} else {
+		if err := os.Rename(filename, path); err != nil {
+			return "", err
+		}
+		if err := os.Chmod(path, 0400); err != nil {
+			return "", err
+		}
+	}
+	if err := s.touch(sha); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Remove removes a blob e.g., if it no longer matches its sha256
+func (s *Store) Remove(sha string) error {
+	if err := checkSha(sha); err != nil {
+		return err
+	}
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return s.remove(sha)
+}
+
+func (s *Store) remove(sha string) error {
+	log.Infof("blobstore: removing %s", sha)
+	if err := os.RemoveAll(s.refsDir(sha)); err != nil {
+		return err
+	}
+	if err := os.Remove(s.Path(sha)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// touch records that the blob was used now
+func (s *Store) touch(sha string) error {
+	dir := s.refsDir(sha)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	now := time.Now()
+	return os.Chtimes(dir, now, now)
+}
+
+// AddRef adds a reference to the blob. The holder is unique per user of
+// the blob e.g., the agent name and the key of its object. Adding the
+// same reference twice has no effect.
+func (s *Store) AddRef(sha string, holder string) error {
+	if err := checkSha(sha); err != nil {
+		return err
+	}
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, err := os.Stat(s.Path(sha)); err != nil {
+		return err
+	}
+	if err := s.touch(sha); err != nil {
+		return err
+	}
+	filename := filepath.Join(s.refsDir(sha), holderFilename(holder))
+	return ioutil.WriteFile(filename, nil, 0600)
+}
+
+// ReleaseRef removes a reference added by AddRef. Releasing a reference
+// which isn't there has no effect.
+func (s *Store) ReleaseRef(sha string, holder string) error {
+	if err := checkSha(sha); err != nil {
+		return err
+	}
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	filename := filepath.Join(s.refsDir(sha), holderFilename(holder))
+	if err := os.Remove(filename); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return s.touch(sha)
+}
+
+// ReleaseHolders removes the references of the holders with the prefix.
+// Used by an agent when it starts to drop references it held before
+// a restart.
+func (s *Store) ReleaseHolders(prefix string) {
+	unlock, err := s.lock()
+	if err != nil {
+		log.Errorf("blobstore ReleaseHolders: %s", err)
+		return
+	}
+	defer unlock()
+
+	prefix = holderFilename(prefix)
+	for _, sha := range s.List() {
+		for _, holder := range s.refs(sha) {
+			if !strings.HasPrefix(holder, prefix) {
+				continue
+			}
+			filename := filepath.Join(s.refsDir(sha), holder)
+			if err := os.Remove(filename); err != nil {
+				log.Errorf("blobstore ReleaseHolders: %s", err)
+				continue
+			}
+			s.touch(sha)
+		}
+	}
+}
+
+// Refs returns the holders of references to the blob
+func (s *Store) Refs(sha string) []string {
+	if checkSha(sha) != nil {
+		return nil
+	}
+	return s.refs(sha)
+}
+
+func (s *Store) refs(sha string) []string {
+	var holders []string
+	locations, err := ioutil.ReadDir(s.refsDir(sha))
+	if err != nil {
+		return holders
+	}
+	for _, location := range locations {
+		holders = append(holders, location.Name())
+	}
+	return holders
+}
+
+// RefCount returns the number of references to the blob
+func (s *Store) RefCount(sha string) int {
+	return len(s.Refs(sha))
+}
+
+// LastUse returns when a reference to the blob was last added or released
+func (s *Store) LastUse(sha string) time.Time {
+	if checkSha(sha) != nil {
+		return time.Time{}
+	}
+	info, err := os.Stat(s.refsDir(sha))
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// GC removes the blobs which have had no references for gcTime and
+// returns their sha256
+func (s *Store) GC(gcTime time.Duration) []string {
+	var removed []string
+	unlock, err := s.lock()
+	if err != nil {
+		log.Errorf("blobstore GC: %s", err)
+		return removed
+	}
+	defer unlock()
+
+	for _, sha := range s.List() {
+		if len(s.refs(sha)) != 0 {
+			continue
+		}
+		lastUse := s.LastUse(sha)
+		if lastUse.IsZero() {
+			// No refs directory e.g., after a crash in Add
+			s.touch(sha)
+			continue
+		}
+		if time.Since(lastUse) < gcTime {
+			continue
+		}
+		log.Infof("blobstore GC: %s unused since %v", sha, lastUse)
+		if err := s.remove(sha); err != nil {
+			log.Errorf("blobstore GC: %s", err)
+			continue
+		}
+		removed = append(removed, sha)
+	}
+	// References to blobs which are gone
+	locations, err := ioutil.ReadDir(filepath.Join(s.dir, "refs"))
+	if err == nil {
+		for _, location := range locations {
+			sha := location.Name()
+			if _, err := os.Stat(s.Path(sha)); err == nil {
+				continue
+			}
+			if err := os.RemoveAll(s.refsDir(sha)); err != nil {
+				log.Errorf("blobstore GC: %s", err)
+			}
+		}
+	}
+	return removed
+}
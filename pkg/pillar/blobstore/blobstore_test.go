@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package blobstore
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefsAndGC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blobstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s, err := New(filepath.Join(dir, "store"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("some content")
+	sha := fmt.Sprintf("%x", sha256.Sum256(content))
+	add := func() (string, error) {
+		filename := filepath.Join(dir, "pending")
+		if err := ioutil.WriteFile(filename, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return s.Add(sha, filename)
+	}
+	age := func(d time.Duration) {
+		old := time.Now().Add(-d)
+		os.Chtimes(s.refsDir(sha), old, old)
+	}
+
+	// The steps build on each other
+	testMatrix := []struct {
+		name       string
+		step       func() error
+		expectErr  bool
+		gcTime     time.Duration
+		refCount   int
+		expectBlob bool
+		expectGCed bool
+	}{
+		{
+			name:       "Add",
+			step:       func() error { _, err := add(); return err },
+			gcTime:     time.Hour,
+			expectBlob: true,
+		},
+		{
+			name:       "Add duplicate",
+			step:       func() error { _, err := add(); return err },
+			gcTime:     time.Hour,
+			expectBlob: true,
+		},
+		{
+			name:       "Reference",
+			step:       func() error { return s.AddRef(sha, "verifier.appImg.obj.a/b") },
+			gcTime:     0,
+			refCount:   1,
+			expectBlob: true,
+		},
+		{
+			name:       "Same reference again",
+			step:       func() error { return s.AddRef(sha, "verifier.appImg.obj.a/b") },
+			gcTime:     0,
+			refCount:   1,
+			expectBlob: true,
+		},
+		{
+			name:       "Second holder",
+			step:       func() error { return s.AddRef(sha, "domainmgr.img") },
+			gcTime:     0,
+			refCount:   2,
+			expectBlob: true,
+		},
+		{
+			name: "Release after restart",
+			step: func() error {
+				s.ReleaseHolders("verifier.")
+				return nil
+			},
+			gcTime:     0,
+			refCount:   1,
+			expectBlob: true,
+		},
+		{
+			name:       "Release recently",
+			step:       func() error { return s.ReleaseRef(sha, "domainmgr.img") },
+			gcTime:     time.Hour,
+			expectBlob: true,
+		},
+		{
+			name: "Unused for long",
+			step: func() error {
+				age(2 * time.Hour)
+				return nil
+			},
+			gcTime:     time.Hour,
+			expectGCed: true,
+		},
+		{
+			name:      "Reference to missing blob",
+			step:      func() error { return s.AddRef(sha, "domainmgr.img") },
+			expectErr: true,
+			gcTime:    time.Hour,
+		},
+		{
+			name:      "Bad sha",
+			step:      func() error { return s.AddRef("../../etc", "domainmgr.img") },
+			expectErr: true,
+			gcTime:    time.Hour,
+		},
+	}
+
+	for _, test := range testMatrix {
+		t.Logf("Running test case %s", test.name)
+		err := test.step()
+		if test.expectErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+		removed := s.GC(test.gcTime)
+		if test.expectGCed {
+			assert.Equal(t, []string{sha}, removed)
+		} else {
+			assert.Empty(t, removed)
+		}
+		assert.Equal(t, test.refCount, s.RefCount(sha))
+		assert.Equal(t, test.expectBlob, s.Has(sha))
+		if test.expectBlob {
+			b, err := ioutil.ReadFile(s.Path(sha))
+			assert.NoError(t, err)
+			assert.Equal(t, content, b)
+		}
+	}
+}
@@ -12,6 +12,8 @@ import (
 
 // XXX template?
 // XXX alternative seems to be a deep copy of some sort
+// New code should use the typed publications and subscriptions in
+// typedpubsub which make these casts unnecessary.
 
 func CastNetworkXObjectConfig(in interface{}) types.NetworkXObjectConfig {
 	b, err := json.Marshal(in)
@@ -33,6 +33,7 @@ import (
 	"flag"
 	"fmt"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/blobstore"
 	"github.com/lf-edge/eve/pkg/pillar/cast"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
@@ -73,6 +74,8 @@ type baseOsMgrContext struct {
 	subBaseOsDownloadStatus  *pubsub.Subscription
 	subCertObjDownloadStatus *pubsub.Subscription
 	subBaseOsVerifierStatus  *pubsub.Subscription
+
+	store *blobstore.Store // Verified objects
 }
 
 var debug = false
@@ -112,6 +115,11 @@ func Run() {
 
 	// Context to pass around
 	ctx := baseOsMgrContext{}
+	store, err := blobstore.New(blobstore.DefaultDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.store = store
 
 	// initialize publishing handles
 	initializeSelfPublishHandles(&ctx)
@@ -276,7 +276,7 @@ func doBaseOsActivate(ctx *baseOsMgrContext, uuidStr string,
 	publishBaseOsStatus(ctx, status)
 
 	// install the image at proper partition; dd etc
-	if installDownloadedObjects(baseOsObj, uuidStr,
+	if installDownloadedObjects(ctx, baseOsObj, uuidStr,
 		&status.StorageStatusList) {
 
 		changed = true
@@ -166,7 +166,7 @@ func doCertObjInstall(ctx *baseOsMgrContext, uuidStr string, config types.CertOb
 	}
 
 	// install the certs now
-	if installDownloadedObjects(certObj, uuidStr, &status.StorageStatusList) {
+	if installDownloadedObjects(ctx, certObj, uuidStr, &status.StorageStatusList) {
 		// Automatically move from DOWNLOADED to INSTALLED
 		status.State = types.INSTALLED
 		changed = true
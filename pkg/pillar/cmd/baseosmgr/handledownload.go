@@ -324,8 +324,8 @@ func checkStorageDownloadStatus(ctx *baseOsMgrContext, objType string,
 	return ret
 }
 
-func installDownloadedObjects(objType string, uuidStr string,
-	status *[]types.StorageStatus) bool {
+func installDownloadedObjects(ctx *baseOsMgrContext, objType string,
+	uuidStr string, status *[]types.StorageStatus) bool {
 
 	ret := true
 	log.Infof("installDownloadedObjects(%s)\n", uuidStr)
@@ -335,7 +335,7 @@ func installDownloadedObjects(objType string, uuidStr string,
 
 		safename := types.UrlToSafename(ss.Name, ss.ImageSha256)
 
-		installDownloadedObject(objType, safename, ss)
+		installDownloadedObject(ctx, objType, safename, ss)
 
 		// if something is still not installed, mark accordingly
 		if ss.State != types.INSTALLED {
@@ -350,8 +350,8 @@ func installDownloadedObjects(objType string, uuidStr string,
 // based on download/verification state, if
 // the final installation directory is mentioned,
 // move the object there
-func installDownloadedObject(objType string, safename string,
-	status *types.StorageStatus) error {
+func installDownloadedObject(ctx *baseOsMgrContext, objType string,
+	safename string, status *types.StorageStatus) error {
 
 	var ret error
 	var srcFilename string = objectDownloadDirname + "/" + objType
@@ -361,8 +361,8 @@ func installDownloadedObject(objType string, safename string,
 
 	// if the object is in downloaded state,
 	// pick from pending directory
-	// if ithe object is n delivered state,
-	//  pick from verified directory
+	// if the object is in delivered state,
+	//  pick from the blob store
 	switch status.State {
 
 	case types.INSTALLED:
@@ -381,8 +381,16 @@ func installDownloadedObject(objType string, safename string,
 		srcFilename += "/pending/" + safename
 
 	case types.DELIVERED:
-		srcFilename += "/verified/" + status.ImageSha256 + "/" +
-			types.SafenameToFilename(safename)
+		// Hold a reference so the blob isn't garbage collected
+		// while we install it
+		holder := agentName + "." + objType + "." + safename
+		if err := ctx.store.AddRef(status.ImageSha256, holder); err != nil {
+			log.Errorf("installDownloadedObject %s, missing blob: %s\n",
+				safename, err)
+			return err
+		}
+		defer ctx.store.ReleaseRef(status.ImageSha256, holder)
+		srcFilename = ctx.store.Path(status.ImageSha256)
 
 	default:
 		log.Infof("installDownloadedObject %s, still not ready (%d)\n",
@@ -265,7 +265,7 @@ func checkStorageVerifierStatus(ctx *baseOsMgrContext, objType string, uuidStr s
 		case types.INITIAL:
 			// Nothing to do
 		default:
-			ss.ActiveFileLocation = objectDownloadDirname + "/" + objType + "/" + vs.Safename
+			ss.ActiveFileLocation = vs.FileLocation
 
 			log.Infof("checkStorageVerifierStatus(%s) Update SSL ActiveFileLocation to %s\n",
 				uuidStr, ss.ActiveFileLocation)
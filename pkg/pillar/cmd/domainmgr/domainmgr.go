@@ -1,8 +1,10 @@
 // Copyright (c) 2017-2018 Zededa, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
-// Manage Xen guest domains based on the subscribed collection of DomainConfig
+// Manage guest domains based on the subscribed collection of DomainConfig
 // and publish the result in a collection of DomainStatus structs.
+// The hypervisor backend (xen, kvm) is picked at boot; containers run on
+// top of it using rkt.
 // We run a separate go routine for each domU to be able to boot and halt
 // them concurrently and also pick up their state periodically.
 
@@ -16,7 +18,6 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -27,13 +28,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/lf-edge/eve/pkg/pillar/adapters"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
-	"github.com/lf-edge/eve/pkg/pillar/cast"
+	"github.com/lf-edge/eve/pkg/pillar/blobstore"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hardware"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
-	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/sema"
+	"github.com/lf-edge/eve/pkg/pillar/typedpubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/wrap"
 	"github.com/satori/go.uuid"
@@ -50,12 +52,10 @@ const (
 	persistDir        = "/persist"
 	persistRktDataDir = persistDir + "/rkt"
 	rwImgDirname      = persistDir + "/img"       // We store images here
-	xenDirname        = runDirname + "/xen"       // We store xen cfg files here
+	xenDirname        = runDirname + "/xen"       // We store hypervisor cfg files here
 	ciDirname         = runDirname + "/cloudinit" // For cloud-init images
 	downloadDirname   = persistDir + "/downloads"
 	imgCatalogDirname = downloadDirname + "/" + appImgObj
-	// Read-only images named based on sha256 hash each in its own directory
-	verifiedDirname = imgCatalogDirname + "/verified"
 )
 
 // Really a constant
@@ -78,18 +78,31 @@ type domainContext struct {
 	dnsLock                sync.Mutex
 	assignableAdapters     *types.AssignableAdapters
 	DNSinitialized         bool // Received DeviceNetworkStatus
-	subDeviceNetworkStatus *pubsub.Subscription
-	subDomainConfig        *pubsub.Subscription
-	pubDomainStatus        *pubsub.Publication
-	subGlobalConfig        *pubsub.Subscription
-	pubImageStatus         *pubsub.Publication
-	pubAssignableAdapters  *pubsub.Publication
+	subDeviceNetworkStatus *typedpubsub.DeviceNetworkStatusSubscription
+	subDomainConfig        *typedpubsub.DomainConfigSubscription
+	pubDomainStatus        *typedpubsub.DomainStatusPublication
+	subGlobalConfig        *typedpubsub.GlobalConfigSubscription
+	subAppInstanceConfig   *typedpubsub.AppInstanceConfigSubscription
+	appInstancesKnown      bool // subAppInstanceConfig synchronized
+	pubImageStatus         *typedpubsub.ImageStatusPublication
+	pubAssignableAdapters  *typedpubsub.AssignableAdaptersPublication
+	store                  *blobstore.Store // Verified read-only images
 	usbAccess              bool
 	createSema             sema.Semaphore
+	hyper                  hypervisor.Hypervisor // VM backend picked at boot
+	containerHyper         hypervisor.Hypervisor // Runs containers on hyper
 }
 
 func (ctx *domainContext) publishAssignableAdapters() {
-	ctx.pubAssignableAdapters.Publish("global", ctx.assignableAdapters)
+	ctx.pubAssignableAdapters.Publish("global", *ctx.assignableAdapters)
+}
+
+// Pick the backend for the domain
+func (ctx *domainContext) hypervisorFor(status *types.DomainStatus) hypervisor.Hypervisor {
+	if status.IsContainer {
+		return ctx.containerHyper
+	}
+	return ctx.hyper
 }
 
 var debug = false
@@ -102,6 +115,7 @@ func Run() {
 	versionPtr := flag.Bool("v", false, "Version")
 	debugPtr := flag.Bool("d", false, "Debug flag")
 	curpartPtr := flag.String("c", "", "Current partition")
+	hyperPtr := flag.String("H", "", "Hypervisor (xen or kvm); default auto-detect")
 	flag.Parse()
 	debug = *debugPtr
 	debugOverride = debug
@@ -164,25 +178,34 @@ func Run() {
 			log.Fatal(err)
 		}
 	}
-	if _, err := os.Stat(verifiedDirname); err != nil {
-		if err := os.MkdirAll(verifiedDirname, 0700); err != nil {
-			log.Fatal(err)
-		}
+
+	hyper, err := hypervisor.GetHypervisor(*hyperPtr)
+	if err != nil {
+		log.Fatal(err)
 	}
+	log.Infof("Using hypervisor %s\n", hyper.Name())
 
-	domainCtx := domainContext{usbAccess: true}
-	// Allow only one concurrent xl create
+	domainCtx := domainContext{
+		usbAccess:      true,
+		hyper:          hyper,
+		containerHyper: hypervisor.GetContainerHypervisor(hyper),
+	}
+	domainCtx.store, err = blobstore.New(blobstore.DefaultDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Allow only one concurrent domain create
 	domainCtx.createSema = sema.Create(1)
 	domainCtx.createSema.P(1)
 
-	pubDomainStatus, err := pubsub.Publish(agentName, types.DomainStatus{})
+	pubDomainStatus, err := typedpubsub.PublishDomainStatus(agentName)
 	if err != nil {
 		log.Fatal(err)
 	}
 	domainCtx.pubDomainStatus = pubDomainStatus
 	pubDomainStatus.ClearRestarted()
 
-	pubImageStatus, err := pubsub.Publish(agentName, types.ImageStatus{})
+	pubImageStatus, err := typedpubsub.PublishImageStatus(agentName)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -192,8 +215,7 @@ func Run() {
 	// Publish existing images with RefCount zero
 	populateInitialImageStatus(&domainCtx, rwImgDirname)
 
-	pubAssignableAdapters, err := pubsub.Publish(agentName,
-		types.AssignableAdapters{})
+	pubAssignableAdapters, err := typedpubsub.PublishAssignableAdapters(agentName)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -201,23 +223,23 @@ func Run() {
 	pubAssignableAdapters.ClearRestarted()
 
 	// Look for global config such as log levels
-	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
+	subGlobalConfig, err := typedpubsub.SubscribeGlobalConfig("",
 		false, &domainCtx)
 	if err != nil {
 		log.Fatal(err)
 	}
-	subGlobalConfig.ModifyHandler = handleGlobalConfigModify
-	subGlobalConfig.DeleteHandler = handleGlobalConfigDelete
+	subGlobalConfig.SetModifyHandler(handleGlobalConfigModify)
+	subGlobalConfig.SetDeleteHandler(handleGlobalConfigDelete)
 	domainCtx.subGlobalConfig = subGlobalConfig
 	subGlobalConfig.Activate()
 
-	subDeviceNetworkStatus, err := pubsub.Subscribe("nim",
-		types.DeviceNetworkStatus{}, false, &domainCtx)
+	subDeviceNetworkStatus, err := typedpubsub.SubscribeDeviceNetworkStatus("nim",
+		false, &domainCtx)
 	if err != nil {
 		log.Fatal(err)
 	}
-	subDeviceNetworkStatus.ModifyHandler = handleDNSModify
-	subDeviceNetworkStatus.DeleteHandler = handleDNSDelete
+	subDeviceNetworkStatus.SetModifyHandler(handleDNSModify)
+	subDeviceNetworkStatus.SetDeleteHandler(handleDNSDelete)
 	domainCtx.subDeviceNetworkStatus = subDeviceNetworkStatus
 	subDeviceNetworkStatus.Activate()
 
@@ -282,15 +304,26 @@ func Run() {
 	}
 	log.Infof("Have %d assignable adapters\n", len(aa.IoBundleList))
 
+	// The AppInstanceConfig tells which of the copies are preserved
+	// while zedmanager waits to publish their DomainConfig
+	subAppInstanceConfig, err := typedpubsub.SubscribeAppInstanceConfig("zedagent",
+		false, &domainCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subAppInstanceConfig.SynchronizedHandler = handleAppInstanceConfigSynchronized
+	domainCtx.subAppInstanceConfig = subAppInstanceConfig
+	subAppInstanceConfig.Activate()
+
 	// Subscribe to DomainConfig from zedmanager
-	subDomainConfig, err := pubsub.Subscribe("zedmanager",
-		types.DomainConfig{}, false, &domainCtx)
+	subDomainConfig, err := typedpubsub.SubscribeDomainConfig("zedmanager",
+		false, &domainCtx)
 	if err != nil {
 		log.Fatal(err)
 	}
-	subDomainConfig.ModifyHandler = handleDomainModify
-	subDomainConfig.CreateHandler = handleDomainCreate
-	subDomainConfig.DeleteHandler = handleDomainDelete
+	subDomainConfig.SetModifyHandler(handleDomainModify)
+	subDomainConfig.SetCreateHandler(handleDomainCreate)
+	subDomainConfig.SetDeleteHandler(handleDomainDelete)
 	subDomainConfig.RestartHandler = handleRestart
 	domainCtx.subDomainConfig = subDomainConfig
 	subDomainConfig.Activate()
@@ -304,6 +337,9 @@ func Run() {
 		case change := <-subGlobalConfig.C:
 			subGlobalConfig.ProcessChange(change)
 
+		case change := <-subAppInstanceConfig.C:
+			subAppInstanceConfig.ProcessChange(change)
+
 		case change := <-subDomainConfig.C:
 			subDomainConfig.ProcessChange(change)
 
@@ -314,7 +350,7 @@ func Run() {
 			subAa.ProcessChange(change)
 
 		case <-gc.C:
-			gcObjects(&domainCtx, rwImgDirname)
+			gcObjects(&domainCtx)
 
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
@@ -341,6 +377,8 @@ func populateInitialImageStatus(ctx *domainContext, dirName string) {
 	if err != nil {
 		log.Fatal(err)
 	}
+	// The references are added back below for the copies we still have
+	ctx.store.ReleaseHolders(agentName + ".")
 
 	for _, location := range locations {
 		filelocation := dirName + "/" + location.Name()
@@ -361,32 +399,82 @@ func populateInitialImageStatus(ctx *domainContext, dirName string) {
 		status := types.ImageStatus{
 			Filename:     location.Name(),
 			FileLocation: filelocation,
+			ImageSha256:  imageSha256FromFilename(location.Name()),
 			Size:         uint64(size),
 			RefCount:     0,
 			LastUse:      time.Now(),
 		}
-
+		addImageRef(ctx, &status)
 		publishImageStatus(ctx, &status)
 	}
 }
 
-func addImageStatus(ctx *domainContext, fileLocation string) {
+func handleAppInstanceConfigSynchronized(ctxArg interface{}, done bool) {
+	log.Infof("handleAppInstanceConfigSynchronized(%v)\n", done)
+	ctx := ctxArg.(*domainContext)
+	if done {
+		ctx.appInstancesKnown = true
+	}
+}
+
+// The copies are named <sha256>-<app uuid>.<format>
+func imageSha256FromFilename(filename string) string {
+	i := strings.Index(filename, "-")
+	if i <= 0 {
+		return ""
+	}
+	return filename[:i]
+}
+
+func appUUIDFromFilename(filename string) string {
+	i := strings.Index(filename, "-")
+	if i <= 0 {
+		return ""
+	}
+	return strings.TrimSuffix(filename[i+1:], filepath.Ext(filename))
+}
+
+// A copy holds a reference to the image it was copied from in the blob
+// store since a copy which isn't preserved is made again from the image
+// when the domain is activated.
+func addImageRef(ctx *domainContext, status *types.ImageStatus) {
+	if status.ImageSha256 == "" {
+		return
+	}
+	err := ctx.store.AddRef(status.ImageSha256, agentName+"."+status.Filename)
+	if err != nil {
+		log.Errorf("addImageRef(%s) failed: %s\n", status.Filename, err)
+	}
+}
+
+func releaseImageRef(ctx *domainContext, status *types.ImageStatus) {
+	if status.ImageSha256 == "" {
+		return
+	}
+	err := ctx.store.ReleaseRef(status.ImageSha256, agentName+"."+status.Filename)
+	if err != nil {
+		log.Errorf("releaseImageRef(%s) failed: %s\n", status.Filename, err)
+	}
+}
+
+func addImageStatus(ctx *domainContext, imageSha256 string, fileLocation string) {
 
 	filename := filepath.Base(fileLocation)
 	pub := ctx.pubImageStatus
-	st, _ := pub.Get(filename)
-	if st == nil {
+	status, err := pub.Get(filename)
+	if err != nil {
 		log.Infof("addImageStatus(%s) not found\n", filename)
 		status := types.ImageStatus{
 			Filename:     filename,
 			FileLocation: fileLocation,
+			ImageSha256:  imageSha256,
 			Size:         0, // XXX
 			RefCount:     1,
 			LastUse:      time.Now(),
 		}
+		addImageRef(ctx, &status)
 		publishImageStatus(ctx, &status)
 	} else {
-		status := cast.CastImageStatus(st)
 		log.Infof("addImageStatus(%s) found RefCount %d LastUse %v\n",
 			filename, status.RefCount, status.LastUse)
 
@@ -403,26 +491,33 @@ func delImageStatus(ctx *domainContext, fileLocation string) {
 
 	filename := filepath.Base(fileLocation)
 	pub := ctx.pubImageStatus
-	st, _ := pub.Get(filename)
-	if st == nil {
+	status, err := pub.Get(filename)
+	if err != nil {
 		log.Errorf("delImageStatus(%s) not found\n", filename)
 		return
 	}
-	status := cast.CastImageStatus(st)
 	log.Infof("delImageStatus(%s) found RefCount %d LastUse %v\n",
 		filename, status.RefCount, status.LastUse)
+	releaseImageRef(ctx, &status)
 	unpublishImageStatus(ctx, &status)
 }
 
 // Periodic garbage collection looking at RefCount=0 files
-func gcObjects(ctx *domainContext, dirName string) {
+// A copy from before a restart has a zero RefCount until its DomainConfig
+// arrives, which zedmanager publishes only once the application is ready
+// to run, hence the preserved copies are kept as long as their
+// AppInstanceConfig exists.
+func gcObjects(ctx *domainContext) {
 
 	log.Debugf("gcObjects()\n")
 
+	if !ctx.appInstancesKnown {
+		log.Debugf("gcObjects: waiting for AppInstanceConfig\n")
+		return
+	}
 	pub := ctx.pubImageStatus
 	items := pub.GetAll()
-	for key, st := range items {
-		status := cast.CastImageStatus(st)
+	for key, status := range items {
 		if status.Key() != key {
 			log.Errorf("gcObjects key/UUID mismatch %s vs %s; ignored %+v\n",
 				key, status.Key(), status)
@@ -443,6 +538,12 @@ func gcObjects(ctx *domainContext, dirName string) {
 				status.RefCount, key)
 			continue
 		}
+		if isPreservedCopy(ctx, status.Filename) {
+			log.Debugf("gcObjects: skipping preserved %s\n", key)
+			status.LastUse = time.Now()
+			publishImageStatus(ctx, &status)
+			continue
+		}
 		timePassed := time.Since(status.LastUse)
 		if timePassed < vdiskGCTime {
 			log.Debugf("gcObjects: skipping recently used %s remains %d seconds\n",
@@ -454,6 +555,7 @@ func gcObjects(ctx *domainContext, dirName string) {
 		if err := os.Remove(filelocation); err != nil {
 			log.Errorln(err)
 		}
+		releaseImageRef(ctx, &status)
 		unpublishImageStatus(ctx, &status)
 	}
 }
@@ -463,8 +565,7 @@ func findActiveFileLocation(ctx *domainContext, filename string) bool {
 	log.Debugf("findActiveFileLocation(%v)\n", filename)
 	pub := ctx.pubDomainStatus
 	items := pub.GetAll()
-	for key, st := range items {
-		status := cast.CastDomainStatus(st)
+	for key, status := range items {
 		if status.Key() != key {
 			log.Errorf("findActiveFileLocation key/UUID mismatch %s vs %s; ignored %+v\n",
 				key, status.Key(), status)
@@ -479,12 +580,31 @@ func findActiveFileLocation(ctx *domainContext, filename string) bool {
 	return false
 }
 
+// Check if the copy is of a preserved disk of an AppInstanceConfig
+func isPreservedCopy(ctx *domainContext, filename string) bool {
+	config, err := ctx.subAppInstanceConfig.Get(appUUIDFromFilename(filename))
+	if err != nil {
+		return false
+	}
+	return isPreservedIn(config, imageSha256FromFilename(filename))
+}
+
+func isPreservedIn(config types.AppInstanceConfig, imageSha256 string) bool {
+	for _, sc := range config.StorageConfigList {
+		if !sc.ReadOnly && sc.Preserve &&
+			strings.EqualFold(sc.ImageSha256, imageSha256) {
+			return true
+		}
+	}
+	return false
+}
+
 func publishDomainStatus(ctx *domainContext, status *types.DomainStatus) {
 
 	key := status.Key()
 	log.Debugf("publishDomainStatus(%s)\n", key)
 	pub := ctx.pubDomainStatus
-	pub.Publish(key, status)
+	pub.Publish(key, *status)
 }
 
 func unpublishDomainStatus(ctx *domainContext, status *types.DomainStatus) {
@@ -492,8 +612,7 @@ func unpublishDomainStatus(ctx *domainContext, status *types.DomainStatus) {
 	key := status.Key()
 	log.Debugf("unpublishDomainStatus(%s)\n", key)
 	pub := ctx.pubDomainStatus
-	st, _ := pub.Get(key)
-	if st == nil {
+	if _, err := pub.Get(key); err != nil {
 		log.Errorf("unpublishDomainStatus(%s) not found\n", key)
 		return
 	}
@@ -505,7 +624,7 @@ func publishImageStatus(ctx *domainContext, status *types.ImageStatus) {
 	key := status.Key()
 	log.Debugf("publishImageStatus(%s)\n", key)
 	pub := ctx.pubImageStatus
-	pub.Publish(key, status)
+	pub.Publish(key, *status)
 }
 
 func unpublishImageStatus(ctx *domainContext, status *types.ImageStatus) {
@@ -513,16 +632,16 @@ func unpublishImageStatus(ctx *domainContext, status *types.ImageStatus) {
 	key := status.Key()
 	log.Debugf("unpublishImageStatus(%s)\n", key)
 	pub := ctx.pubImageStatus
-	st, _ := pub.Get(key)
-	if st == nil {
+	if _, err := pub.Get(key); err != nil {
 		log.Errorf("unpublishImageStatus(%s) not found\n", key)
 		return
 	}
 	pub.Unpublish(key)
 }
 
-func xenCfgFilename(appNum int) string {
-	return xenDirname + "/xen" + strconv.Itoa(appNum) + ".cfg"
+// The name of the config file depends on the VM backend
+func (ctx *domainContext) domCfgFilename(appNum int) string {
+	return xenDirname + "/" + ctx.hyper.Name() + strconv.Itoa(appNum) + ".cfg"
 }
 
 // We have one goroutine per provisioned domU object.
@@ -530,7 +649,7 @@ func xenCfgFilename(appNum int) string {
 // Channel is closed when the object is deleted
 // The go-routine owns writing status for the object
 // The key in the map is the objects Key() - UUID in this case
-type handlers map[string]chan<- interface{}
+type handlers map[string]chan<- types.DomainConfig
 
 var handlerMap handlers
 
@@ -541,10 +660,10 @@ func handlersInit() {
 // Wrappers around handleCreate, handleModify, and handleDelete
 
 // Determine whether it is an create or modify
-func handleDomainModify(ctxArg interface{}, key string, configArg interface{}) {
+func handleDomainModify(ctxArg interface{}, key string,
+	config types.DomainConfig) {
 
 	log.Infof("handleDomainModify(%s)\n", key)
-	config := cast.CastDomainConfig(configArg)
 	if config.Key() != key {
 		log.Errorf("handleDomainModify key/UUID mismatch %s vs %s; ignored %+v\n",
 			key, config.Key(), config)
@@ -554,13 +673,13 @@ func handleDomainModify(ctxArg interface{}, key string, configArg interface{}) {
 	if !ok {
 		log.Fatalf("handleDomainModify called on config that does not exist")
 	}
-	h <- configArg
+	h <- config
 }
-func handleDomainCreate(ctxArg interface{}, key string, configArg interface{}) {
+func handleDomainCreate(ctxArg interface{}, key string,
+	config types.DomainConfig) {
 
 	log.Infof("handleDomainCreate(%s)\n", key)
 	ctx := ctxArg.(*domainContext)
-	config := cast.CastDomainConfig(configArg)
 	if config.Key() != key {
 		log.Errorf("handleDomainCreate key/UUID mismatch %s vs %s; ignored %+v\n",
 			key, config.Key(), config)
@@ -570,15 +689,15 @@ func handleDomainCreate(ctxArg interface{}, key string, configArg interface{}) {
 	if ok {
 		log.Fatalf("handleDomainCreate called on config that already exists")
 	}
-	h1 := make(chan interface{})
+	h1 := make(chan types.DomainConfig)
 	handlerMap[config.Key()] = h1
 	go runHandler(ctx, key, h1)
 	h = h1
-	h <- configArg
+	h <- config
 }
 
 func handleDomainDelete(ctxArg interface{}, key string,
-	configArg interface{}) {
+	config types.DomainConfig) {
 
 	log.Infof("handleDomainDelete(%s)\n", key)
 	// Do we have a channel/goroutine?
@@ -596,7 +715,7 @@ func handleDomainDelete(ctxArg interface{}, key string,
 
 // Server for each domU
 // Runs timer every 30 seconds to update status
-func runHandler(ctx *domainContext, key string, c <-chan interface{}) {
+func runHandler(ctx *domainContext, key string, c <-chan types.DomainConfig) {
 
 	log.Infof("runHandler starting\n")
 
@@ -609,9 +728,8 @@ func runHandler(ctx *domainContext, key string, c <-chan interface{}) {
 	closed := false
 	for !closed {
 		select {
-		case configArg, ok := <-c:
+		case config, ok := <-c:
 			if ok {
-				config := cast.CastDomainConfig(configArg)
 				status := lookupDomainStatus(ctx, key)
 				if status == nil {
 					handleCreate(ctx, key, &config)
@@ -641,7 +759,7 @@ func runHandler(ctx *domainContext, key string, c <-chan interface{}) {
 // Check if it is still running
 // XXX would xen state be useful?
 func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
-	domainID, err := xlDomid(status.DomainName, status.DomainId)
+	domainID, err := ctx.hypervisorFor(status).Info(*status)
 	if err != nil {
 		if status.Activated {
 			errStr := fmt.Sprintf("verifyStatus(%s) failed %s",
@@ -649,6 +767,9 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			log.Warnln(errStr)
 			status.Activated = false
 			status.State = types.HALTED
+			// Report the crash; clearing Activate cleans up
+			status.LastErr = errStr
+			status.LastErrTime = time.Now()
 		}
 		status.DomainId = 0
 		publishDomainStatus(ctx, status)
@@ -700,10 +821,11 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 	status.TriedCount += 1
 
 	ctx.createSema.V(1)
-	domainID, podUUID, err := DomainCreate(*status)
+	domainID, err := ctx.hypervisorFor(status).Create(status,
+		ctx.domCfgFilename(status.AppNum))
 	ctx.createSema.P(1)
 	if err != nil {
-		log.Errorf("maybeRetryBoot DomainCreate for %s: %s\n",
+		log.Errorf("maybeRetryBoot Create for %s: %s\n",
 			status.DomainName, err)
 		status.BootFailed = true
 		status.LastErr = fmt.Sprintf("%v", err)
@@ -711,7 +833,6 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 		publishDomainStatus(ctx, status)
 		return
 	}
-	status.PodUUID = podUUID
 	status.BootFailed = false
 	doActivateTail(ctx, status, domainID)
 }
@@ -764,12 +885,11 @@ func maybeRetryAdapters(ctx *domainContext, status *types.DomainStatus) {
 func lookupDomainStatus(ctx *domainContext, key string) *types.DomainStatus {
 
 	pub := ctx.pubDomainStatus
-	st, _ := pub.Get(key)
-	if st == nil {
+	status, err := pub.Get(key)
+	if err != nil {
 		log.Infof("lookupDomainStatus(%s) not found\n", key)
 		return nil
 	}
-	status := cast.CastDomainStatus(st)
 	if status.Key() != key {
 		log.Errorf("lookupDomainStatus key/UUID mismatch %s vs %s; ignored %+v\n",
 			key, status.Key(), status)
@@ -781,12 +901,11 @@ func lookupDomainStatus(ctx *domainContext, key string) *types.DomainStatus {
 func lookupDomainConfig(ctx *domainContext, key string) *types.DomainConfig {
 
 	sub := ctx.subDomainConfig
-	c, _ := sub.Get(key)
-	if c == nil {
+	config, err := sub.Get(key)
+	if err != nil {
 		log.Infof("lookupDomainConfig(%s) not found\n", key)
 		return nil
 	}
-	config := cast.CastDomainConfig(c)
 	if config.Key() != key {
 		log.Errorf("lookupDomainConfig key/UUID mismatch %s vs %s; ignored %+v\n",
 			key, config.Key(), config)
@@ -796,10 +915,11 @@ func lookupDomainConfig(ctx *domainContext, key string) *types.DomainConfig {
 }
 
 func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
+	alog := agentlog.WithApp(config.UUIDandVersion.UUID.String())
 
-	log.Infof("handleCreate(%v) for %s\n",
+	alog.Infof("handleCreate(%v) for %s\n",
 		config.UUIDandVersion, config.DisplayName)
-	log.Debugf("DomainConfig %+v\n", config)
+	alog.Debugf("DomainConfig %+v\n", config)
 	// Name of Xen domain must be unique; uniqify AppNum
 	name := config.DisplayName + "." + strconv.Itoa(config.AppNum)
 
@@ -819,15 +939,16 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 		IsContainer:        config.IsContainer,
 		ContainerImageID:   config.ContainerImageID,
 	}
+	status.Hypervisor = ctx.hypervisorFor(&status).Name()
 	status.DiskStatusList = make([]types.DiskStatus,
 		len(config.DiskConfigList))
 	publishDomainStatus(ctx, &status)
-	log.Infof("handleCreate(%v) set domainName %s for %s\n",
+	alog.Infof("handleCreate(%v) set domainName %s for %s\n",
 		config.UUIDandVersion, status.DomainName,
 		config.DisplayName)
 
 	if err := configToStatus(ctx, *config, &status); err != nil {
-		log.Errorf("Failed to create DomainStatus from %v: %s\n",
+		alog.Errorf("Failed to create DomainStatus from %v: %s\n",
 			config, err)
 		status.PendingAdd = false
 		status.LastErr = fmt.Sprintf("%v", err)
@@ -845,16 +966,16 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 		if ds.ReadOnly || !ds.Preserve {
 			continue
 		}
-		log.Infof("Copy from %s to %s\n", ds.FileLocation, ds.ActiveFileLocation)
+		alog.Infof("Copy from %s to %s\n", ds.FileLocation, ds.ActiveFileLocation)
 		if _, err := os.Stat(ds.ActiveFileLocation); err == nil {
 			if ds.Preserve {
-				log.Infof("Preserve and target exists - skip copy\n")
+				alog.Infof("Preserve and target exists - skip copy\n")
 			} else {
-				log.Infof("Not preserve and target exists - assume rebooted and preserve\n")
+				alog.Infof("Not preserve and target exists - assume rebooted and preserve\n")
 			}
 		} else {
 			if err := cp(ds.ActiveFileLocation, ds.FileLocation); err != nil {
-				log.Errorf("Copy failed from %s to %s: %s\n",
+				alog.Errorf("Copy failed from %s to %s: %s\n",
 					ds.FileLocation, ds.ActiveFileLocation, err)
 				status.PendingAdd = false
 				status.LastErr = fmt.Sprintf("%v", err)
@@ -868,7 +989,7 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 			if err != nil {
 				errStr := fmt.Sprintf("handleCreate(%s) failed %v",
 					status.Key(), err)
-				log.Errorln(errStr)
+				alog.Errorln(errStr)
 				status.LastErr = errStr
 				status.LastErrTime = time.Now()
 				status.PendingAdd = false
@@ -876,13 +997,13 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 				return
 			}
 		}
-		addImageStatus(ctx, ds.ActiveFileLocation)
-		log.Infof("Copy DONE from %s to %s\n",
+		addImageStatus(ctx, ds.ImageSha256, ds.ActiveFileLocation)
+		alog.Infof("Copy DONE from %s to %s\n",
 			ds.FileLocation, ds.ActiveFileLocation)
 	}
 
 	if err := configAdapters(ctx, *config); err != nil {
-		log.Errorf("Failed to reserve adapters for %v: %s\n",
+		alog.Errorf("Failed to reserve adapters for %v: %s\n",
 			config, err)
 		status.PendingAdd = false
 		status.LastErr = fmt.Sprintf("%v", err)
@@ -907,7 +1028,7 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 	// work done
 	status.PendingAdd = false
 	publishDomainStatus(ctx, &status)
-	log.Infof("handleCreate(%v) DONE for %s\n",
+	alog.Infof("handleCreate(%v) DONE for %s\n",
 		config.UUIDandVersion, config.DisplayName)
 }
 
@@ -983,14 +1104,14 @@ func doAssignIoAdaptersToDomain(ctx *domainContext, config types.DomainConfig,
 		}
 	}
 	for i, long := range assignments {
-		err := pciAssignableAdd(long)
+		err := ctx.hyper.PCIReserve(long)
 		if err != nil {
 			// Undo what we assigned
 			for j, long := range assignments {
 				if j >= i {
 					break
 				}
-				pciAssignableRemove(long)
+				ctx.hyper.PCIRelease(long)
 			}
 			status.LastErr = fmt.Sprintf("%v", err)
 			status.LastErrTime = time.Now()
@@ -1049,19 +1170,20 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 			status.LastErrTime = time.Now()
 			return
 		}
-		addImageStatus(ctx, ds.ActiveFileLocation)
+		addImageStatus(ctx, ds.ImageSha256, ds.ActiveFileLocation)
 		log.Infof("Copy DONE from %s to %s\n",
 			ds.FileLocation, ds.ActiveFileLocation)
 	}
 
-	filename := xenCfgFilename(config.AppNum)
+	hyper := ctx.hypervisorFor(status)
+	filename := ctx.domCfgFilename(config.AppNum)
 	file, err := os.Create(filename)
 	if err != nil {
 		log.Fatal("os.Create for ", filename, err)
 	}
 	defer file.Close()
 
-	if err := configToXencfg(config, *status, ctx.assignableAdapters,
+	if err := hyper.CreateDomConfig(config, *status, ctx.assignableAdapters,
 		file); err != nil {
 		log.Errorf("Failed to create DomainStatus from %v\n", config)
 		status.LastErr = fmt.Sprintf("%v", err)
@@ -1071,31 +1193,29 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 
 	status.TriedCount = 0
 	var domainID int
-	var podUUID string
-	// Invoke xl create; try 3 times with a timeout
+	// Invoke create; try 3 times with a timeout
 	for {
 		status.TriedCount += 1
 		var err error
 		ctx.createSema.V(1)
-		domainID, podUUID, err = DomainCreate(*status)
+		domainID, err = hyper.Create(status, filename)
 		ctx.createSema.P(1)
 		if err == nil {
 			break
 		}
 		if status.TriedCount >= 3 {
-			log.Errorf("DomainCreate for %s: %s\n", status.DomainName, err)
+			log.Errorf("Create for %s: %s\n", status.DomainName, err)
 			status.BootFailed = true
 			status.LastErr = fmt.Sprintf("%v", err)
 			status.LastErrTime = time.Now()
 			publishDomainStatus(ctx, status)
 			return
 		}
-		log.Warnf("Retry xl create for %s: failed %s\n",
-			status.DomainName, err)
+		log.Warnf("Retry %s create for %s: failed %s\n",
+			hyper.Name(), status.DomainName, err)
 		publishDomainStatus(ctx, status)
 		time.Sleep(5 * time.Second)
 	}
-	status.PodUUID = podUUID
 	status.BootFailed = false
 	doActivateTail(ctx, status, domainID)
 }
@@ -1110,28 +1230,26 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 	status.State = types.BOOTING
 	publishDomainStatus(ctx, status)
 
-	// Disable offloads for all vifs
-	err := xlDisableVifOffload(status.DomainName, domainID,
-		len(status.VifList))
-	if err != nil {
-		// XXX continuing even if we get a failure?
-		log.Errorf("xlDisableVifOffload for %s: %s\n",
-			status.DomainName, err)
-	}
-	err = xlUnpause(status.DomainName, domainID)
+	hyper := ctx.hypervisorFor(status)
+	err := hyper.Start(*status)
 	if err != nil {
 		// XXX shouldn't we destroy it?
-		log.Errorf("xl unpause for %s: %s\n", status.DomainName, err)
+		log.Errorf("%s start for %s: %s\n", hyper.Name(),
+			status.DomainName, err)
 		status.LastErr = fmt.Sprintf("%v", err)
 		status.LastErrTime = time.Now()
 		return
 	}
 
 	status.State = types.RUNNING
-	// XXX dumping status to log
-	xlStatus(status.DomainName, status.DomainId)
+	if console, err := hyper.ConsoleInfo(*status); err == nil {
+		log.Infof("Console for %s logged in %s\n",
+			status.DomainName, console)
+		// logmanager collects it for the app instance
+		status.ConsoleFile = console
+	}
 
-	domainID, err = xlDomid(status.DomainName, status.DomainId)
+	domainID, err = hyper.Info(*status)
 	if err == nil && domainID != status.DomainId {
 		status.DomainId = domainID
 	}
@@ -1144,7 +1262,8 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus) {
 
 	log.Infof("doInactivate(%v) for %s\n",
 		status.UUIDandVersion, status.DisplayName)
-	domainID, err := xlDomid(status.DomainName, status.DomainId)
+	hyper := ctx.hypervisorFor(status)
+	domainID, err := hyper.Info(*status)
 	if err == nil && domainID != status.DomainId {
 		status.DomainId = domainID
 	}
@@ -1158,43 +1277,43 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus) {
 			// Do a short shutdown wait, then a shutdown -F
 			// just in case there are PV tools in guest
 			shortDelay := time.Second * 10
-			if err := DomainShutdown(*status, false); err != nil {
-				log.Errorf("DomainShutdown %s failed: %s\n",
+			if err := hyper.Stop(*status, false); err != nil {
+				log.Errorf("Stop %s failed: %s\n",
 					status.DomainName, err)
 			} else {
 				// Wait for the domain to go away
 				log.Infof("doInactivate(%v) for %s: waiting for domain to shutdown\n",
 					status.UUIDandVersion, status.DisplayName)
 			}
-			gone := waitForDomainGone(*status, shortDelay)
+			gone := waitForDomainGone(ctx, *status, shortDelay)
 			if gone {
 				status.DomainId = 0
 				break
 			}
-			if err := DomainShutdown(*status, true); err != nil {
-				log.Errorf("DomainShutdown -F %s failed: %s\n",
+			if err := hyper.Stop(*status, true); err != nil {
+				log.Errorf("Stop force %s failed: %s\n",
 					status.DomainName, err)
 			} else {
 				// Wait for the domain to go away
 				log.Infof("doInactivate(%v) for %s: waiting for domain to shutdown\n",
 					status.UUIDandVersion, status.DisplayName)
 			}
-			gone = waitForDomainGone(*status, maxDelay)
+			gone = waitForDomainGone(ctx, *status, maxDelay)
 			if gone {
 				status.DomainId = 0
 				break
 			}
 
 		case types.PV:
-			if err := DomainShutdown(*status, false); err != nil {
-				log.Errorf("DomainShutdown %s failed: %s\n",
+			if err := hyper.Stop(*status, false); err != nil {
+				log.Errorf("Stop %s failed: %s\n",
 					status.DomainName, err)
 			} else {
 				// Wait for the domain to go away
 				log.Infof("doInactivate(%v) for %s: waiting for domain to shutdown\n",
 					status.UUIDandVersion, status.DisplayName)
 			}
-			gone := waitForDomainGone(*status, maxDelay)
+			gone := waitForDomainGone(ctx, *status, maxDelay)
 			if gone {
 				status.DomainId = 0
 				break
@@ -1202,20 +1321,20 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus) {
 		}
 	}
 
-	// Incase of rkt based container, DomainShutdown moves the
+	// Incase of rkt based container, Stop moves the
 	// container to exit state and the domain is destroyed
-	// Issue Domain Destroy irrespective in container case
+	// Issue Destroy irrespective in container case
 	if status.IsContainer || status.DomainId != 0 {
-		err := DomainDestroy(*status)
+		err := hyper.Destroy(*status)
 		if err != nil {
-			log.Errorf("DomainDestroy %s failed: %s\n",
+			log.Errorf("Destroy %s failed: %s\n",
 				status.DomainName, err)
 		}
 		// Even if destroy failed we wait again
 		log.Infof("doInactivate(%v) for %s: waiting for domain to be destroyed\n",
 			status.UUIDandVersion, status.DisplayName)
 
-		gone := waitForDomainGone(*status, maxDelay)
+		gone := waitForDomainGone(ctx, *status, maxDelay)
 		if gone {
 			status.DomainId = 0
 		}
@@ -1299,7 +1418,7 @@ func pciUnassign(ctx *domainContext, status *types.DomainStatus,
 		checkIoBundleAll(ctx)
 	}
 	for _, long := range assignments {
-		err := pciAssignableRemove(long)
+		err := ctx.hyper.PCIRelease(long)
 		if err != nil && !ignoreErrors {
 			status.LastErr = fmt.Sprintf("%v", err)
 			status.LastErrTime = time.Now()
@@ -1308,6 +1427,14 @@ func pciUnassign(ctx *domainContext, status *types.DomainStatus,
 	ctx.publishAssignableAdapters()
 }
 
+// Location for a per-guest copy
+// Use App UUID to make sure name is the same even
+// after adds and deletes of instances and device reboots
+func rwCopyFilename(config types.DomainConfig, dc types.DiskConfig) string {
+	return fmt.Sprintf("%s/%s-%s.%s", rwImgDirname, dc.ImageSha256,
+		config.UUIDandVersion.UUID.String(), dc.Format)
+}
+
 // Produce DomainStatus based on the config
 func configToStatus(ctx *domainContext, config types.DomainConfig,
 	status *types.DomainStatus) error {
@@ -1323,7 +1450,7 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 		ds.Maxsizebytes = dc.Maxsizebytes
 		ds.Devtype = dc.Devtype
 		// map from i=1 to xvda, 2 to xvdb etc
-		xv := "xvd" + string(int('a')+i)
+		xv := "xvd" + string(rune('a'+i))
 		ds.Vdev = xv
 
 		var location string
@@ -1334,27 +1461,20 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 			}
 			location = filepath.Join(persistRktDataDir, "cas", "blob", "sha512", string(status.ContainerImageID[7:9]), status.ContainerImageID)
 		} else {
-			locationDir := verifiedDirname + "/" + dc.ImageSha256
 			log.Debugf("configToStatus(%v) processing disk img %s for %s\n",
-				config.UUIDandVersion, locationDir, config.DisplayName)
-
-			var err error
-			location, err = locationFromDir(locationDir)
-			if err != nil {
-				return err
+				config.UUIDandVersion, dc.ImageSha256, config.DisplayName)
+			if !ctx.store.Has(dc.ImageSha256) {
+				errStr := fmt.Sprintf("Missing image %s",
+					dc.ImageSha256)
+				log.Errorln(errStr)
+				return errors.New(errStr)
 			}
+			location = ctx.store.Path(dc.ImageSha256)
 		}
 		ds.FileLocation = location
 		target := location
 		if !status.IsContainer && !dc.ReadOnly {
-			// Pick new location for a per-guest copy
-			// Use App UUID to make sure name is the same even
-			// after adds and deletes of instances and device reboots
-			dstFilename := fmt.Sprintf("%s/%s-%s.%s",
-				rwImgDirname, dc.ImageSha256,
-				config.UUIDandVersion.UUID.String(),
-				dc.Format)
-			target = dstFilename
+			target = rwCopyFilename(config, dc)
 		}
 		ds.ActiveFileLocation = target
 	}
@@ -1412,284 +1532,6 @@ func configAdapters(ctx *domainContext, config types.DomainConfig) error {
 	return nil
 }
 
-// Produce the xen cfg file based on the config and status created above
-// XXX or produce output to a string instead of file to make comparison
-// easier?
-func configToXencfg(config types.DomainConfig, status types.DomainStatus,
-	aa *types.AssignableAdapters, file *os.File) error {
-
-	xen_type := "pv"
-	rootDev := ""
-	extra := ""
-	bootLoader := ""
-	uuidStr := fmt.Sprintf("appuuid=%s ", config.UUIDandVersion.UUID)
-
-	switch config.VirtualizationMode {
-	case types.PV:
-		xen_type = "pv"
-		// Note that qcow2 images might have partitions hence xvda1 by default
-		rootDev = config.RootDev
-		if rootDev == "" {
-			rootDev = "/dev/xvda1"
-		}
-		extra = "console=hvc0 " + uuidStr + config.ExtraArgs
-		// XXX zedcloud should really set "pygrub"
-		bootLoader = config.BootLoader
-		if strings.HasSuffix(bootLoader, "pygrub") {
-			log.Warnf("Changing from %s to pygrub for %s\n",
-				bootLoader, config.Key())
-			bootLoader = "pygrub"
-		}
-	case types.HVM:
-		xen_type = "hvm"
-	}
-
-	file.WriteString("# This file is automatically generated by domainmgr\n")
-	file.WriteString(fmt.Sprintf("name = \"%s\"\n", status.DomainName))
-	file.WriteString(fmt.Sprintf("type = \"%s\"\n", xen_type))
-	file.WriteString(fmt.Sprintf("uuid = \"%s\"\n",
-		config.UUIDandVersion.UUID))
-
-	if config.Kernel != "" {
-		file.WriteString(fmt.Sprintf("kernel = \"%s\"\n",
-			config.Kernel))
-	}
-
-	if config.Ramdisk != "" {
-		file.WriteString(fmt.Sprintf("ramdisk = \"%s\"\n",
-			config.Ramdisk))
-	}
-
-	if bootLoader != "" {
-		file.WriteString(fmt.Sprintf("bootloader = \"%s\"\n",
-			bootLoader))
-	}
-	if config.EnableVnc {
-		file.WriteString(fmt.Sprintf("vnc = 1\n"))
-		file.WriteString(fmt.Sprintf("vnclisten = \"0.0.0.0\"\n"))
-		file.WriteString(fmt.Sprintf("usb=1\n"))
-		file.WriteString(fmt.Sprintf("usbdevice=[\"tablet\"]\n"))
-
-		if config.VncDisplay != 0 {
-			file.WriteString(fmt.Sprintf("vncdisplay = %d\n",
-				config.VncDisplay))
-		}
-		if config.VncPasswd != "" {
-			file.WriteString(fmt.Sprintf("vncpasswd = \"%s\"\n",
-				config.VncPasswd))
-		}
-	}
-
-	// Go from kbytes to mbytes
-	kbyte2mbyte := func(kbyte int) int {
-		return (kbyte + 1023) / 1024
-	}
-	file.WriteString(fmt.Sprintf("memory = %d\n",
-		kbyte2mbyte(config.Memory)))
-	if config.MaxMem != 0 {
-		file.WriteString(fmt.Sprintf("maxmem = %d\n",
-			kbyte2mbyte(config.MaxMem)))
-	}
-	vCpus := config.VCpus
-	if vCpus == 0 {
-		vCpus = 1
-	}
-	file.WriteString(fmt.Sprintf("vcpus = %d\n", vCpus))
-	maxCpus := config.MaxCpus
-	if maxCpus == 0 {
-		maxCpus = vCpus
-	}
-	file.WriteString(fmt.Sprintf("maxcpus = %d\n", maxCpus))
-	if config.CPUs != "" {
-		file.WriteString(fmt.Sprintf("cpus = \"%s\"\n", config.CPUs))
-	}
-	if config.DeviceTree != "" {
-		file.WriteString(fmt.Sprintf("device_tree = \"%s\"\n",
-			config.DeviceTree))
-	}
-	dtString := ""
-	for _, dt := range config.DtDev {
-		if dtString != "" {
-			dtString += ","
-		}
-		dtString += fmt.Sprintf("\"%s\"", dt)
-	}
-	if dtString != "" {
-		file.WriteString(fmt.Sprintf("dtdev = [%s]\n", dtString))
-	}
-	// Note that qcow2 images might have partitions hence xvda1 by default
-	if rootDev != "" {
-		file.WriteString(fmt.Sprintf("root = \"%s\"\n", rootDev))
-	}
-	if extra != "" {
-		file.WriteString(fmt.Sprintf("extra = \"%s\"\n", extra))
-	}
-	// XXX Should one be able to disable the serial console? Would need
-	// knob in manifest
-
-	var serialAssignments []string
-	serialAssignments = append(serialAssignments, "pty")
-
-	// Always prefer CDROM vdisk over disk
-	file.WriteString(fmt.Sprintf("boot = \"%s\"\n", "dc"))
-
-	diskString := ""
-	for i, ds := range status.DiskStatusList {
-		access := "rw"
-		if ds.ReadOnly {
-			access = "ro"
-		}
-		oneDisk := fmt.Sprintf("'%s,%s,%s,%s'",
-			ds.ActiveFileLocation, ds.Format, ds.Vdev, access)
-		log.Debugf("Processing disk %d: %s\n", i, oneDisk)
-		if diskString == "" {
-			diskString = oneDisk
-		} else {
-			diskString = diskString + ", " + oneDisk
-		}
-	}
-	file.WriteString(fmt.Sprintf("disk = [%s]\n", diskString))
-
-	vifString := ""
-	for _, net := range config.VifList {
-		oneVif := fmt.Sprintf("'bridge=%s,vifname=%s,mac=%s,type=vif'",
-			net.Bridge, net.Vif, net.Mac)
-		if vifString == "" {
-			vifString = oneVif
-		} else {
-			vifString = vifString + ", " + oneVif
-		}
-	}
-	file.WriteString(fmt.Sprintf("vif = [%s]\n", vifString))
-
-	imString := ""
-	for _, im := range config.IOMem {
-		if imString != "" {
-			imString += ","
-		}
-		imString += fmt.Sprintf("\"%s\"", im)
-	}
-	if imString != "" {
-		file.WriteString(fmt.Sprintf("iomem = [%s]\n", imString))
-	}
-
-	// Gather all PCI assignments into a single line
-	// Also irqs, ioports, and serials
-	// irqs and ioports are used if we are pv; serials if hvm
-	var pciAssignments []typeAndPCI
-	var irqAssignments []string
-	var ioportsAssignments []string
-
-	for _, irq := range config.IRQs {
-		irqString := fmt.Sprintf("%d", irq)
-		irqAssignments = addNoDuplicate(irqAssignments, irqString)
-	}
-	for _, adapter := range config.IoAdapterList {
-		log.Debugf("configToXenCfg processing adapter %d %s\n",
-			adapter.Type, adapter.Name)
-		list := aa.LookupIoBundleGroup(adapter.Type, adapter.Name)
-		// We reserved it in handleCreate so nobody could have stolen it
-		if len(list) == 0 {
-			log.Fatalf("configToXencfg IoBundle disappeared %d %s for %s\n",
-				adapter.Type, adapter.Name, status.DomainName)
-		}
-		for _, ib := range list {
-			if ib == nil {
-				continue
-			}
-			if ib.UsedByUUID != config.UUIDandVersion.UUID {
-				log.Fatalf("configToXencfg IoBundle not ours %s: %d %s for %s\n",
-					ib.UsedByUUID, adapter.Type, adapter.Name,
-					status.DomainName)
-			}
-			if ib.PciLong != "" {
-				tap := typeAndPCI{pciLong: ib.PciLong, ioType: ib.Type}
-				pciAssignments = addNoDuplicatePCI(pciAssignments, tap)
-			}
-			if ib.Irq != "" && config.VirtualizationMode == types.PV {
-				log.Infof("Adding irq <%s>\n", ib.Irq)
-				irqAssignments = addNoDuplicate(irqAssignments,
-					ib.Irq)
-			}
-			if ib.Ioports != "" && config.VirtualizationMode == types.PV {
-				log.Infof("Adding ioport <%s>\n", ib.Ioports)
-				ioportsAssignments = addNoDuplicate(ioportsAssignments, ib.Ioports)
-			}
-			if ib.Serial != "" && config.VirtualizationMode == types.HVM {
-				log.Infof("Adding serial <%s>\n", ib.Serial)
-				serialAssignments = addNoDuplicate(serialAssignments, ib.Serial)
-			}
-		}
-	}
-	if len(pciAssignments) != 0 {
-		log.Debugf("PCI assignments %v\n", pciAssignments)
-		cfg := fmt.Sprintf("pci = [ ")
-		for i, pa := range pciAssignments {
-			if i != 0 {
-				cfg = cfg + ", "
-			}
-			short := types.PCILongToShort(pa.pciLong)
-			// USB controller are subject to legacy USB support from
-			// some BIOS. Use relaxed to get past that.
-			if pa.ioType == types.IoUSB {
-				cfg = cfg + fmt.Sprintf("'%s,rdm_policy=relaxed'",
-					short)
-			} else {
-				cfg = cfg + fmt.Sprintf("'%s'", short)
-			}
-		}
-		cfg = cfg + "]"
-		log.Debugf("Adding pci config <%s>\n", cfg)
-		file.WriteString(fmt.Sprintf("%s\n", cfg))
-	}
-	irqString := ""
-	for _, irq := range irqAssignments {
-		if irqString != "" {
-			irqString += ","
-		}
-		irqString += irq
-	}
-	if irqString != "" {
-		file.WriteString(fmt.Sprintf("irqs = [%s]\n", irqString))
-	}
-	ioportString := ""
-	for _, ioports := range ioportsAssignments {
-		if ioportString != "" {
-			ioportString += ","
-		}
-		ioportString += ioports
-	}
-	if ioportString != "" {
-		file.WriteString(fmt.Sprintf("ioports = [%s]\n", ioportString))
-	}
-	serialString := ""
-	for _, serial := range serialAssignments {
-		if serialString != "" {
-			serialString += ","
-		}
-		serialString += "'" + serial + "'"
-	}
-	if serialString != "" {
-		file.WriteString(fmt.Sprintf("serial = [%s]\n", serialString))
-	}
-	return nil
-}
-
-type typeAndPCI struct {
-	pciLong string
-	ioType  types.IoType
-}
-
-func addNoDuplicatePCI(list []typeAndPCI, tap typeAndPCI) []typeAndPCI {
-
-	for _, t := range list {
-		if t.pciLong == tap.pciLong {
-			return list
-		}
-	}
-	return append(list, tap)
-}
-
 func addNoDuplicate(list []string, add string) []string {
 
 	for _, s := range list {
@@ -1725,8 +1567,9 @@ func cp(dst, src string) error {
 // XXX should we reboot if there are such changes? Or reject with error?
 func handleModify(ctx *domainContext, key string,
 	config *types.DomainConfig, status *types.DomainStatus) {
+	alog := agentlog.WithApp(config.UUIDandVersion.UUID.String())
 
-	log.Infof("handleModify(%v) for %s\n",
+	alog.Infof("handleModify(%v) for %s\n",
 		config.UUIDandVersion, config.DisplayName)
 
 	status.PendingModify = true
@@ -1740,14 +1583,14 @@ func handleModify(ctx *domainContext, key string,
 		status.AppNum = config.AppNum
 		status.VifList = config.VifList
 		publishDomainStatus(ctx, status)
-		log.Infof("handleModify(%v) set domainName %s for %s\n",
+		alog.Infof("handleModify(%v) set domainName %s for %s\n",
 			config.UUIDandVersion, status.DomainName,
 			config.DisplayName)
 
 		// This has the effect of trying a boot again for any
 		// handleModify after an error.
 		if status.LastErr != "" {
-			log.Infof("handleModify(%v) ignoring existing error for %s\n",
+			alog.Infof("handleModify(%v) ignoring existing error for %s\n",
 				config.UUIDandVersion, config.DisplayName)
 			status.LastErr = ""
 			status.LastErrTime = time.Time{}
@@ -1759,7 +1602,7 @@ func handleModify(ctx *domainContext, key string,
 		changed = true
 	} else if !config.Activate {
 		if status.LastErr != "" {
-			log.Infof("handleModify(%v) clearing existing error for %s\n",
+			alog.Infof("handleModify(%v) clearing existing error for %s\n",
 				config.UUIDandVersion, config.DisplayName)
 			status.LastErr = ""
 			status.LastErrTime = time.Time{}
@@ -1781,7 +1624,7 @@ func handleModify(ctx *domainContext, key string,
 		// in handleDelete
 		status.PendingModify = false
 		publishDomainStatus(ctx, status)
-		log.Infof("handleModify(%v) DONE for %s\n",
+		alog.Infof("handleModify(%v) DONE for %s\n",
 			config.UUIDandVersion, config.DisplayName)
 		return
 	}
@@ -1792,7 +1635,7 @@ func handleModify(ctx *domainContext, key string,
 	// before activation.
 
 	if config.UUIDandVersion.Version == status.UUIDandVersion.Version {
-		log.Infof("Same version %s for %s\n",
+		alog.Infof("Same version %s for %s\n",
 			config.UUIDandVersion.Version, key)
 		status.PendingModify = false
 		publishDomainStatus(ctx, status)
@@ -1808,7 +1651,7 @@ func handleModify(ctx *domainContext, key string,
 	status.PendingModify = false
 	status.UUIDandVersion = config.UUIDandVersion
 	publishDomainStatus(ctx, status)
-	log.Infof("handleModify(%v) DONE for %s\n",
+	alog.Infof("handleModify(%v) DONE for %s\n",
 		config.UUIDandVersion, config.DisplayName)
 }
 
@@ -1820,14 +1663,17 @@ func updateStatusFromConfig(status *types.DomainStatus, config types.DomainConfi
 }
 
 // Used to wait both after shutdown and destroy
-func waitForDomainGone(status types.DomainStatus, maxDelay time.Duration) bool {
+func waitForDomainGone(ctx *domainContext, status types.DomainStatus,
+	maxDelay time.Duration) bool {
+
+	hyper := ctx.hypervisorFor(&status)
 	gone := false
 	var delay time.Duration
 	for {
 		log.Infof("waitForDomainGone(%v) for %s: waiting for %v\n",
 			status.UUIDandVersion, status.DisplayName, delay)
 		time.Sleep(delay)
-		if err := xlStatus(status.DomainName, status.DomainId); err != nil {
+		if _, err := hyper.Info(status); err != nil {
 			log.Infof("waitForDomainGone(%v) for %s: domain is gone\n",
 				status.UUIDandVersion, status.DisplayName)
 			gone = true
@@ -1846,13 +1692,11 @@ func waitForDomainGone(status types.DomainStatus, maxDelay time.Duration) bool {
 }
 
 func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
+	alog := agentlog.WithApp(status.UUIDandVersion.UUID.String())
 
-	log.Infof("handleDelete(%v) for %s\n",
+	alog.Infof("handleDelete(%v) for %s\n",
 		status.UUIDandVersion, status.DisplayName)
 
-	// XXX dumping status to log
-	xlStatus(status.DomainName, status.DomainId)
-
 	status.PendingDelete = true
 	publishDomainStatus(ctx, status)
 
@@ -1871,10 +1715,10 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 
 	updateUsbAccess(ctx)
 
-	// Delete xen cfg file for good measure
-	filename := xenCfgFilename(status.AppNum)
+	// Delete hypervisor cfg file for good measure
+	filename := ctx.domCfgFilename(status.AppNum)
 	if err := os.Remove(filename); err != nil {
-		log.Errorln(err)
+		alog.Errorln(err)
 	}
 
 	// Do we need to delete any rw files that were not deleted during
@@ -1884,9 +1728,9 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 			continue
 		}
 		if !ds.ReadOnly && ds.Preserve {
-			log.Infof("Delete copy at %s\n", ds.ActiveFileLocation)
+			alog.Infof("Delete copy at %s\n", ds.ActiveFileLocation)
 			if err := os.Remove(ds.ActiveFileLocation); err != nil {
-				log.Errorln(err)
+				alog.Errorln(err)
 				// XXX return? Cleanup status?
 			}
 			delImageStatus(ctx, ds.ActiveFileLocation)
@@ -1896,463 +1740,13 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 	publishDomainStatus(ctx, status)
 	// Write out what we modified to DomainStatus aka delete
 	unpublishDomainStatus(ctx, status)
-	log.Infof("handleDelete(%v) DONE for %s\n",
+	alog.Infof("handleDelete(%v) DONE for %s\n",
 		status.UUIDandVersion, status.DisplayName)
 }
 
-// DomainCreate is a wrapper for domain creation thru xlCreate or rktRun
-// returns domainID, PodUUID and error
-func DomainCreate(status types.DomainStatus) (int, string, error) {
-
-	var domainID int
-	var err error
-	podUUID := ""
-
-	filename := xenCfgFilename(status.AppNum)
-	log.Infof("DomainCreate %s ... xenCfgFilename - %s\n", status.DomainName, filename)
-	if status.IsContainer {
-		// Use rkt tool
-		log.Infof("Using rkt tool ... ContainerImageID - %s\n", status.ContainerImageID)
-		domainID, podUUID, err = rktRun(status.DomainName, status.ContainerImageID, filename)
-	} else {
-		// Use xl tool
-		log.Infof("Using xl tool ... xenCfgFilename - %s\n", filename)
-		domainID, err = xlCreate(status.DomainName, filename)
-	}
-
-	return domainID, podUUID, err
-}
-
-// Launch app/container thru rkt
-// returns domainID, podUUID and error
-func rktRun(domainName string, ContainerImageID string, xenCfgFilename string) (int, string, error) {
-
-	// STAGE1_XL_OPTS=-p STAGE1_SEED_XL_CFG=xenCfgFilename rkt --dir=<RKT_DATA_DIR> --insecure-options=image run <SHA> --stage1-path=/usr/sbin/stage1-xen.aci --uuid-file-save=uuid_file
-	log.Infof("rktRun %s - ContainerImageID %s\n", domainName, ContainerImageID)
-	cmd := "rkt"
-	args := []string{
-		"--dir=" + persistRktDataDir,
-		"--insecure-options=image",
-		"run",
-		ContainerImageID,
-		"--stage1-path=/usr/sbin/stage1-xen.aci",
-		"--uuid-file-save=/persist/rkt/uuid_file",
-	}
-	stage1XlOpts := "STAGE1_XL_OPTS=-p"
-	stage1XlCfg := "STAGE1_SEED_XL_CFG=" + xenCfgFilename
-	log.Infof("Calling command %s %v\n", cmd, args)
-	log.Infof("Also setting env vars %s %s\n", stage1XlOpts, stage1XlCfg)
-	cmdLine := exec.Command(cmd, args...)
-	cmdLine.Env = append(os.Environ(), stage1XlOpts, stage1XlCfg)
-	stdoutStderr, err := cmdLine.CombinedOutput()
-	if err != nil {
-		log.Errorln("rkt run failed ", err)
-		log.Errorln("rkt run output ", string(stdoutStderr))
-		return 0, "", fmt.Errorf("rkt run failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("rkt run done\n")
-
-	// Get Pod UUID
-	uuidData, err := ioutil.ReadFile("/persist/rkt/uuid_file")
-	if err != nil {
-		log.Errorf("Open /persist/rkt/uuid_file failed : %s\n", err)
-		return 0, "", fmt.Errorf("open /persist/rkt/uuid_file failed: %s\n", err)
-	}
-	podUUID := strings.TrimSpace(string(uuidData))
-	log.Infof("podUUID = %s\n", podUUID)
-
-	// Obtain the domain id
-	cmd = "xl"
-	args = []string{
-		"domid",
-		domainName,
-	}
-	stdoutStderr, err = wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl domid failed ", err)
-		log.Errorln("xl domid output ", string(stdoutStderr))
-		return 0, "", fmt.Errorf("xl domid failed: %s\n",
-			string(stdoutStderr))
-	}
-	res := strings.TrimSpace(string(stdoutStderr))
-	domainID, err := strconv.Atoi(res)
-	if err != nil {
-		log.Errorf("Can't extract domainID from %s: %s\n", res, err)
-		return 0, "", fmt.Errorf("Can't extract domainID from %s: %s\n", res, err)
-	}
-	return domainID, podUUID, nil
-}
-
-// Create in paused state; Need to call xlUnpause later
-func xlCreate(domainName string, xenCfgFilename string) (int, error) {
-	log.Infof("xlCreate %s %s\n", domainName, xenCfgFilename)
-	cmd := "xl"
-	args := []string{
-		"create",
-		xenCfgFilename,
-		"-p",
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl create failed ", err)
-		log.Errorln("xl create output ", string(stdoutStderr))
-		return 0, fmt.Errorf("xl create failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("xl create done\n")
-
-	args = []string{
-		"domid",
-		domainName,
-	}
-	stdoutStderr, err = wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl domid failed ", err)
-		log.Errorln("xl domid output ", string(stdoutStderr))
-		return 0, fmt.Errorf("xl domid failed: %s\n",
-			string(stdoutStderr))
-	}
-	res := strings.TrimSpace(string(stdoutStderr))
-	domainID, err := strconv.Atoi(res)
-	if err != nil {
-		log.Errorf("Can't extract domainID from %s: %s\n", res, err)
-		return 0, fmt.Errorf("Can't extract domainID from %s: %s\n", res, err)
-	}
-	return domainID, nil
-}
-
-func xlStatus(domainName string, domainID int) error {
-	log.Infof("xlStatus %s %d\n", domainName, domainID)
-	// XXX xl list -l domainName returns json. XXX but state not included!
-	// Note that state is not very useful anyhow
-	cmd := "xl"
-	args := []string{
-		"list",
-		"-l",
-		domainName,
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl list failed ", err)
-		log.Errorln("xl list output ", string(stdoutStderr))
-		return fmt.Errorf("xl list failed: %s\n",
-			string(stdoutStderr))
-	}
-	// XXX parse json to look at state? Not currently included
-	// XXX note that there is a warning at the top of the combined
-	// output. If we want to parse the json we need to get Output()
-	log.Infof("xl list done. Result %s\n", string(stdoutStderr))
-	return nil
-}
-
-// If we have a domain reboot issue the domainID
-// can change.
-func xlDomid(domainName string, domainID int) (int, error) {
-	log.Debugf("xlDomid %s %d\n", domainName, domainID)
-	cmd := "xl"
-	args := []string{
-		"domid",
-		domainName,
-	}
-	// Avoid wrap since we are called periodically
-	stdoutStderr, err := exec.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Debugln("xl domid failed ", err)
-		log.Debugln("xl domid output ", string(stdoutStderr))
-		return domainID, fmt.Errorf("xl domid failed: %s\n",
-			string(stdoutStderr))
-	}
-	res := strings.TrimSpace(string(stdoutStderr))
-	domainID2, err := strconv.Atoi(res)
-	if err != nil {
-		log.Errorf("xl domid not integer %s: failed %s\n", res, err)
-		return domainID, err
-	}
-	if domainID2 != domainID {
-		log.Warningf("domainid changed from %d to %d for %s\n",
-			domainID, domainID2, domainName)
-	}
-	return domainID2, err
-}
-
-// Perform xenstore write to disable all of these for all VIFs
-// feature-sg, feature-gso-tcpv4, feature-gso-tcpv6, feature-ipv6-csum-offload
-func xlDisableVifOffload(domainName string, domainID int, vifCount int) error {
-	log.Infof("xlDisableVifOffload %s %d %d\n",
-		domainName, domainID, vifCount)
-	pref := "/local/domain"
-	for i := 0; i < vifCount; i += 1 {
-		varNames := []string{
-			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-sg",
-				pref, domainID, i),
-			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-gso-tcpv4",
-				pref, domainID, i),
-			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-gso-tcpv6",
-				pref, domainID, i),
-			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-ipv4-csum-offload",
-				pref, domainID, i),
-			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-ipv6-csum-offload",
-				pref, domainID, i),
-			fmt.Sprintf("%s/%d/device/vif/%d/feature-sg",
-				pref, domainID, i),
-			fmt.Sprintf("%s/%d/device/vif/%d/feature-gso-tcpv4",
-				pref, domainID, i),
-			fmt.Sprintf("%s/%d/device/vif/%d/feature-gso-tcpv6",
-				pref, domainID, i),
-			fmt.Sprintf("%s/%d/device/vif/%d/feature-ipv4-csum-offload",
-				pref, domainID, i),
-			fmt.Sprintf("%s/%d/device/vif/%d/feature-ipv6-csum-offload",
-				pref, domainID, i),
-		}
-		for _, varName := range varNames {
-			cmd := "xenstore"
-			args := []string{
-				"write",
-				varName,
-				"0",
-			}
-			stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-			if err != nil {
-				log.Errorln("xenstore write failed ", err)
-				log.Errorln("xenstore write output ", string(stdoutStderr))
-				return fmt.Errorf("xenstore write failed: %s\n",
-					string(stdoutStderr))
-			}
-			log.Debugf("xenstore write done. Result %s\n",
-				string(stdoutStderr))
-		}
-	}
-
-	log.Infof("xlDisableVifOffload done.\n")
-	return nil
-}
-
-func xlUnpause(domainName string, domainID int) error {
-	log.Infof("xlUnpause %s %d\n", domainName, domainID)
-	cmd := "xl"
-	args := []string{
-		"unpause",
-		domainName,
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl unpause failed ", err)
-		log.Errorln("xl unpause output ", string(stdoutStderr))
-		return fmt.Errorf("xl unpause failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("xlUnpause done. Result %s\n", string(stdoutStderr))
-	return nil
-}
-
-// DomainShutdown is a wrapper for domain shutdown thru xlShutdown or rktStop
-func DomainShutdown(status types.DomainStatus, force bool) error {
-
-	var err error
-	log.Infof("DomainShutdown force-%v %s %d\n", force, status.DomainName, status.DomainId)
-
-	if status.IsContainer {
-		// Use rkt tool
-		log.Infof("Using rkt tool ... PodUUID - %s\n", status.PodUUID)
-		err = rktStop(status.PodUUID, force)
-		if err != nil {
-			log.Errorf("rktStop %s failed: %s\n", status.DomainName, err)
-		}
-		// Unconditionally invoke xl shutdown
-		// This is causing an error, since rkt stop is putting the container to exit state
-		// and killing the domain
-		//log.Infof("Invoke xl tool ... DomainName - %s\n", status.DomainName)
-		//err = xlShutdown(status.DomainName, status.DomainId, force)
-	} else {
-		// Use xl tool
-		log.Infof("Using xl tool ... DomainName - %s\n", status.DomainName)
-		err = xlShutdown(status.DomainName, status.DomainId, force)
-	}
-
-	return err
-}
-
-func rktStop(PodUUID string, force bool) error {
-	log.Infof("rktStop %s %t\n", PodUUID, force)
-	cmd := "rkt"
-	var args []string
-	if force {
-		// rkt --dir=<RKT_DATA_DIR> stop PodUUID --force=true
-		args = []string{
-			"--dir=" + persistRktDataDir,
-			"stop",
-			PodUUID,
-			"--force=true",
-		}
-	} else {
-		// rkt --dir=<RKT_DATA_DIR> stop PodUUID
-		args = []string{
-			"--dir=" + persistRktDataDir,
-			"stop",
-			PodUUID,
-		}
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("rkt stop failed ", err)
-		log.Errorln("rkt stop output ", string(stdoutStderr))
-		return fmt.Errorf("rkt stop failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("rkt stop done\n")
-	return nil
-}
-
-func xlShutdown(domainName string, domainID int, force bool) error {
-	log.Infof("xlShutdown %s %d\n", domainName, domainID)
-	cmd := "xl"
-	var args []string
-	if force {
-		args = []string{
-			"shutdown",
-			"-F",
-			domainName,
-		}
-	} else {
-		args = []string{
-			"shutdown",
-			domainName,
-		}
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl shutdown failed ", err)
-		log.Errorln("xl shutdown output ", string(stdoutStderr))
-		return fmt.Errorf("xl shutdown failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("xl shutdown done\n")
-	return nil
-}
-
-// DomainDestroy is a wrapper for domain Destroy thru xlDestroy or rktRm
-func DomainDestroy(status types.DomainStatus) error {
-
-	var err error
-	log.Infof("DomainDestroy %s %d\n", status.DomainName, status.DomainId)
-
-	if status.IsContainer {
-		// Use rkt tool
-		log.Infof("Using rkt tool ... PodUUID - %s\n", status.PodUUID)
-		err = rktRm(status.PodUUID)
-	} else {
-		// Use xl tool
-		log.Infof("Using xl tool ... DomainName - %s\n", status.DomainName)
-		err = xlDestroy(status.DomainName, status.DomainId)
-	}
-
-	return err
-}
-
-func rktRm(PodUUID string) error {
-	log.Infof("rktRm %s\n", PodUUID)
-	// rkt --dir=<RKT_DATA_DIR> rm PodUUID
-	cmd := "rkt"
-	args := []string{
-		"--dir=" + persistRktDataDir,
-		"rm",
-		PodUUID,
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("rkt Rm failed ", err)
-		log.Errorln("rkt Rm output ", string(stdoutStderr))
-		return fmt.Errorf("rkt Rm failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("rkt Rm done\n")
-	return nil
-}
-
-func xlDestroy(domainName string, domainID int) error {
-	log.Infof("xlDestroy %s %d\n", domainName, domainID)
-	cmd := "xl"
-	args := []string{
-		"destroy",
-		domainName,
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("xl destroy failed ", err)
-		log.Errorln("xl destroy output ", string(stdoutStderr))
-		return fmt.Errorf("xl destroy failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("xl destroy done\n")
-	return nil
-}
-
-func locationFromDir(locationDir string) (string, error) {
-	if _, err := os.Stat(locationDir); err != nil {
-		log.Errorf("Missing directory: %s, %s\n", locationDir, err)
-		return "", err
-	}
-	// locationDir is a directory. Need to find single file inside
-	// which the verifier ensures.
-	locations, err := ioutil.ReadDir(locationDir)
-	if err != nil {
-		log.Errorln(err)
-		return "", err
-	}
-	if len(locations) != 1 {
-		log.Errorf("Multiple files in %s\n", locationDir)
-		return "", fmt.Errorf("Multiple files in %s\n",
-			locationDir)
-	}
-	if len(locations) == 0 {
-		log.Errorf("No files in %s\n", locationDir)
-		return "", fmt.Errorf("No files in %s\n",
-			locationDir)
-	}
-	return locationDir + "/" + locations[0].Name(), nil
-}
-
-func pciAssignableAdd(long string) error {
-	log.Infof("pciAssignableAdd %s\n", long)
-	cmd := "xl"
-	args := []string{
-		"pci-assignable-add",
-		long,
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		errStr := fmt.Sprintf("xl pci-assignable-add failed: %s\n",
-			string(stdoutStderr))
-		log.Errorln(errStr)
-		return errors.New(errStr)
-	}
-	log.Infof("xl pci-assignable-add done\n")
-	return nil
-}
-
-func pciAssignableRemove(long string) error {
-	log.Infof("pciAssignableRemove %s\n", long)
-	cmd := "xl"
-	args := []string{
-		"pci-assignable-rem",
-		"-r",
-		long,
-	}
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		errStr := fmt.Sprintf("xl pci-assignable-rem failed: %s\n",
-			string(stdoutStderr))
-		log.Errorln(errStr)
-		return errors.New(errStr)
-	}
-	log.Infof("xl pci-assignable-rem done\n")
-	return nil
-}
-
-func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
+func handleDNSModify(ctxArg interface{}, key string,
+	status types.DeviceNetworkStatus) {
 
-	status := cast.CastDeviceNetworkStatus(statusArg)
 	ctx := ctxArg.(*domainContext)
 	if key != "global" {
 		log.Infof("handleDNSModify: ignoring %s\n", key)
@@ -2371,7 +1765,8 @@ func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
 	log.Infof("handleDNSModify done for %s\n", key)
 }
 
-func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
+func handleDNSDelete(ctxArg interface{}, key string,
+	status types.DeviceNetworkStatus) {
 
 	ctx := ctxArg.(*domainContext)
 	if key != "global" {
@@ -2386,7 +1781,7 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 }
 
 func handleGlobalConfigModify(ctxArg interface{}, key string,
-	statusArg interface{}) {
+	config types.GlobalConfig) {
 
 	ctx := ctxArg.(*domainContext)
 	if key != "global" {
@@ -2395,7 +1790,7 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 	}
 	log.Infof("handleGlobalConfigModify for %s\n", key)
 	var gcp *types.GlobalConfig
-	debug, gcp = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
+	debug, gcp = agentlog.HandleGlobalConfig(ctx.subGlobalConfig.Subscription, agentName,
 		debugOverride)
 	if gcp != nil {
 		if gcp.VdiskGCTime != 0 {
@@ -2413,7 +1808,7 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 }
 
 func handleGlobalConfigDelete(ctxArg interface{}, key string,
-	statusArg interface{}) {
+	config types.GlobalConfig) {
 
 	ctx := ctxArg.(*domainContext)
 	if key != "global" {
@@ -2421,7 +1816,7 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 		return
 	}
 	log.Infof("handleGlobalConfigDelete for %s\n", key)
-	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
+	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig.Subscription, agentName,
 		debugOverride)
 	log.Infof("handleGlobalConfigDelete done for %s\n", key)
 }
@@ -2616,9 +2011,9 @@ func checkAndSetIoBundle(ctx *domainContext, ib *types.IoBundle) error {
 			if ib.PciLong != "" {
 				log.Infof("Removing %s (%s) from pciback\n",
 					ib.Name, ib.PciLong)
-				err := pciAssignableRemove(ib.PciLong)
+				err := ctx.hyper.PCIRelease(ib.PciLong)
 				if err != nil {
-					log.Errorf("checkAndSetIoBundle(%d %s %s) pciAssignableRemove %s failed %v\n",
+					log.Errorf("checkAndSetIoBundle(%d %s %s) PCIRelease %s failed %v\n",
 						ib.Type, ib.Name, ib.AssignmentGroup, ib.PciLong, err)
 				}
 				// Seems like like no risk for race; when we return
@@ -2690,7 +2085,7 @@ func checkAndSetIoBundle(ctx *domainContext, ib *types.IoBundle) error {
 		} else if ib.PciLong != "" {
 			log.Infof("Assigning %s (%s) to pciback\n",
 				ib.Name, ib.PciLong)
-			err := pciAssignableAdd(ib.PciLong)
+			err := ctx.hyper.PCIReserve(ib.PciLong)
 			if err != nil {
 				return err
 			}
@@ -2790,7 +2185,7 @@ func maybeAssignableAdd(ctx *domainContext) {
 		}
 	}
 	for _, long := range assignments {
-		err := pciAssignableAdd(long)
+		err := ctx.hyper.PCIReserve(long)
 		if err != nil {
 			log.Errorf("updateUsbAccess add failed: %s", err)
 		}
@@ -2821,7 +2216,7 @@ func maybeAssignableRem(ctx *domainContext) {
 		}
 	}
 	for _, long := range assignments {
-		err := pciAssignableRemove(long)
+		err := ctx.hyper.PCIRelease(long)
 		if err != nil {
 			log.Errorf("updateUsbAccess remove failed: %s\n", err)
 		}
@@ -2839,9 +2234,9 @@ func handleIBDelete(ctx *domainContext, ib types.IoBundle,
 		log.Infof("handleIBDelete: Assigning %s (%s) back\n",
 			ib.Name, ib.PciLong)
 		if ib.PciLong != "" {
-			err := pciAssignableRemove(ib.PciLong)
+			err := ctx.hyper.PCIRelease(ib.PciLong)
 			if err != nil {
-				log.Errorf("handleIBDelete(%d %s %s) pciAssignableRemove %s failed %v\n",
+				log.Errorf("handleIBDelete(%d %s %s) PCIRelease %s failed %v\n",
 					ib.Type, ib.Name, ib.AssignmentGroup, ib.PciLong, err)
 			}
 			ib.IsPCIBack = false
@@ -2872,7 +2267,7 @@ func handleIBModify(ctx *domainContext, statusIb types.IoBundle, configIb types.
 			continue
 		}
 		// XXX can we have changes which require us to
-		// do pciAssignableRemove for the old status?
+		// do PCIRelease for the old status?
 		if err := checkAndSetIoBundle(ctx, &configIb); err != nil {
 			log.Warnf("Not reporting non-existent PCI device %d %s: %v\n",
 				configIb.Type, configIb.Name, err)
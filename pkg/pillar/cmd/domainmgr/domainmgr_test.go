@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyFilename(t *testing.T) {
+	testMatrix := map[string]struct {
+		filename     string
+		expectedSha  string
+		expectedUUID string
+	}{
+		"qcow2 copy": {
+			filename:     "abcd01-6ba7b810-9dad-11d1-80b4-00c04fd430c8.qcow2",
+			expectedSha:  "abcd01",
+			expectedUUID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		"raw copy": {
+			filename:     "abcd01-6ba7b810-9dad-11d1-80b4-00c04fd430c8.raw",
+			expectedSha:  "abcd01",
+			expectedUUID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		"Not a copy": {
+			filename: "lost+found",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expectedSha, imageSha256FromFilename(test.filename))
+		assert.Equal(t, test.expectedUUID, appUUIDFromFilename(test.filename))
+	}
+}
+
+func TestIsPreservedIn(t *testing.T) {
+	testMatrix := map[string]struct {
+		storage     []types.StorageConfig
+		imageSha256 string
+		expected    bool
+	}{
+		"Preserved": {
+			storage: []types.StorageConfig{
+				{ImageSha256: "ABCD01", Preserve: true},
+			},
+			imageSha256: "abcd01",
+			expected:    true,
+		},
+		"Not preserved": {
+			storage: []types.StorageConfig{
+				{ImageSha256: "abcd01"},
+			},
+			imageSha256: "abcd01",
+		},
+		"Read only": {
+			storage: []types.StorageConfig{
+				{ImageSha256: "abcd01", Preserve: true, ReadOnly: true},
+			},
+			imageSha256: "abcd01",
+		},
+		"Other image preserved": {
+			storage: []types.StorageConfig{
+				{ImageSha256: "abcd01"},
+				{ImageSha256: "abcd02", Preserve: true},
+			},
+			imageSha256: "abcd01",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.AppInstanceConfig{StorageConfigList: test.storage}
+		assert.Equal(t, test.expected, isPreservedIn(config, test.imageSha256))
+	}
+}
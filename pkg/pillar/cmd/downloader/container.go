@@ -0,0 +1,456 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Container images are pulled from the registry into an OCI image layout
+// in the pending directory using zedUpload, which verifies the digests.
+// Since the containers are run using rkt the image is then flattened into
+// an ACI and imported into the rkt store; no network access by rkt.
+
+package downloader
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/wrap"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload"
+	zedOCI "github.com/lf-edge/eve/pkg/pillar/zedUpload/ociutil"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	log "github.com/sirupsen/logrus"
+)
+
+// Pull the image into the OCI layout in locDirname.
+// The registry and dpath are empty if the name is a complete reference.
+func doOci(ctx *downloaderContext, status *types.DownloaderStatus,
+	syncOp zedUpload.SyncOpType, registry string, dpath string,
+	apiKey string, password string, maxsize uint64, ifname string,
+	ipSrc net.IP, imageName string, locDirname string) error {
+
+	auth := &zedUpload.AuthInput{
+		AuthType: "oci",
+		Uname:    apiKey,
+		Password: password,
+	}
+
+	trType := zedUpload.SyncOciTr
+
+	// create Endpoint
+	dEndPoint, err := ctx.dCtx.NewSyncerDest(trType, registry, dpath, auth)
+	if err != nil {
+		log.Errorf("NewSyncerDest failed: %s\n", err)
+		return err
+	}
+	proxyUrl, err := zedcloud.LookupProxy(
+		&ctx.deviceNetworkStatus, ifname, registry)
+	if err == nil && proxyUrl != nil {
+		log.Infof("doOci: Using proxy %s", proxyUrl.String())
+		dEndPoint.WithSrcIpAndProxySelection(ipSrc, proxyUrl)
+	} else {
+		dEndPoint.WithSrcIpSelection(ipSrc)
+	}
+	dEndPoint.WithBandwidthLimiter(portLimiter(ctx, ifname))
+	status.Throttled = portThrottled(ctx, ifname)
+	status.WaitingForWindow = false
+	var respChan = make(chan *zedUpload.DronaRequest)
+
+	log.Infof("doOci syncOp for <%s>, <%s>, <%s>\n", registry, dpath,
+		imageName)
+	// create Request
+	// Round up from bytes to Mbytes
+	maxMB := (maxsize + 1024*1024 - 1) / (1024 * 1024)
+	req := dEndPoint.NewRequest(syncOp, imageName, locDirname,
+		int64(maxMB), true, respChan)
+	if req == nil {
+		return errors.New("NewRequest failed")
+	}
+
+	req.Post()
+	for {
+		select {
+		case resp, ok := <-respChan:
+			if resp.IsDnUpdate() {
+				asize := resp.GetAsize()
+				osize := resp.GetOsize()
+				log.Infof("Update progress for %v: %v/%v",
+					resp.GetLocalName(), asize, osize)
+				if osize == 0 {
+					status.Progress = 0
+				} else {
+					percent := 100 * asize / osize
+					status.Progress = uint(percent)
+				}
+				publishDownloaderStatus(ctx, status)
+				continue
+			}
+			if !ok {
+				errStr := fmt.Sprintf("respChan EOF for <%s>, <%s>",
+					registry, imageName)
+				log.Errorln(errStr)
+				return errors.New(errStr)
+			}
+			err = resp.GetDnStatus()
+			if resp.IsError() {
+				return err
+			}
+			log.Infof("Done for %v: size %v/%v digest %s",
+				resp.GetLocalName(),
+				resp.GetAsize(), resp.GetOsize(),
+				resp.GetImageDigest())
+			status.ImageDigest = resp.GetImageDigest()
+			status.LayerDigests = resp.GetLayerDigests()
+			status.Progress = 100
+			publishDownloaderStatus(ctx, status)
+			return nil
+		}
+	}
+}
+
+// importContainerImage verifies the pulled image against the sha256 from
+// the controller and the layer digests of the pull, imports it into the
+// rkt store and completes the download
+func importContainerImage(ctx *downloaderContext,
+	config types.DownloaderConfig, status *types.DownloaderStatus,
+	locDirname string, key string) {
+
+	aciFilename := locDirname + ".aci"
+	defer os.Remove(aciFilename)
+	imageID := ""
+	_, err := zedOCI.VerifyLayout(locDirname, config.ImageSha256,
+		status.LayerDigests)
+	if err == nil {
+		err = ociToACI(locDirname, aciFilename, config.Name)
+	}
+	if err == nil {
+		imageID, err = rktImport(aciFilename)
+	}
+	if err != nil {
+		log.Errorf("importContainerImage failed for %s: %s\n",
+			config.Name, err)
+		handleSyncOpResponse(ctx, config, status, locDirname, key,
+			err.Error())
+		return
+	}
+	log.Infof("importContainerImage successful. imageID: <%s>\n", imageID)
+	status.ContainerImageID = imageID
+	handleSyncOpResponse(ctx, config, status, locDirname, key, "")
+}
+
+// Import an ACI file into the rkt store and return the image ID
+func rktImport(aciFilename string) (string, error) {
+	// rkt --insecure-options=image fetch <file> --dir=/persist/rkt
+	cmd := "rkt"
+	args := []string{
+		"--dir=" + persistRktDataDir,
+		"--insecure-options=image",
+		"fetch",
+		aciFilename,
+	}
+	log.Infof("rktImport - args: %+v\n", args)
+
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("rkt fetch failed ", err)
+		log.Errorln("rkt fetch output ", string(stdoutStderr))
+		return "", fmt.Errorf("rkt fetch failed: %s\n",
+			string(stdoutStderr))
+	}
+	outputStr := string(stdoutStderr)
+	log.Debugf("rktImport - outputStr: %s\n", outputStr)
+	outputStrArray := strings.Split(strings.TrimSpace(outputStr), "\n")
+
+	// Get ImageID from the output. The last line in rkt fetch output
+	// with sha512- is the imageID
+	imageID := ""
+	for i := len(outputStrArray) - 1; i >= 0; i-- {
+		if strings.HasPrefix(outputStrArray[i], "sha512-") {
+			imageID = outputStrArray[i]
+			break
+		}
+	}
+	log.Infof("rktImport - imageID: %s\n", imageID)
+	if imageID == "" {
+		errMsg := "rkt fetch: Can't find imageID.\n Fetch Output: " +
+			outputStr
+		return "", errors.New(errMsg)
+	}
+	return imageID, nil
+}
+
+// Subset of the OCI image config we need for the ACI manifest
+type ociImageConfig struct {
+	Config struct {
+		User       string
+		Env        []string
+		Entrypoint []string
+		Cmd        []string
+		WorkingDir string
+	} `json:"config"`
+}
+
+type aciNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type aciApp struct {
+	Exec             []string       `json:"exec,omitempty"`
+	User             string         `json:"user"`
+	Group            string         `json:"group"`
+	WorkingDirectory string         `json:"workingDirectory,omitempty"`
+	Environment      []aciNameValue `json:"environment,omitempty"`
+}
+
+type aciManifest struct {
+	ACKind    string         `json:"acKind"`
+	ACVersion string         `json:"acVersion"`
+	Name      string         `json:"name"`
+	Labels    []aciNameValue `json:"labels"`
+	App       aciApp         `json:"app"`
+}
+
+// aciName makes an AC identifier out of the image name
+func aciName(imageName string) string {
+	name := strings.ToLower(imageName)
+	if i := strings.Index(name, "://"); i != -1 {
+		name = name[i+3:]
+	}
+	mapper := func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9',
+			r == '-', r == '.', r == '/', r == '~', r == '_':
+			return r
+		default:
+			return '-'
+		}
+	}
+	return strings.Trim(strings.Map(mapper, name), "-./")
+}
+
+func aciArch() string {
+	if runtime.GOARCH == "arm64" {
+		return "aarch64"
+	}
+	return runtime.GOARCH
+}
+
+// ociToACI flattens the layers in the OCI layout into the rootfs of an ACI
+func ociToACI(layoutDir string, aciFilename string, imageName string) error {
+	manifest, _, err := zedOCI.ReadLayout(layoutDir)
+	if err != nil {
+		return err
+	}
+	var imageConfig ociImageConfig
+	configFile, err := zedOCI.BlobPath(layoutDir, manifest.Config.Digest)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(configFile)
+	if err != nil {
+		return err
+	}
+	err = json.NewDecoder(f).Decode(&imageConfig)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("image config decode failed: %s", err)
+	}
+	am := aciManifest{
+		ACKind:    "ImageManifest",
+		ACVersion: "0.8.11",
+		Name:      aciName(imageName),
+		Labels: []aciNameValue{
+			{Name: "os", Value: "linux"},
+			{Name: "arch", Value: aciArch()},
+		},
+		App: aciApp{
+			Exec: append(imageConfig.Config.Entrypoint,
+				imageConfig.Config.Cmd...),
+			User:             "0",
+			Group:            "0",
+			WorkingDirectory: imageConfig.Config.WorkingDir,
+		},
+	}
+	if user := imageConfig.Config.User; user != "" {
+		ug := strings.SplitN(user, ":", 2)
+		am.App.User = ug[0]
+		if len(ug) == 2 {
+			am.App.Group = ug[1]
+		}
+	}
+	for _, env := range imageConfig.Config.Env {
+		nv := strings.SplitN(env, "=", 2)
+		if len(nv) == 2 {
+			am.App.Environment = append(am.App.Environment,
+				aciNameValue{Name: nv[0], Value: nv[1]})
+		}
+	}
+	b, err := json.MarshalIndent(am, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(aciFilename)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	tw := tar.NewWriter(out)
+	if err := tw.WriteHeader(&tar.Header{Name: "manifest", Mode: 0644,
+		Size: int64(len(b)), Typeflag: tar.TypeReg}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(b); err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "rootfs/", Mode: 0755,
+		Typeflag: tar.TypeDir}); err != nil {
+		return err
+	}
+	var digests []string
+	for _, layer := range manifest.Layers {
+		digests = append(digests, layer.Digest)
+	}
+	if err := copyLayers(tw, layoutDir, digests); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return out.Sync()
+}
+
+// copyLayers applies the layers, lowest first, from the top so the upper
+// files and whiteouts take precedence
+func copyLayers(tw *tar.Writer, layoutDir string, digests []string) error {
+	seen := make(map[string]bool)
+	var hidden []string
+	for i := len(digests) - 1; i >= 0; i-- {
+		digest := digests[i]
+		added, opaqueRoot, err := copyLayer(tw, layoutDir, digest, seen,
+			hidden)
+		if err != nil {
+			return fmt.Errorf("layer %s: %s", digest, err)
+		}
+		if opaqueRoot {
+			log.Infof("layer %s replaces the lower layers\n", digest)
+			break
+		}
+		hidden = append(hidden, added...)
+	}
+	return nil
+}
+
+// isHidden checks if an upper layer removed the file. Entries ending in
+// a slash are opaque directories
+func isHidden(name string, hidden []string) bool {
+	for _, h := range hidden {
+		if strings.HasSuffix(h, "/") {
+			if strings.HasPrefix(name, h) {
+				return true
+			}
+		} else if name == h || strings.HasPrefix(name, h+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// copyLayer copies the entries not hidden by the upper layers and returns
+// the whiteouts in this layer. An opaque whiteout in the root directory
+// hides all of the lower layers, which is returned as opaqueRoot since
+// there is no path to add to the whiteouts for it.
+func copyLayer(tw *tar.Writer, layoutDir string, digest string,
+	seen map[string]bool, hidden []string) (added []string, opaqueRoot bool, err error) {
+
+	blobFile, err := zedOCI.BlobPath(layoutDir, digest)
+	if err != nil {
+		return nil, false, err
+	}
+	f, err := os.Open(blobFile)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	// Layers are normally gzip compressed
+	if magic, err := br.Peek(2); err == nil &&
+		magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, false, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		name := strings.TrimPrefix(path.Clean("/"+hdr.Name), "/")
+		if name == "" {
+			continue
+		}
+		base := path.Base(name)
+		dir := path.Dir(name)
+		if base == ".wh..wh..opq" {
+			if dir == "." {
+				opaqueRoot = true
+			} else {
+				added = append(added, dir+"/")
+			}
+			continue
+		}
+		if strings.HasPrefix(base, ".wh.") {
+			added = append(added, path.Join(dir, base[len(".wh."):]))
+			continue
+		}
+		if seen[name] || isHidden(name, hidden) {
+			continue
+		}
+		seen[name] = true
+		hdr.Name = "rootfs/" + name
+		if hdr.Typeflag == tar.TypeDir {
+			hdr.Name += "/"
+		}
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Linkname = "rootfs/" + strings.TrimPrefix(
+				path.Clean("/"+hdr.Linkname), "/")
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, false, err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return nil, false, err
+		}
+	}
+	return added, opaqueRoot, nil
+}
+
+// dirSize returns the total size of the files in the directory
+func dirSize(dirname string) int64 {
+	var size int64
+	filepath.Walk(dirname, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestLayer stores a tar of the names in the layout and returns its
+// digest. Names ending in a slash are directories.
+func writeTestLayer(t *testing.T, layoutDir string, names []string) string {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range names {
+		hdr := &tar.Header{Name: name, Mode: 0644, Typeflag: tar.TypeReg}
+		if name[len(name)-1] == '/' {
+			hdr.Mode = 0755
+			hdr.Typeflag = tar.TypeDir
+		}
+		assert.NoError(t, tw.WriteHeader(hdr))
+	}
+	assert.NoError(t, tw.Close())
+	sum := fmt.Sprintf("%x", sha256.Sum256(buf.Bytes()))
+	dir := filepath.Join(layoutDir, "blobs", "sha256")
+	assert.NoError(t, os.MkdirAll(dir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, sum), buf.Bytes(),
+		0644))
+	return "sha256:" + sum
+}
+
+func readTestTar(t *testing.T, b []byte) []string {
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(b))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err) {
+			break
+		}
+		names = append(names, hdr.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestCopyLayers(t *testing.T) {
+	testMatrix := map[string]struct {
+		layers   [][]string // Lowest first
+		expected []string
+	}{
+		"One layer": {
+			layers:   [][]string{{"etc/", "etc/hosts"}},
+			expected: []string{"rootfs/etc/", "rootfs/etc/hosts"},
+		},
+		"Upper file wins": {
+			layers:   [][]string{{"a"}, {"./a"}},
+			expected: []string{"rootfs/a"},
+		},
+		"Whiteout": {
+			layers: [][]string{{"etc/", "etc/hosts", "etc/passwd"},
+				{"etc/.wh.hosts"}},
+			expected: []string{"rootfs/etc/", "rootfs/etc/passwd"},
+		},
+		"Opaque directory": {
+			layers: [][]string{{"etc/", "etc/hosts", "var/", "var/log"},
+				{"etc/.wh..wh..opq", "etc/passwd"}},
+			expected: []string{"rootfs/etc/", "rootfs/etc/passwd",
+				"rootfs/var/", "rootfs/var/log"},
+		},
+		"Opaque root": {
+			layers: [][]string{{"etc/", "etc/hosts"},
+				{"./.wh..wh..opq", "bin/", "bin/sh"}},
+			expected: []string{"rootfs/bin/", "rootfs/bin/sh"},
+		},
+		"Opaque root in middle layer": {
+			layers: [][]string{{"etc/", "etc/hosts"},
+				{".wh..wh..opq", "bin/"},
+				{"bin/sh"}},
+			expected: []string{"rootfs/bin/", "rootfs/bin/sh"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		layoutDir, err := ioutil.TempDir("", "layout")
+		if !assert.NoError(t, err) {
+			continue
+		}
+		var digests []string
+		for _, layer := range test.layers {
+			digests = append(digests, writeTestLayer(t, layoutDir, layer))
+		}
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		assert.NoError(t, copyLayers(tw, layoutDir, digests))
+		assert.NoError(t, tw.Close())
+		assert.Equal(t, test.expected, readTestTar(t, buf.Bytes()))
+		os.RemoveAll(layoutDir)
+	}
+}
+
+func TestIsHidden(t *testing.T) {
+	testMatrix := map[string]struct {
+		name     string
+		hidden   []string
+		expected bool
+	}{
+		"Nothing hidden":        {name: "etc/hosts"},
+		"Whiteout":              {name: "etc/hosts", hidden: []string{"etc/hosts"}, expected: true},
+		"Under whiteout":        {name: "etc/ssh/config", hidden: []string{"etc/ssh"}, expected: true},
+		"Same prefix":           {name: "etc/sshd", hidden: []string{"etc/ssh"}},
+		"Opaque directory":      {name: "etc/hosts", hidden: []string{"etc/"}, expected: true},
+		"Outside opaque":        {name: "etcx/hosts", hidden: []string{"etc/"}},
+		"Opaque dir not itself": {name: "etc", hidden: []string{"etc/"}},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, isHidden(test.name, test.hidden))
+	}
+}
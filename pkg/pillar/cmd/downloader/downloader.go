@@ -8,7 +8,6 @@
 package downloader
 
 import (
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -22,13 +21,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/blobstore"
 	"github.com/lf-edge/eve/pkg/pillar/cast"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
-	"github.com/lf-edge/eve/pkg/pillar/wrap"
 	"github.com/lf-edge/eve/pkg/pillar/zedUpload"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
 	"github.com/satori/go.uuid"
@@ -36,15 +35,13 @@ import (
 )
 
 const (
-	appImgObj                    = "appImg.obj"
-	baseOsObj                    = "baseOs.obj"
-	certObj                      = "cert.obj"
-	agentName                    = "downloader"
-	persistDir                   = "/persist"
-	objectDownloadDirname        = persistDir + "/downloads"
-	persistRktDataDir            = persistDir + "/rkt"
-	persistRktLocalConfigDir     = persistDir + "/rktlocal"
-	persistRktLocalConfigAuthDir = persistRktLocalConfigDir + "/auth.d"
+	appImgObj             = "appImg.obj"
+	baseOsObj             = "baseOs.obj"
+	certObj               = "cert.obj"
+	agentName             = "downloader"
+	persistDir            = "/persist"
+	objectDownloadDirname = persistDir + "/downloads"
+	persistRktDataDir     = persistDir + "/rkt"
 )
 
 // Go doesn't like this as a constant
@@ -73,13 +70,24 @@ type downloaderContext struct {
 	globalStatusLock        sync.Mutex
 	globalStatus            types.GlobalDownloadStatus
 	subGlobalConfig         *pubsub.Subscription
+	throttleLock            sync.Mutex // Protects portThrottles
+	portThrottles           map[string]*portThrottle
+	store                   *blobstore.Store // Verified objects
 }
 
 var debug = false
 var debugOverride bool                                   // From command line arg
-var downloadGCTime = time.Duration(600) * time.Second    // Unless from GlobalConfig
 var downloadRetryTime = time.Duration(600) * time.Second // Unless from GlobalConfig
 
+var downloadChunks = 1              // Unless from GlobalConfig
+var downloadMaxBandwidth = int64(0) // Bytes per second; unless from GlobalConfig
+
+// Partial downloads which haven't progressed for this long are not resumed
+const partialKeepTime = 24 * time.Hour
+
+// How often we look for objects whose blob was garbage collected
+const gcInterval = time.Minute
+
 func Run() {
 	handlersInit()
 
@@ -129,6 +137,12 @@ func Run() {
 
 	// Any state needed by handler functions
 	ctx := downloaderContext{}
+	ctx.store, err = blobstore.New(blobstore.DefaultDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Our references are added back when the config is processed
+	ctx.store.ReleaseHolders(agentName + ".")
 
 	// Look for global config such as log levels
 	subGlobalConfig, err := pubsub.Subscribe("", types.GlobalConfig{},
@@ -267,10 +281,14 @@ func Run() {
 		types.CountLocalAddrAnyNoLinkLocal(ctx.deviceNetworkStatus))
 
 	ctx.dCtx = downloaderInit(&ctx)
+	updateThrottles(&ctx)
+
+	// We expire zero RefCount objects once the verifier has garbage
+	// collected their blob
+	gc := time.NewTicker(gcInterval)
 
-	// We will cleanup zero RefCount objects after a while
-	// We run timer 10 times more often than the limit on LastUse
-	gc := time.NewTicker(downloadGCTime / 10)
+	// Enter and leave the download windows
+	throttleTimer := time.NewTicker(throttleInterval)
 
 	for {
 		select {
@@ -304,6 +322,9 @@ func Run() {
 		case <-gc.C:
 			gcObjects(&ctx)
 
+		case <-throttleTimer.C:
+			updateThrottles(&ctx)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -557,7 +578,11 @@ func maybeRetryDownload(ctx *downloaderContext,
 	}
 	t := time.Now()
 	elapsed := t.Sub(status.LastErrTime)
-	if elapsed < downloadRetryTime {
+	// Don't wait for the retry time once a download window opens
+	if status.WaitingForWindow && anyPortInWindow(ctx) {
+		log.Infof("maybeRetryDownload(%s) download window open\n",
+			status.Key())
+	} else if elapsed < downloadRetryTime {
 		log.Infof("maybeRetryDownload(%s) %v remaining\n",
 			status.Key(),
 			(downloadRetryTime-elapsed)/time.Second)
@@ -711,6 +736,9 @@ func handleModify(ctx *downloaderContext, key string,
 		status.PendingModify = false
 		publishDownloaderStatus(ctx, status)
 	} else if status.RefCount != config.RefCount {
+		if config.RefCount == 0 {
+			releaseBlobRef(ctx, status)
+		}
 		status.RefCount = config.RefCount
 		status.LastUse = time.Now()
 		status.Expired = false
@@ -748,13 +776,35 @@ func deletefile(dirname string, status *types.DownloaderStatus) {
 		filename := dirname + "/" + status.Safename
 		if _, err := os.Stat(filename); err == nil {
 			log.Infof("Deleting %s\n", filename)
-			// Remove file
-			if err := os.Remove(filename); err != nil {
+			// Remove file or OCI image layout directory
+			if err := os.RemoveAll(filename); err != nil {
 				log.Errorf("Failed to remove %s: err %s\n",
 					filename, err)
 			}
 		}
+		metaFilename := resumeMetaFilename(filename)
+		if _, err := os.Stat(metaFilename); err == nil {
+			if err := os.Remove(metaFilename); err != nil {
+				log.Errorf("Failed to remove %s: err %s\n",
+					metaFilename, err)
+			}
+		}
+	}
+}
+
+// The progress of a partial download is kept next to it so that the
+// download can be resumed
+func resumeMetaFilename(locFilename string) string {
+	return locFilename + ".meta"
+}
+
+// Returns true if we have a partial download which can be resumed
+func canResume(locFilename string) bool {
+	if _, err := os.Stat(resumeMetaFilename(locFilename)); err != nil {
+		return false
 	}
+	info, err := os.Stat(locFilename)
+	return err == nil && info.Mode().IsRegular()
 }
 
 func handleDelete(ctx *downloaderContext, key string,
@@ -778,6 +828,7 @@ func handleDelete(ctx *downloaderContext, key string,
 
 	publishDownloaderStatus(ctx, status)
 
+	releaseBlobRef(ctx, status)
 	doDelete(ctx, key, locDirname, status)
 
 	status.PendingDelete = false
@@ -793,7 +844,7 @@ func handleDelete(ctx *downloaderContext, key string,
 
 func downloaderInit(ctx *downloaderContext) *zedUpload.DronaCtx {
 
-	initializeDirs()
+	partialSize := initializeDirs()
 
 	log.Infof("MaxSpace %d\n", ctx.globalConfig.MaxSpace)
 
@@ -803,7 +854,9 @@ func downloaderInit(ctx *downloaderContext) *zedUpload.DronaCtx {
 	// XXX look at verifier and downloader status which have Size
 	// We read objectDownloadDirname/* and determine how much space
 	// is used. Place in GlobalDownloadStatus. Calculate remaining space.
-	totalUsed := diskmetrics.SizeFromDir(objectDownloadDirname)
+	// Partial downloads are covered by the space we reserve when
+	// they are resumed
+	totalUsed := diskmetrics.SizeFromDir(objectDownloadDirname) - partialSize
 	kb := types.RoundupToKB(totalUsed)
 	initSpace(ctx, kb)
 
@@ -814,6 +867,7 @@ func downloaderInit(ctx *downloaderContext) *zedUpload.DronaCtx {
 		log.Errorf("context create fail %s\n", err)
 		log.Fatal(err)
 	}
+	dCtx.SetMaxBandwidth(downloadMaxBandwidth)
 
 	return dCtx
 }
@@ -832,15 +886,17 @@ func handleGlobalDownloadConfigModify(ctxArg interface{}, key string,
 	log.Infof("handleGlobalDownloadConfigModify done for %s\n", key)
 }
 
-func initializeDirs() {
+// Returns the size of the partial downloads we kept
+func initializeDirs() uint64 {
 
 	// Remove any files which didn't make it to the verifier.
 	// XXX space calculation doesn't take into account files in verifier
 	// XXX get space report from verifier??
-	clearInProgressDownloadDirs(downloaderObjTypes)
+	partialSize := clearInProgressDownloadDirs(downloaderObjTypes)
 
 	// create the object download directories
 	createDownloadDirs(downloaderObjTypes)
+	return partialSize
 }
 
 // Create the object download directories we own
@@ -862,28 +918,71 @@ func createDownloadDirs(objTypes []string) {
 	}
 }
 
-// clear in-progress object download directories
-func clearInProgressDownloadDirs(objTypes []string) {
+// clear in-progress object download directories except for partial
+// downloads which can be resumed. Returns the size of those.
+func clearInProgressDownloadDirs(objTypes []string) uint64 {
 
 	inProgressDirTypes := []string{"pending"}
+	var partialSize uint64
 
 	// now create the download dirs
 	for _, objType := range objTypes {
 		for _, dirType := range inProgressDirTypes {
 			dirName := objectDownloadDirname + "/" + objType + "/" + dirType
 			if _, err := os.Stat(dirName); err == nil {
-				if err := os.RemoveAll(dirName); err != nil {
-					log.Fatal(err)
-				}
+				partialSize += clearInProgressDir(dirName, true)
+			}
+		}
+	}
+	return partialSize
+}
+
+// The pending directory has the files and the <sha>/ directories with
+// the files. The OCI image layout directories are always removed.
+func clearInProgressDir(dirName string, top bool) uint64 {
+	var partialSize uint64
+
+	locations, err := ioutil.ReadDir(dirName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, location := range locations {
+		filename := dirName + "/" + location.Name()
+		if location.IsDir() && top {
+			partialSize += clearInProgressDir(filename, false)
+			continue
+		}
+		if strings.HasSuffix(filename, ".meta") &&
+			canResume(strings.TrimSuffix(filename, ".meta")) {
+			continue
+		}
+		if canResume(filename) {
+			info, err := os.Stat(resumeMetaFilename(filename))
+			if err == nil && time.Since(info.ModTime()) < partialKeepTime {
+				log.Infof("Keeping %s to resume\n", filename)
+				partialSize += uint64(location.Size())
+				continue
 			}
+			os.Remove(resumeMetaFilename(filename))
+		}
+		log.Infof("Removing %s\n", filename)
+		if err := os.RemoveAll(filename); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if !top && partialSize == 0 {
+		if err := os.RemoveAll(dirName); err != nil {
+			log.Fatal(err)
 		}
 	}
+	return partialSize
 }
 
-// If an object has a zero RefCount and dropped to zero more than
-// downloadGCTime ago, then we delete the Status. That will result in the
-// user (zedmanager or baseosmgr) deleting the Config, unless a RefCount
-// increase is underway.
+// If an object has a zero RefCount and its content is not in the blob
+// store, then we expire the Status. That will result in the user
+// (zedmanager or baseosmgr) deleting the Config, unless a RefCount
+// increase is underway. Objects in the blob store are kept until the
+// verifier garbage collects the blob once it has no references.
 // XXX Note that this runs concurrently with the handler.
 func gcObjects(ctx *downloaderContext) {
 	log.Debugf("gcObjects()\n")
@@ -906,15 +1005,13 @@ func gcObjects(ctx *downloaderContext) {
 					status.RefCount, key)
 				continue
 			}
-			timePassed := time.Since(status.LastUse)
-			if timePassed < downloadGCTime {
-				log.Debugf("gcObjects: skipping recently used %s remains %d seconds\n",
-					key,
-					(timePassed-downloadGCTime)/time.Second)
+			if !status.IsContainer && ctx.store.Has(status.ImageSha256) {
+				log.Debugf("gcObjects: skipping %s in blob store\n",
+					key)
 				continue
 			}
-			log.Infof("gcObjects: expiring status for %s; LastUse %v now %v\n",
-				key, status.LastUse, time.Now())
+			log.Infof("gcObjects: expiring status for %s; LastUse %v\n",
+				key, status.LastUse)
 			status.Expired = true
 			publishDownloaderStatus(ctx, &status)
 		}
@@ -1075,6 +1172,9 @@ func doHttp(ctx *downloaderContext, status *types.DownloaderStatus,
 	} else {
 		dEndPoint.WithSrcIpSelection(ipSrc)
 	}
+	dEndPoint.WithBandwidthLimiter(portLimiter(ctx, ifname))
+	status.Throttled = portThrottled(ctx, ifname)
+	status.WaitingForWindow = false
 	var respChan = make(chan *zedUpload.DronaRequest)
 
 	log.Infof("doHttp syncOp for <%s>, <%s>, <%s>\n", serverUrl, dpath,
@@ -1087,6 +1187,8 @@ func doHttp(ctx *downloaderContext, status *types.DownloaderStatus,
 		return errors.New("NewRequest failed")
 	}
 
+	req.WithResume(resumeMetaFilename(locFilename))
+	req.WithChunks(downloadChunks)
 	req.Post()
 	for {
 		select {
@@ -1102,6 +1204,7 @@ func doHttp(ctx *downloaderContext, status *types.DownloaderStatus,
 					percent := 100 * asize / osize
 					status.Progress = uint(percent)
 				}
+				status.ResumedSize = uint64(resp.GetResumedSize())
 				publishDownloaderStatus(ctx, status)
 				continue
 			}
@@ -1123,6 +1226,7 @@ func doHttp(ctx *downloaderContext, status *types.DownloaderStatus,
 					resp.GetLocalName(),
 					resp.GetAsize(), resp.GetOsize())
 				status.Progress = 100
+				status.ResumedSize = uint64(resp.GetResumedSize())
 				publishDownloaderStatus(ctx, status)
 				return nil
 			}
@@ -1158,6 +1262,9 @@ func doS3(ctx *downloaderContext, status *types.DownloaderStatus,
 	} else {
 		dEndPoint.WithSrcIpSelection(ipSrc)
 	}
+	dEndPoint.WithBandwidthLimiter(portLimiter(ctx, ifname))
+	status.Throttled = portThrottled(ctx, ifname)
+	status.WaitingForWindow = false
 
 	var respChan = make(chan *zedUpload.DronaRequest)
 
@@ -1171,6 +1278,8 @@ func doS3(ctx *downloaderContext, status *types.DownloaderStatus,
 		return errors.New("NewRequest failed")
 	}
 
+	req.WithResume(resumeMetaFilename(locFilename))
+	req.WithChunks(downloadChunks)
 	req.Post()
 	for {
 		select {
@@ -1186,6 +1295,7 @@ func doS3(ctx *downloaderContext, status *types.DownloaderStatus,
 					percent := 100 * asize / osize
 					status.Progress = uint(percent)
 				}
+				status.ResumedSize = uint64(resp.GetResumedSize())
 				publishDownloaderStatus(ctx, status)
 				continue
 			}
@@ -1207,6 +1317,7 @@ func doS3(ctx *downloaderContext, status *types.DownloaderStatus,
 					resp.GetLocalName(),
 					resp.GetAsize(), resp.GetOsize())
 				status.Progress = 100
+				status.ResumedSize = uint64(resp.GetResumedSize())
 				publishDownloaderStatus(ctx, status)
 				return nil
 			}
@@ -1216,7 +1327,7 @@ func doS3(ctx *downloaderContext, status *types.DownloaderStatus,
 
 func doSftp(ctx *downloaderContext, status *types.DownloaderStatus,
 	syncOp zedUpload.SyncOpType, apiKey string, password string,
-	serverUrl string, dpath string, maxsize uint64,
+	serverUrl string, dpath string, maxsize uint64, ifname string,
 	ipSrc net.IP, filename string, locFilename string) error {
 
 	auth := &zedUpload.AuthInput{
@@ -1234,6 +1345,9 @@ func doSftp(ctx *downloaderContext, status *types.DownloaderStatus,
 		return err
 	}
 	dEndPoint.WithSrcIpSelection(ipSrc)
+	dEndPoint.WithBandwidthLimiter(portLimiter(ctx, ifname))
+	status.Throttled = portThrottled(ctx, ifname)
+	status.WaitingForWindow = false
 	var respChan = make(chan *zedUpload.DronaRequest)
 
 	log.Infof("doSftp syncOp for <%s>, <%s>, <%s>\n", serverUrl, dpath,
@@ -1312,164 +1426,6 @@ func constructDatastoreContext(config types.DownloaderConfig, status *types.Down
 	return &dsCtx
 }
 
-func rktFetch(url string, localConfigDir string) (string, error) {
-	// rkt --insecure-options=image fetch <url> --dir=/persist/rkt --full=true
-	log.Debugf("rktFetch - url: %s ,  localConfigDir:%s\n",
-		url, localConfigDir)
-	cmd := "rkt"
-	args := []string{
-		"--dir=" + persistRktDataDir,
-		"--insecure-options=image",
-		"fetch",
-	}
-	// if len(localConfigDir) > 0 {
-	// 	args = append(args, "--system-config="+persistRktLocalConfigDir)
-	// }
-	args = append(args, url)
-	args = append(args, "--full=true")
-
-	log.Infof("rktFetch - url: %s ,  localConfigDir:%s, args: %+v\n",
-		url, localConfigDir, args)
-
-	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
-	if err != nil {
-		log.Errorln("rkt fetch failed ", err)
-		log.Errorln("rkt fetch output ", string(stdoutStderr))
-		return "", fmt.Errorf("rkt fetch failed: %s\n",
-			string(stdoutStderr))
-	}
-	log.Infof("rktFetch - image fetch successful. stdoutStderr: %s\n",
-		stdoutStderr)
-	outputStr := string(stdoutStderr)
-	log.Debugf("rktFetch - outputStr: %s\n", outputStr)
-	outputStrArray := strings.Split(outputStr, "\n")
-
-	log.Debugf("rktFetch - outputStrArray:\n")
-	for i, op := range outputStrArray {
-		log.Debugf("index:%d, op:%s", i, op)
-	}
-	log.Debugf("rktFetch - outputStrArray DONE\n")
-
-	// Get ImageID from the oputput. The last line in rkt fetch output
-	// with sha12- is the imageID
-	imageID := ""
-	for i := len(outputStrArray) - 1; i >= 0; i-- {
-		imageID = outputStrArray[i]
-		if strings.HasPrefix(imageID, "sha512-") {
-			break
-		}
-	}
-	log.Infof("rktFetch - imageID: %s\n", imageID)
-	if imageID == "" {
-		errMsg := "rkt fetch: Can't find imageID.\n Fetch Output: " +
-			outputStr
-		return "", errors.New(errMsg)
-	}
-
-	// XXX:FIXME - we should run "rkt image ls" and verify image fetch
-	// went thru without errors.
-	return imageID, nil
-}
-
-func rktAuthFilename(appName string) string {
-	return persistRktLocalConfigAuthDir + "/rktAuth" + appName + ".json"
-}
-
-func rktCreateAuthFile(config *types.DownloaderConfig,
-	dsCtx types.DatastoreContext) (string, error) {
-
-	if len(strings.TrimSpace(dsCtx.APIKey)) == 0 {
-		log.Debugf("rktCreateAuthFile: empty APIKey. Skipping AuthFile")
-		return "", nil
-	}
-
-	err := os.MkdirAll(persistRktLocalConfigAuthDir, 0755)
-	if err != nil {
-		log.Errorf("rktCreateAuthFile: empty username. Skipping AuthFile")
-		return "", fmt.Errorf("Failed create dir %s, "+
-			"err: %+v\n", persistRktLocalConfigAuthDir, err)
-	}
-
-	filename := rktAuthFilename(config.Safename)
-
-	rktAuth := types.RktAuthInfo{
-		RktKind:    "dockerAuth",
-		RktVersion: "v1",
-		Registries: []string{dsCtx.DownloadURL},
-		Credentials: types.RktCredentials{
-			User:     dsCtx.APIKey,
-			Password: dsCtx.Password,
-		},
-	}
-	log.Infof("rktCreateAuthFile: created Auth file %s\n"+
-		"rktAuth: %+v\n", filename, rktAuth)
-
-	file, err := json.MarshalIndent(rktAuth, "", " ")
-	if err != nil {
-		return "", fmt.Errorf("Failed convert rktAuth to json"+
-			"err: %+v\n", err)
-	}
-	err = ioutil.WriteFile(filename, file, 0644)
-	if err != nil {
-		return "", fmt.Errorf("Failed to create Auth file for"+
-			"rkt fetch: %+v\n", err)
-	}
-	return filename, nil
-}
-
-func rktFetchContainerImage(ctx *downloaderContext, key string,
-	config types.DownloaderConfig, status *types.DownloaderStatus,
-	dsCtx types.DatastoreContext) error {
-	// update status to DOWNLOAD STARTED
-	status.State = types.DOWNLOAD_STARTED
-	publishDownloaderStatus(ctx, status)
-
-	imageID := ""
-	// Save credentials to Auth file
-	log.Infof("rktFetchContainerImage: fetch  <%s>\n", dsCtx.DownloadURL)
-	authFile, err := rktCreateAuthFile(&config, dsCtx)
-	if err == nil {
-		log.Debugf("rktFetchContainerImage: authFile: %s\n", authFile)
-		// We should really move to have per-fetch directory..
-		localConfigDir := persistRktLocalConfigDir
-		if len(authFile) == 0 {
-			localConfigDir = ""
-			log.Infof("rktFetchContainerImage: no Auth File")
-		}
-		imageID, err = rktFetch(dsCtx.DownloadURL, localConfigDir)
-	} else {
-		log.Errorf("rktCreateAuthFile Failed. err: %+v", err)
-	}
-
-	if err != nil {
-		log.Errorf("rktFetchContainerImage: fetch  Failed. url:%s, "+
-			"authFile: %s, Err: %+v\n", dsCtx.DownloadURL, authFile, err)
-		status.PendingAdd = false
-		status.Size = 0
-		status.LastErr = fmt.Sprintf("%v", err)
-		status.LastErrTime = time.Now()
-		status.RetryCount++
-		publishDownloaderStatus(ctx, status)
-		return err
-	}
-	log.Infof("rktFetchContainerImage successful. imageID: <%s>\n",
-		imageID)
-
-	// Update globalStatus and status
-	unreserveSpace(ctx, status)
-
-	// We do not clear any status.RetryCount, LastErr, etc. The caller
-	// should look at State == DOWNLOADED to determine it is done.
-	status.ContainerImageID = imageID
-	status.ModTime = time.Now()
-	status.PendingAdd = false
-	status.State = types.DOWNLOADED
-	status.Progress = 100 // Just in case
-	publishDownloaderStatus(ctx, status)
-
-	return nil
-}
-
 // Drona APIs for object Download
 func handleSyncOp(ctx *downloaderContext, key string,
 	config types.DownloaderConfig, status *types.DownloaderStatus,
@@ -1487,13 +1443,14 @@ func handleSyncOp(ctx *downloaderContext, key string,
 	log.Debugf("handleSyncOp: config: %+v", config)
 	log.Debugf("handleSyncOp: IsContainer: %v", config.IsContainer)
 
+	if !config.IsContainer && ctx.store.Has(config.ImageSha256) {
+		useStoredBlob(ctx, config, status)
+		return
+	}
+
 	// get the datastore context
 	dsCtx := constructDatastoreContext(config, status, dst)
 
-	if config.IsContainer {
-		rktFetchContainerImage(ctx, key, config, status, *dsCtx)
-		return
-	}
 	locDirname := objectDownloadDirname + "/" + status.ObjType
 	locFilename = locDirname + "/pending"
 
@@ -1539,6 +1496,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 	}
 
 	// Loop through all interfaces until a success
+	outsideWindow := 0
 	for addrIndex := 0; addrIndex < addrCount; addrIndex += 1 {
 		var ipSrc net.IP
 		if config.UseFreeMgmtPorts {
@@ -1555,6 +1513,12 @@ func handleSyncOp(ctx *downloaderContext, key string,
 			continue
 		}
 		ifname := types.GetMgmtPortFromAddr(ctx.deviceNetworkStatus, ipSrc)
+		if !portInWindow(ctx, ifname) {
+			log.Infof("Skipping IP source %v if %s outside its download windows\n",
+				ipSrc, ifname)
+			outsideWindow++
+			continue
+		}
 		log.Infof("Using IP source %v if %s transport %v\n",
 			ipSrc, ifname, dsCtx.TransportMethod)
 		switch dsCtx.TransportMethod {
@@ -1589,7 +1553,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 			serverUrl := getServerUrl(dsCtx, filename)
 			err = doSftp(ctx, status, syncOp, dsCtx.APIKey,
 				dsCtx.Password, serverUrl, dsCtx.Dpath,
-				config.Size, ipSrc, filename, locFilename)
+				config.Size, ifname, ipSrc, filename, locFilename)
 			if err != nil {
 				log.Errorf("Source IP %s failed: %s\n",
 					ipSrc.String(), err)
@@ -1638,10 +1602,42 @@ func handleSyncOp(ctx *downloaderContext, key string,
 					locFilename, key, "")
 				return
 			}
+		case zconfig.DsType_DsContainerRegistry.String():
+			registry := dst.Fqdn
+			dpath := dsCtx.Dpath
+			if config.NameIsURL {
+				registry = ""
+				dpath = ""
+			}
+			err = doOci(ctx, status, syncOp, registry, dpath,
+				dsCtx.APIKey, dsCtx.Password, config.Size, ifname,
+				ipSrc, config.Name, locFilename)
+			if err != nil {
+				log.Errorf("Source IP %s failed: %s\n",
+					ipSrc.String(), err)
+				errStr = errStr + "\n" + err.Error()
+				zedcloud.ZedCloudFailure(ifname,
+					metricsUrl, 1024, 0)
+			} else {
+				zedcloud.ZedCloudSuccess(ifname,
+					metricsUrl, 1024, dirSize(locFilename))
+				importContainerImage(ctx, config, status,
+					locFilename, key)
+				return
+			}
 		default:
 			log.Fatal("unsupported transport method")
 		}
 	}
+	if addrCount != 0 && outsideWindow == addrCount {
+		// Retried when a window opens
+		errStr = "All management ports are outside their download windows"
+		log.Infof("handleSyncOp(%s): %s\n", config.Name, errStr)
+		status.WaitingForWindow = true
+		handleSyncOpResponse(ctx, config, status, locFilename,
+			key, errStr)
+		return
+	}
 	log.Errorf("All source IP addresses failed. All errors:%s\n", errStr)
 	handleSyncOpResponse(ctx, config, status, locFilename,
 		key, errStr)
@@ -1659,6 +1655,59 @@ func getServerUrl(dsCtx *types.DatastoreContext, filename string) string {
 	}
 }
 
+// The holder of our reference to a blob in the store
+func blobHolder(status *types.DownloaderStatus) string {
+	return agentName + "." + status.ObjType + "." + status.Safename
+}
+
+// useStoredBlob skips the download when the verifier already has the
+// content in the blob store. We hold a reference until the verifier has
+// one, that is, until our RefCount drops to zero.
+func useStoredBlob(ctx *downloaderContext, config types.DownloaderConfig,
+	status *types.DownloaderStatus) {
+
+	log.Infof("useStoredBlob(%s) have %s\n", config.Name,
+		config.ImageSha256)
+	err := ctx.store.AddRef(config.ImageSha256, blobHolder(status))
+	if err == nil {
+		var size int64
+		size, err = ctx.store.Size(config.ImageSha256)
+		status.Size = uint64(size)
+	}
+	if err != nil {
+		// Garbage collected in the mean time
+		errStr := fmt.Sprintf("Blob %s gone: %s", config.ImageSha256,
+			err)
+		log.Errorln(errStr)
+		unreserveSpace(ctx, status)
+		status.Size = 0
+		status.PendingAdd = false
+		status.LastErr = errStr
+		status.LastErrTime = time.Now()
+		status.RetryCount++
+		publishDownloaderStatus(ctx, status)
+		return
+	}
+	// Update globalStatus and status
+	unreserveSpace(ctx, status)
+
+	status.ModTime = time.Now()
+	status.PendingAdd = false
+	status.State = types.DOWNLOADED
+	status.Progress = 100
+	publishDownloaderStatus(ctx, status)
+}
+
+func releaseBlobRef(ctx *downloaderContext, status *types.DownloaderStatus) {
+	if status.IsContainer || status.ImageSha256 == "" {
+		return
+	}
+	if err := ctx.store.ReleaseRef(status.ImageSha256, blobHolder(status)); err != nil {
+		log.Errorf("releaseBlobRef(%s) failed: %s\n",
+			status.Safename, err)
+	}
+}
+
 func handleSyncOpResponse(ctx *downloaderContext, config types.DownloaderConfig,
 	status *types.DownloaderStatus, locFilename string,
 	key string, errStr string) {
@@ -1669,8 +1718,15 @@ func handleSyncOpResponse(ctx *downloaderContext, config types.DownloaderConfig,
 	}
 	locDirname := objectDownloadDirname + "/" + status.ObjType
 	if errStr != "" {
-		// Delete file
-		doDelete(ctx, key, locDirname, status)
+		if canResume(locFilename) {
+			// Keep the partial file for the retry
+			log.Infof("handleSyncOpResponse keeping %s to resume\n",
+				locFilename)
+			status.State = types.INITIAL
+		} else {
+			// Delete file
+			doDelete(ctx, key, locDirname, status)
+		}
 		status.PendingAdd = false
 		status.Size = 0
 		status.LastErr = errStr
@@ -1696,7 +1752,12 @@ func handleSyncOpResponse(ctx *downloaderContext, config types.DownloaderConfig,
 		publishDownloaderStatus(ctx, status)
 		return
 	}
-	status.Size = uint64(info.Size())
+	if config.IsContainer {
+		// OCI image layout directory
+		status.Size = uint64(dirSize(locFilename))
+	} else {
+		status.Size = uint64(info.Size())
+	}
 
 	// Update globalStatus and status
 	unreserveSpace(ctx, status)
@@ -1730,6 +1791,7 @@ func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
 	log.Infof("handleDNSModify %d free management ports addresses; %d any\n",
 		types.CountLocalAddrFreeNoLinkLocal(ctx.deviceNetworkStatus),
 		types.CountLocalAddrAnyNoLinkLocal(ctx.deviceNetworkStatus))
+	updateThrottles(ctx)
 
 	log.Infof("handleDNSModify done for %s\n", key)
 }
@@ -1743,6 +1805,7 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 		return
 	}
 	ctx.deviceNetworkStatus = types.DeviceNetworkStatus{}
+	updateThrottles(ctx)
 	log.Infof("handleDNSDelete done for %s\n", key)
 }
 
@@ -1759,12 +1822,19 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 	debug, gcp = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
 		debugOverride)
 	if gcp != nil {
-		if gcp.DownloadGCTime != 0 {
-			downloadGCTime = time.Duration(gcp.DownloadGCTime) * time.Second
-		}
 		if gcp.DownloadRetryTime != 0 {
 			downloadRetryTime = time.Duration(gcp.DownloadRetryTime) * time.Second
 		}
+		if gcp.DownloadChunks != 0 {
+			downloadChunks = int(gcp.DownloadChunks)
+		}
+		downloadMaxBandwidth = int64(gcp.DownloadMaxBandwidth) * 1024
+		// Before downloaderInit we set it there
+		if ctx.dCtx != nil {
+			ctx.dCtx.SetMaxBandwidth(downloadMaxBandwidth)
+		}
+		downloadPortSettings = gcp.DownloadPortSettings
+		updateThrottles(ctx)
 	}
 	log.Infof("handleGlobalConfigModify done for %s\n", key)
 }
@@ -0,0 +1,155 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Per port download throttling. Each management port has a limiter which
+// is the parent of the limiters of the endpoints downloading over the
+// port, and the limiter of the DronaCtx is its parent in turn. The rate
+// and the time of day windows come from the download.port.* settings in
+// GlobalConfig. Outside its windows the limiter of a port is paused and
+// new downloads use other ports or wait for a window.
+
+package downloader
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
+	log "github.com/sirupsen/logrus"
+)
+
+// How often we check if we entered or left a window
+const throttleInterval = time.Minute
+
+var downloadPortSettings map[string]types.PerPortDownloadSettings // From GlobalConfig
+
+type portThrottle struct {
+	limiter  *rateutil.Limiter
+	settings types.PerPortDownloadSettings
+	inWindow bool
+}
+
+// lookupPortSettings returns the settings for the port name, the ifname
+// or the kind of port in that order
+func lookupPortSettings(port types.NetworkPortStatus) types.PerPortDownloadSettings {
+	keys := []string{port.Name, port.IfName, "metered"}
+	if port.Free {
+		keys[2] = "free"
+	}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if settings, ok := downloadPortSettings[key]; ok {
+			return settings
+		}
+	}
+	return types.PerPortDownloadSettings{}
+}
+
+// updateThrottles applies the settings and the current time to the
+// limiters of the management ports and publishes them in the
+// GlobalDownloadStatus
+func updateThrottles(ctx *downloaderContext) {
+	if ctx.dCtx == nil {
+		// Called again from downloaderInit
+		return
+	}
+	now := time.Now()
+	var ports []types.DownloadPortStatus
+
+	ctx.throttleLock.Lock()
+	if ctx.portThrottles == nil {
+		ctx.portThrottles = make(map[string]*portThrottle)
+	}
+	for _, port := range ctx.deviceNetworkStatus.Ports {
+		if ctx.deviceNetworkStatus.Version >= types.DPCIsMgmt &&
+			!port.IsMgmt {
+			continue
+		}
+		settings := lookupPortSettings(port)
+		// Checked by zedagent hence we only log
+		windows, err := types.ParseDownloadWindows(settings.Windows)
+		if err != nil {
+			log.Errorf("updateThrottles(%s): %s", port.IfName, err)
+		}
+		pt, ok := ctx.portThrottles[port.IfName]
+		if !ok {
+			pt = &portThrottle{limiter: ctx.dCtx.NewLimiter(0)}
+			ctx.portThrottles[port.IfName] = pt
+		}
+		inWindow := types.InDownloadWindows(windows, now)
+		if pt.settings != settings || pt.inWindow != inWindow || !ok {
+			log.Infof("updateThrottles(%s) max bandwidth %d kbytes/sec windows %q in window %v",
+				port.IfName, settings.MaxBandwidth, settings.Windows,
+				inWindow)
+		}
+		pt.settings = settings
+		pt.inWindow = inWindow
+		pt.limiter.SetRate(int64(settings.MaxBandwidth) * 1024)
+		pt.limiter.SetPaused(!inWindow)
+		ports = append(ports, types.DownloadPortStatus{
+			IfName:       port.IfName,
+			MaxBandwidth: settings.MaxBandwidth,
+			Windows:      settings.Windows,
+			InWindow:     inWindow,
+		})
+	}
+	ctx.throttleLock.Unlock()
+
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i].IfName < ports[j].IfName
+	})
+	ctx.globalStatusLock.Lock()
+	ctx.globalStatus.MaxBandwidth = uint32(downloadMaxBandwidth / 1024)
+	ctx.globalStatus.Ports = ports
+	ctx.globalStatusLock.Unlock()
+	publishGlobalStatus(ctx)
+}
+
+// portLimiter returns the limiter for downloads over the port
+func portLimiter(ctx *downloaderContext, ifname string) *rateutil.Limiter {
+	ctx.throttleLock.Lock()
+	defer ctx.throttleLock.Unlock()
+	if pt, ok := ctx.portThrottles[ifname]; ok {
+		return pt.limiter
+	}
+	return nil
+}
+
+// portThrottled returns true if downloads over the port have a
+// bandwidth limit
+func portThrottled(ctx *downloaderContext, ifname string) bool {
+	if downloadMaxBandwidth != 0 {
+		return true
+	}
+	ctx.throttleLock.Lock()
+	defer ctx.throttleLock.Unlock()
+	if pt, ok := ctx.portThrottles[ifname]; ok {
+		return pt.settings.MaxBandwidth != 0
+	}
+	return false
+}
+
+// portInWindow returns true if we can start a download over the port now
+func portInWindow(ctx *downloaderContext, ifname string) bool {
+	ctx.throttleLock.Lock()
+	defer ctx.throttleLock.Unlock()
+	if pt, ok := ctx.portThrottles[ifname]; ok {
+		return pt.inWindow
+	}
+	return true
+}
+
+// anyPortInWindow returns true if some port can be used for downloads now
+func anyPortInWindow(ctx *downloaderContext) bool {
+	ctx.throttleLock.Lock()
+	defer ctx.throttleLock.Unlock()
+	for _, pt := range ctx.portThrottles {
+		if pt.inWindow {
+			return true
+		}
+	}
+	return len(ctx.portThrottles) == 0
+}
@@ -0,0 +1,439 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Per app instance capture of the guest console or the container output.
+// domainmgr reports the file in DomainStatus.ConsoleFile. Each app has
+// its own rate limit and its own stream of LogBundles which is sent to
+// the app instance logs API. The offset up to which the lines were sent is
+// saved every so often and when the capture stops so that we don't resend
+// lines when logmanager restarts.
+
+package logmanager
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lf-edge/eve/api/go/logs"
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	appLogsApi               = "api/v1/edgedevice/apps/instanceid"
+	appLogPollInterval       = 5 * time.Second
+	appLogOffsetSaveInterval = time.Minute
+	appLogBurstSeconds       = 10 // Burst allowed by the rate limit
+	appLogAppNameTag         = "appname"
+)
+
+var (
+	appLogsUrl      string // Prefix; set in sendCtxInit
+	appLogOffsetDir = "/persist/applogoffset"
+
+	// Unless from GlobalConfig. Use atomic since read by the run
+	// goroutines.
+	appLogRateLimit = types.GlobalConfigDefaults.AppLogRateLimit
+
+	// Indexed by app instance UUID. Only used from the main goroutine.
+	appLoggers = make(map[string]*appLogger)
+)
+
+type appLogger struct {
+	appUUID     string
+	displayName string
+	filename    string
+	source      string // "console" or "container"
+	logChan     chan logEntry
+	done        chan struct{}
+	exited      chan struct{}   // Closed once the offset is saved
+	previous    <-chan struct{} // exited of the logger we replace
+	forget      bool            // App is gone hence drop the saved offset
+
+	// Only used by the run goroutine
+	fileDesc  *os.File
+	reader    *bufio.Reader
+	offset    int64
+	partial   string // Line without a newline yet
+	limiter   rateLimiter
+	lastSaved time.Time
+
+	// Where each line queued to processEvents ends, in order, and the
+	// offset up to which the lines were sent
+	offsetLock  sync.Mutex
+	queued      []int64
+	sentOffset  int64
+	savedOffset int64
+}
+
+// Token bucket with rate from GlobalConfig
+type rateLimiter struct {
+	tokens  float64
+	last    time.Time
+	dropped uint64 // Since we last allowed a line
+}
+
+// allow returns true if a line can be sent. Also returns the number of
+// lines dropped before this one.
+func (rl *rateLimiter) allow(now time.Time, rate uint32) (bool, uint64) {
+	burst := float64(rate) * appLogBurstSeconds
+	if rl.last.IsZero() {
+		rl.tokens = burst
+	} else {
+		rl.tokens += now.Sub(rl.last).Seconds() * float64(rate)
+		if rl.tokens > burst {
+			rl.tokens = burst
+		}
+	}
+	rl.last = now
+	if rl.tokens < 1 {
+		rl.dropped++
+		return false, 0
+	}
+	rl.tokens--
+	dropped := rl.dropped
+	rl.dropped = 0
+	return true, dropped
+}
+
+// updateAppLogger starts, restarts or stops the capture based on the
+// DomainStatus
+func updateAppLogger(status types.DomainStatus) {
+	appUUID := status.UUIDandVersion.UUID.String()
+	l, ok := appLoggers[appUUID]
+	if ok && l.filename == status.ConsoleFile {
+		return
+	}
+	var previous <-chan struct{}
+	if ok {
+		previous = l.exited
+		stopAppLogger(appUUID, false)
+	}
+	if status.ConsoleFile == "" {
+		return
+	}
+	source := "console"
+	if status.IsContainer {
+		source = "container"
+	}
+	l = &appLogger{
+		appUUID:     appUUID,
+		displayName: status.DisplayName,
+		filename:    status.ConsoleFile,
+		source:      source,
+		logChan:     make(chan logEntry),
+		done:        make(chan struct{}),
+		exited:      make(chan struct{}),
+		previous:    previous,
+	}
+	log.Infof("updateAppLogger: add %s for %s %s\n", l.filename,
+		l.displayName, appUUID)
+	appLoggers[appUUID] = l
+	// All lines are new since we track the offset hence no lastSent
+	go func() {
+		processEvents(appUUID, time.Time{}, l.logChan, l.send)
+		if l.forget {
+			deleteAppLogOffset(l.appUUID)
+		} else {
+			l.saveOffset()
+		}
+		close(l.exited)
+	}()
+	go l.run()
+}
+
+// stopAppLogger sends what is left in the file and stops the goroutines.
+// If forget is set we start from the beginning of the file should the
+// app reappear.
+func stopAppLogger(appUUID string, forget bool) {
+	l, ok := appLoggers[appUUID]
+	if !ok {
+		return
+	}
+	log.Infof("stopAppLogger: %s for %s %s\n", l.filename,
+		l.displayName, appUUID)
+	l.forget = forget
+	close(l.done)
+	delete(appLoggers, appUUID)
+}
+
+func (l *appLogger) run() {
+	// The logger we replace saves its offset when it is done
+	if l.previous != nil {
+		select {
+		case <-l.previous:
+		case <-l.done:
+		}
+	}
+	ticker := time.NewTicker(appLogPollInterval)
+	defer ticker.Stop()
+	l.lastSaved = time.Now()
+	for {
+		l.readLines()
+		select {
+		case <-l.done:
+			l.readLines()
+			if l.fileDesc != nil {
+				l.fileDesc.Close()
+			}
+			// Makes processEvents send what it has and exit
+			// after which we save the offset
+			close(l.logChan)
+			return
+		case <-ticker.C:
+		}
+		if time.Since(l.lastSaved) >= appLogOffsetSaveInterval {
+			l.saveOffset()
+			l.lastSaved = time.Now()
+		}
+	}
+}
+
+// open the file and go to the saved offset unless the file has shrunk
+func (l *appLogger) open() bool {
+	fileDesc, err := os.Open(l.filename)
+	if err != nil {
+		log.Debugf("appLogger open: %s\n", err)
+		return false
+	}
+	offset := readAppLogOffset(l.appUUID)
+	fi, err := fileDesc.Stat()
+	if err != nil || offset > fi.Size() {
+		offset = 0
+	}
+	if _, err := fileDesc.Seek(offset, os.SEEK_SET); err != nil {
+		log.Errorf("appLogger seek %s: %s\n", l.filename, err)
+		offset = 0
+	}
+	l.fileDesc = fileDesc
+	l.reader = bufio.NewReader(fileDesc)
+	l.offset = offset
+	l.partial = ""
+	l.offsetLock.Lock()
+	l.sentOffset = offset
+	l.savedOffset = offset
+	l.offsetLock.Unlock()
+	return true
+}
+
+func (l *appLogger) readLines() {
+	if l.fileDesc == nil && !l.open() {
+		return
+	}
+	// A new domain or pod truncates the file
+	if fi, err := l.fileDesc.Stat(); err == nil && l.offset > fi.Size() {
+		log.Infof("File %s shrunk from %d to %d\n",
+			l.filename, l.offset, fi.Size())
+		if _, err := l.fileDesc.Seek(0, os.SEEK_SET); err != nil {
+			log.Errorf("appLogger seek %s: %s\n", l.filename, err)
+			return
+		}
+		l.reader.Reset(l.fileDesc)
+		l.offset = 0
+		l.partial = ""
+	}
+	for {
+		line, err := l.reader.ReadString('\n')
+		l.offset += int64(len(line))
+		if err != nil {
+			if err != io.EOF {
+				log.Errorf("appLogger read %s: %s\n", l.filename, err)
+			}
+			// Wait for the rest of the line
+			l.partial += line
+			break
+		}
+		line = l.partial + strings.TrimRight(line, "\r\n")
+		l.partial = ""
+		l.sendLine(line)
+	}
+}
+
+// sendLine queues the line, which ends at l.offset, to processEvents
+// unless it is over the rate limit. Lines which are dropped are sent as
+// far as the offset is concerned.
+func (l *appLogger) sendLine(line string) {
+	if !utf8.ValidString(line) {
+		log.Errorf("Invalid UTF-8 from %s - dropping line: %v",
+			l.appUUID, line)
+		l.queue(nil)
+		return
+	}
+	now := time.Now()
+	ok, dropped := l.limiter.allow(now, atomic.LoadUint32(&appLogRateLimit))
+	if !ok {
+		l.queue(nil)
+		return
+	}
+	if dropped != 0 {
+		entry := l.entry(now, "warning",
+			fmt.Sprintf("logmanager dropped %d lines over the rate limit",
+				dropped))
+		l.queue(&entry)
+	}
+	entry := l.entry(now, "info", line)
+	l.queue(&entry)
+}
+
+// queue records where the entry ends before passing it to processEvents.
+// A nil entry moves the offset once all the queued entries are sent.
+func (l *appLogger) queue(entry *logEntry) {
+	l.offsetLock.Lock()
+	if entry == nil && len(l.queued) == 0 {
+		l.sentOffset = l.offset
+		l.offsetLock.Unlock()
+		return
+	}
+	if entry == nil {
+		l.queued[len(l.queued)-1] = l.offset
+		l.offsetLock.Unlock()
+		return
+	}
+	l.queued = append(l.queued, l.offset)
+	l.offsetLock.Unlock()
+	l.logChan <- *entry
+}
+
+// send is the logSender for processEvents
+func (l *appLogger) send(reportLogs *logs.LogBundle, appUUID string,
+	iteration int) bool {
+
+	count := len(reportLogs.Log)
+	sent := sendAppLogs(reportLogs, appUUID, iteration)
+	l.sent(count, sent)
+	return sent
+}
+
+// sent moves the offset past the count oldest queued entries if they
+// were sent. Those which were not sent are dropped hence later entries
+// being sent also moves the offset past them.
+func (l *appLogger) sent(count int, sent bool) {
+	l.offsetLock.Lock()
+	defer l.offsetLock.Unlock()
+	if count > len(l.queued) {
+		count = len(l.queued)
+	}
+	if count == 0 {
+		return
+	}
+	if sent {
+		l.sentOffset = l.queued[count-1]
+	}
+	l.queued = l.queued[count:]
+}
+
+// saveOffset writes the offset up to which the lines were sent if it
+// changed since we last wrote it
+func (l *appLogger) saveOffset() {
+	l.offsetLock.Lock()
+	offset := l.sentOffset
+	changed := offset != l.savedOffset
+	l.savedOffset = offset
+	l.offsetLock.Unlock()
+	if changed {
+		writeAppLogOffset(l.appUUID, offset)
+	}
+}
+
+func (l *appLogger) entry(timestamp time.Time, severity string,
+	content string) logEntry {
+
+	return logEntry{
+		source:    l.source,
+		iid:       l.appUUID,
+		content:   content,
+		severity:  severity,
+		timestamp: timestamp,
+		tags: map[string]string{
+			agentlog.AppUUIDField: l.appUUID,
+			appLogAppNameTag:      l.displayName,
+		},
+	}
+}
+
+func appLogOffsetFilename(appUUID string) string {
+	return appLogOffsetDir + "/" + appUUID
+}
+
+func readAppLogOffset(appUUID string) int64 {
+	b, err := ioutil.ReadFile(appLogOffsetFilename(appUUID))
+	if err != nil {
+		return 0
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		log.Errorf("readAppLogOffset %s: %s\n", appUUID, err)
+		return 0
+	}
+	return offset
+}
+
+func writeAppLogOffset(appUUID string, offset int64) {
+	if err := os.MkdirAll(appLogOffsetDir, 0700); err != nil {
+		log.Errorf("writeAppLogOffset: %s\n", err)
+		return
+	}
+	err := ioutil.WriteFile(appLogOffsetFilename(appUUID),
+		[]byte(strconv.FormatInt(offset, 10)), 0600)
+	if err != nil {
+		log.Errorf("writeAppLogOffset: %s\n", err)
+	}
+}
+
+func deleteAppLogOffset(appUUID string) {
+	filename := appLogOffsetFilename(appUUID)
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		log.Errorf("deleteAppLogOffset: %s\n", err)
+	}
+}
+
+// sendAppLogs sends a bundle for the app instance. Unlike the device logs
+// these are not spooled when we can't send them; the rate limit is
+// there to bound what we send and not what we keep.
+// Returns true if a message was successfully sent
+func sendAppLogs(reportLogs *logs.LogBundle, appUUID string,
+	iteration int) bool {
+
+	defer func() { reportLogs.Log = []*logs.LogEntry{} }()
+
+	reportLogs.Timestamp = ptypes.TimestampNow()
+	reportLogs.DevID = devUUID.String()
+	reportLogs.Image = appUUID
+	data, err := proto.Marshal(reportLogs)
+	if err != nil {
+		log.Fatal("sendAppLogs proto marshaling error: ", err)
+	}
+	size := int64(len(data))
+	url := appLogsUrl + "/" + appUUID + "/logs"
+
+	// For any 400 error we abandon
+	const return400 = true
+	resp, _, _, err := zedcloud.SendOnAllIntf(zedcloudCtx, url,
+		size, bytes.NewBuffer(data), iteration, return400)
+	if resp != nil && resp.StatusCode == 400 {
+		log.Errorf("sendAppLogs: failed sending %d bytes to %s; code 400; ignored error\n",
+			size, url)
+		return true
+	}
+	if err != nil {
+		log.Errorf("sendAppLogs: dropping %d bytes for %s: %s\n",
+			size, appUUID, err)
+		return false
+	}
+	log.Debugf("sendAppLogs: sent %d bytes to %s\n", size, url)
+	return true
+}
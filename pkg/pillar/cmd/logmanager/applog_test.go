@@ -0,0 +1,168 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logmanager
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	start := time.Now()
+	// The steps build on each other
+	testMatrix := []struct {
+		name          string
+		after         time.Duration // Since start
+		lines         int
+		expectAllowed int
+		expectDropped uint64 // Reported with the first allowed line
+	}{
+		{
+			name:          "Burst",
+			after:         0,
+			lines:         25,
+			expectAllowed: 20,
+		},
+		{
+			name:          "Still over the limit",
+			after:         100 * time.Millisecond,
+			lines:         3,
+			expectAllowed: 0,
+		},
+		{
+			name:          "Refill after a second",
+			after:         1100 * time.Millisecond,
+			lines:         3,
+			expectAllowed: 2,
+			expectDropped: 8,
+		},
+		{
+			name:          "Refill is capped at the burst",
+			after:         time.Hour,
+			lines:         30,
+			expectAllowed: 20,
+			expectDropped: 1,
+		},
+	}
+
+	var rl rateLimiter
+	for _, test := range testMatrix {
+		t.Logf("Running test case %s", test.name)
+		allowed := 0
+		var reported uint64
+		for i := 0; i < test.lines; i++ {
+			ok, dropped := rl.allow(start.Add(test.after), 2)
+			if ok {
+				if allowed == 0 {
+					reported = dropped
+				} else {
+					assert.Equal(t, uint64(0), dropped)
+				}
+				allowed++
+			}
+		}
+		assert.Equal(t, test.expectAllowed, allowed)
+		assert.Equal(t, test.expectDropped, reported)
+	}
+}
+
+func newTestAppLogger(filename string) *appLogger {
+	return &appLogger{
+		appUUID:  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		filename: filename,
+		source:   "console",
+		logChan:  make(chan logEntry, 100),
+	}
+}
+
+func receivedLines(l *appLogger) []string {
+	var lines []string
+	for {
+		select {
+		case entry := <-l.logChan:
+			lines = append(lines, entry.content)
+		default:
+			return lines
+		}
+	}
+}
+
+func appendFile(t *testing.T, filename string, content string) {
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0600)
+	if err != nil {
+		t.Fatalf("OpenFile failed: %s", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatalf("WriteString failed: %s", err)
+	}
+}
+
+func TestAppLogOffsetResume(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "applog")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dirname)
+	savedDir := appLogOffsetDir
+	appLogOffsetDir = filepath.Join(dirname, "offset")
+	defer func() { appLogOffsetDir = savedDir }()
+	filename := filepath.Join(dirname, "console.log")
+	appendFile(t, filename, "one\ntwo\nthr")
+
+	t.Logf("Running test case Partial line and failed send")
+	l := newTestAppLogger(filename)
+	l.readLines()
+	assert.Equal(t, []string{"one", "two"}, receivedLines(l))
+	// Nothing is saved until the lines are sent
+	l.saveOffset()
+	assert.Equal(t, int64(0), readAppLogOffset(l.appUUID))
+	l.sent(1, true)
+	l.sent(1, false)
+	l.saveOffset()
+	assert.Equal(t, int64(4), readAppLogOffset(l.appUUID))
+
+	t.Logf("Running test case Resume after a restart")
+	l = newTestAppLogger(filename)
+	l.readLines()
+	assert.Equal(t, []string{"two"}, receivedLines(l))
+	appendFile(t, filename, "ee\n")
+	l.readLines()
+	assert.Equal(t, []string{"three"}, receivedLines(l))
+	l.sent(2, true)
+	l.saveOffset()
+	assert.Equal(t, int64(len("one\ntwo\nthree\n")),
+		readAppLogOffset(l.appUUID))
+
+	t.Logf("Running test case Lines over the rate limit")
+	savedRate := atomic.LoadUint32(&appLogRateLimit)
+	atomic.StoreUint32(&appLogRateLimit, 1)
+	defer atomic.StoreUint32(&appLogRateLimit, savedRate)
+	l.limiter = rateLimiter{tokens: 0, last: time.Now()}
+	appendFile(t, filename, "four\n")
+	l.readLines()
+	assert.Nil(t, receivedLines(l))
+	l.saveOffset()
+	assert.Equal(t, int64(len("one\ntwo\nthree\nfour\n")),
+		readAppLogOffset(l.appUUID))
+
+	t.Logf("Running test case File shrunk")
+	assert.Nil(t, ioutil.WriteFile(filename, []byte("new\n"), 0600))
+	l = newTestAppLogger(filename)
+	l.readLines()
+	assert.Equal(t, []string{"new"}, receivedLines(l))
+	l.sent(1, true)
+	l.saveOffset()
+	assert.Equal(t, int64(4), readAppLogOffset(l.appUUID))
+
+	deleteAppLogOffset(l.appUUID)
+	assert.Equal(t, int64(0), readAppLogOffset(l.appUUID))
+}
@@ -8,17 +8,6 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// Return the UUID of the instance based on the domainname
-func lookupDomainName(domainName string) string {
-	if du, ok := domainUuid[domainName]; ok {
-		return du
-	}
-	return ""
-}
-
-// Map from domainName to the UUID
-var domainUuid map[string]string = make(map[string]string)
-
 func handleDomainStatusModify(ctxArg interface{}, key string,
 	statusArg interface{}) {
 
@@ -29,10 +18,8 @@ func handleDomainStatusModify(ctxArg interface{}, key string,
 			key, status.Key(), status)
 		return
 	}
-	// Record the domainName even if Pending* is set
-	log.Infof("handleDomainStatusModify add %s to %s\n",
-		status.DomainName, status.UUIDandVersion.UUID.String())
-	domainUuid[status.DomainName] = status.UUIDandVersion.UUID.String()
+	// Track the console file even if Pending* is set
+	updateAppLogger(status)
 	log.Infof("handleDomainStatusModify done for %s\n", key)
 }
 
@@ -46,13 +33,6 @@ func handleDomainStatusDelete(ctxArg interface{}, key string,
 			key, status.Key(), status)
 		return
 	}
-	if _, ok := domainUuid[status.DomainName]; !ok {
-		log.Errorf("handleDomainStatusDelete UUID %s not in map\n",
-			status.UUIDandVersion.UUID.String())
-		return
-	}
-	log.Infof("handleDomainStatusDomain remove %s\n",
-		status.DomainName)
-	delete(domainUuid, status.DomainName)
+	stopAppLogger(key, true)
 	log.Infof("handleDomainStatusDelete done for %s\n", key)
 }
@@ -27,9 +27,10 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
-	dbg "runtime/debug"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 )
@@ -56,7 +57,8 @@ var (
 	logsUrl             string
 	zedcloudCtx         zedcloud.ZedCloudContext
 
-	globalDeferInprogress bool
+	// Unless from GlobalConfig
+	spoolMaxBytes = int64(types.GlobalConfigDefaults.LogSpoolMaxSize) * 1024 * 1024
 )
 
 // global stuff
@@ -75,9 +77,10 @@ var Version = "No version specified"
 type logEntry struct {
 	severity  string
 	source    string // basename of filename?
-	iid       string // XXX e.g. PID - where do we get it from?
+	iid       string // PID from the JSON fields
 	content   string // One line
 	timestamp time.Time
+	tags      map[string]string // App UUID, object key and other fields
 }
 
 // List of log files we watch
@@ -111,7 +114,7 @@ type imageLoggerContext struct {
 type DNSContext struct {
 	usableAddressCount     int
 	subDeviceNetworkStatus *pubsub.Subscription
-	doDeferred             bool
+	kickSpool              chan struct{} // Set once we can send
 }
 
 type zedcloudLogs struct {
@@ -185,6 +188,13 @@ func Run() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	pubDeferred, err := pubsub.Publish(agentName, zedcloud.DeferredMetrics{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	spool = newLogSpool(spoolDirname, spoolMaxBytes)
+	sink = newSyslogSink()
 
 	logmanagerCtx := logmanagerContext{}
 	// Look for global config such as log levels
@@ -248,13 +258,15 @@ func Run() {
 	log.Infof("Have %d management ports with usable addresses\n",
 		DNSctx.usableAddressCount)
 
-	// Timer for deferred sends of info messages
-	deferredChan := zedcloud.InitDeferred()
-	DNSctx.doDeferred = true
-
 	//Get servername, set logUrl, get device id and initialize zedcloudCtx
 	sendCtxInit()
 
+	// Send what was spooled, including before a restart
+	kickSpool := make(chan struct{}, 1)
+	go runSpoolSender(kickSpool)
+	DNSctx.kickSpool = kickSpool
+	kickSpool <- struct{}{}
+
 	// Publish send metrics for zedagent every 10 seconds
 	interval := time.Duration(10 * time.Second)
 	max := float64(interval)
@@ -266,13 +278,14 @@ func Run() {
 	loggerChan := make(chan logEntry)
 	ctx := loggerContext{logChan: loggerChan, image: currentPartition}
 	xenCtx := imageLoggerContext{}
-	lastSent := readLast(lastSentDirname, currentPartition)
+	lastSent := readLastQueued(currentPartition)
 	lastSentStr, _ := lastSent.MarshalText()
 	log.Debugf("Current partition logs were last sent at %s\n",
 		string(lastSentStr))
 
 	// Start sender of log events
-	go processEvents(currentPartition, lastSent, loggerChan)
+	go processEvents(currentPartition, lastSent, loggerChan,
+		sendProtoStrForLogs)
 
 	// If we have a logdir from a failed update, then set that up
 	// as well.
@@ -290,12 +303,13 @@ func Run() {
 			otherLogDirname)
 		otherLoggerChan := make(chan logEntry)
 		otherPartition := zboot.GetOtherPartition()
-		lastSent := readLast(lastSentDirname, otherPartition)
+		lastSent := readLastQueued(otherPartition)
 		lastSentStr, _ := lastSent.MarshalText()
 		log.Debugf("Other partition logs were last sent at %s\n",
 			string(lastSentStr))
 
-		go processEvents(otherPartition, lastSent, otherLoggerChan)
+		go processEvents(otherPartition, lastSent, otherLoggerChan,
+			sendProtoStrForLogs)
 
 		go watch.WatchStatus(otherLogDirname, false, otherLogDirChanges)
 		otherCtx = loggerContext{logChan: otherLoggerChan,
@@ -313,7 +327,6 @@ func Run() {
 
 	// Run these dir -> event as goroutines since they will block
 	// when there is backpressure
-	// XXX state sharing with HandleDeferred?
 	go handleLogDir(logDirChanges, logDirName, &ctx)
 	go handleLogDir(otherLogDirChanges, otherLogDirname, &otherCtx)
 	go handleLogDir(lispLogDirChanges, lispLogDirName, &ctx)
@@ -336,12 +349,9 @@ func Run() {
 			if err != nil {
 				log.Errorln(err)
 			}
-		case change := <-deferredChan:
-			done := zedcloud.HandleDeferred(change, 1*time.Second)
-			dbg.FreeOSMemory()
-			globalDeferInprogress = !done
-			if globalDeferInprogress {
-				log.Warnf("logmanager: globalDeferInprogress")
+			err = pubDeferred.Publish("global", spool.metrics())
+			if err != nil {
+				log.Errorln(err)
 			}
 
 		case <-stillRunning.C:
@@ -398,12 +408,11 @@ func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
 	newAddrCount := types.CountLocalAddrAnyNoLinkLocal(*deviceNetworkStatus)
 	cameOnline := (ctx.usableAddressCount == 0) && (newAddrCount != 0)
 	ctx.usableAddressCount = newAddrCount
-	if cameOnline && ctx.doDeferred {
-		change := time.Now()
-		done := zedcloud.HandleDeferred(change, 1*time.Second)
-		globalDeferInprogress = !done
-		if globalDeferInprogress {
-			log.Warnf("handleDNSModify: globalDeferInprogress")
+	if cameOnline && ctx.kickSpool != nil {
+		select {
+		case ctx.kickSpool <- struct{}{}:
+		default:
+			// Already kicked
 		}
 	}
 	log.Infof("handleDNSModify done for %s; %d usable\n",
@@ -425,10 +434,13 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 	log.Infof("handleDNSDelete done for %s\n", key)
 }
 
+// Sends a bundle for the image or app instance
+type logSender func(reportLogs *logs.LogBundle, image string, iteration int) bool
+
 // This runs as a separate go routine sending out data
 // Compares and drops events which have already been sent to the cloud
 func processEvents(image string, prevLastSent time.Time,
-	logChan <-chan logEntry) {
+	logChan <-chan logEntry, send logSender) {
 
 	log.Infof("processEvents(%s, %s)\n", image, prevLastSent.String())
 
@@ -441,24 +453,7 @@ func processEvents(image string, prevLastSent time.Time,
 		time.Duration(max))
 	messageCount := 0
 	dropped := 0
-	deferInprogress := false
 	for {
-		// If we had a defer wait until it has been taken care of
-		// Note that globalDeferInprogress might not yet be set
-		// but if the condition persists it will be set in a bit
-		if deferInprogress {
-			log.Warnf("processEvents(%s) deferInprogress", image)
-			time.Sleep(2 * time.Minute)
-			if globalDeferInprogress {
-				log.Warnf("processEvents(%s) globalDeferInprogress",
-					image)
-				continue
-			}
-			log.Infof("processEvents(%s) deferInprogress done",
-				image)
-			deferInprogress = false
-		}
-
 		select {
 		case event, more := <-logChan:
 			sent := false
@@ -468,7 +463,7 @@ func processEvents(image string, prevLastSent time.Time,
 				if messageCount == 0 {
 					return
 				}
-				sent = sendProtoStrForLogs(reportLogs, image,
+				sent = send(reportLogs, image,
 					iteration)
 				if sent {
 					recordLast(lastSentDirname, image)
@@ -481,6 +476,8 @@ func processEvents(image string, prevLastSent time.Time,
 				dropped++
 				break
 			}
+			// Sent even if we can't reach the controller
+			sink.add(event)
 			HandleLogEvent(event, reportLogs, messageCount)
 			messageCount++
 			// Bytes before appending this one
@@ -494,7 +491,7 @@ func processEvents(image string, prevLastSent time.Time,
 
 			log.Debugf("processEvents(%s): sending at messageCount %d, byteCount %d\n",
 				image, messageCount, byteCount)
-			sent = sendProtoStrForLogs(reportLogs, image,
+			sent = send(reportLogs, image,
 				iteration)
 			messageCount = 0
 			iteration += 1
@@ -502,7 +499,6 @@ func processEvents(image string, prevLastSent time.Time,
 				recordLast(lastSentDirname, image)
 			} else {
 				recordLast(lastDeferDirname, image)
-				deferInprogress = true
 			}
 
 		case <-flushTimer.C:
@@ -513,7 +509,7 @@ func processEvents(image string, prevLastSent time.Time,
 				image, time.Now().String(),
 				dropped, messageCount,
 				proto.Size(reportLogs))
-			sent := sendProtoStrForLogs(reportLogs, image,
+			sent := send(reportLogs, image,
 				iteration)
 			messageCount = 0
 			iteration += 1
@@ -521,7 +517,6 @@ func processEvents(image string, prevLastSent time.Time,
 				recordLast(lastSentDirname, image)
 			} else {
 				recordLast(lastDeferDirname, image)
-				deferInprogress = true
 			}
 		}
 	}
@@ -565,6 +560,18 @@ func readLast(dirname string, image string) time.Time {
 	return st.ModTime()
 }
 
+// readLastQueued returns when logs were last sent or deferred. The
+// deferred ones are replayed from the deferred queue after a restart
+// hence we don't read them again.
+func readLastQueued(image string) time.Time {
+	lastSent := readLast(lastSentDirname, image)
+	lastDefer := readLast(lastDeferDirname, image)
+	if lastDefer.After(lastSent) {
+		return lastDefer
+	}
+	return lastSent
+}
+
 var msgIdCounter = 1
 var iteration = 0
 
@@ -589,6 +596,7 @@ func HandleLogEvent(event logEntry, reportLogs *logs.LogBundle, counter int) {
 	logDetails.Timestamp, _ = ptypes.TimestampProto(event.timestamp)
 	logDetails.Source = event.source
 	logDetails.Iid = event.iid
+	logDetails.Tags = event.tags
 	logDetails.Msgid = uint64(msgId)
 	oldLen := int64(proto.Size(reportLogs))
 	reportLogs.Log = append(reportLogs.Log, logDetails)
@@ -626,11 +634,13 @@ func sendProtoStrForLogs(reportLogs *logs.LogBundle, image string,
 
 	// For any 400 error we abandon
 	const return400 = true
-	if zedcloud.HasDeferred(image) {
-		log.Infof("SendProtoStrForLogs queued after existing for %s\n",
+	if !spool.empty() {
+		log.Infof("SendProtoStrForLogs spooled after existing for %s\n",
 			image)
-		zedcloud.AddDeferred(image, buf, size, logsUrl, zedcloudCtx,
-			return400)
+		if err := spool.add(data); err != nil {
+			log.Errorf("SendProtoStrForLogs spool failed for %s: %s\n",
+				image, err)
+		}
 		reportLogs.Log = []*logs.LogEntry{}
 		return false
 	}
@@ -649,16 +659,12 @@ func sendProtoStrForLogs(reportLogs *logs.LogBundle, image string,
 	if err != nil {
 		log.Errorf("SendProtoStrForLogs %d bytes image %s failed: %s\n",
 			size, image, err)
-		// Try sending later. The deferred state means processEvents
-		// will sleep until the timer takes care of sending this
-		// hence we'll keep things in order for a given image
-		// The buf might have been consumed
-		buf := bytes.NewBuffer(data)
-		if buf == nil {
-			log.Fatal("sendProtoStrForLogs malloc error:")
+		// Try sending later. Later bundles are spooled after this
+		// one hence we keep things in order
+		if err := spool.add(data); err != nil {
+			log.Errorf("SendProtoStrForLogs spool failed for %s: %s\n",
+				image, err)
 		}
-		zedcloud.AddDeferred(image, buf, size, logsUrl, zedcloudCtx,
-			return400)
 		reportLogs.Log = []*logs.LogEntry{}
 		return false
 	}
@@ -679,6 +685,7 @@ func sendCtxInit() {
 
 	//set log url
 	logsUrl = serverNameAndPort + "/" + logsApi
+	appLogsUrl = serverNameAndPort + "/" + appLogsApi
 
 	tlsConfig, err := zedcloud.GetTlsConfig(serverName, nil)
 	if err != nil {
@@ -757,17 +764,11 @@ func handleXenLogDirModify(context interface{},
 			return
 		}
 	}
-	// Look for guest-domainName.log and look it up to find app UUID
-	// change source to app UUID
+	// The guest-domainName.log consoles are sent per app instance
+	// based on DomainStatus.ConsoleFile
 	if strings.HasPrefix(source, "guest-") {
-		domainName := strings.TrimPrefix(source, "guest-")
-		uuidStr := lookupDomainName(domainName)
-		if uuidStr != "" {
-			log.Infof("Changing %s to %s\n", source, uuidStr)
-			source = uuidStr
-		} else {
-			log.Infof("DomainName %s not found\n", domainName)
-		}
+		log.Debugf("Ignoring %s handled by the app logger\n", source)
+		return
 	}
 	createXenLogger(ctx, filename, source)
 }
@@ -798,13 +799,13 @@ func createXenLogger(ctx *imageLoggerContext, filename string, source string) {
 		logChan: make(chan logEntry),
 	}
 
-	lastSent := readLast(lastSentDirname, source)
+	lastSent := readLastQueued(source)
 	lastSentStr, _ := lastSent.MarshalText()
 	log.Debugf("createXenLogger: source %s last sent at %s\n",
 		source, string(lastSentStr))
 
 	// process associated channel
-	go processEvents(source, lastSent, r.logChan)
+	go processEvents(source, lastSent, r.logChan, sendProtoStrForLogs)
 
 	// Write start event to ensure log is not empty
 	now := time.Now()
@@ -943,13 +944,8 @@ func readLineToEvent(r *logfileReader, logChan chan<- logEntry) {
 					r.source, level)
 				continue
 			}
-			// XXX set iid to PID? From where?
 			// We add time to front of msg.
-			logChan <- logEntry{source: r.source,
-				content:   loginfo.Time + ": " + loginfo.Msg,
-				severity:  loginfo.Level,
-				timestamp: timestamp,
-			}
+			logChan <- loginfoToEntry(r.source, loginfo, timestamp)
 			lastLevel = int(level)
 		} else {
 			// Reformat/add timestamp to front of line
@@ -971,6 +967,39 @@ func readLineToEvent(r *logfileReader, logChan chan<- logEntry) {
 	}
 }
 
+// loginfoToEntry uses the fields from the JSON output of our agents for
+// the source, iid and tags. Source defaults to the file we read.
+func loginfoToEntry(source string, loginfo agentlog.Loginfo,
+	timestamp time.Time) logEntry {
+
+	entry := logEntry{source: source,
+		content:   loginfo.Time + ": " + loginfo.Msg,
+		severity:  loginfo.Level,
+		timestamp: timestamp,
+	}
+	if loginfo.Agent != "" {
+		entry.source = loginfo.Agent
+	}
+	if loginfo.Pid != 0 {
+		entry.iid = strconv.Itoa(loginfo.Pid)
+	}
+	if loginfo.AppUUID != "" || loginfo.ObjectKey != "" ||
+		len(loginfo.Fields) != 0 {
+
+		entry.tags = make(map[string]string)
+		for k, v := range loginfo.Fields {
+			entry.tags[k] = v
+		}
+		if loginfo.AppUUID != "" {
+			entry.tags[agentlog.AppUUIDField] = loginfo.AppUUID
+		}
+		if loginfo.ObjectKey != "" {
+			entry.tags[agentlog.ObjectKeyField] = loginfo.ObjectKey
+		}
+	}
+	return entry
+}
+
 // Read unchanging files until EOF
 // Used for the otherpartition files!
 func logReader(logFile string, source string, logChan chan<- logEntry) {
@@ -1021,6 +1050,14 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 	}
 	// Any deletes?
 	delRemoteMapAgents(foundAgents)
+	if status.LogSpoolMaxSize != 0 {
+		spoolMaxBytes = int64(status.LogSpoolMaxSize) * 1024 * 1024
+	}
+	spool.setQuota(spoolMaxBytes)
+	if status.AppLogRateLimit != 0 {
+		atomic.StoreUint32(&appLogRateLimit, status.AppLogRateLimit)
+	}
+	sink.setServer(status.SyslogServer)
 	log.Infof("handleGlobalConfigModify done for %s\n", key)
 }
 
@@ -1036,6 +1073,7 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
 		debugOverride)
 	delRemoteMapAll()
+	sink.setServer("")
 	log.Infof("handleGlobalConfigDelete done for %s\n", key)
 }
 
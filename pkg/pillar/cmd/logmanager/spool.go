@@ -0,0 +1,263 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Store and forward of log bundles. A bundle we fail to send, and any
+// bundle after it while the spool is not empty, is written compressed
+// to the spool directory. A separate goroutine sends the spooled bundles
+// in the order they were spooled once we can reach the controller.
+// The oldest bundles are dropped when the spool exceeds its quota.
+
+package logmanager
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	dbg "runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	log "github.com/sirupsen/logrus"
+)
+
+const spoolDirname = "/persist/logspool"
+
+type spoolFile struct {
+	seq  uint64
+	size int64 // Compressed
+}
+
+type logSpool struct {
+	sync.Mutex
+	dirname      string
+	maxBytes     int64
+	seq          uint64
+	files        []spoolFile // Oldest first
+	usedBytes    int64
+	droppedFiles uint64
+	droppedBytes uint64
+}
+
+// Created before we subscribe to GlobalConfig
+var spool *logSpool
+
+// newLogSpool loads what was spooled before a restart
+func newLogSpool(dirname string, maxBytes int64) *logSpool {
+	s := &logSpool{dirname: dirname, maxBytes: maxBytes}
+	if err := os.MkdirAll(dirname, 0700); err != nil {
+		log.Fatal(err)
+	}
+	// ReadDir sorts by name hence by sequence number
+	locations, err := ioutil.ReadDir(dirname)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, location := range locations {
+		filename := filepath.Join(dirname, location.Name())
+		seq, err := strconv.ParseUint(strings.TrimSuffix(location.Name(),
+			".pb.gz"), 10, 64)
+		if err != nil || !strings.HasSuffix(location.Name(), ".pb.gz") {
+			// E.g., a temporary file from WriteRename
+			log.Warnf("newLogSpool: removing %s\n", filename)
+			os.RemoveAll(filename)
+			continue
+		}
+		s.files = append(s.files, spoolFile{seq: seq, size: location.Size()})
+		s.usedBytes += location.Size()
+		s.seq = seq
+	}
+	log.Infof("newLogSpool(%s) %d bundles %d bytes\n", dirname,
+		len(s.files), s.usedBytes)
+	s.enforceQuota()
+	return s
+}
+
+func (s *logSpool) filename(seq uint64) string {
+	return filepath.Join(s.dirname, fmt.Sprintf("%016d.pb.gz", seq))
+}
+
+// empty returns true if nothing is waiting to be sent
+func (s *logSpool) empty() bool {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.files) == 0
+}
+
+// add compresses and spools a marshaled LogBundle
+func (s *logSpool) add(data []byte) error {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.seq++
+	if err := pubsub.WriteRename(s.filename(s.seq), buf.Bytes()); err != nil {
+		return err
+	}
+	s.files = append(s.files, spoolFile{seq: s.seq, size: int64(buf.Len())})
+	s.usedBytes += int64(buf.Len())
+	log.Debugf("spool add %d: %d bytes compressed to %d\n", s.seq,
+		len(data), buf.Len())
+	s.enforceQuota()
+	return nil
+}
+
+// setQuota applies a new quota from GlobalConfig
+func (s *logSpool) setQuota(maxBytes int64) {
+	s.Lock()
+	defer s.Unlock()
+	if s.maxBytes != maxBytes {
+		log.Infof("spool quota %d bytes\n", maxBytes)
+	}
+	s.maxBytes = maxBytes
+	s.enforceQuota()
+}
+
+// Drop the oldest bundles until we are within the quota.
+// Caller must hold the lock.
+func (s *logSpool) enforceQuota() {
+	for s.usedBytes > s.maxBytes && len(s.files) != 0 {
+		f := s.files[0]
+		log.Warnf("spool over quota %d bytes; dropping %d size %d\n",
+			s.maxBytes, f.seq, f.size)
+		s.remove(f)
+		s.droppedFiles++
+		s.droppedBytes += uint64(f.size)
+	}
+}
+
+// Caller must hold the lock
+func (s *logSpool) remove(f spoolFile) {
+	for i := range s.files {
+		if s.files[i].seq == f.seq {
+			s.files = append(s.files[:i], s.files[i+1:]...)
+			s.usedBytes -= f.size
+			break
+		}
+	}
+	if err := os.Remove(s.filename(f.seq)); err != nil && !os.IsNotExist(err) {
+		log.Errorf("spool remove: %s\n", err)
+	}
+}
+
+// oldest returns the oldest bundle uncompressed. Returns false if the
+// spool is empty.
+func (s *logSpool) oldest() (spoolFile, []byte, bool, error) {
+	s.Lock()
+	if len(s.files) == 0 {
+		s.Unlock()
+		return spoolFile{}, nil, false, nil
+	}
+	f := s.files[0]
+	s.Unlock()
+
+	file, err := os.Open(s.filename(f.seq))
+	if err != nil {
+		return f, nil, true, err
+	}
+	defer file.Close()
+	r, err := gzip.NewReader(file)
+	if err != nil {
+		return f, nil, true, err
+	}
+	data, err := ioutil.ReadAll(r)
+	return f, data, true, err
+}
+
+// done removes a bundle which was sent or which we can't read
+func (s *logSpool) done(f spoolFile) {
+	s.Lock()
+	defer s.Unlock()
+	s.remove(f)
+}
+
+// metrics are reported by zedagent with those of the deferred queues
+func (s *logSpool) metrics() zedcloud.DeferredMetrics {
+	s.Lock()
+	defer s.Unlock()
+	return zedcloud.DeferredMetrics{
+		QueuedItems:  uint64(len(s.files)),
+		QueuedBytes:  uint64(s.usedBytes),
+		DroppedItems: s.droppedFiles,
+		DroppedBytes: s.droppedBytes,
+	}
+}
+
+// runSpoolSender sends the spooled bundles when kicked e.g., when we
+// get connectivity, and every so often with backoff
+func runSpoolSender(kick <-chan struct{}) {
+	ticker := flextimer.NewExpTicker(time.Minute, 15*time.Minute, 0.3)
+	for {
+		select {
+		case <-kick:
+		case <-ticker.C:
+		}
+		if spool.empty() {
+			continue
+		}
+		if sendSpooled() {
+			// Start over with short retries next time
+			ticker.UpdateExpTicker(time.Minute, 15*time.Minute, 0.3)
+		}
+		dbg.FreeOSMemory()
+	}
+}
+
+// drain passes the bundles to send oldest first until send fails.
+// A bundle is removed once sent, or if we can't read it.
+// Returns true if the spool is empty.
+func (s *logSpool) drain(send func(f spoolFile, data []byte) error) bool {
+	for {
+		f, data, ok, err := s.oldest()
+		if !ok {
+			return true
+		}
+		if err != nil {
+			log.Errorf("spool drain: dropping %d: %s\n", f.seq, err)
+			s.done(f)
+			continue
+		}
+		if err := send(f, data); err != nil {
+			return false
+		}
+		s.done(f)
+	}
+}
+
+// sendSpooled sends the bundles oldest first until one fails.
+// Returns true if the spool is empty.
+func sendSpooled() bool {
+	// For any 400 error we abandon
+	const return400 = true
+	iteration := 0
+	return spool.drain(func(f spoolFile, data []byte) error {
+		size := int64(len(data))
+		resp, _, _, err := zedcloud.SendOnAllIntf(zedcloudCtx, logsUrl,
+			size, bytes.NewBuffer(data), iteration, return400)
+		if resp != nil && resp.StatusCode == 400 {
+			log.Errorf("sendSpooled: failed sending %d bytes to %s; code 400; ignored error\n",
+				size, logsUrl)
+		} else if err != nil {
+			log.Errorf("sendSpooled %d bytes failed: %s\n", size, err)
+			return err
+		}
+		log.Debugf("sendSpooled %d: sent %d bytes to %s\n", f.seq, size,
+			logsUrl)
+		iteration++
+		return nil
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logmanager
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSpool(t *testing.T, maxBytes int64) (*logSpool, string) {
+	dirname, err := ioutil.TempDir("", "logspool")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	return newLogSpool(dirname, maxBytes), dirname
+}
+
+// Random content so the compressed sizes are close to the sizes
+func bundle(n int) []byte {
+	data := make([]byte, 1000)
+	rand.New(rand.NewSource(int64(n))).Read(data)
+	return data
+}
+
+func spooledSeqs(s *logSpool) []uint64 {
+	s.Lock()
+	defer s.Unlock()
+	var seqs []uint64
+	for _, f := range s.files {
+		seqs = append(seqs, f.seq)
+	}
+	return seqs
+}
+
+func spooledFiles(t *testing.T, dirname string) []string {
+	locations, err := ioutil.ReadDir(dirname)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %s", err)
+	}
+	var names []string
+	for _, location := range locations {
+		names = append(names, location.Name())
+	}
+	return names
+}
+
+// drainAll returns the bundles in the order they were sent
+func drainAll(t *testing.T, s *logSpool) [][]byte {
+	var sent [][]byte
+	assert.True(t, s.drain(func(f spoolFile, data []byte) error {
+		sent = append(sent, data)
+		return nil
+	}))
+	return sent
+}
+
+func TestSpoolQuota(t *testing.T) {
+	s, dirname := newTestSpool(t, 1024*1024)
+	defer os.RemoveAll(dirname)
+	for i := 1; i <= 5; i++ {
+		assert.Nil(t, s.add(bundle(i)))
+	}
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, spooledSeqs(s))
+	var sizes []int64
+	for _, f := range s.files {
+		sizes = append(sizes, f.size)
+	}
+
+	testMatrix := []struct {
+		name         string
+		maxBytes     int64
+		add          int // Bundle to add after applying the quota
+		expectedSeqs []uint64
+		droppedFiles uint64
+	}{
+		{
+			name:         "Within quota",
+			maxBytes:     1024 * 1024,
+			expectedSeqs: []uint64{1, 2, 3, 4, 5},
+		},
+		{
+			name:         "Drop the two oldest",
+			maxBytes:     sizes[2] + sizes[3] + sizes[4],
+			expectedSeqs: []uint64{3, 4, 5},
+			droppedFiles: 2,
+		},
+		{
+			name:         "Drop the oldest when adding",
+			maxBytes:     sizes[2] + sizes[3] + sizes[4],
+			add:          6,
+			expectedSeqs: []uint64{4, 5, 6},
+			droppedFiles: 3,
+		},
+		{
+			name:         "Nothing fits",
+			maxBytes:     0,
+			expectedSeqs: nil,
+			droppedFiles: 6,
+		},
+	}
+	for _, test := range testMatrix {
+		t.Logf("Running test case %s", test.name)
+		s.setQuota(test.maxBytes)
+		if test.add != 0 {
+			assert.Nil(t, s.add(bundle(test.add)))
+		}
+		assert.Equal(t, test.expectedSeqs, spooledSeqs(s))
+		var names []string
+		for _, seq := range test.expectedSeqs {
+			names = append(names, fmt.Sprintf("%016d.pb.gz", seq))
+		}
+		assert.Equal(t, names, spooledFiles(t, dirname))
+		metrics := s.metrics()
+		assert.Equal(t, uint64(len(test.expectedSeqs)), metrics.QueuedItems)
+		assert.Equal(t, test.droppedFiles, metrics.DroppedItems)
+		assert.True(t, s.usedBytes <= test.maxBytes)
+	}
+}
+
+func TestSpoolDrain(t *testing.T) {
+	s, dirname := newTestSpool(t, 1024*1024)
+	defer os.RemoveAll(dirname)
+	for i := 1; i <= 4; i++ {
+		assert.Nil(t, s.add(bundle(i)))
+	}
+	// A bundle we can't read is dropped
+	err := ioutil.WriteFile(s.filename(2), []byte("garbage"), 0600)
+	assert.Nil(t, err)
+
+	// Oldest first and stop at the first failure
+	var sent [][]byte
+	empty := s.drain(func(f spoolFile, data []byte) error {
+		if f.seq == 4 {
+			return errors.New("no connectivity")
+		}
+		sent = append(sent, data)
+		return nil
+	})
+	assert.False(t, empty)
+	assert.Equal(t, [][]byte{bundle(1), bundle(3)}, sent)
+	assert.Equal(t, []uint64{4}, spooledSeqs(s))
+	assert.False(t, s.empty())
+
+	// Newer bundles queue behind the one which failed
+	assert.Nil(t, s.add(bundle(5)))
+	assert.Equal(t, [][]byte{bundle(4), bundle(5)}, drainAll(t, s))
+	assert.True(t, s.empty())
+	assert.Nil(t, spooledFiles(t, dirname))
+	assert.Equal(t, int64(0), s.usedBytes)
+}
+
+func TestSpoolReload(t *testing.T) {
+	s, dirname := newTestSpool(t, 1024*1024)
+	defer os.RemoveAll(dirname)
+	for i := 1; i <= 3; i++ {
+		assert.Nil(t, s.add(bundle(i)))
+	}
+	// E.g., left by WriteRename when we crashed
+	tmpFilename := filepath.Join(dirname, "0000000000000004.pb.gz.tmp")
+	assert.Nil(t, ioutil.WriteFile(tmpFilename, []byte("partial"), 0600))
+
+	t.Logf("Running test case Restart")
+	s = newLogSpool(dirname, 1024*1024)
+	assert.Equal(t, []uint64{1, 2, 3}, spooledSeqs(s))
+	_, err := os.Stat(tmpFilename)
+	assert.True(t, os.IsNotExist(err))
+	// The sequence continues after the spooled bundles
+	assert.Nil(t, s.add(bundle(4)))
+	assert.Equal(t, []uint64{1, 2, 3, 4}, spooledSeqs(s))
+
+	t.Logf("Running test case Restart with a smaller quota")
+	s = newLogSpool(dirname, s.files[2].size+s.files[3].size)
+	assert.Equal(t, []uint64{3, 4}, spooledSeqs(s))
+	assert.Equal(t, [][]byte{bundle(3), bundle(4)}, drainAll(t, s))
+}
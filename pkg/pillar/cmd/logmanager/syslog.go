@@ -0,0 +1,305 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Copy of the logs to a syslog collector using RFC5424 over UDP, or over
+// TCP or TLS with RFC6587 octet counting. The server is set with the
+// log.syslog.server GlobalConfig item. For TLS the collector certificate
+// is checked against the ca PEM file and the servername in the query
+// e.g., tls://collector:6514?ca=/persist/certs/syslog.pem&servername=x
+// This does not depend on reaching the controller; the events are copied
+// as we read them. We drop events if the collector can't keep up or can't
+// be reached.
+
+package logmanager
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	syslogQueueLen      = 1000
+	syslogRetryInterval = 30 * time.Second
+	syslogWriteTimeout  = 10 * time.Second
+	syslogTimeFormat    = "2006-01-02T15:04:05.000000Z07:00"
+	syslogFacility      = 3 // daemon
+)
+
+type syslogSink struct {
+	sync.Mutex
+	server  string // From GlobalConfig; empty means disabled
+	events  chan logEntry
+	dropped uint64 // Atomic
+}
+
+// Created before we subscribe to GlobalConfig
+var sink *syslogSink
+
+func newSyslogSink() *syslogSink {
+	s := &syslogSink{events: make(chan logEntry, syslogQueueLen)}
+	go s.run()
+	return s
+}
+
+// setServer applies the server from GlobalConfig
+func (s *syslogSink) setServer(server string) {
+	if server != "" {
+		if _, _, _, err := parseSyslogServer(server); err != nil {
+			log.Errorf("syslog server ignored: %s\n", err)
+			server = ""
+		}
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.server != server {
+		log.Infof("syslog server from <%s> to <%s>\n", s.server, server)
+	}
+	s.server = server
+}
+
+func (s *syslogSink) getServer() string {
+	s.Lock()
+	defer s.Unlock()
+	return s.server
+}
+
+// add queues an event without blocking the senders to the controller
+func (s *syslogSink) add(event logEntry) {
+	if s.getServer() == "" {
+		return
+	}
+	select {
+	case s.events <- event:
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+	}
+}
+
+func (s *syslogSink) run() {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	var conn net.Conn
+	var stream bool
+	var connServer string
+	var retryTime time.Time
+	for event := range s.events {
+		server := s.getServer()
+		if server != connServer {
+			if conn != nil {
+				conn.Close()
+				conn = nil
+			}
+			connServer = server
+			retryTime = time.Time{}
+		}
+		if server == "" {
+			continue
+		}
+		if conn == nil {
+			if time.Now().Before(retryTime) {
+				atomic.AddUint64(&s.dropped, 1)
+				continue
+			}
+			conn, stream, err = dialSyslog(server)
+			if err != nil {
+				log.Errorf("syslog dial %s: %s\n", server, err)
+				retryTime = time.Now().Add(syslogRetryInterval)
+				atomic.AddUint64(&s.dropped, 1)
+				continue
+			}
+			log.Infof("syslog connected to %s\n", server)
+			if dropped := atomic.SwapUint64(&s.dropped, 0); dropped != 0 {
+				log.Warnf("syslog dropped %d events\n", dropped)
+			}
+		}
+		msg := formatRFC5424(event, hostname)
+		if stream {
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+		conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			log.Errorf("syslog write %s: %s\n", server, err)
+			conn.Close()
+			conn = nil
+			retryTime = time.Now().Add(syslogRetryInterval)
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	}
+}
+
+// parseSyslogServer returns the network and the address with the
+// default port for the scheme if none is specified, and for tls the
+// configuration to check the collector certificate
+func parseSyslogServer(server string) (string, string, *tls.Config, error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", "", nil, err
+	}
+	var port string
+	switch u.Scheme {
+	case "udp":
+		port = "514"
+	case "tcp":
+		port = "601"
+	case "tls":
+		port = "6514"
+	default:
+		return "", "", nil, fmt.Errorf("unsupported scheme in %s; use udp, tcp or tls",
+			server)
+	}
+	if u.Hostname() == "" {
+		return "", "", nil, fmt.Errorf("no host in %s", server)
+	}
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	var tlsConfig *tls.Config
+	if u.Scheme == "tls" {
+		tlsConfig, err = syslogTLSConfig(u)
+		if err != nil {
+			return "", "", nil, err
+		}
+	}
+	return u.Scheme, net.JoinHostPort(u.Hostname(), port), tlsConfig, nil
+}
+
+// syslogTLSConfig uses the ca and servername from the query. Without a
+// ca the system roots are used, and without a servername the host.
+func syslogTLSConfig(u *url.URL) (*tls.Config, error) {
+	query := u.Query()
+	tlsConfig := &tls.Config{ServerName: u.Hostname()}
+	if serverName := query.Get("servername"); serverName != "" {
+		tlsConfig.ServerName = serverName
+	}
+	if caFile := query.Get("ca"); caFile != "" {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", caFile)
+		}
+	}
+	return tlsConfig, nil
+}
+
+// dialSyslog returns the connection and whether it is a stream
+func dialSyslog(server string) (net.Conn, bool, error) {
+	network, addr, tlsConfig, err := parseSyslogServer(server)
+	if err != nil {
+		return nil, false, err
+	}
+	dialer := net.Dialer{Timeout: syslogWriteTimeout}
+	switch network {
+	case "udp":
+		conn, err := dialer.Dial("udp", addr)
+		return conn, false, err
+	case "tls":
+		conn, err := tls.DialWithDialer(&dialer, "tcp", addr, tlsConfig)
+		return conn, true, err
+	default:
+		conn, err := dialer.Dial("tcp", addr)
+		return conn, true, err
+	}
+}
+
+// Map from the logrus level names
+func syslogSeverity(severity string) int {
+	switch severity {
+	case "panic":
+		return 0 // emerg
+	case "fatal":
+		return 2 // crit
+	case "error":
+		return 3 // err
+	case "warning":
+		return 4 // warning
+	case "debug", "trace":
+		return 7 // debug
+	default:
+		return 6 // info
+	}
+}
+
+// RFC5424 header fields are printable US-ASCII without spaces
+func syslogHeaderField(field string, maxLen int) string {
+	field = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, field)
+	if len(field) > maxLen {
+		field = field[:maxLen]
+	}
+	if field == "" {
+		return "-"
+	}
+	return field
+}
+
+// Tag names use the SD-NAME rules; printable US-ASCII except '=', ']'
+// and '"'
+func syslogParamName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, name)
+	return syslogHeaderField(name, 32)
+}
+
+// Tag values escape '"', '\' and ']' as in a PARAM-VALUE
+func syslogParamValue(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r == '"' || r == '\\' || r == ']' {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// formatRFC5424 uses the source as APP-NAME and the iid as PROCID.
+// The tags are sent in the message since we have no registered SD-ID,
+// but they are formatted and escaped as SD-PARAMs.
+func formatRFC5424(event logEntry, hostname string) string {
+	pri := syslogFacility*8 + syslogSeverity(event.severity)
+	content := strings.TrimRight(event.content, "\n")
+	if len(event.tags) != 0 {
+		var keys []string
+		for k := range event.tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var tags []string
+		for _, k := range keys {
+			tags = append(tags, fmt.Sprintf("%s=\"%s\"",
+				syslogParamName(k), syslogParamValue(event.tags[k])))
+		}
+		content = strings.Join(tags, " ") + " " + content
+	}
+	return fmt.Sprintf("<%d>1 %s %s %s %s - - %s", pri,
+		event.timestamp.Format(syslogTimeFormat),
+		syslogHeaderField(hostname, 255),
+		syslogHeaderField(event.source, 48),
+		syslogHeaderField(event.iid, 128),
+		content)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logmanager
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRFC5424(t *testing.T) {
+	timestamp := time.Date(2019, 11, 5, 10, 20, 30, 123456000, time.UTC)
+	testMatrix := map[string]struct {
+		event    logEntry
+		hostname string
+		expected string
+	}{
+		"Info": {
+			event: logEntry{severity: "info", source: "zedagent",
+				iid: "1234", content: "hello\n", timestamp: timestamp},
+			hostname: "eve",
+			expected: "<30>1 2019-11-05T10:20:30.123456Z eve zedagent 1234 - - hello",
+		},
+		"Error": {
+			event: logEntry{severity: "error", source: "zedagent",
+				content: "failed", timestamp: timestamp},
+			hostname: "eve",
+			expected: "<27>1 2019-11-05T10:20:30.123456Z eve zedagent - - - failed",
+		},
+		"Panic": {
+			event: logEntry{severity: "panic", source: "zedagent",
+				content: "bye", timestamp: timestamp},
+			hostname: "eve",
+			expected: "<24>1 2019-11-05T10:20:30.123456Z eve zedagent - - - bye",
+		},
+		"Warning": {
+			event: logEntry{severity: "warning", source: "zedagent",
+				content: "careful", timestamp: timestamp},
+			hostname: "eve",
+			expected: "<28>1 2019-11-05T10:20:30.123456Z eve zedagent - - - careful",
+		},
+		"Debug": {
+			event: logEntry{severity: "debug", source: "zedagent",
+				content: "details", timestamp: timestamp},
+			hostname: "eve",
+			expected: "<31>1 2019-11-05T10:20:30.123456Z eve zedagent - - - details",
+		},
+		"Unknown severity is info": {
+			event: logEntry{severity: "bogus", source: "zedagent",
+				content: "what", timestamp: timestamp},
+			hostname: "eve",
+			expected: "<30>1 2019-11-05T10:20:30.123456Z eve zedagent - - - what",
+		},
+		"Header fields without spaces": {
+			event: logEntry{severity: "info", source: "guest vm",
+				iid: "pid\t1", content: "x", timestamp: timestamp},
+			hostname: "my host",
+			expected: "<30>1 2019-11-05T10:20:30.123456Z my_host guest_vm pid_1 - - x",
+		},
+		"Tags sorted and escaped": {
+			event: logEntry{severity: "info", source: "console",
+				content: "line", timestamp: timestamp,
+				tags: map[string]string{
+					"appname": `my "app"`,
+					"a=b]":    `back\slash]`,
+				}},
+			hostname: "eve",
+			expected: `<30>1 2019-11-05T10:20:30.123456Z eve console - - - a_b_="back\\slash\]" appname="my \"app\"" line`,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		msg := formatRFC5424(test.event, test.hostname)
+		assert.Equal(t, test.expected, msg)
+	}
+}
+
+// writeTestCA writes a self-signed certificate in PEM
+func writeTestCA(t *testing.T, filename string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %s", err)
+	}
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "syslog test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template,
+		&key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate failed: %s", err)
+	}
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err := ioutil.WriteFile(filename, b, 0600); err != nil {
+		t.Fatalf("WriteFile failed: %s", err)
+	}
+}
+
+func TestParseSyslogServer(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "syslog")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dirname)
+	caFile := filepath.Join(dirname, "ca.pem")
+	writeTestCA(t, caFile)
+	badCAFile := filepath.Join(dirname, "bad.pem")
+	assert.Nil(t, ioutil.WriteFile(badCAFile, []byte("garbage"), 0600))
+
+	testMatrix := map[string]struct {
+		server        string
+		network       string
+		addr          string
+		serverName    string // If tls
+		expectRootCAs bool
+		expectErr     bool
+	}{
+		"UDP default port": {
+			server:  "udp://collector",
+			network: "udp",
+			addr:    "collector:514",
+		},
+		"TCP default port": {
+			server:  "tcp://collector",
+			network: "tcp",
+			addr:    "collector:601",
+		},
+		"TLS default port": {
+			server:     "tls://collector",
+			network:    "tls",
+			addr:       "collector:6514",
+			serverName: "collector",
+		},
+		"Explicit port": {
+			server:  "udp://10.1.2.3:1514",
+			network: "udp",
+			addr:    "10.1.2.3:1514",
+		},
+		"IPv6 address": {
+			server:  "tcp://[fd00::1]",
+			network: "tcp",
+			addr:    "[fd00::1]:601",
+		},
+		"TLS with servername and ca": {
+			server: "tls://10.1.2.3?servername=collector.example.com&ca=" +
+				caFile,
+			network:       "tls",
+			addr:          "10.1.2.3:6514",
+			serverName:    "collector.example.com",
+			expectRootCAs: true,
+		},
+		"TLS with missing ca": {
+			server:    "tls://collector?ca=" + filepath.Join(dirname, "none"),
+			expectErr: true,
+		},
+		"TLS with bad ca": {
+			server:    "tls://collector?ca=" + badCAFile,
+			expectErr: true,
+		},
+		"No scheme": {
+			server:    "collector:514",
+			expectErr: true,
+		},
+		"Unsupported scheme": {
+			server:    "http://collector",
+			expectErr: true,
+		},
+		"No host": {
+			server:    "udp://:514",
+			expectErr: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		network, addr, tlsConfig, err := parseSyslogServer(test.server)
+		if test.expectErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.network, network)
+		assert.Equal(t, test.addr, addr)
+		if network != "tls" {
+			assert.Nil(t, tlsConfig)
+			continue
+		}
+		if assert.NotNil(t, tlsConfig) {
+			assert.Equal(t, test.serverName, tlsConfig.ServerName)
+			assert.Equal(t, test.expectRootCAs, tlsConfig.RootCAs != nil)
+		}
+	}
+}
@@ -381,9 +381,17 @@ func Run() {
 				log.Errorf("linkChanges closed\n")
 				linkChanges = devicenetwork.LinkChangeInit()
 				// XXX Need to discard all cached information?
-			} else if devicenetwork.LinkChange(change) {
-				handleLinkChange(&nimCtx)
-				// XXX trigger testing??
+			} else {
+				if devicenetwork.LinkChange(change) {
+					handleLinkChange(&nimCtx)
+					// XXX trigger testing??
+				}
+				// The link state of the bond members is part of
+				// the DeviceNetworkStatus
+				if devicenetwork.IsBondMember(*nimCtx.DevicePortConfig,
+					change.Attrs().Name) {
+					devicenetwork.HandleAddressChange(&nimCtx.DeviceNetworkContext)
+				}
 			}
 
 		case <-geoTimer.C:
@@ -486,9 +494,17 @@ func Run() {
 				log.Errorf("linkChanges closed\n")
 				linkChanges = devicenetwork.LinkChangeInit()
 				// XXX Need to discard all cached information?
-			} else if devicenetwork.LinkChange(change) {
-				handleLinkChange(&nimCtx)
-				// XXX trigger testing??
+			} else {
+				if devicenetwork.LinkChange(change) {
+					handleLinkChange(&nimCtx)
+					// XXX trigger testing??
+				}
+				// The link state of the bond members is part of
+				// the DeviceNetworkStatus
+				if devicenetwork.IsBondMember(*nimCtx.DevicePortConfig,
+					change.Attrs().Name) {
+					devicenetwork.HandleAddressChange(&nimCtx.DeviceNetworkContext)
+				}
 			}
 
 		case <-geoTimer.C:
@@ -813,7 +829,7 @@ func isAssigned(ctx *nimContext, ifname string) bool {
 	return false
 }
 
-// Check in NetworkInstanceStatus Type=switch
+// Check in NetworkInstanceStatus Type=switch or transparent
 // XXX should we check for other shared usage? Static IP config?
 func isSwitch(ctx *nimContext, ifname string) bool {
 
@@ -830,7 +846,8 @@ func isSwitch(ctx *nimContext, ifname string) bool {
 		}
 		log.Infof("isSwitch(%s) found use in %s/%s\n",
 			ifname, status.DisplayName, status.Key())
-		if status.Type != types.NetworkInstanceTypeSwitch {
+		if status.Type != types.NetworkInstanceTypeSwitch &&
+			status.Type != types.NetworkInstanceTypeTransparent {
 			continue
 		}
 		foundExcl = true
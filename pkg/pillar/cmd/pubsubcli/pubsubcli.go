@@ -0,0 +1,225 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Inspect the pubsub publications on the device.
+//
+// Example usage:
+// pubsub inspect
+//     lists all publications with key counts, subscriber counts, the
+//     restarted flag and last update time
+// pubsub inspect zedmanager/DomainConfig
+//     shows the same for one publication
+// pubsub inspect -w zedmanager/DomainConfig
+//     watches the publication and prints the changes as json diffs
+// For agents with agentScope use e.g., zedmanager/appImg.obj/DownloaderConfig
+
+package pubsubcli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	log "github.com/sirupsen/logrus"
+)
+
+const diffContext = 2 // Unchanged lines shown around changes
+
+var debugOverride bool // From command line arg
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s inspect [-d] [-w] [agent/topic]\n",
+		os.Args[0])
+	os.Exit(1)
+}
+
+func Run() {
+	if len(os.Args) < 2 || os.Args[1] != "inspect" {
+		usage()
+	}
+	flagSet := flag.NewFlagSet("inspect", flag.ExitOnError)
+	watchPtr := flagSet.Bool("w", false, "Watch for changes")
+	debugPtr := flagSet.Bool("d", false, "Debug flag")
+	flagSet.Parse(os.Args[2:])
+	debugOverride = *debugPtr
+	if debugOverride {
+		log.SetLevel(log.DebugLevel)
+	} else {
+		log.SetLevel(log.WarnLevel)
+	}
+	args := flagSet.Args()
+	switch {
+	case *watchPtr && len(args) == 1:
+		watch(args[0])
+	case *watchPtr:
+		usage()
+	case len(args) == 1:
+		list([]string{args[0]})
+	case len(args) == 0:
+		names, err := pubsub.ListPublications()
+		if err != nil {
+			log.Fatal(err)
+		}
+		list(names)
+	default:
+		usage()
+	}
+}
+
+func list(names []string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKEYS\tSUBSCRIBERS\tSYNCHRONIZED\tRESTARTED\tLAST UPDATE")
+	for _, name := range names {
+		info, err := pubsub.InspectPublication(name)
+		if err != nil {
+			fmt.Fprintf(w, "%s\t%s\n", name, err)
+			continue
+		}
+		subscribers := fmt.Sprintf("%d", info.Subscribers)
+		synchronized := fmt.Sprintf("%d", info.Synchronized)
+		if info.FromDir {
+			// Publisher did not answer
+			subscribers = "-"
+			synchronized = "-"
+		}
+		lastUpdate := "-"
+		if !info.LastUpdate.IsZero() {
+			lastUpdate = info.LastUpdate.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%t\t%s\n", name, info.KeyCount,
+			subscribers, synchronized, info.Restarted, lastUpdate)
+	}
+	w.Flush()
+}
+
+type watchContext struct {
+	items map[string][]string // Indented json lines per key
+}
+
+func watch(name string) {
+	ctx := watchContext{items: make(map[string][]string)}
+	sub, err := pubsub.SubscribeByName(name, &ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sub.CreateHandler = handleModify
+	sub.ModifyHandler = handleModify
+	sub.DeleteHandler = handleDelete
+	sub.RestartHandler = handleRestart
+	sub.SynchronizedHandler = handleSynchronized
+	if err := sub.Activate(); err != nil {
+		log.Fatal(err)
+	}
+	for {
+		change := <-sub.C
+		sub.ProcessChange(change)
+	}
+}
+
+func timestamp() string {
+	return time.Now().Format("15:04:05.000")
+}
+
+func handleModify(ctxArg interface{}, key string, item interface{}) {
+	ctx := ctxArg.(*watchContext)
+	b, err := json.MarshalIndent(item, "", "  ")
+	if err != nil {
+		log.Errorf("handleModify %s: %s\n", key, err)
+		return
+	}
+	lines := strings.Split(string(b), "\n")
+	old, ok := ctx.items[key]
+	ctx.items[key] = lines
+	if !ok {
+		fmt.Printf("%s + %s\n", timestamp(), key)
+		for _, l := range lines {
+			fmt.Printf("+ %s\n", l)
+		}
+		return
+	}
+	fmt.Printf("%s ~ %s\n", timestamp(), key)
+	for _, l := range jsonDiff(old, lines) {
+		fmt.Println(l)
+	}
+}
+
+func handleDelete(ctxArg interface{}, key string, item interface{}) {
+	ctx := ctxArg.(*watchContext)
+	delete(ctx.items, key)
+	fmt.Printf("%s - %s\n", timestamp(), key)
+}
+
+func handleRestart(ctxArg interface{}, restarted bool) {
+	fmt.Printf("%s restarted %t\n", timestamp(), restarted)
+}
+
+func handleSynchronized(ctxArg interface{}, synchronized bool) {
+	fmt.Printf("%s synchronized %t\n", timestamp(), synchronized)
+}
+
+// jsonDiff returns the changed lines prefixed by "-" and "+" with
+// diffContext unchanged lines around each change
+func jsonDiff(old []string, new []string) []string {
+	// Longest common subsequence table
+	lcs := make([][]int, len(old)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(new)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(new) - 1; j >= 0; j-- {
+			if old[i] == new[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	type diffLine struct {
+		prefix string
+		line   string
+	}
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(old) || j < len(new) {
+		switch {
+		case i < len(old) && j < len(new) && old[i] == new[j]:
+			lines = append(lines, diffLine{" ", old[i]})
+			i++
+			j++
+		case i < len(old) && (j == len(new) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{"-", old[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{"+", new[j]})
+			j++
+		}
+	}
+	// Only keep the context around the changes
+	var result []string
+	lastShown := -1
+	for n, l := range lines {
+		show := false
+		for k := n - diffContext; k <= n+diffContext; k++ {
+			if k >= 0 && k < len(lines) && lines[k].prefix != " " {
+				show = true
+				break
+			}
+		}
+		if !show {
+			continue
+		}
+		if lastShown != -1 && n != lastShown+1 {
+			result = append(result, "  ...")
+		}
+		result = append(result, l.prefix+" "+l.line)
+		lastShown = n
+	}
+	return result
+}
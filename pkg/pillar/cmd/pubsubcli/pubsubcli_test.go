@@ -0,0 +1,83 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsubcli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func jsonLines(t *testing.T, item interface{}) []string {
+	b, err := json.MarshalIndent(item, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndent failed: %s", err)
+	}
+	return strings.Split(string(b), "\n")
+}
+
+func TestJSONDiff(t *testing.T) {
+	nestedOld := map[string]interface{}{
+		"a": 1,
+		"b": map[string]int{"c": 1, "d": 2, "e": 3, "f": 4, "g": 5},
+		"h": 6,
+	}
+	nestedNew := map[string]interface{}{
+		"a": 2,
+		"b": map[string]int{"c": 1, "d": 2, "e": 3, "f": 4, "g": 50},
+		"h": 6,
+	}
+	testMatrix := map[string]struct {
+		old      []string
+		new      []string
+		expected []string
+	}{
+		"Unchanged": {
+			old:      []string{"a", "b", "c"},
+			new:      []string{"a", "b", "c"},
+			expected: nil,
+		},
+		"Add": {
+			old:      []string{"a", "b", "c"},
+			new:      []string{"a", "b", "x", "c"},
+			expected: []string{"  a", "  b", "+ x", "  c"},
+		},
+		"Remove": {
+			old:      []string{"a", "b", "c"},
+			new:      []string{"a", "c"},
+			expected: []string{"  a", "- b", "  c"},
+		},
+		"Replace": {
+			old:      []string{"a", "b", "c"},
+			new:      []string{"a", "x", "c"},
+			expected: []string{"  a", "- b", "+ x", "  c"},
+		},
+		"Nested": {
+			old: jsonLines(t, nestedOld),
+			new: jsonLines(t, nestedNew),
+			expected: []string{
+				`  {`,
+				`-   "a": 1,`,
+				`+   "a": 2,`,
+				`    "b": {`,
+				`      "c": 1,`,
+				`  ...`,
+				`      "e": 3,`,
+				`      "f": 4,`,
+				`-     "g": 5`,
+				`+     "g": 50`,
+				`    },`,
+				`    "h": 6`,
+			},
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		diff := jsonDiff(test.old, test.new)
+		assert.Equal(t, test.expected, diff)
+	}
+}
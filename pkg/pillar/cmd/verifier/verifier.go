@@ -11,9 +11,10 @@
 // Move the file from objectDownloadDirname/pending/<claimedsha>/<safename> to
 // to objectDownloadDirname/verifier/<claimedsha>/<safename> and make RO,
 // then attempt to verify sum.
-// Once sum is verified, move to objectDownloadDirname/verified/<sha>/<filename>// where the filename is the last part of the URL (after the last '/')
-// Note that different URLs for same file will download to the same <sha>
-// directory. We delete duplicates assuming the file content will be the same.
+// Once sum is verified, move it into the blob store where it is shared by
+// all the objects with the same sha256, hence verified only once.
+// The verifier holds a reference in the blob store for each object with a
+// non-zero RefCount and garbage collects the unreferenced blobs.
 
 package verifier
 
@@ -35,10 +36,12 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/blobstore"
 	"github.com/lf-edge/eve/pkg/pillar/cast"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	zedOCI "github.com/lf-edge/eve/pkg/pillar/zedUpload/ociutil"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -54,7 +57,7 @@ const (
 	rootCertFileName   = rootCertDirname + "/root-certificate.pem"
 	certificateDirname = persistDir + "/certs"
 
-	// If this file is present we don't delete verified files in handleDelete
+	// If this file is present we don't garbage collect the blob store
 	tmpDirname       = "/var/tmp/zededa"
 	preserveFilename = tmpDirname + "/preserve"
 )
@@ -74,6 +77,7 @@ type verifierContext struct {
 	subBaseOsConfig *pubsub.Subscription
 	pubBaseOsStatus *pubsub.Publication
 	subGlobalConfig *pubsub.Subscription
+	store           *blobstore.Store
 }
 
 var debug = false
@@ -118,6 +122,10 @@ func Run() {
 
 	// Any state needed by handler functions
 	ctx := verifierContext{}
+	ctx.store, err = blobstore.New(blobstore.DefaultDir)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Set up our publications before the subscriptions so ctx is set
 	pubAppImgStatus, err := pubsub.PublishScope(agentName, appImgObj,
@@ -292,33 +300,134 @@ func handleInitWorkinProgressObjects(ctx *verifierContext) {
 // Recreate status files for verified objects as types.DOWNLOADED
 func handleInitVerifiedObjects(ctx *verifierContext) {
 
+	// Move the objects verified before we had the blob store
 	for _, objType := range verifierObjTypes {
 
 		verifiedDirname := objectDownloadDirname + "/" + objType + "/verified"
 		if _, err := os.Stat(verifiedDirname); err == nil {
 			populateInitialStatusFromVerified(ctx, objType,
 				verifiedDirname, "")
+			if err := os.RemoveAll(verifiedDirname); err != nil {
+				log.Error(err)
+			}
 		}
 	}
+	populateInitialStatusFromStore(ctx)
 }
 
 // Verify the sha/signatures and then mark as types.DELIVERED
 func handleInitUpdateVerifiedObjects(ctx *verifierContext) {
 
 	log.Infoln("handleInitUpdateVerifiedObjects")
-	for _, objType := range verifierObjTypes {
-
-		verifiedDirname := objectDownloadDirname + "/" + objType + "/verified"
-		if _, err := os.Stat(verifiedDirname); err == nil {
-			updateInitialStatusFromVerified(ctx, objType,
-				verifiedDirname, "")
+	publications := []*pubsub.Publication{
+		ctx.pubAppImgStatus,
+		ctx.pubBaseOsStatus,
+	}
+	for _, sha := range ctx.store.List() {
+		// We re-verify the sha on reboot/restart
+		// XXX what about signature? Do we have the certs?
+		filename := ctx.store.Path(sha)
+		imageHash, err := computeShaFile(filename)
+		if err != nil {
+			log.Errorf("computeShaFile %s failed %s\n",
+				filename, err)
+			ctx.store.Remove(sha)
+			continue
+		}
+		got := fmt.Sprintf("%x", imageHash)
+		if got != sha {
+			log.Errorf("computed   %s\n", got)
+			log.Errorf("configured %s\n", sha)
+			ctx.store.Remove(sha)
+			continue
+		}
+		// Passed sha verification
+		for _, pub := range publications {
+			for _, st := range pub.GetAll() {
+				status := cast.CastVerifyImageStatus(st)
+				if strings.ToLower(status.ImageSha256) != sha ||
+					status.State != types.DOWNLOADED {
+					continue
+				}
+				status.State = types.DELIVERED
+				publishVerifyImageStatus(ctx, &status)
+			}
 		}
 	}
 	log.Infoln("handleInitUpdateVerifiedObjects done")
 }
 
-// Recursive scanning for verified objects,
-// to recreate the VerifyImageStatus.
+// The holders of the references in the blob store tell us which objects
+// we had before a restart. We recreate their status with a zero RefCount
+// and drop our references; they are added back once the config arrives
+// with a RefCount.
+func populateInitialStatusFromStore(ctx *verifierContext) {
+
+	log.Infof("populateInitialStatusFromStore(%s)\n", ctx.store.Dir())
+	for _, sha := range ctx.store.List() {
+		size, err := ctx.store.Size(sha)
+		if err != nil {
+			log.Error(err)
+		}
+		for _, holder := range ctx.store.Refs(sha) {
+			objType, safename := parseHolder(holder)
+			if objType == "" {
+				continue
+			}
+			log.Debugf("populateInitialStatusFromStore: %s/%s: %d Mbytes\n",
+				objType, safename, size/(1024*1024))
+			status := types.VerifyImageStatus{
+				Safename:     safename,
+				ObjType:      objType,
+				ImageSha256:  sha,
+				State:        types.DOWNLOADED,
+				FileLocation: ctx.store.Path(sha),
+				Size:         size,
+				RefCount:     0,
+				LastUse:      time.Now(),
+			}
+			publishVerifyImageStatus(ctx, &status)
+		}
+	}
+	ctx.store.ReleaseHolders(agentName + ".")
+}
+
+// The reference we hold in the blob store for the object
+func holderName(status *types.VerifyImageStatus) string {
+	return agentName + "." + status.ObjType + "." + status.Safename
+}
+
+// parseHolder returns the objType and safename of one of our references
+func parseHolder(holder string) (string, string) {
+	for _, objType := range verifierObjTypes {
+		prefix := agentName + "." + objType + "."
+		if strings.HasPrefix(holder, prefix) {
+			return objType, strings.TrimPrefix(holder, prefix)
+		}
+	}
+	return "", ""
+}
+
+// updateRef makes us hold a reference in the blob store if the object
+// is verified and in use
+func updateRef(ctx *verifierContext, status *types.VerifyImageStatus) {
+	if status.IsContainer || status.ImageSha256 == "" {
+		return
+	}
+	var err error
+	if status.RefCount != 0 && status.State == types.DELIVERED {
+		err = ctx.store.AddRef(status.ImageSha256, holderName(status))
+	} else {
+		err = ctx.store.ReleaseRef(status.ImageSha256, holderName(status))
+	}
+	if err != nil {
+		log.Errorf("updateRef(%s) RefCount %d failed: %s\n",
+			status.Key(), status.RefCount, err)
+	}
+}
+
+// Recursive scanning for verified objects from before the blob store,
+// to move them there and recreate the VerifyImageStatus.
 func populateInitialStatusFromVerified(ctx *verifierContext,
 	objType string, objDirname string, parentDirname string) {
 
@@ -352,84 +461,27 @@ func populateInitialStatusFromVerified(ctx *verifierContext,
 			log.Debugf("populateInitialStatusFromVerified: Processing %s: %d Mbytes\n",
 				filename, size/(1024*1024))
 
-			sha := parentDirname
+			sha := strings.ToLower(parentDirname)
 			// We don't know the URL; Pick a name which is unique
 			safename := location.Name() + "." + sha
 
-			status := types.VerifyImageStatus{
-				Safename:    safename,
-				ObjType:     objType,
-				ImageSha256: sha,
-				State:       types.DOWNLOADED,
-				Size:        size,
-				RefCount:    0,
-				LastUse:     time.Now(),
-			}
-			publishVerifyImageStatus(ctx, &status)
-		}
-	}
-}
-
-// Recursive scanning for verified objects,
-// to update the VerifyImageStatus after verifying the sha/signatures.
-func updateInitialStatusFromVerified(ctx *verifierContext,
-	objType string, objDirname string, parentDirname string) {
-
-	log.Infof("updateInitialStatusFromVerified(%s, %s)\n", objDirname,
-		parentDirname)
-
-	locations, err := ioutil.ReadDir(objDirname)
-
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	for _, location := range locations {
-
-		filename := objDirname + "/" + location.Name()
-
-		if location.IsDir() {
-			log.Debugf("updateInitialStatusFromVerified: Looking in %s\n", filename)
-			if _, err := os.Stat(filename); err == nil {
-				updateInitialStatusFromVerified(ctx,
-					objType, filename, location.Name())
-			}
-		} else {
-			log.Debugf("updateInitialStatusFromVerified: Processing %s\n",
-				filename)
-
-			sha := parentDirname
-			safename := location.Name() + "." + sha
-			status := lookupVerifyImageStatus(ctx,
-				objType, safename)
-			if status == nil {
-				log.Errorf("updateInitialStatusFromVerified: %s/%s not found\n",
-					objType, safename)
-				continue
-			}
-
-			// We re-verify the sha on reboot/restart
-			// XXX what about signature? Do we have the certs?
-			imageHash, err := computeShaFile(filename)
+			path, err := ctx.store.Add(sha, filename)
 			if err != nil {
-				log.Errorf("computeShaFile %s failed %s\n",
-					filename, err)
-				doDelete(status)
+				log.Errorf("populateInitialStatusFromVerified: %s\n",
+					err)
 				continue
 			}
-
-			got := fmt.Sprintf("%x", imageHash)
-			if got != strings.ToLower(sha) {
-				log.Errorf("computed   %s\n", got)
-				log.Errorf("configured %s\n",
-					strings.ToLower(sha))
-				doDelete(status)
-				continue
+			status := types.VerifyImageStatus{
+				Safename:     safename,
+				ObjType:      objType,
+				ImageSha256:  sha,
+				State:        types.DOWNLOADED,
+				FileLocation: path,
+				Size:         size,
+				RefCount:     0,
+				LastUse:      time.Now(),
 			}
-
-			status.State = types.DELIVERED
-			// Passed sha verification
-			publishVerifyImageStatus(ctx, status)
+			publishVerifyImageStatus(ctx, &status)
 		}
 	}
 }
@@ -495,13 +547,19 @@ func clearInProgressDownloadDirs(objTypes []string) {
 	}
 }
 
-// If an object has a zero RefCount and dropped to zero more than
-// downloadGCTime ago, then we delete the Status. That will result in the
-// user (zedmanager or baseosmgr) deleting the Config, unless a RefCount
-// increase is underway.
+// Blobs which have had no references for downloadGCTime are removed
+// from the blob store. If an object has a zero RefCount and its content
+// is not in the blob store, then we expire the Status. That will result
+// in the user (zedmanager or baseosmgr) deleting the Config, unless a
+// RefCount increase is underway.
 // XXX Note that this runs concurrently with the handler.
 func gcVerifiedObjects(ctx *verifierContext) {
 	log.Debugf("gcVerifiedObjects()\n")
+	if _, err := os.Stat(preserveFilename); err == nil {
+		log.Debugf("gcVerifiedObjects: preserving blob store\n")
+		return
+	}
+	ctx.store.GC(downloadGCTime)
 	publications := []*pubsub.Publication{
 		ctx.pubAppImgStatus,
 		ctx.pubBaseOsStatus,
@@ -520,15 +578,13 @@ func gcVerifiedObjects(ctx *verifierContext) {
 					status.RefCount, key)
 				continue
 			}
-			timePassed := time.Since(status.LastUse)
-			if timePassed < downloadGCTime {
-				log.Debugf("gcverifiedObjects: skipping recently used %s remains %d seconds\n",
-					key,
-					(timePassed-downloadGCTime)/time.Second)
+			if !status.IsContainer && ctx.store.Has(status.ImageSha256) {
+				log.Debugf("gcVerifiedObjects: skipping %s in blob store\n",
+					key)
 				continue
 			}
-			log.Infof("gcVerifiedObjects: expiring status for %s; LastUse %v now %v\n",
-				key, status.LastUse, time.Now())
+			log.Infof("gcVerifiedObjects: expiring status for %s; LastUse %v\n",
+				key, status.LastUse)
 			status.Expired = true
 			publishVerifyImageStatus(ctx, &status)
 		}
@@ -766,9 +822,33 @@ func handleCreate(ctx *verifierContext, objType string,
 	}
 	publishVerifyImageStatus(ctx, &status)
 
-	// Skip verification steps for Container. Container image verification
-	// is done by rkt fetch itself.. No need to be done here.
-	if !config.IsContainer {
+	// The downloader verified the container image against ImageSha256
+	// and the layer digests before the import. We check it again before
+	// the image is used.
+	if config.IsContainer {
+		if !verifyContainerLayers(ctx, config, &status) {
+			log.Errorf("handleCreate fail for %s\n", config.Name)
+			return
+		}
+	} else if ctx.store.Has(config.ImageSha256) {
+		// Verified for another object; the downloader doesn't
+		// download it again
+		log.Infof("handleCreate %s already in blob store\n",
+			config.Name)
+		size, err := ctx.store.Size(config.ImageSha256)
+		if err != nil {
+			log.Error(err)
+		}
+		status.Size = size
+		status.FileLocation = ctx.store.Path(config.ImageSha256)
+		// Remove any copy the downloader made before the blob was
+		// added for another object
+		pendingFilename := objectDownloadDirname + "/" + objType +
+			"/pending/" + config.ImageSha256 + "/" + config.Safename
+		if err := os.RemoveAll(pendingFilename); err != nil {
+			log.Error(err)
+		}
+	} else {
 		ok, size := markObjectAsVerifying(ctx, config, &status)
 		if !ok {
 			log.Errorf("handleCreate fail for %s\n", config.Name)
@@ -787,6 +867,7 @@ func handleCreate(ctx *verifierContext, objType string,
 	}
 	status.PendingAdd = false
 	status.State = types.DELIVERED
+	updateRef(ctx, &status)
 	publishVerifyImageStatus(ctx, &status)
 	log.Infof("handleCreate done for %s\n", config.Name)
 }
@@ -799,8 +880,8 @@ func markObjectAsVerifying(ctx *verifierContext,
 	// Form the unique filename in
 	// objectDownloadDirname/<objType>/pending/
 	// based on the claimed Sha256 and safename, and the same name
-	// in objectDownloadDirname/<objType>/verifier/. Form a shorter name for
-	// objectDownloadDirname/<objType/>verified/.
+	// in objectDownloadDirname/<objType>/verifier/. Once verified the
+	// file moves to the blob store.
 
 	objType := status.ObjType
 	downloadDirname := objectDownloadDirname + "/" + objType
@@ -902,6 +983,41 @@ func verifyObjectSha(ctx *verifierContext, config *types.VerifyImageConfig,
 	return true
 }
 
+// Check the OCI image layout the downloader left in
+// objectDownloadDirname/<objType>/pending/<claimedsha>/<safename>
+// against the ImageSha256 from the controller, if any; the manifest (or
+// the index referring to it) and then the config and layers of that
+// manifest, which must be the LayerDigests the downloader pulled.
+func verifyContainerLayers(ctx *verifierContext,
+	config *types.VerifyImageConfig, status *types.VerifyImageStatus) bool {
+
+	objType := status.ObjType
+	downloadDirname := objectDownloadDirname + "/" + objType
+	layoutDirname := downloadDirname + "/pending/" + status.ImageSha256 +
+		"/" + config.Safename
+
+	log.Infof("Verifying URL %s in %s against %s and %d layers\n",
+		config.Name, layoutDirname, config.ImageSha256,
+		len(config.LayerDigests))
+	if config.ImageSha256 == "" {
+		log.Warnf("No sha256 for %s; verifying the layers only\n",
+			config.Name)
+	}
+	manifest, err := zedOCI.VerifyLayout(layoutDirname, config.ImageSha256,
+		config.LayerDigests)
+	if err != nil {
+		cerr := fmt.Sprintf("%v", err)
+		status.PendingAdd = false
+		updateVerifyErrStatus(ctx, status, cerr)
+		log.Errorf("verifyContainerLayers %s failed %s\n",
+			config.Name, cerr)
+		return false
+	}
+	log.Infof("Validation of manifest and %d layers successful for %s\n",
+		len(manifest.Layers), config.Name)
+	return true
+}
+
 func computeShaFile(filename string) ([]byte, error) {
 	f, err := os.Open(filename)
 	if err != nil {
@@ -1057,59 +1173,18 @@ func markObjectAsVerified(ctx *verifierContext, config *types.VerifyImageConfig,
 	objType := status.ObjType
 	downloadDirname := objectDownloadDirname + "/" + objType
 	verifierDirname := downloadDirname + "/verifier/" + status.ImageSha256
-	verifiedDirname := downloadDirname + "/verified/" + config.ImageSha256
-
 	verifierFilename := verifierDirname + "/" + config.Safename
-	verifiedFilename := verifiedDirname + "/" + config.Safename
-
-	// Move directory from objectDownloadDirname/verifier to
-	// objectDownloadDirname/verified
-	// XXX should have dom0 do this and/or have RO mounts
-	filename := types.SafenameToFilename(config.Safename)
-	verifiedFilename = verifiedDirname + "/" + filename
-	log.Infof("Move from %s to %s\n", verifierFilename, verifiedFilename)
-
-	if _, err := os.Stat(verifierFilename); err != nil {
-		log.Fatal(err)
-	}
 
-	if _, err := os.Stat(verifiedFilename); err == nil {
-		log.Warn(verifiedFilename + ": file exists")
-		if err := os.RemoveAll(verifiedFilename); err != nil {
-			log.Fatal(err)
-		}
-	}
-
-	if _, err := os.Stat(verifiedDirname); err == nil {
-		// Directory exists thus we have a sha256 collision presumably
-		// due to multiple safenames (i.e., URLs) for the same content.
-		// Delete existing to avoid wasting space.
-		locations, err := ioutil.ReadDir(verifiedDirname)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, location := range locations {
-			log.Debugf("Identical sha256 (%s) for safenames %s and %s; deleting old\n",
-				config.ImageSha256, location.Name(),
-				config.Safename)
-		}
-		if err := os.RemoveAll(verifiedDirname); err != nil {
-			log.Fatal(err)
-		}
-	}
-
-	log.Errorf("Create %s\n", verifiedDirname)
-	if err := os.MkdirAll(verifiedDirname, 0700); err != nil {
-		log.Fatal(err)
-	}
-
-	if err := os.Rename(verifierFilename, verifiedFilename); err != nil {
-		log.Fatal(err)
-	}
-
-	if err := os.Chmod(verifiedDirname, 0500); err != nil {
+	// Move from objectDownloadDirname/verifier to the blob store. If
+	// the store already has the sha256 due to multiple safenames
+	// (i.e., URLs) for the same content we delete ours to avoid
+	// wasting space.
+	log.Infof("Move from %s to blob store\n", verifierFilename)
+	path, err := ctx.store.Add(config.ImageSha256, verifierFilename)
+	if err != nil {
 		log.Fatal(err)
 	}
+	status.FileLocation = path
 
 	// Clean up empty directory
 	if err := os.RemoveAll(verifierDirname); err != nil {
@@ -1139,6 +1214,7 @@ func handleModify(ctx *verifierContext, config *types.VerifyImageConfig,
 		status.RefCount = config.RefCount
 		status.Expired = false
 		changed = true
+		updateRef(ctx, status)
 	}
 
 	if status.RefCount == 0 {
@@ -1184,23 +1260,22 @@ func handleDelete(ctx *verifierContext, status *types.VerifyImageStatus) {
 			status.Safename)
 	}
 
-	doDelete(status)
+	status.RefCount = 0
+	updateRef(ctx, status)
+	doDelete(ctx, status)
 
 	unpublishVerifyImageStatus(ctx, status)
 	log.Infof("handleDelete done for %s\n", status.Safename)
 }
 
-// Remove the file from any of the three directories
-// Only if it verified (state DELIVERED) do we delete the final. Needed
-// to avoid deleting a different verified file with same sha as this claimed
-// to have
-func doDelete(status *types.VerifyImageStatus) {
+// Remove the file from the verifier directory. The verified content is
+// in the blob store where it is garbage collected once unreferenced.
+func doDelete(ctx *verifierContext, status *types.VerifyImageStatus) {
 	log.Infof("doDelete(%v)\n", status.Safename)
 
 	objType := status.ObjType
 	downloadDirname := objectDownloadDirname + "/" + objType
 	verifierDirname := downloadDirname + "/verifier/" + status.ImageSha256
-	verifiedDirname := downloadDirname + "/verified/" + status.ImageSha256
 
 	_, err := os.Stat(verifierDirname)
 	if err == nil {
@@ -1209,17 +1284,6 @@ func doDelete(status *types.VerifyImageStatus) {
 			log.Fatal(err)
 		}
 	}
-	_, err = os.Stat(verifiedDirname)
-	if err == nil && status.State == types.DELIVERED {
-		if _, err := os.Stat(preserveFilename); err != nil {
-			log.Infof("doDelete removing %s\n", verifiedDirname)
-			if err := os.RemoveAll(verifiedDirname); err != nil {
-				log.Fatal(err)
-			}
-		} else {
-			log.Infof("doDelete preserving %s\n", verifiedDirname)
-		}
-	}
 	log.Infof("doDelete(%v) done\n", status.Safename)
 }
 
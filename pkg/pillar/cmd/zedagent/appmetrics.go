@@ -0,0 +1,258 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Disk I/O and network rates per app instance. The rates in the metrics
+// report are over the time since the previous report, and the history has
+// one sample per appMetricsSampleInterval for the last
+// appMetricsHistoryLength. Only used from the metrics goroutine.
+
+package zedagent
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/lf-edge/eve/pkg/pillar/cast"
+	"github.com/lf-edge/eve/pkg/pillar/domainmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	appMetricsSampleInterval = time.Minute
+	appMetricsHistoryLength  = time.Hour
+)
+
+// appCounters are the cumulative counters of an app instance at some time
+type appCounters struct {
+	time     time.Time
+	cpuTotal uint64                              // Seconds
+	disks    map[string]domainmetrics.DiskMetric // Key is the vdev
+	vifs     map[string]types.NetworkMetric
+}
+
+type appMetricsState struct {
+	reported appCounters // At the last metrics report
+	sampled  appCounters // At the end of the last history sample
+	history  []*metrics.AppMetricSample
+}
+
+// Key is the app instance UUID
+var appMetricsStates = make(map[string]*appMetricsState)
+
+func lookupAppMetricsState(key string) *appMetricsState {
+	state, ok := appMetricsStates[key]
+	if !ok {
+		state = &appMetricsState{}
+		appMetricsStates[key] = state
+	}
+	return state
+}
+
+// lookupAppCounters returns the current counters of the running domain.
+// The vif counters are from the perspective of the app.
+func lookupAppCounters(aiStatus types.AppInstanceStatus,
+	dm domainmetrics.DomainMetric, now time.Time) appCounters {
+
+	counters := appCounters{
+		time:     now,
+		cpuTotal: dm.CPUTotal,
+		disks:    dm.Disks,
+		vifs:     make(map[string]types.NetworkMetric),
+	}
+	for _, ifName := range aiStatus.GetAppInterfaceList() {
+		for _, m := range networkMetrics.MetricList {
+			if ifName == m.IfName {
+				counters.vifs[ifName] = appVifMetric(m)
+				break
+			}
+		}
+	}
+	return counters
+}
+
+// appVifMetric swaps the counters of the bu* and bo* interfaces since
+// the packets received on them were sent by the domU and vice versa
+func appVifMetric(metric types.NetworkMetric) types.NetworkMetric {
+	if strings.HasPrefix(metric.IfName, "nbn") ||
+		strings.HasPrefix(metric.IfName, "nbu") ||
+		strings.HasPrefix(metric.IfName, "nbo") {
+		return metric
+	}
+	return types.NetworkMetric{
+		IfName:              metric.IfName,
+		TxPkts:              metric.RxPkts,
+		RxPkts:              metric.TxPkts,
+		TxBytes:             metric.RxBytes,
+		RxBytes:             metric.TxBytes,
+		TxDrops:             metric.RxDrops,
+		RxDrops:             metric.TxDrops,
+		TxErrors:            metric.RxErrors,
+		RxErrors:            metric.TxErrors,
+		TxAclDrops:          metric.RxAclDrops,
+		RxAclDrops:          metric.TxAclDrops,
+		TxAclRateLimitDrops: metric.RxAclRateLimitDrops,
+		RxAclRateLimitDrops: metric.TxAclRateLimitDrops,
+	}
+}
+
+// swapReportedCounters records the counters of this report and returns
+// those of the previous one
+func swapReportedCounters(key string, counters appCounters) appCounters {
+	state := lookupAppMetricsState(key)
+	prev := state.reported
+	state.reported = counters
+	return prev
+}
+
+// diskIoRate is nil if there is no previous value or the counters were
+// reset e.g., by a restart of the app instance
+func (prev appCounters) diskIoRate(cur appCounters,
+	vdev string) *metrics.DiskIoRate {
+
+	secs := cur.time.Sub(prev.time).Seconds()
+	p, ok := prev.disks[vdev]
+	if !ok || prev.time.IsZero() || secs <= 0 {
+		return nil
+	}
+	c, ok := cur.disks[vdev]
+	if !ok {
+		return nil
+	}
+	if c.ReadBytes < p.ReadBytes || c.WriteBytes < p.WriteBytes ||
+		c.ReadOps < p.ReadOps || c.WriteOps < p.WriteOps {
+		return nil
+	}
+	return &metrics.DiskIoRate{
+		ReadBytes:  float64(c.ReadBytes-p.ReadBytes) / secs,
+		WriteBytes: float64(c.WriteBytes-p.WriteBytes) / secs,
+		ReadOps:    float64(c.ReadOps-p.ReadOps) / secs,
+		WriteOps:   float64(c.WriteOps-p.WriteOps) / secs,
+	}
+}
+
+// networkRate is nil if there is no previous value or the counters were
+// reset e.g., when the vif was recreated
+func (prev appCounters) networkRate(cur appCounters,
+	ifName string) *metrics.NetworkRate {
+
+	secs := cur.time.Sub(prev.time).Seconds()
+	p, ok := prev.vifs[ifName]
+	if !ok || prev.time.IsZero() || secs <= 0 {
+		return nil
+	}
+	c, ok := cur.vifs[ifName]
+	if !ok {
+		return nil
+	}
+	if c.TxBytes < p.TxBytes || c.RxBytes < p.RxBytes ||
+		c.TxPkts < p.TxPkts || c.RxPkts < p.RxPkts {
+		return nil
+	}
+	return &metrics.NetworkRate{
+		TxBytes: float64(c.TxBytes-p.TxBytes) / secs,
+		RxBytes: float64(c.RxBytes-p.RxBytes) / secs,
+		TxPkts:  float64(c.TxPkts-p.TxPkts) / secs,
+		RxPkts:  float64(c.RxPkts-p.RxPkts) / secs,
+	}
+}
+
+// sample returns the usage between prev and cur summed over the disks
+// and the vifs
+func (prev appCounters) sample(cur appCounters) *metrics.AppMetricSample {
+	secs := cur.time.Sub(prev.time).Seconds()
+	endTime, _ := ptypes.TimestampProto(cur.time)
+	sample := &metrics.AppMetricSample{
+		EndTime:  endTime,
+		Interval: uint32(secs + 0.5),
+		Disk:     &metrics.DiskIoRate{},
+		Network:  &metrics.NetworkRate{},
+	}
+	if cur.cpuTotal >= prev.cpuTotal && secs > 0 {
+		sample.CpuPercent = 100 * float64(cur.cpuTotal-prev.cpuTotal) / secs
+	}
+	for vdev := range cur.disks {
+		rate := prev.diskIoRate(cur, vdev)
+		if rate == nil {
+			continue
+		}
+		sample.Disk.ReadBytes += rate.ReadBytes
+		sample.Disk.WriteBytes += rate.WriteBytes
+		sample.Disk.ReadOps += rate.ReadOps
+		sample.Disk.WriteOps += rate.WriteOps
+	}
+	for ifName := range cur.vifs {
+		rate := prev.networkRate(cur, ifName)
+		if rate == nil {
+			continue
+		}
+		sample.Network.TxBytes += rate.TxBytes
+		sample.Network.RxBytes += rate.RxBytes
+		sample.Network.TxPkts += rate.TxPkts
+		sample.Network.RxPkts += rate.RxPkts
+	}
+	return sample
+}
+
+// sampleAppMetrics adds a sample to the history of the running app
+// instances and forgets about those which are gone
+func sampleAppMetrics(ctx *zedagentContext) {
+	domainMetrics, err := metricsCollector.Collect(lookupDomainRefs(ctx))
+	if err != nil {
+		log.Errorf("sampleAppMetrics: %s\n", err)
+		return
+	}
+	now := time.Now()
+	present := make(map[string]bool)
+	items := ctx.getconfigCtx.subAppInstanceStatus.GetAll()
+	for _, st := range items {
+		aiStatus := cast.CastAppInstanceStatus(st)
+		key := aiStatus.Key()
+		present[key] = true
+		state := lookupAppMetricsState(key)
+		dm, ok := domainMetrics.Domains[aiStatus.DomainName]
+		if !ok {
+			// Start over once it is running
+			state.sampled = appCounters{}
+			continue
+		}
+		counters := lookupAppCounters(aiStatus, dm, now)
+		if !state.sampled.time.IsZero() {
+			state.history = append(state.history,
+				state.sampled.sample(counters))
+		}
+		state.sampled = counters
+		trimAppMetricsHistory(state, now)
+	}
+	for key := range appMetricsStates {
+		if !present[key] {
+			log.Infof("sampleAppMetrics: forgetting %s\n", key)
+			delete(appMetricsStates, key)
+		}
+	}
+}
+
+// trimAppMetricsHistory drops the samples older than
+// appMetricsHistoryLength
+func trimAppMetricsHistory(state *appMetricsState, now time.Time) {
+	cutoff := now.Add(-appMetricsHistoryLength)
+	i := 0
+	for ; i < len(state.history); i++ {
+		endTime, err := ptypes.Timestamp(state.history[i].EndTime)
+		if err == nil && endTime.After(cutoff) {
+			break
+		}
+	}
+	state.history = state.history[i:]
+}
+
+// appMetricsHistory returns the samples, oldest first
+func appMetricsHistory(key string) []*metrics.AppMetricSample {
+	state, ok := appMetricsStates[key]
+	if !ok {
+		return nil
+	}
+	return state.history
+}
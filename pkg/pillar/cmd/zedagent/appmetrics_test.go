@@ -0,0 +1,194 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/lf-edge/eve/pkg/pillar/domainmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskIoRate(t *testing.T) {
+	start := time.Unix(1572949230, 0)
+	prev := appCounters{
+		time: start,
+		disks: map[string]domainmetrics.DiskMetric{
+			"xvda": {ReadBytes: 1000, WriteBytes: 2000, ReadOps: 10,
+				WriteOps: 20},
+		},
+	}
+	testMatrix := map[string]struct {
+		prev     appCounters
+		cur      appCounters
+		vdev     string
+		expected *metrics.DiskIoRate
+	}{
+		"Rate": {
+			prev: prev,
+			cur: appCounters{
+				time: start.Add(10 * time.Second),
+				disks: map[string]domainmetrics.DiskMetric{
+					"xvda": {ReadBytes: 3000, WriteBytes: 2000,
+						ReadOps: 30, WriteOps: 25},
+				},
+			},
+			vdev: "xvda",
+			expected: &metrics.DiskIoRate{ReadBytes: 200, ReadOps: 2,
+				WriteOps: 0.5},
+		},
+		"No previous report": {
+			cur: appCounters{
+				time: start,
+				disks: map[string]domainmetrics.DiskMetric{
+					"xvda": {ReadBytes: 3000},
+				},
+			},
+			vdev: "xvda",
+		},
+		"No time elapsed": {
+			prev: prev,
+			cur:  prev,
+			vdev: "xvda",
+		},
+		"Counter reset": {
+			prev: prev,
+			cur: appCounters{
+				time: start.Add(10 * time.Second),
+				disks: map[string]domainmetrics.DiskMetric{
+					"xvda": {ReadBytes: 3000, WriteBytes: 100,
+						ReadOps: 30, WriteOps: 25},
+				},
+			},
+			vdev: "xvda",
+		},
+		"Disk added": {
+			prev: prev,
+			cur: appCounters{
+				time: start.Add(10 * time.Second),
+				disks: map[string]domainmetrics.DiskMetric{
+					"xvdb": {ReadBytes: 3000},
+				},
+			},
+			vdev: "xvdb",
+		},
+		"Disk gone": {
+			prev: prev,
+			cur:  appCounters{time: start.Add(10 * time.Second)},
+			vdev: "xvda",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, test.prev.diskIoRate(test.cur,
+			test.vdev))
+	}
+}
+
+func TestNetworkRate(t *testing.T) {
+	start := time.Unix(1572949230, 0)
+	prev := appCounters{
+		time: start,
+		vifs: map[string]types.NetworkMetric{
+			"nbu1x1": {TxBytes: 1000, RxBytes: 2000, TxPkts: 10,
+				RxPkts: 20},
+		},
+	}
+	testMatrix := map[string]struct {
+		prev     appCounters
+		cur      appCounters
+		ifName   string
+		expected *metrics.NetworkRate
+	}{
+		"Rate": {
+			prev: prev,
+			cur: appCounters{
+				time: start.Add(4 * time.Second),
+				vifs: map[string]types.NetworkMetric{
+					"nbu1x1": {TxBytes: 2000, RxBytes: 2400,
+						TxPkts: 14, RxPkts: 22},
+				},
+			},
+			ifName: "nbu1x1",
+			expected: &metrics.NetworkRate{TxBytes: 250, RxBytes: 100,
+				TxPkts: 1, RxPkts: 0.5},
+		},
+		"No previous report": {
+			cur: appCounters{
+				time: start,
+				vifs: map[string]types.NetworkMetric{
+					"nbu1x1": {TxBytes: 2000},
+				},
+			},
+			ifName: "nbu1x1",
+		},
+		"Clock went back": {
+			prev: prev,
+			cur: appCounters{
+				time: start.Add(-time.Second),
+				vifs: prev.vifs,
+			},
+			ifName: "nbu1x1",
+		},
+		"Counter reset": {
+			prev: prev,
+			cur: appCounters{
+				time: start.Add(4 * time.Second),
+				vifs: map[string]types.NetworkMetric{
+					"nbu1x1": {TxBytes: 2000, RxBytes: 2400,
+						TxPkts: 14, RxPkts: 2},
+				},
+			},
+			ifName: "nbu1x1",
+		},
+		"Vif gone": {
+			prev:   prev,
+			cur:    appCounters{time: start.Add(4 * time.Second)},
+			ifName: "nbu1x1",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, test.prev.networkRate(test.cur,
+			test.ifName))
+	}
+}
+
+func TestAppMetricsSample(t *testing.T) {
+	start := time.Unix(1572949230, 0)
+	prev := appCounters{
+		time:     start,
+		cpuTotal: 100,
+		disks: map[string]domainmetrics.DiskMetric{
+			"xvda": {ReadBytes: 1000},
+			"xvdb": {WriteBytes: 5000},
+		},
+		vifs: map[string]types.NetworkMetric{
+			"nbu1x1": {TxBytes: 1000},
+			"nbu2x1": {RxBytes: 1000},
+		},
+	}
+	cur := appCounters{
+		time:     start.Add(time.Minute),
+		cpuTotal: 130,
+		disks: map[string]domainmetrics.DiskMetric{
+			"xvda": {ReadBytes: 7000},
+			// Reset hence not in the sum
+			"xvdb": {WriteBytes: 10},
+		},
+		vifs: map[string]types.NetworkMetric{
+			"nbu1x1": {TxBytes: 7000},
+			"nbu2x1": {RxBytes: 4000},
+		},
+	}
+	sample := prev.sample(cur)
+	assert.Equal(t, uint32(60), sample.Interval)
+	assert.InDelta(t, 50, sample.CpuPercent, 0.01)
+	assert.Equal(t, &metrics.DiskIoRate{ReadBytes: 100}, sample.Disk)
+	assert.Equal(t, &metrics.NetworkRate{TxBytes: 100, RxBytes: 50},
+		sample.Network)
+}
@@ -7,11 +7,10 @@ package zedagent
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"os"
 	"os/exec"
-	"regexp"
+	"reflect"
 	"strconv"
 	"strings"
 	"syscall"
@@ -27,8 +26,10 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cast"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/tpmmgr"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/domainmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hardware"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"github.com/lf-edge/eve/pkg/pillar/netclone"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
@@ -46,12 +47,42 @@ var reportPaths = []string{"/", "/config", persistPath}
 // Application-related files live here; includes downloads and verifications in progress
 var appPersistPaths = []string{"/persist/img", "/persist/downloads/appImg.obj"}
 
+// Created in Run; also used for the device info
+var metricsCollector domainmetrics.Collector
+
 func publishMetrics(ctx *zedagentContext, iteration int) {
-	cpuMemoryStat := ExecuteXentopCmd()
-	if cpuMemoryStat == nil {
-		return
+	domainMetrics, err := metricsCollector.Collect(lookupDomainRefs(ctx))
+	if err != nil {
+		// Report the other metrics
+		log.Errorf("publishMetrics: %s\n", err)
 	}
-	PublishMetricsToZedCloud(ctx, cpuMemoryStat, iteration)
+	PublishMetricsToZedCloud(ctx, domainMetrics, iteration)
+}
+
+// lookupDomainRefs returns the running domains from domainmgr
+func lookupDomainRefs(ctx *zedagentContext) []domainmetrics.DomainRef {
+	var domains []domainmetrics.DomainRef
+	items := ctx.subDomainStatus.GetAll()
+	for _, st := range items {
+		status := cast.CastDomainStatus(st)
+		// DomainId could be a stale pid
+		if !status.Activated {
+			continue
+		}
+		ref := domainmetrics.DomainRef{
+			Name:     status.DomainName,
+			DomainID: status.DomainId,
+		}
+		for i, ds := range status.DiskStatusList {
+			ref.Disks = append(ref.Disks, domainmetrics.DiskRef{
+				Vdev: ds.Vdev,
+				DriveID: hypervisor.QemuDriveID(status.Hypervisor,
+					i, ds),
+			})
+		}
+		domains = append(domains, ref)
+	}
+	return domains
 }
 
 // Run a periodic post of the metrics
@@ -68,6 +99,9 @@ func metricsTimerTask(ctx *zedagentContext, handleChannel chan interface{}) {
 	// Return handle to caller
 	handleChannel <- ticker
 
+	// Sample the app metrics history independently of the MetricInterval
+	appMetricsSample := time.NewTicker(appMetricsSampleInterval)
+
 	// Run a periodic timer so we always update StillRunning
 	stillRunning := time.NewTicker(25 * time.Second)
 
@@ -76,6 +110,8 @@ func metricsTimerTask(ctx *zedagentContext, handleChannel chan interface{}) {
 		case <-ticker.C:
 			iteration += 1
 			publishMetrics(ctx, iteration)
+		case <-appMetricsSample.C:
+			sampleAppMetrics(ctx)
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName + "metrics")
 		}
@@ -100,183 +136,8 @@ func updateMetricsTimer(tickerHandle interface{}) {
 	flextimer.TickNow(tickerHandle)
 }
 
-func ExecuteXlInfoCmd() map[string]string {
-	xlCmd := exec.Command("xl", "info")
-	stdout, err := xlCmd.Output()
-	if err != nil {
-		log.Errorf("xl info failed %s\n", err)
-		return nil
-	}
-	xlInfo := string(stdout)
-	splitXlInfo := strings.Split(xlInfo, "\n")
-
-	dict := make(map[string]string, len(splitXlInfo)-1)
-	for _, str := range splitXlInfo {
-		res := strings.SplitN(str, ":", 2)
-		if len(res) == 2 {
-			dict[strings.TrimSpace(res[0])] = strings.TrimSpace(res[1])
-		}
-	}
-	return dict
-}
-
-// XXX can we use libxenstat? /usr/local/lib/libxenstat.so on hikey
-// /usr/lib/libxenstat.so in container
-func ExecuteXentopCmd() [][]string {
-	var cpuMemoryStat [][]string
-
-	count := 0
-	counter := 0
-	arg1 := "xentop"
-	arg2 := "-b"
-	arg3 := "-d"
-	arg4 := "1"
-	arg5 := "-i"
-	arg6 := "2"
-	arg7 := "-f"
-
-	stdout, ok, err := execWithTimeout(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
-	if err != nil {
-		log.Errorf("xentop failed: %s", err)
-		return [][]string{}
-	}
-	if !ok {
-		log.Warnf("xentop timed out")
-		return nil
-	}
-
-	xentopInfo := string(stdout)
-
-	splitXentopInfo := strings.Split(xentopInfo, "\n")
-
-	splitXentopInfoLength := len(splitXentopInfo)
-	var i int
-	var start int
-
-	for i = 0; i < splitXentopInfoLength; i++ {
-
-		str := splitXentopInfo[i]
-		re := regexp.MustCompile(" ")
-
-		spaceRemovedsplitXentopInfo := re.ReplaceAllLiteralString(str, "")
-		matched, err := regexp.MatchString("NAMESTATECPU.*", spaceRemovedsplitXentopInfo)
-
-		if err != nil {
-			log.Debugf("MatchString failed: %s", err)
-		} else if matched {
-
-			count++
-			log.Debugf("string matched: %s", str)
-			if count == 2 {
-				start = i + 1
-				log.Debugf("value of i: %d", start)
-			}
-		}
-	}
-
-	length := splitXentopInfoLength - 1 - start
-	finalOutput := make([][]string, length)
-
-	for j := start; j < splitXentopInfoLength-1; j++ {
-
-		finalOutput[j-start] = strings.Fields(strings.TrimSpace(splitXentopInfo[j]))
-	}
-
-	cpuMemoryStat = make([][]string, length)
-
-	for i := range cpuMemoryStat {
-		cpuMemoryStat[i] = make([]string, 20)
-	}
-
-	// Need to treat "no limit" as one token
-	for f := 0; f < length; f++ {
-
-		// First name and state
-		out := 0
-		counter++
-		cpuMemoryStat[f][counter] = finalOutput[f][out]
-		out++
-		counter++
-		cpuMemoryStat[f][counter] = finalOutput[f][out]
-		out++
-		for ; out < len(finalOutput[f]); out++ {
-
-			if finalOutput[f][out] == "no" {
-
-			} else if finalOutput[f][out] == "limit" {
-				counter++
-				cpuMemoryStat[f][counter] = "no limit"
-			} else {
-				counter++
-				cpuMemoryStat[f][counter] = finalOutput[f][out]
-			}
-		}
-		counter = 0
-	}
-	log.Debugf("ExecuteXentopCmd return %+v", cpuMemoryStat)
-	return cpuMemoryStat
-}
-
-func execWithTimeout(command string, args ...string) ([]byte, bool, error) {
-
-	ctx, cancel := context.WithTimeout(context.Background(),
-		10*time.Second)
-	defer cancel()
-
-	cmd := exec.CommandContext(ctx, command, args...)
-	out, err := cmd.Output()
-	if ctx.Err() == context.DeadlineExceeded {
-		return nil, false, nil
-	}
-	return out, true, err
-}
-
-// Returns cpuTotal, usedMemory, availableMemory, usedPercentage
-func lookupCpuMemoryStat(cpuMemoryStat [][]string, domainname string) (uint64, uint32, uint32, float64) {
-
-	for _, stat := range cpuMemoryStat {
-		if len(stat) <= 2 {
-			continue
-		}
-		dn := strings.TrimSpace(stat[1])
-		if dn == domainname {
-			if len(stat) <= 6 {
-				return 0, 0, 0, 0.0
-			}
-			log.Debugf("lookupCpuMemoryStat for %s %d elem: %+v",
-				domainname, len(stat), stat)
-			cpuTotal, err := strconv.ParseUint(stat[3], 10, 0)
-			if err != nil {
-				log.Errorf("ParseUint(%s) failed: %s",
-					stat[3], err)
-				cpuTotal = 0
-			}
-			// This is in kbytes
-			totalMemory, err := strconv.ParseUint(stat[5], 10, 0)
-			if err != nil {
-				log.Errorf("ParseUint(%s) failed: %s",
-					stat[5], err)
-				totalMemory = 0
-			}
-			totalMemory = RoundFromKbytesToMbytes(totalMemory)
-			usedMemoryPercent, err := strconv.ParseFloat(stat[6], 10)
-			if err != nil {
-				log.Errorf("ParseFloat(%s) failed: %s",
-					stat[6], err)
-				usedMemoryPercent = 0
-			}
-			usedMemory := (float64(totalMemory) * (usedMemoryPercent)) / 100
-			availableMemory := float64(totalMemory) - usedMemory
-
-			return cpuTotal, uint32(usedMemory), uint32(availableMemory),
-				float64(usedMemoryPercent)
-		}
-	}
-	return 0, 0, 0, 0.0
-}
-
-func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
-	iteration int) {
+func PublishMetricsToZedCloud(ctx *zedagentContext,
+	domainMetrics domainmetrics.Metrics, iteration int) {
 
 	var ReportMetrics = &metrics.ZMetricMsg{}
 
@@ -306,32 +167,13 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 	ReportDeviceMetric.CpuMetric.UpTime = uptime
 
 	// Memory related info for the device
-	dict := ExecuteXlInfoCmd()
-	var totalMemory, freeMemory uint64
-	if dict != nil {
-		var err error
-		totalMemory, err = strconv.ParseUint(dict["total_memory"], 10, 64)
-		if err != nil {
-			log.Errorf("Failed parsing total_memory: %s", err)
-			totalMemory = 0
-		}
-		freeMemory, err = strconv.ParseUint(dict["free_memory"], 10, 64)
-		if err != nil {
-			log.Errorf("Failed parsing free_memory: %s", err)
-			freeMemory = 0
-		}
-	}
-	// total_memory and free_memory is in MBytes
-	used := totalMemory - freeMemory
+	host := domainMetrics.Host
+	used := host.TotalMemory - host.FreeMemory
 	ReportDeviceMetric.Memory.UsedMem = uint32(used)
-	ReportDeviceMetric.Memory.AvailMem = uint32(freeMemory)
-	var usedPercent float64
-	if totalMemory != 0 {
-		usedPercent = float64(100) * float64(used) / float64(totalMemory)
-	}
-	ReportDeviceMetric.Memory.UsedPercentage = usedPercent
-	ReportDeviceMetric.Memory.AvailPercentage = (100.0 - (usedPercent))
-	log.Debugf("Device Memory from xl info: %v %v %v %v",
+	ReportDeviceMetric.Memory.AvailMem = uint32(host.FreeMemory)
+	ReportDeviceMetric.Memory.UsedPercentage = host.UsedPercent
+	ReportDeviceMetric.Memory.AvailPercentage = (100.0 - (host.UsedPercent))
+	log.Debugf("Device Memory: %v %v %v %v",
 		ReportDeviceMetric.Memory.UsedMem,
 		ReportDeviceMetric.Memory.AvailMem,
 		ReportDeviceMetric.Memory.UsedPercentage,
@@ -373,51 +215,7 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 	}
 	log.Debugln("network metrics: ", ReportDeviceMetric.Network)
 
-	// Collect zedcloud metrics from ourselves and other agents
-	cms := zedcloud.GetCloudMetrics()
-	// Have to make a copy
-	cms = zedcloud.CastCloudMetrics(cms)
-	cms1 := zedcloud.CastCloudMetrics(clientMetrics)
-	if cms1 != nil {
-		cms = zedcloud.Append(cms, cms1)
-	}
-	cms1 = zedcloud.CastCloudMetrics(logmanagerMetrics)
-	if cms1 != nil {
-		cms = zedcloud.Append(cms, cms1)
-	}
-	cms1 = zedcloud.CastCloudMetrics(downloaderMetrics)
-	if cms1 != nil {
-		cms = zedcloud.Append(cms, cms1)
-	}
-	for ifname, cm := range cms {
-		metric := metrics.ZedcloudMetric{IfName: ifname,
-			Failures: cm.FailureCount,
-			Success:  cm.SuccessCount,
-		}
-		if !cm.LastFailure.IsZero() {
-			lf, _ := ptypes.TimestampProto(cm.LastFailure)
-			metric.LastFailure = lf
-		}
-		if !cm.LastSuccess.IsZero() {
-			ls, _ := ptypes.TimestampProto(cm.LastSuccess)
-			metric.LastSuccess = ls
-		}
-		for url, um := range cm.UrlCounters {
-			log.Debugf("CloudMetrics[%s] url %s %v\n",
-				ifname, url, um)
-			urlMet := new(metrics.UrlcloudMetric)
-			urlMet.Url = url
-			urlMet.TryMsgCount = um.TryMsgCount
-			urlMet.TryByteCount = um.TryByteCount
-			urlMet.SentMsgCount = um.SentMsgCount
-			urlMet.SentByteCount = um.SentByteCount
-			urlMet.RecvMsgCount = um.RecvMsgCount
-			urlMet.RecvByteCount = um.RecvByteCount
-			metric.UrlMetrics = append(metric.UrlMetrics, urlMet)
-		}
-		ReportDeviceMetric.Zedcloud = append(ReportDeviceMetric.Zedcloud,
-			&metric)
-	}
+	ReportDeviceMetric.Zedcloud = encodeCloudMetrics()
 
 	disks := findDisksPartitions()
 	for _, d := range disks {
@@ -506,18 +304,25 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 		setMetricAnyValue(item, i.Value)
 		ReportDeviceMetric.MetricItems = append(ReportDeviceMetric.MetricItems, item)
 	}
+	for _, i := range deferredMetricItems() {
+		item := new(metrics.MetricItem)
+		item.Key = i.Key
+		item.Type = metrics.MetricItemType(i.Type)
+		setMetricAnyValue(item, i.Value)
+		ReportDeviceMetric.MetricItems = append(ReportDeviceMetric.MetricItems, item)
+	}
 
-	cpuTotal, usedMemory, availableMemory, usedMemoryPercent := lookupCpuMemoryStat(cpuMemoryStat, "Domain-0")
-	log.Debugf("Domain-0 CPU from xentop: %d, percent used %d\n",
-		cpuTotal, (100*cpuTotal)/uint64(info.Uptime))
-	ReportDeviceMetric.CpuMetric.Total = *proto.Uint64(cpuTotal)
+	services := domainMetrics.Services()
+	log.Debugf("%s CPU: %d, percent used %d\n", host.ServicesName,
+		services.CPUTotal, (100*services.CPUTotal)/uint64(info.Uptime))
+	ReportDeviceMetric.CpuMetric.Total = *proto.Uint64(services.CPUTotal)
 
 	ReportDeviceMetric.SystemServicesMemoryMB = new(metrics.MemoryMetric)
-	ReportDeviceMetric.SystemServicesMemoryMB.UsedMem = usedMemory
-	ReportDeviceMetric.SystemServicesMemoryMB.AvailMem = availableMemory
-	ReportDeviceMetric.SystemServicesMemoryMB.UsedPercentage = usedMemoryPercent
-	ReportDeviceMetric.SystemServicesMemoryMB.AvailPercentage = (100.0 - (usedMemoryPercent))
-	log.Debugf("dom-0 Memory from xentop: %v %v %v %v",
+	ReportDeviceMetric.SystemServicesMemoryMB.UsedMem = services.UsedMemory
+	ReportDeviceMetric.SystemServicesMemoryMB.AvailMem = services.AvailableMemory()
+	ReportDeviceMetric.SystemServicesMemoryMB.UsedPercentage = services.UsedMemoryPercent
+	ReportDeviceMetric.SystemServicesMemoryMB.AvailPercentage = (100.0 - (services.UsedMemoryPercent))
+	log.Debugf("%s Memory: %v %v %v %v", host.ServicesName,
 		ReportDeviceMetric.SystemServicesMemoryMB.UsedMem,
 		ReportDeviceMetric.SystemServicesMemoryMB.AvailMem,
 		ReportDeviceMetric.SystemServicesMemoryMB.UsedPercentage,
@@ -529,6 +334,7 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 	}
 
 	// Loop over AppInstanceStatus so we report before the instance has booted
+	now := time.Now()
 	sub := ctx.getconfigCtx.subAppInstanceStatus
 	items := sub.GetAll()
 	for _, st := range items {
@@ -546,30 +352,27 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 			ReportAppMetric.Cpu.UpTime = uptime
 		}
 
-		appCpuTotal, usedMemory, availableMemory, usedMemoryPercent := lookupCpuMemoryStat(cpuMemoryStat, aiStatus.DomainName)
-		log.Debugf("xentop for %s CPU %d, usedMem %v, availMem %v, availMemPercent %v",
-			aiStatus.DomainName, appCpuTotal, usedMemory,
-			availableMemory, usedMemoryPercent)
-		ReportAppMetric.Cpu.Total = *proto.Uint64(appCpuTotal)
-		ReportAppMetric.Memory.UsedMem = usedMemory
-		ReportAppMetric.Memory.AvailMem = availableMemory
-		ReportAppMetric.Memory.UsedPercentage = usedMemoryPercent
-		availableMemoryPercent := 100.0 - usedMemoryPercent
+		dm := domainMetrics.Domains[aiStatus.DomainName]
+		log.Debugf("Metrics for %s CPU %d, usedMem %v, availMem %v, usedMemPercent %v",
+			aiStatus.DomainName, dm.CPUTotal, dm.UsedMemory,
+			dm.AvailableMemory(), dm.UsedMemoryPercent)
+		ReportAppMetric.Cpu.Total = *proto.Uint64(dm.CPUTotal)
+		ReportAppMetric.Memory.UsedMem = dm.UsedMemory
+		ReportAppMetric.Memory.AvailMem = dm.AvailableMemory()
+		ReportAppMetric.Memory.UsedPercentage = dm.UsedMemoryPercent
+		availableMemoryPercent := 100.0 - dm.UsedMemoryPercent
 		ReportAppMetric.Memory.AvailPercentage = availableMemoryPercent
 
+		counters := lookupAppCounters(aiStatus, dm, now)
+		prev := swapReportedCounters(aiStatus.Key(), counters)
+
 		appInterfaceList := aiStatus.GetAppInterfaceList()
 		log.Debugf("ReportMetrics: domainName %s ifs %v\n",
 			aiStatus.DomainName, appInterfaceList)
 		// Use the network metrics from zedrouter subscription
 		for _, ifName := range appInterfaceList {
-			var metric *types.NetworkMetric
-			for _, m := range networkMetrics.MetricList {
-				if ifName == m.IfName {
-					metric = &m
-					break
-				}
-			}
-			if metric == nil {
+			metric, ok := counters.vifs[ifName]
+			if !ok {
 				continue
 			}
 			networkDetails := new(metrics.NetworkMetric)
@@ -579,38 +382,19 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 				metric.IfName, name)
 			networkDetails.IName = name
 			networkDetails.LocalName = metric.IfName
-			// Counters not swapped on vif
-			if strings.HasPrefix(ifName, "nbn") ||
-				strings.HasPrefix(ifName, "nbu") ||
-				strings.HasPrefix(ifName, "nbo") {
-				networkDetails.TxPkts = metric.TxPkts
-				networkDetails.RxPkts = metric.RxPkts
-				networkDetails.TxBytes = metric.TxBytes
-				networkDetails.RxBytes = metric.RxBytes
-				networkDetails.TxDrops = metric.TxDrops
-				networkDetails.RxDrops = metric.RxDrops
-				networkDetails.TxErrors = metric.TxErrors
-				networkDetails.RxErrors = metric.RxErrors
-				networkDetails.TxAclDrops = metric.TxAclDrops
-				networkDetails.RxAclDrops = metric.RxAclDrops
-				networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
-				networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
-			} else {
-				// Note that the packets received on bu* and bo* where sent
-				// by the domU and vice versa, hence we swap here
-				networkDetails.TxPkts = metric.RxPkts
-				networkDetails.RxPkts = metric.TxPkts
-				networkDetails.TxBytes = metric.RxBytes
-				networkDetails.RxBytes = metric.TxBytes
-				networkDetails.TxDrops = metric.RxDrops
-				networkDetails.RxDrops = metric.TxDrops
-				networkDetails.TxErrors = metric.RxErrors
-				networkDetails.RxErrors = metric.TxErrors
-				networkDetails.TxAclDrops = metric.RxAclDrops
-				networkDetails.RxAclDrops = metric.TxAclDrops
-				networkDetails.TxAclRateLimitDrops = metric.RxAclRateLimitDrops
-				networkDetails.RxAclRateLimitDrops = metric.TxAclRateLimitDrops
-			}
+			networkDetails.TxPkts = metric.TxPkts
+			networkDetails.RxPkts = metric.RxPkts
+			networkDetails.TxBytes = metric.TxBytes
+			networkDetails.RxBytes = metric.RxBytes
+			networkDetails.TxDrops = metric.TxDrops
+			networkDetails.RxDrops = metric.RxDrops
+			networkDetails.TxErrors = metric.TxErrors
+			networkDetails.RxErrors = metric.RxErrors
+			networkDetails.TxAclDrops = metric.TxAclDrops
+			networkDetails.RxAclDrops = metric.RxAclDrops
+			networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
+			networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
+			networkDetails.Rate = prev.networkRate(counters, ifName)
 			ReportAppMetric.Network = append(ReportAppMetric.Network,
 				networkDetails)
 		}
@@ -624,18 +408,76 @@ func PublishMetricsToZedCloud(ctx *zedagentContext, cpuMemoryStat [][]string,
 					diskfile, err)
 				continue
 			}
+			if disk, ok := counters.disks[ss.Vdev]; ok {
+				appDiskDetails.ReadBytes = disk.ReadBytes
+				appDiskDetails.WriteBytes = disk.WriteBytes
+				appDiskDetails.ReadCount = disk.ReadOps
+				appDiskDetails.WriteCount = disk.WriteOps
+				appDiskDetails.Rate = prev.diskIoRate(counters, ss.Vdev)
+			}
 			ReportAppMetric.Disk = append(ReportAppMetric.Disk,
 				appDiskDetails)
 		}
+		ReportAppMetric.History = appMetricsHistory(aiStatus.Key())
 		ReportMetrics.Am = append(ReportMetrics.Am, ReportAppMetric)
 	}
 
 	createNetworkInstanceMetrics(ctx, ReportMetrics)
 
+	saveMetricsForExport(ReportMetrics)
 	log.Debugf("PublishMetricsToZedCloud sending %s\n", ReportMetrics)
 	SendMetricsProtobuf(ReportMetrics, iteration)
 }
 
+// Collect zedcloud metrics from ourselves and other agents
+func encodeCloudMetrics() []*metrics.ZedcloudMetric {
+	var zedcloudMetrics []*metrics.ZedcloudMetric
+	cms := zedcloud.GetCloudMetrics()
+	// Have to make a copy
+	cms = zedcloud.CastCloudMetrics(cms)
+	cms1 := zedcloud.CastCloudMetrics(clientMetrics)
+	if cms1 != nil {
+		cms = zedcloud.Append(cms, cms1)
+	}
+	cms1 = zedcloud.CastCloudMetrics(logmanagerMetrics)
+	if cms1 != nil {
+		cms = zedcloud.Append(cms, cms1)
+	}
+	cms1 = zedcloud.CastCloudMetrics(downloaderMetrics)
+	if cms1 != nil {
+		cms = zedcloud.Append(cms, cms1)
+	}
+	for ifname, cm := range cms {
+		metric := metrics.ZedcloudMetric{IfName: ifname,
+			Failures: cm.FailureCount,
+			Success:  cm.SuccessCount,
+		}
+		if !cm.LastFailure.IsZero() {
+			lf, _ := ptypes.TimestampProto(cm.LastFailure)
+			metric.LastFailure = lf
+		}
+		if !cm.LastSuccess.IsZero() {
+			ls, _ := ptypes.TimestampProto(cm.LastSuccess)
+			metric.LastSuccess = ls
+		}
+		for url, um := range cm.UrlCounters {
+			log.Debugf("CloudMetrics[%s] url %s %v\n",
+				ifname, url, um)
+			urlMet := new(metrics.UrlcloudMetric)
+			urlMet.Url = url
+			urlMet.TryMsgCount = um.TryMsgCount
+			urlMet.TryByteCount = um.TryByteCount
+			urlMet.SentMsgCount = um.SentMsgCount
+			urlMet.SentByteCount = um.SentByteCount
+			urlMet.RecvMsgCount = um.RecvMsgCount
+			urlMet.RecvByteCount = um.RecvByteCount
+			metric.UrlMetrics = append(metric.UrlMetrics, urlMet)
+		}
+		zedcloudMetrics = append(zedcloudMetrics, &metric)
+	}
+	return zedcloudMetrics
+}
+
 func getDiskInfo(diskfile string, appDiskDetails *metrics.AppDiskMetric) error {
 	imgInfo, err := diskmetrics.GetImgInfo(diskfile)
 	if err != nil {
@@ -707,21 +549,14 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 		ReportDeviceInfo.Platform = *proto.String(strings.TrimSpace(platform))
 	}
 
-	dict := ExecuteXlInfoCmd()
-	if dict != nil {
+	if host, err := metricsCollector.Host(); err != nil {
+		log.Errorf("PublishDeviceInfoToZedCloud: %s\n", err)
+	} else {
 		// Note that this is the set of physical CPUs which is different
 		// than the set of CPUs assigned to dom0
-		ncpus, err := strconv.ParseUint(dict["nr_cpus"], 10, 32)
-		if err != nil {
-			log.Errorln("error while converting ncpus to int: ", err)
-		} else {
-			ReportDeviceInfo.Ncpu = *proto.Uint32(uint32(ncpus))
-		}
-		totalMemory, err := strconv.ParseUint(dict["total_memory"], 10, 64)
-		if err == nil {
-			// totalMemory is in MBytes
-			ReportDeviceInfo.Memory = *proto.Uint64(uint64(totalMemory))
-		}
+		ReportDeviceInfo.Ncpu = *proto.Uint32(host.Ncpus)
+		// In MBytes
+		ReportDeviceInfo.Memory = *proto.Uint64(host.TotalMemory)
 	}
 
 	// Find all disks and partitions
@@ -1120,6 +955,30 @@ func addUserSwInfo(ctx *zedagentContext, swInfo *info.ZInfoDevSW) {
 	}
 }
 
+// The queues of messages waiting to be sent to the controller by
+// ourselves and logmanager
+func deferredMetricItems() []types.MetricItem {
+	var items []types.MetricItem
+	agents := map[string]zedcloud.DeferredMetrics{
+		agentName: zedcloud.GetDeferredMetrics(),
+	}
+	if logmanagerDeferredMetrics != nil {
+		agents["logmanager"] = zedcloud.CastDeferredMetrics(logmanagerDeferredMetrics)
+	}
+	for agent, dm := range agents {
+		items = append(items,
+			types.MetricItem{Key: agent + "-deferred-items",
+				Type: types.MetricItemGauge, Value: dm.QueuedItems},
+			types.MetricItem{Key: agent + "-deferred-bytes",
+				Type: types.MetricItemGauge, Value: dm.QueuedBytes},
+			types.MetricItem{Key: agent + "-deferred-dropped-items",
+				Type: types.MetricItemCounter, Value: dm.DroppedItems},
+			types.MetricItem{Key: agent + "-deferred-dropped-bytes",
+				Type: types.MetricItemCounter, Value: dm.DroppedBytes})
+	}
+	return items
+}
+
 func setMetricAnyValue(item *metrics.MetricItem, val interface{}) {
 	switch t := val.(type) {
 	case uint32:
@@ -1302,9 +1161,54 @@ func encodeNetworkPortConfig(npc *types.NetworkPortConfig) *info.DevicePort {
 	// XXX  string dhcpRangeHigh = 18;
 
 	dp.Proxy = encodeProxyStatus(&npc.ProxyConfig)
+	if len(npc.Bond.Members) != 0 {
+		dp.BondMembers = encodeBondMembers(npc)
+	}
 	return dp
 }
 
+// encodeBondMembers takes the link state from the DeviceNetworkStatus if
+// the port there has the same members
+func encodeBondMembers(npc *types.NetworkPortConfig) []*info.BondMemberInfo {
+	var members []types.BondMemberStatus
+	portStatus := deviceNetworkStatus.GetPortByIfName(npc.IfName)
+	if portStatus != nil && reflect.DeepEqual(portStatus.Bond, npc.Bond) {
+		members = portStatus.BondMembers
+	}
+	var reportMembers []*info.BondMemberInfo
+	for _, ifname := range npc.Bond.Members {
+		reportMember := new(info.BondMemberInfo)
+		reportMember.Ifname = ifname
+		for _, m := range members {
+			if m.IfName == ifname {
+				reportMember.Up = m.Up
+				reportMember.Active = m.Active
+			}
+		}
+		reportMembers = append(reportMembers, reportMember)
+	}
+	return reportMembers
+}
+
+// encodeAppHealth leaves out the times which are not set
+func encodeAppHealth(health types.AppHealthStatus) *info.ZInfoAppHealth {
+	reportHealth := new(info.ZInfoAppHealth)
+	reportHealth.State = info.ZAppHealthState(health.State)
+	reportHealth.LastProbeError = health.LastProbeError
+	reportHealth.ConsecutiveFailures = health.ConsecutiveFailures
+	reportHealth.RestartCount = health.RestartCount
+	if !health.LastProbeTime.IsZero() {
+		reportHealth.LastProbeTime, _ = ptypes.TimestampProto(health.LastProbeTime)
+	}
+	if !health.LastRestartTime.IsZero() {
+		reportHealth.LastRestartTime, _ = ptypes.TimestampProto(health.LastRestartTime)
+	}
+	if !health.NextRestartTime.IsZero() {
+		reportHealth.NextRestartTime, _ = ptypes.TimestampProto(health.NextRestartTime)
+	}
+	return reportHealth
+}
+
 // This function is called per change, hence needs to try over all management ports
 // When aiStatus is nil it means a delete and we send a message
 // containing only the UUID to inform zedcloud about the delete.
@@ -1363,6 +1267,7 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			bootTime, _ := ptypes.TimestampProto(aiStatus.BootTime)
 			ReportAppInfo.BootTime = bootTime
 		}
+		ReportAppInfo.Health = encodeAppHealth(aiStatus.Health)
 
 		for _, ia := range aiStatus.IoAdapterList {
 			reportAA := new(info.ZioBundle)
@@ -1404,6 +1309,10 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 					ifname)
 				networkInfo.IPAddrs = make([]string, 1)
 				networkInfo.IPAddrs[0] = *proto.String(ip)
+				if ip6 := getAppIPv6(aiStatus, ifname); ip6 != "" {
+					networkInfo.IPAddrs = append(networkInfo.IPAddrs,
+						ip6)
+				}
 				networkInfo.MacAddr = *proto.String(macAddr)
 				networkInfo.Up = allocated
 				name := appIfnameToName(aiStatus, ifname)
@@ -1607,3 +1516,14 @@ func getAppIP(ctx *zedagentContext, aiStatus *types.AppInstanceStatus,
 	}
 	return "", false, ""
 }
+
+// getAppIPv6 returns the address of a dual-stack underlay or ""
+func getAppIPv6(aiStatus *types.AppInstanceStatus, vifname string) string {
+
+	for _, ulStatus := range aiStatus.UnderlayNetworks {
+		if ulStatus.Vif == vifname {
+			return ulStatus.AllocatedIPv6Addr
+		}
+	}
+	return ""
+}
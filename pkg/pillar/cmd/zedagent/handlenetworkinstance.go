@@ -97,6 +97,10 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 			assignment := new(zinfo.ZmetIPAssignmentEntry)
 			assignment.MacAddress = mac
 			assignment.IpAddress = append(assignment.IpAddress, ip.String())
+			if ip6, ok := status.IPv6Assignments[mac]; ok {
+				assignment.IpAddress = append(assignment.IpAddress,
+					ip6.String())
+			}
 			info.IpAssignments = append(info.IpAssignments,
 				assignment)
 		}
@@ -713,6 +717,8 @@ func handleAppFlowMonitorModify(ctxArg interface{}, key string,
 		return
 	}
 
+	accumulateFlowMetrics(flows)
+
 	// encoding the flows with protobuf format
 	pflows := protoEncodeAppFlowMonitorProto(flows)
 
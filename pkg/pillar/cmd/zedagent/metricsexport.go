@@ -0,0 +1,475 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Local metrics endpoint in the Prometheus text format. Listens on
+// the address of the management port in metrics.export.interface when
+// metrics.export.port is set. Each time the metrics are built for the
+// controller they are rendered together with the zedcloud and flow
+// counters, hence it works when we can't reach the controller. The
+// handler only serves the last rendered copy.
+
+package zedagent
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const metricsExportPath = "/metrics"
+
+var (
+	// Rendered by saveMetricsForExport and served by serveMetrics
+	metricsSnapshotLock sync.Mutex
+	metricsSnapshot     []byte
+
+	// Listener; updated from the main and config goroutines
+	metricsExportLock   sync.Mutex
+	metricsExportAddr   string
+	metricsExportServer *http.Server
+
+	// Flow counters since we started
+	flowMetricsLock sync.Mutex
+	flowMetrics     = make(map[flowMetricsKey]*flowCounters)
+)
+
+type flowMetricsKey struct {
+	appUUID     string
+	networkUUID string
+}
+
+type flowCounters struct {
+	flows   uint64
+	dropped uint64 // Flows which hit a drop rule
+	dnsReqs uint64
+	txBytes uint64
+	txPkts  uint64
+	rxBytes uint64
+	rxPkts  uint64
+}
+
+// saveMetricsForExport is called each time we build the metrics for the
+// controller
+func saveMetricsForExport(reportMetrics *metrics.ZMetricMsg) {
+	snapshot := renderMetrics(reportMetrics, time.Now())
+	metricsSnapshotLock.Lock()
+	metricsSnapshot = snapshot
+	metricsSnapshotLock.Unlock()
+}
+
+// accumulateFlowMetrics adds the flows zedrouter reports for an app
+func accumulateFlowMetrics(ipflow types.IPFlow) {
+	key := flowMetricsKey{
+		appUUID:     ipflow.Scope.UUID.String(),
+		networkUUID: ipflow.Scope.NetUUID.String(),
+	}
+	flowMetricsLock.Lock()
+	defer flowMetricsLock.Unlock()
+	c, ok := flowMetrics[key]
+	if !ok {
+		c = &flowCounters{}
+		flowMetrics[key] = c
+	}
+	for _, f := range ipflow.Flows {
+		c.flows++
+		if strings.HasPrefix(strings.ToLower(f.Action), "drop") {
+			c.dropped++
+		}
+		c.txBytes += uint64(f.TxBytes)
+		c.txPkts += uint64(f.TxPkts)
+		c.rxBytes += uint64(f.RxBytes)
+		c.rxPkts += uint64(f.RxPkts)
+	}
+	c.dnsReqs += uint64(len(ipflow.DNSReqs))
+}
+
+// updateMetricsExport starts, moves or stops the listener based on
+// globalConfig and the addresses of the management port
+func updateMetricsExport() {
+	addr := ""
+	port := globalConfig.MetricsExportPort
+	intf := globalConfig.MetricsExportInterface
+	if port != 0 && intf != "" {
+		ip, err := types.GetLocalAddrAnyNoLinkLocal(*deviceNetworkStatus,
+			0, intf)
+		if err != nil {
+			log.Warnf("updateMetricsExport: no address on %s: %s\n",
+				intf, err)
+		} else {
+			addr = net.JoinHostPort(ip.String(),
+				strconv.Itoa(int(port)))
+		}
+	}
+
+	metricsExportLock.Lock()
+	defer metricsExportLock.Unlock()
+	if addr == metricsExportAddr {
+		return
+	}
+	if metricsExportServer != nil {
+		log.Infof("updateMetricsExport: stop on %s\n", metricsExportAddr)
+		metricsExportServer.Close()
+		metricsExportServer = nil
+	}
+	metricsExportAddr = addr
+	if addr == "" {
+		return
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Errorf("updateMetricsExport: listen on %s: %s\n", addr, err)
+		// Retry on the next change
+		metricsExportAddr = ""
+		return
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(metricsExportPath, serveMetrics)
+	metricsExportServer = &http.Server{Handler: mux}
+	log.Infof("updateMetricsExport: serving %s on %s\n",
+		metricsExportPath, addr)
+	go func(server *http.Server) {
+		err := server.Serve(listener)
+		if err != http.ErrServerClosed {
+			log.Errorf("updateMetricsExport: serve: %s\n", err)
+		}
+	}(metricsExportServer)
+}
+
+func serveMetrics(w http.ResponseWriter, r *http.Request) {
+	metricsSnapshotLock.Lock()
+	snapshot := metricsSnapshot
+	metricsSnapshotLock.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	w.Write(snapshot)
+}
+
+// renderMetrics returns the metrics in the Prometheus text format
+func renderMetrics(reportMetrics *metrics.ZMetricMsg,
+	builtTime time.Time) []byte {
+
+	var buf bytes.Buffer
+	p := newPromWriter()
+	p.add("eve_metrics_timestamp_seconds", "gauge",
+		"When the device and app metrics were collected",
+		float64(builtTime.Unix()))
+	exportDeviceMetrics(p, reportMetrics.GetDm())
+	for _, am := range reportMetrics.Am {
+		exportAppMetrics(p, am)
+	}
+	for _, nm := range reportMetrics.Nm {
+		exportNetworkInstanceMetrics(p, nm)
+	}
+	exportCloudMetrics(p, encodeCloudMetrics())
+	exportFlowMetrics(p)
+	p.write(&buf)
+	return buf.Bytes()
+}
+
+func exportDeviceMetrics(p *promWriter, dm *metrics.DeviceMetric) {
+	if dm == nil {
+		return
+	}
+	if cpu := dm.CpuMetric; cpu != nil {
+		if cpu.UpTime != nil {
+			p.add("eve_device_uptime_seconds", "gauge",
+				"Device uptime", float64(cpu.UpTime.Seconds))
+		}
+		p.add("eve_device_cpu_seconds_total", "counter",
+			"CPU used by the system services", float64(cpu.Total))
+	}
+	if mem := dm.Memory; mem != nil {
+		p.add("eve_device_memory_used_mbytes", "gauge",
+			"Device memory used", float64(mem.UsedMem))
+		p.add("eve_device_memory_available_mbytes", "gauge",
+			"Device memory available", float64(mem.AvailMem))
+	}
+	for _, nm := range dm.Network {
+		exportNetworkMetric(p, "eve_device_network", nm,
+			"port", nm.IName, "ifname", nm.LocalName)
+	}
+	for _, d := range dm.Disk {
+		labels := []string{"disk", d.Disk, "mountpath", d.MountPath}
+		p.add("eve_device_disk_total_mbytes", "gauge",
+			"Size of the disk or file system", float64(d.Total), labels...)
+		if d.MountPath != "" {
+			p.add("eve_device_disk_used_mbytes", "gauge",
+				"Used in the file system", float64(d.Used), labels...)
+			p.add("eve_device_disk_free_mbytes", "gauge",
+				"Free in the file system", float64(d.Free), labels...)
+		} else {
+			p.add("eve_device_disk_read_mbytes_total", "counter",
+				"Read from the disk", float64(d.ReadBytes), labels...)
+			p.add("eve_device_disk_write_mbytes_total", "counter",
+				"Written to the disk", float64(d.WriteBytes), labels...)
+		}
+	}
+	for _, item := range dm.MetricItems {
+		value, ok := metricItemValue(item)
+		if !ok {
+			continue
+		}
+		if item.Type == metrics.MetricItemType_MetricItemCounter {
+			p.add("eve_device_metric_item_total", "counter",
+				"Other device counters by key", value, "key", item.Key)
+		} else {
+			p.add("eve_device_metric_item", "gauge",
+				"Other device metrics by key", value, "key", item.Key)
+		}
+	}
+}
+
+func exportAppMetrics(p *promWriter, am *metrics.AppMetric) {
+	app := []string{"app_uuid", am.AppID, "app_name", am.AppName}
+	if cpu := am.Cpu; cpu != nil {
+		if cpu.UpTime != nil {
+			p.add("eve_app_uptime_seconds", "gauge",
+				"App instance uptime", float64(cpu.UpTime.Seconds),
+				app...)
+		}
+		p.add("eve_app_cpu_seconds_total", "counter",
+			"CPU used by the app instance", float64(cpu.Total), app...)
+	}
+	if mem := am.Memory; mem != nil {
+		p.add("eve_app_memory_used_mbytes", "gauge",
+			"App instance memory used", float64(mem.UsedMem), app...)
+		p.add("eve_app_memory_available_mbytes", "gauge",
+			"App instance memory available", float64(mem.AvailMem),
+			app...)
+	}
+	for _, nm := range am.Network {
+		labels := append([]string{"name", nm.IName, "ifname", nm.LocalName},
+			app...)
+		exportNetworkMetric(p, "eve_app_network", nm, labels...)
+	}
+	for _, d := range am.Disk {
+		labels := append([]string{"disk", d.Disk}, app...)
+		p.add("eve_app_disk_provisioned_mbytes", "gauge",
+			"Provisioned size of the app instance disk",
+			float64(d.Provisioned), labels...)
+		p.add("eve_app_disk_used_mbytes", "gauge",
+			"Used in the app instance disk", float64(d.Used), labels...)
+	}
+}
+
+func exportNetworkMetric(p *promWriter, prefix string,
+	nm *metrics.NetworkMetric, labels ...string) {
+
+	p.add(prefix+"_tx_bytes_total", "counter", "Bytes sent",
+		float64(nm.TxBytes), labels...)
+	p.add(prefix+"_rx_bytes_total", "counter", "Bytes received",
+		float64(nm.RxBytes), labels...)
+	p.add(prefix+"_tx_packets_total", "counter", "Packets sent",
+		float64(nm.TxPkts), labels...)
+	p.add(prefix+"_rx_packets_total", "counter", "Packets received",
+		float64(nm.RxPkts), labels...)
+	p.add(prefix+"_tx_drops_total", "counter", "Packets dropped on send",
+		float64(nm.TxDrops), labels...)
+	p.add(prefix+"_rx_drops_total", "counter", "Packets dropped on receive",
+		float64(nm.RxDrops), labels...)
+	p.add(prefix+"_tx_errors_total", "counter", "Errors on send",
+		float64(nm.TxErrors), labels...)
+	p.add(prefix+"_rx_errors_total", "counter", "Errors on receive",
+		float64(nm.RxErrors), labels...)
+	p.add(prefix+"_tx_acl_drops_total", "counter",
+		"Packets sent dropped by ACLs",
+		float64(nm.TxAclDrops+nm.TxAclRateLimitDrops), labels...)
+	p.add(prefix+"_rx_acl_drops_total", "counter",
+		"Packets received dropped by ACLs",
+		float64(nm.RxAclDrops+nm.RxAclRateLimitDrops), labels...)
+}
+
+func exportNetworkInstanceMetrics(p *promWriter,
+	nm *metrics.ZMetricNetworkInstance) {
+
+	labels := []string{"network_uuid", nm.NetworkID,
+		"network_name", nm.Displayname,
+		"type", strconv.Itoa(int(nm.InstType))}
+	stats := nm.NetworkStats
+	if stats == nil {
+		return
+	}
+	for _, dir := range []struct {
+		name  string
+		stats *metrics.NetworkStats
+	}{{"tx", stats.Tx}, {"rx", stats.Rx}} {
+		if dir.stats == nil {
+			continue
+		}
+		prefix := "eve_network_instance_" + dir.name
+		p.add(prefix+"_bytes_total", "counter",
+			"Bytes through the network instance",
+			float64(dir.stats.TotalBytes), labels...)
+		p.add(prefix+"_packets_total", "counter",
+			"Packets through the network instance",
+			float64(dir.stats.TotalPackets), labels...)
+		p.add(prefix+"_drops_total", "counter",
+			"Packets dropped by the network instance",
+			float64(dir.stats.Drops), labels...)
+		p.add(prefix+"_errors_total", "counter",
+			"Errors in the network instance",
+			float64(dir.stats.Errors), labels...)
+	}
+}
+
+func exportCloudMetrics(p *promWriter, zedcloudMetrics []*metrics.ZedcloudMetric) {
+	for _, zm := range zedcloudMetrics {
+		intf := []string{"ifname", zm.IfName}
+		p.add("eve_zedcloud_success_total", "counter",
+			"Successful requests to the controller",
+			float64(zm.Success), intf...)
+		p.add("eve_zedcloud_failure_total", "counter",
+			"Failed requests to the controller",
+			float64(zm.Failures), intf...)
+		if zm.LastSuccess != nil {
+			if t, err := ptypes.Timestamp(zm.LastSuccess); err == nil {
+				p.add("eve_zedcloud_last_success_timestamp_seconds",
+					"gauge", "Last successful request",
+					float64(t.Unix()), intf...)
+			}
+		}
+		if zm.LastFailure != nil {
+			if t, err := ptypes.Timestamp(zm.LastFailure); err == nil {
+				p.add("eve_zedcloud_last_failure_timestamp_seconds",
+					"gauge", "Last failed request",
+					float64(t.Unix()), intf...)
+			}
+		}
+		for _, um := range zm.UrlMetrics {
+			labels := append([]string{"url", um.Url}, intf...)
+			p.add("eve_zedcloud_url_sent_messages_total", "counter",
+				"Messages sent to the URL",
+				float64(um.SentMsgCount), labels...)
+			p.add("eve_zedcloud_url_sent_bytes_total", "counter",
+				"Bytes sent to the URL",
+				float64(um.SentByteCount), labels...)
+			p.add("eve_zedcloud_url_recv_messages_total", "counter",
+				"Messages received from the URL",
+				float64(um.RecvMsgCount), labels...)
+			p.add("eve_zedcloud_url_recv_bytes_total", "counter",
+				"Bytes received from the URL",
+				float64(um.RecvByteCount), labels...)
+		}
+	}
+}
+
+func exportFlowMetrics(p *promWriter) {
+	flowMetricsLock.Lock()
+	defer flowMetricsLock.Unlock()
+	for key, c := range flowMetrics {
+		labels := []string{"app_uuid", key.appUUID,
+			"network_uuid", key.networkUUID}
+		p.add("eve_flow_records_total", "counter",
+			"Flows reported for the app instance",
+			float64(c.flows), labels...)
+		p.add("eve_flow_dropped_total", "counter",
+			"Flows which hit a drop rule", float64(c.dropped), labels...)
+		p.add("eve_flow_dns_requests_total", "counter",
+			"DNS requests from the app instance",
+			float64(c.dnsReqs), labels...)
+		p.add("eve_flow_tx_bytes_total", "counter",
+			"Bytes sent in the flows", float64(c.txBytes), labels...)
+		p.add("eve_flow_rx_bytes_total", "counter",
+			"Bytes received in the flows", float64(c.rxBytes), labels...)
+		p.add("eve_flow_tx_packets_total", "counter",
+			"Packets sent in the flows", float64(c.txPkts), labels...)
+		p.add("eve_flow_rx_packets_total", "counter",
+			"Packets received in the flows", float64(c.rxPkts),
+			labels...)
+	}
+}
+
+// metricItemValue returns false for strings which Prometheus can't
+// represent
+func metricItemValue(item *metrics.MetricItem) (float64, bool) {
+	switch v := item.GetMetricItemValue().(type) {
+	case *metrics.MetricItem_BoolValue:
+		if v.BoolValue {
+			return 1, true
+		}
+		return 0, true
+	case *metrics.MetricItem_Uint32Value:
+		return float64(v.Uint32Value), true
+	case *metrics.MetricItem_Uint64Value:
+		return float64(v.Uint64Value), true
+	case *metrics.MetricItem_FloatValue:
+		return float64(v.FloatValue), true
+	default:
+		return 0, false
+	}
+}
+
+// promWriter groups the samples by metric name since the text format
+// requires all samples of a metric to follow its HELP and TYPE lines
+type promWriter struct {
+	families map[string]*promFamily
+}
+
+type promFamily struct {
+	help    string
+	typ     string
+	samples []string
+}
+
+func newPromWriter() *promWriter {
+	return &promWriter{families: make(map[string]*promFamily)}
+}
+
+// add a sample with labels given as name, value pairs. Labels with an
+// empty value are omitted.
+func (p *promWriter) add(name string, typ string, help string,
+	value float64, labels ...string) {
+
+	f, ok := p.families[name]
+	if !ok {
+		f = &promFamily{help: help, typ: typ}
+		p.families[name] = f
+	}
+	var pairs []string
+	for i := 0; i+1 < len(labels); i += 2 {
+		if labels[i+1] == "" {
+			continue
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", labels[i],
+			escapeLabelValue(labels[i+1])))
+	}
+	sample := name
+	if len(pairs) != 0 {
+		sample += "{" + strings.Join(pairs, ",") + "}"
+	}
+	sample += " " + strconv.FormatFloat(value, 'g', -1, 64)
+	f.samples = append(f.samples, sample)
+}
+
+func (p *promWriter) write(w io.Writer) {
+	var names []string
+	for name := range p.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := p.families[name]
+		fmt.Fprintf(w, "# HELP %s %s\n", name, f.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", name, f.typ)
+		for _, sample := range f.samples {
+			fmt.Fprintln(w, sample)
+		}
+	}
+}
+
+func escapeLabelValue(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	return strings.Replace(value, "\n", `\n`, -1)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+type promSample struct {
+	name   string
+	typ    string
+	help   string
+	value  float64
+	labels []string
+}
+
+func TestPromWriter(t *testing.T) {
+	testMatrix := map[string]struct {
+		samples  []promSample
+		expected []string
+	}{
+		"No samples": {
+			expected: nil,
+		},
+		"Sorted and grouped by name": {
+			samples: []promSample{
+				{name: "b_total", typ: "counter", help: "B", value: 1,
+					labels: []string{"port", "eth0"}},
+				{name: "a", typ: "gauge", help: "A", value: 2},
+				{name: "b_total", typ: "counter", help: "B", value: 3,
+					labels: []string{"port", "eth1"}},
+			},
+			expected: []string{
+				"# HELP a A",
+				"# TYPE a gauge",
+				"a 2",
+				"# HELP b_total B",
+				"# TYPE b_total counter",
+				`b_total{port="eth0"} 1`,
+				`b_total{port="eth1"} 3`,
+			},
+		},
+		"Empty label values omitted": {
+			samples: []promSample{
+				{name: "m", typ: "gauge", help: "M", value: 1,
+					labels: []string{"disk", "sda", "mountpath", ""}},
+				{name: "m", typ: "gauge", help: "M", value: 2,
+					labels: []string{"disk", ""}},
+			},
+			expected: []string{
+				"# HELP m M",
+				"# TYPE m gauge",
+				`m{disk="sda"} 1`,
+				"m 2",
+			},
+		},
+		"Escaped label values": {
+			samples: []promSample{
+				{name: "m", typ: "gauge", help: "M", value: 1,
+					labels: []string{"app_name", "my \"app\"\\\nx"}},
+			},
+			expected: []string{
+				"# HELP m M",
+				"# TYPE m gauge",
+				`m{app_name="my \"app\"\\\nx"} 1`,
+			},
+		},
+		"Values": {
+			samples: []promSample{
+				{name: "m", typ: "gauge", help: "M", value: 0.5,
+					labels: []string{"v", "half"}},
+				{name: "m", typ: "gauge", help: "M", value: 12345678,
+					labels: []string{"v", "large"}},
+				{name: "m", typ: "gauge", help: "M", value: 0,
+					labels: []string{"v", "zero"}},
+			},
+			expected: []string{
+				"# HELP m M",
+				"# TYPE m gauge",
+				`m{v="half"} 0.5`,
+				`m{v="large"} 1.2345678e+07`,
+				`m{v="zero"} 0`,
+			},
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		p := newPromWriter()
+		for _, s := range test.samples {
+			p.add(s.name, s.typ, s.help, s.value, s.labels...)
+		}
+		var buf bytes.Buffer
+		p.write(&buf)
+		var lines []string
+		if buf.Len() != 0 {
+			lines = strings.Split(strings.TrimSuffix(buf.String(), "\n"),
+				"\n")
+		}
+		assert.Equal(t, test.expected, lines)
+	}
+}
+
+func TestServeMetricsSnapshot(t *testing.T) {
+	reportMetrics := &metrics.ZMetricMsg{
+		MetricContent: &metrics.ZMetricMsg_Dm{
+			Dm: &metrics.DeviceMetric{
+				Memory: &metrics.MemoryMetric{UsedMem: 100,
+					AvailMem: 900},
+			},
+		},
+		Am: []*metrics.AppMetric{
+			{AppID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				AppName: "app1",
+				Memory:  &metrics.MemoryMetric{UsedMem: 10}},
+		},
+	}
+	saveMetricsForExport(reportMetrics)
+	// Changes after the snapshot are not served
+	reportMetrics.GetDm().Memory.UsedMem = 200
+
+	w := httptest.NewRecorder()
+	serveMetrics(w, httptest.NewRequest("GET", metricsExportPath, nil))
+	body, err := ioutil.ReadAll(w.Result().Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "text/plain; version=0.0.4",
+		w.Result().Header.Get("Content-Type"))
+	lines := strings.Split(string(body), "\n")
+	assert.Contains(t, lines, "eve_device_memory_used_mbytes 100")
+	assert.Contains(t, lines, "eve_device_memory_available_mbytes 900")
+	assert.Contains(t, lines,
+		`eve_app_memory_used_mbytes{app_uuid="6ba7b810-9dad-11d1-80b4-00c04fd430c8",app_name="app1"} 10`)
+
+	// The timestamp is when the metrics were rendered
+	builtTime := time.Unix(1572949230, 0)
+	lines = strings.Split(string(renderMetrics(reportMetrics, builtTime)),
+		"\n")
+	assert.Contains(t, lines, "eve_metrics_timestamp_seconds 1.57294923e+09")
+	assert.Contains(t, lines, "eve_device_memory_used_mbytes 200")
+}
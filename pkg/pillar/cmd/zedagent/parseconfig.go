@@ -360,6 +360,15 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 		if apiConfigEntry.Port != nil {
 			networkInstanceConfig.Port = apiConfigEntry.Port.Name
 		}
+		if apiConfigEntry.VlanId > maxVlanID {
+			// Not attaching to the untagged traffic instead
+			log.Errorf("Network instance %s %s, invalid VLAN ID %d. ignored\n",
+				networkInstanceConfig.UUID.String(),
+				networkInstanceConfig.DisplayName,
+				apiConfigEntry.VlanId)
+			continue
+		}
+		networkInstanceConfig.VlanID = uint16(apiConfigEntry.VlanId)
 		// XXX temporary hack:
 		// For switch log+force to AddressTypeNone and do not copy
 		// ipconfig but do copy opaque
@@ -368,11 +377,12 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 		networkInstanceConfig.IpType = types.AddressType(apiConfigEntry.IpType)
 
 		switch networkInstanceConfig.Type {
-		case types.NetworkInstanceTypeSwitch:
+		case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeTransparent:
 			if networkInstanceConfig.IpType != types.AddressTypeNone {
-				log.Warnf("Switch network instance %s %s with invalid IpType %d overridden as %d\n",
+				log.Warnf("L2 network instance %s %s type %d with invalid IpType %d overridden as %d\n",
 					networkInstanceConfig.UUID.String(),
 					networkInstanceConfig.DisplayName,
+					networkInstanceConfig.Type,
 					networkInstanceConfig.IpType,
 					types.AddressTypeNone)
 				networkInstanceConfig.IpType = types.AddressTypeNone
@@ -418,6 +428,15 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 					networkInstanceConfig.DisplayName,
 					networkInstanceConfig.IpType)
 			}
+
+		case types.NetworkInstanceTypeHoneyPot:
+			// zedrouter rejects it; flag it here as well
+			if networkInstanceConfig.Port != "" {
+				log.Errorf("Network instance %s %s, HoneyPot with port %s\n",
+					networkInstanceConfig.UUID.String(),
+					networkInstanceConfig.DisplayName,
+					networkInstanceConfig.Port)
+			}
 		}
 
 		// other than switch-type(l2)
@@ -426,6 +445,16 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 			parseIpspec(apiConfigEntry.Ip,
 				&networkInstanceConfig)
 
+			if apiConfigEntry.Ip6 != nil {
+				err := parseIpspec6(apiConfigEntry.Ip6,
+					&networkInstanceConfig)
+				if err != nil {
+					log.Errorf("Network instance %s %s, %s\n",
+						networkInstanceConfig.UUID.String(),
+						networkInstanceConfig.DisplayName, err)
+				}
+			}
+
 			parseDnsNameToIpList(apiConfigEntry,
 				&networkInstanceConfig)
 		}
@@ -593,6 +622,8 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 
 		appInstance.CloudInitUserData = userData
 		appInstance.RemoteConsole = cfgApp.GetRemoteConsole()
+		appInstance.HealthCheck = parseAppHealthCheck(cfgApp.GetHealthCheck())
+		parseAppDependencies(&appInstance, cfgApp)
 		// get the certs for image sha verification
 		certInstance := getCertObjects(appInstance.UUIDandVersion,
 			appInstance.ConfigSha256, appInstance.StorageConfigList)
@@ -607,8 +638,172 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 	}
 }
 
+// Defaults for the zero values in AppHealthCheck; in seconds
+const (
+	appHealthIntervalDefault         = 10
+	appHealthTimeoutDefault          = 5
+	appHealthFailureThresholdDefault = 3
+	appHealthGracePeriodDefault      = 60
+	appHealthBackoffInitialDefault   = 10
+	appHealthBackoffMaxDefault       = 300
+)
+
+// parseAppHealthCheck fills in the defaults hence the domain liveness is
+// checked even if the controller did not send a health check
+func parseAppHealthCheck(cfg *zconfig.AppHealthCheck) types.AppHealthCheck {
+	hc := types.AppHealthCheck{
+		Probe:            types.AppHealthProbe(cfg.GetProbe()),
+		Port:             uint16(cfg.GetPort()),
+		Path:             cfg.GetPath(),
+		Interval:         cfg.GetInterval(),
+		Timeout:          cfg.GetTimeout(),
+		FailureThreshold: cfg.GetFailureThreshold(),
+		GracePeriod:      cfg.GetGracePeriod(),
+		RestartPolicy:    types.AppRestartPolicy(cfg.GetRestartPolicy()),
+		MaxRetries:       cfg.GetMaxRetries(),
+		BackoffInitial:   cfg.GetBackoffInitial(),
+		BackoffMax:       cfg.GetBackoffMax(),
+	}
+	if hc.Path == "" {
+		hc.Path = "/"
+	} else if !strings.HasPrefix(hc.Path, "/") {
+		hc.Path = "/" + hc.Path
+	}
+	if hc.Interval == 0 {
+		hc.Interval = appHealthIntervalDefault
+	}
+	if hc.Timeout == 0 {
+		hc.Timeout = appHealthTimeoutDefault
+	}
+	if hc.Timeout > hc.Interval {
+		log.Warnf("parseAppHealthCheck: timeout %d larger than interval %d\n",
+			hc.Timeout, hc.Interval)
+		hc.Timeout = hc.Interval
+	}
+	if hc.FailureThreshold == 0 {
+		hc.FailureThreshold = appHealthFailureThresholdDefault
+	}
+	if hc.GracePeriod == 0 {
+		hc.GracePeriod = appHealthGracePeriodDefault
+	}
+	if hc.BackoffInitial == 0 {
+		hc.BackoffInitial = appHealthBackoffInitialDefault
+	}
+	if hc.BackoffMax == 0 {
+		hc.BackoffMax = appHealthBackoffMaxDefault
+	}
+	if hc.BackoffMax < hc.BackoffInitial {
+		hc.BackoffMax = hc.BackoffInitial
+	}
+	if (hc.Probe == types.AppProbeTCP || hc.Probe == types.AppProbeHTTP) &&
+		hc.Port == 0 {
+		log.Errorf("parseAppHealthCheck: no port for probe %d; ignored\n",
+			hc.Probe)
+		hc.Probe = types.AppProbeNone
+	}
+	log.Debugf("parseAppHealthCheck: %+v\n", hc)
+	return hc
+}
+
+func parseAppDependencies(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig) {
+
+	appInstance.Dependencies = nil
+	for _, dep := range cfgApp.Dependencies {
+		id, err := uuid.FromString(dep.Uuid)
+		if err != nil {
+			errStr := fmt.Sprintf("Bad dependency UUID %s: %s",
+				dep.Uuid, err)
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			continue
+		}
+		if id == appInstance.UUIDandVersion.UUID {
+			errStr := "App instance depends on itself"
+			log.Errorln(errStr)
+			appInstance.Errors = append(appInstance.Errors, errStr)
+			continue
+		}
+		appInstance.Dependencies = append(appInstance.Dependencies,
+			types.AppDependency{UUID: id,
+				Condition: types.AppStartCondition(dep.Condition)})
+	}
+	log.Debugf("parseAppDependencies: %+v\n", appInstance.Dependencies)
+}
+
 var systemAdaptersPrevConfigHash []byte
 
+// 802.1Q
+const maxVlanID = 4094
+
+// lowerLayerIfName maps a logical name to the interface name if it refers
+// to another system adapter
+func lowerLayerIfName(sysAdapters []*zconfig.SystemAdapter,
+	name string) string {
+
+	for _, sysAdapter := range sysAdapters {
+		if sysAdapter.LogicalName != "" && sysAdapter.LogicalName == name {
+			return sysAdapter.Name
+		}
+	}
+	return name
+}
+
+func parseBondAdapter(sysAdapters []*zconfig.SystemAdapter, name string,
+	bond *zconfig.BondAdapter) (types.BondConfig, error) {
+
+	bondConfig := types.BondConfig{
+		Mode:       types.BondMode(bond.Mode),
+		MIIMonitor: bond.MiiMonitor,
+	}
+	switch bondConfig.Mode {
+	case types.BondModeActiveBackup, types.BondMode8023ad:
+	default:
+		return bondConfig, fmt.Errorf("bad bond mode %d", bond.Mode)
+	}
+	for _, lowerLayerName := range bond.LowerLayerNames {
+		member := lowerLayerIfName(sysAdapters, lowerLayerName)
+		if member == "" || member == name {
+			return bondConfig, fmt.Errorf("bad bond member %s",
+				lowerLayerName)
+		}
+		bondConfig.Members = append(bondConfig.Members, member)
+	}
+	if len(bondConfig.Members) == 0 {
+		return bondConfig, fmt.Errorf("bond without members")
+	}
+	if bond.Primary != "" {
+		bondConfig.Primary = lowerLayerIfName(sysAdapters, bond.Primary)
+		found := false
+		for _, member := range bondConfig.Members {
+			if member == bondConfig.Primary {
+				found = true
+			}
+		}
+		if !found {
+			return bondConfig, fmt.Errorf("primary %s is not a bond member",
+				bond.Primary)
+		}
+	}
+	return bondConfig, nil
+}
+
+// isBondMember returns true if ifname is aggregated by another adapter
+func isBondMember(sysAdapters []*zconfig.SystemAdapter, ifname string) bool {
+	for _, sysAdapter := range sysAdapters {
+		bond := sysAdapter.GetBond()
+		if bond == nil {
+			continue
+		}
+		for _, lowerLayerName := range bond.LowerLayerNames {
+			if lowerLayerIfName(sysAdapters, lowerLayerName) == ifname {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
 	getconfigCtx *getconfigContext, forceParse bool) {
 	log.Debugf("parseSystemAdapterConfig: EdgeDevConfig: %v\n", *config)
@@ -674,6 +869,40 @@ func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
 		port.IsMgmt = isUplink
 		port.Free = isFreeUplink
 
+		if vlan := sysAdapter.GetVlan(); vlan != nil {
+			parent := lowerLayerIfName(sysAdapters, vlan.LowerLayerName)
+			if vlan.VlanId == 0 || vlan.VlanId > maxVlanID ||
+				parent == "" || parent == sysAdapter.Name {
+				log.Errorf("parseSystemAdapterConfig: Port %s has bad "+
+					"VLAN %d on %s - ignored\n",
+					sysAdapter.Name, vlan.VlanId, vlan.LowerLayerName)
+				continue
+			}
+			port.Vlan = types.VlanConfig{ParentIfName: parent,
+				VlanID: uint16(vlan.VlanId)}
+		}
+		if bond := sysAdapter.GetBond(); bond != nil {
+			if port.Vlan.VlanID != 0 {
+				log.Errorf("parseSystemAdapterConfig: Port %s has "+
+					"both VLAN and bond - ignored\n", sysAdapter.Name)
+				continue
+			}
+			bondConfig, err := parseBondAdapter(sysAdapters, sysAdapter.Name,
+				bond)
+			if err != nil {
+				log.Errorf("parseSystemAdapterConfig: Port %s %s - ignored\n",
+					sysAdapter.Name, err)
+				continue
+			}
+			port.Bond = bondConfig
+		}
+		if isBondMember(sysAdapters, sysAdapter.Name) {
+			// Only the bond gets the IP configuration
+			log.Errorf("parseSystemAdapterConfig: Port %s is a bond member "+
+				"- ignored\n", sysAdapter.Name)
+			continue
+		}
+
 		port.Dhcp = types.DT_NONE
 		// XXX temporary hack: if static IP 0.0.0.0 we log and
 		// Dhcp = DT_NONE. Remove once zedcloud can send Dhcp = None
@@ -1152,6 +1381,45 @@ func parseIpspec(ipspec *zconfig.Ipspec,
 	return nil
 }
 
+// parseIpspec6 only uses the subnet, gateway and dns; the domain and ntp
+// server are shared with IPv4. The gateway defaults to the first address
+// in the subnet. Nothing is set in config if there is an error.
+func parseIpspec6(ipspec *zconfig.Ipspec,
+	config *types.NetworkInstanceConfig) error {
+
+	s := ipspec.GetSubnet()
+	ip, subnet, err := net.ParseCIDR(s)
+	if err != nil {
+		return fmt.Errorf("parseIpspec6: bad subnet %s: %s", s, err)
+	}
+	if ip.To4() != nil {
+		return fmt.Errorf("parseIpspec6: not an IPv6 subnet %s", s)
+	}
+	var gateway net.IP
+	if g := ipspec.GetGateway(); g != "" {
+		gateway = net.ParseIP(g)
+		if gateway == nil {
+			return fmt.Errorf("parseIpspec6: bad gateway IP %s", g)
+		}
+	} else {
+		gateway = make(net.IP, net.IPv6len)
+		copy(gateway, subnet.IP)
+		gateway[net.IPv6len-1] |= 1
+	}
+	var dnsServers []net.IP
+	for _, dsStr := range ipspec.GetDns() {
+		ds := net.ParseIP(dsStr)
+		if ds == nil {
+			return fmt.Errorf("parseIpspec6: bad dns IP %s", dsStr)
+		}
+		dnsServers = append(dnsServers, ds)
+	}
+	config.Subnet6 = *subnet
+	config.Gateway6 = gateway
+	config.DnsServers6 = dnsServers
+	return nil
+}
+
 func parseAppNetworkConfig(appInstance *types.AppInstanceConfig,
 	cfgApp *zconfig.AppInstanceConfig,
 	cfgNetworks []*zconfig.NetworkConfig,
@@ -1643,12 +1911,64 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 			}
 			newGlobalConfig.DomainBootRetryTime = uint32(i64)
 
+		case "download.chunks":
+			i64, err := strconv.ParseInt(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad int value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.DownloadChunks = uint32(i64)
+
+		case "download.max.bandwidth":
+			i64, err := strconv.ParseInt(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad int value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.DownloadMaxBandwidth = uint32(i64)
+
+		case "log.spool.max.size":
+			u64, err := strconv.ParseUint(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad uint value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.LogSpoolMaxSize = uint32(u64)
+
+		case "app.log.rate.limit":
+			u64, err := strconv.ParseUint(item.Value, 10, 32)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad uint value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.AppLogRateLimit = uint32(u64)
+
+		case "metrics.export.port":
+			// A TCP port hence 16 bits
+			u64, err := strconv.ParseUint(item.Value, 10, 16)
+			if err != nil {
+				log.Errorf("parseConfigItems: bad port value %s for %s: %s\n",
+					item.Value, key, err)
+				continue
+			}
+			newGlobalConfig.MetricsExportPort = uint32(u64)
+
+		case "metrics.export.interface":
+			newGlobalConfig.MetricsExportInterface = item.Value
+
 		case "debug.default.loglevel":
 			newGlobalConfig.DefaultLogLevel = item.Value
 
 		case "debug.default.remote.loglevel":
 			newGlobalConfig.DefaultRemoteLogLevel = item.Value
 
+		case "log.syslog.server":
+			newGlobalConfig.SyslogServer = item.Value
+
 		default:
 			// Handle agentname items for loglevels
 			newString := item.Value
@@ -1682,6 +2002,9 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 					agentlog.SetRemoteLogLevel(&newGlobalConfig,
 						agentName, current)
 				}
+			} else if strings.HasPrefix(key, "download.port.") {
+				parseDownloadPortItem(&newGlobalConfig, key,
+					item.Value)
 			} else {
 				log.Errorf("Unknown configItem %s value %s\n",
 					key, item.Value)
@@ -1717,6 +2040,15 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 				globalConfig.SshAuthorizedKeys)
 			ssh.UpdateSshAuthorizedKeys(globalConfig.SshAuthorizedKeys)
 		}
+		if globalConfig.MetricsExportPort != oldGlobalConfig.MetricsExportPort ||
+			globalConfig.MetricsExportInterface != oldGlobalConfig.MetricsExportInterface {
+			log.Infof("parseConfigItems: metrics export change from %s:%d to %s:%d\n",
+				oldGlobalConfig.MetricsExportInterface,
+				oldGlobalConfig.MetricsExportPort,
+				globalConfig.MetricsExportInterface,
+				globalConfig.MetricsExportPort)
+			updateMetricsExport()
+		}
 		err := pubsub.PublishToDir("/persist/config/", "global",
 			&globalConfig)
 		if err != nil {
@@ -1726,6 +2058,46 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 	}
 }
 
+// Handle download.port.<port>.max.bandwidth and
+// download.port.<port>.windows where the port name might contain dots
+func parseDownloadPortItem(gc *types.GlobalConfig, key string, value string) {
+	port := strings.TrimPrefix(key, "download.port.")
+	var setting string
+	for _, suffix := range []string{".max.bandwidth", ".windows"} {
+		if strings.HasSuffix(port, suffix) {
+			port = strings.TrimSuffix(port, suffix)
+			setting = suffix
+			break
+		}
+	}
+	if port == "" || setting == "" {
+		log.Errorf("Unknown configItem %s value %s\n", key, value)
+		return
+	}
+	if gc.DownloadPortSettings == nil {
+		gc.DownloadPortSettings = make(map[string]types.PerPortDownloadSettings)
+	}
+	settings := gc.DownloadPortSettings[port]
+	switch setting {
+	case ".max.bandwidth":
+		i64, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			log.Errorf("parseConfigItems: bad int value %s for %s: %s\n",
+				value, key, err)
+			return
+		}
+		settings.MaxBandwidth = uint32(i64)
+	case ".windows":
+		if _, err := types.ParseDownloadWindows(value); err != nil {
+			log.Errorf("parseConfigItems: bad value %s for %s: %s\n",
+				value, key, err)
+			return
+		}
+		settings.Windows = value
+	}
+	gc.DownloadPortSettings[port] = settings
+}
+
 func publishAppInstanceConfig(getconfigCtx *getconfigContext,
 	config types.AppInstanceConfig) {
 
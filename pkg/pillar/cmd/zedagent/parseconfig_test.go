@@ -0,0 +1,79 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"testing"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIpspec6(t *testing.T) {
+	testMatrix := map[string]struct {
+		ipspec          zconfig.Ipspec
+		expectedSubnet  string
+		expectedGateway string
+		expectedDNS     []string
+		expectFail      bool
+	}{
+		"Default gateway": {
+			ipspec:          zconfig.Ipspec{Subnet: "fd00:1::/64"},
+			expectedSubnet:  "fd00:1::/64",
+			expectedGateway: "fd00:1::1",
+		},
+		"Host bits in subnet": {
+			ipspec:          zconfig.Ipspec{Subnet: "fd00:1::5/64"},
+			expectedSubnet:  "fd00:1::/64",
+			expectedGateway: "fd00:1::1",
+		},
+		"Explicit gateway and DNS": {
+			ipspec: zconfig.Ipspec{
+				Subnet:  "fd00:1::/64",
+				Gateway: "fd00:1::fe",
+				Dns:     []string{"fd00:1::fe", "2001:4860:4860::8888"},
+			},
+			expectedSubnet:  "fd00:1::/64",
+			expectedGateway: "fd00:1::fe",
+			expectedDNS:     []string{"fd00:1::fe", "2001:4860:4860::8888"},
+		},
+		"Bad subnet": {
+			ipspec:     zconfig.Ipspec{Subnet: "fd00:1::"},
+			expectFail: true,
+		},
+		"IPv4 subnet": {
+			ipspec:     zconfig.Ipspec{Subnet: "10.1.0.0/24"},
+			expectFail: true,
+		},
+		"Bad gateway": {
+			ipspec: zconfig.Ipspec{Subnet: "fd00:1::/64",
+				Gateway: "fd00:1::xyz"},
+			expectFail: true,
+		},
+		"Bad DNS": {
+			ipspec: zconfig.Ipspec{Subnet: "fd00:1::/64",
+				Dns: []string{"dns.example.com"}},
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var config types.NetworkInstanceConfig
+		err := parseIpspec6(&test.ipspec, &config)
+		if test.expectFail {
+			assert.Error(t, err)
+			assert.Nil(t, config.Subnet6.IP)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedSubnet, config.Subnet6.String())
+		assert.Equal(t, test.expectedGateway, config.Gateway6.String())
+		var dns []string
+		for _, ds := range config.DnsServers6 {
+			dns = append(dns, ds.String())
+		}
+		assert.Equal(t, test.expectedDNS, dns)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/cast"
+	"github.com/lf-edge/eve/pkg/pillar/domainmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -52,9 +53,15 @@ const (
 	objectDownloadDirname = persistDir + "/downloads"
 	certificateDirname    = persistDir + "/certs"
 	checkpointDirname     = persistDir + "/checkpoint"
+	deferredDirname       = persistDir + "/deferred/" + agentName
 	restartCounterFile    = configDir + "/restartcounter"
 	tmpDirname            = "/var/tmp/zededa"
 	firstbootFile         = tmpDirname + "/first-boot"
+
+	// Limits on the info and metrics queued while we can't reach
+	// the controller
+	deferredMaxBytes = 16 * 1024 * 1024
+	deferredMaxAge   = 24 * time.Hour
 )
 
 // Set from Makefile
@@ -67,6 +74,7 @@ var deviceNetworkStatus *types.DeviceNetworkStatus = &types.DeviceNetworkStatus{
 // XXX could alternatively access sub object when adding them.
 var clientMetrics interface{}
 var logmanagerMetrics interface{}
+var logmanagerDeferredMetrics interface{}
 var downloaderMetrics interface{}
 var networkMetrics types.NetworkMetrics
 
@@ -86,6 +94,7 @@ type zedagentContext struct {
 	zbootRestarted            bool // published by baseosmgr
 	assignableAdapters        *types.AssignableAdapters
 	subAssignableAdapters     *pubsub.Subscription
+	subDomainStatus           *pubsub.Subscription
 	iteration                 int
 	subNetworkInstanceStatus  *pubsub.Subscription
 	subCertObjConfig          *pubsub.Subscription
@@ -246,8 +255,11 @@ func Run() {
 	getconfigCtx.zedagentCtx = &zedagentCtx
 	zedagentCtx.getconfigCtx = &getconfigCtx
 
-	// Timer for deferred sends of info messages
-	deferredChan := zedcloud.InitDeferred()
+	// Timer for deferred sends of info messages. What was queued
+	// before a restart is sent using the zedcloudCtx from handleInit.
+	deferredChan := zedcloud.InitDeferredPersistent(deferredDirname,
+		deferredMaxBytes, deferredMaxAge)
+	zedcloud.ReplayDeferred(zedcloudCtx)
 
 	// Make sure we have a GlobalConfig file with defaults
 	types.EnsureGCFile()
@@ -262,6 +274,17 @@ func Run() {
 	zedagentCtx.subAssignableAdapters = subAssignableAdapters
 	subAssignableAdapters.Activate()
 
+	// Look for DomainStatus from domainmgr to find the domains to
+	// collect metrics for
+	subDomainStatus, err := pubsub.Subscribe("domainmgr",
+		types.DomainStatus{}, false, &zedagentCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subDomainStatus = subDomainStatus
+	subDomainStatus.Activate()
+	metricsCollector = domainmetrics.NewCollector()
+
 	pubDevicePortConfig, err := pubsub.Publish(agentName,
 		types.DevicePortConfig{})
 	if err != nil {
@@ -607,6 +630,11 @@ func Run() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	subLogmanagerDeferredMetrics, err := pubsub.Subscribe("logmanager",
+		zedcloud.DeferredMetrics{}, true, &zedagentCtx)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Publish initial device info.
 	publishDevInfo(&zedagentCtx)
@@ -687,6 +715,9 @@ func Run() {
 		case change := <-subAppInstanceStatus.C:
 			subAppInstanceStatus.ProcessChange(change)
 
+		case change := <-subDomainStatus.C:
+			subDomainStatus.ProcessChange(change)
+
 		case change := <-subBaseOsStatus.C:
 			subBaseOsStatus.ProcessChange(change)
 
@@ -751,6 +782,16 @@ func Run() {
 				logmanagerMetrics = m
 			}
 
+		case change := <-subLogmanagerDeferredMetrics.C:
+			subLogmanagerDeferredMetrics.ProcessChange(change)
+			m, err := subLogmanagerDeferredMetrics.Get("global")
+			if err != nil {
+				log.Errorf("subLogmanagerDeferredMetrics.Get failed: %s\n",
+					err)
+			} else {
+				logmanagerDeferredMetrics = m
+			}
+
 		case change := <-subDownloaderMetrics.C:
 			subDownloaderMetrics.ProcessChange(change)
 			m, err := subDownloaderMetrics.Get("global")
@@ -940,6 +981,8 @@ func handleDNSModify(ctxArg interface{}, key string, statusArg interface{}) {
 	ctx.DNSinitialized = true
 	ctx.usableAddressCount = newAddrCount
 	ctx.triggerDeviceInfo = true
+	// The management port might have a new address
+	updateMetricsExport()
 	log.Infof("handleDNSModify done for %s\n", key)
 }
 
@@ -957,6 +1000,7 @@ func handleDNSDelete(ctxArg interface{}, key string,
 	newAddrCount := types.CountLocalAddrAnyNoLinkLocal(*deviceNetworkStatus)
 	ctx.DNSinitialized = false
 	ctx.usableAddressCount = newAddrCount
+	updateMetricsExport()
 	log.Infof("handleDNSDelete done for %s\n", key)
 }
 
@@ -1046,6 +1090,7 @@ func handleGlobalConfigModify(ctxArg interface{}, key string,
 			cmp.Diff(updated, sane))
 		globalConfig = sane
 		ctx.GCInitialized = true
+		updateMetricsExport()
 	}
 	log.Infof("handleGlobalConfigModify done for %s\n", key)
 }
@@ -1062,6 +1107,7 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 	debug, _ = agentlog.HandleGlobalConfig(ctx.subGlobalConfig, agentName,
 		debugOverride)
 	globalConfig = types.GlobalConfigDefaults
+	updateMetricsExport()
 	log.Infof("handleGlobalConfigDelete done for %s\n", key)
 }
 
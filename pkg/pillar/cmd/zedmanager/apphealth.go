@@ -0,0 +1,363 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Health checks of the app instances. The domain liveness comes from the
+// DomainStatus and the optional probe runs in a goroutine so that a slow
+// app does not hold up the main loop; the result comes back on
+// ctx.healthResults. Unhealthy apps are restarted using the same
+// RestartInprogress workflow as the restart command from the controller.
+
+package zedmanager
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/cast"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How often we look at the health of all app instances; the probe
+	// interval is rounded up to this
+	healthCheckInterval = 5 * time.Second
+
+	// Healthy for this long and we forget about earlier restarts
+	healthyResetTime = 10 * time.Minute
+)
+
+type healthProbeResult struct {
+	key      string
+	bootTime time.Time // Results from before a restart are ignored
+	time     time.Time
+	err      error
+}
+
+type healthProbeState struct {
+	inflight  bool
+	lastStart time.Time
+}
+
+func lookupHealthProbeState(ctx *zedmanagerContext,
+	key string) *healthProbeState {
+
+	p, ok := ctx.healthProbes[key]
+	if !ok {
+		p = &healthProbeState{}
+		ctx.healthProbes[key] = p
+	}
+	return p
+}
+
+// checkAppHealth is called periodically to evaluate all app instances
+func checkAppHealth(ctx *zedmanagerContext) {
+	now := time.Now()
+	present := make(map[string]bool)
+	items := ctx.pubAppInstanceStatus.GetAll()
+	for key, st := range items {
+		status := cast.CastAppInstanceStatus(st)
+		c, _ := ctx.subAppInstanceConfig.Get(key)
+		if c == nil {
+			continue
+		}
+		config := cast.CastAppInstanceConfig(c)
+		present[key] = true
+		if updateAppHealth(ctx, config, &status, now) {
+			publishAppInstanceStatus(ctx, &status)
+		}
+	}
+	for key, p := range ctx.healthProbes {
+		if !present[key] && !p.inflight {
+			delete(ctx.healthProbes, key)
+		}
+	}
+}
+
+// handleHealthProbeResult updates the health from a probe. Successful
+// probes of a healthy app are not published hence LastProbeTime is
+// that of the last probe which changed something.
+func handleHealthProbeResult(ctx *zedmanagerContext, res healthProbeResult) {
+	if p, ok := ctx.healthProbes[res.key]; ok {
+		p.inflight = false
+	}
+	status := lookupAppInstanceStatus(ctx, res.key)
+	if status == nil {
+		return
+	}
+	if !status.BootTime.Equal(res.bootTime) {
+		log.Infof("handleHealthProbeResult(%s) ignoring result from before restart\n",
+			res.key)
+		return
+	}
+	c, _ := ctx.subAppInstanceConfig.Get(res.key)
+	if c == nil {
+		return
+	}
+	config := cast.CastAppInstanceConfig(c)
+	health := &status.Health
+	prev := *health
+	if res.err != nil {
+		log.Warnf("handleHealthProbeResult(%s) %s probe failed: %s\n",
+			res.key, status.DisplayName, res.err)
+		// Saturate to avoid publishing each failure of an unhealthy app
+		if health.ConsecutiveFailures < config.HealthCheck.FailureThreshold {
+			health.ConsecutiveFailures++
+		}
+		health.LastProbeError = res.err.Error()
+	} else {
+		health.ConsecutiveFailures = 0
+		health.LastProbeError = ""
+	}
+	changed := updateAppHealth(ctx, config, status, res.time)
+	if changed || !cmp.Equal(prev, *health) {
+		health.LastProbeTime = res.time
+		publishAppInstanceStatus(ctx, status)
+	}
+}
+
+// updateAppHealth returns true if the status changed and needs to be
+// published
+func updateAppHealth(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, now time.Time) bool {
+
+	health := &status.Health
+	prev := *health
+	prevErr := status.Error
+	restarted := false
+	switch {
+	case !config.Activate || status.PurgeInprogress != types.NONE:
+		*health = types.AppHealthStatus{}
+		clearHealthError(status)
+	case health.State == types.AppHealthFailed:
+		// Until the controller restarts the app instance
+	case status.RestartInprogress != types.NONE:
+		health.State = types.AppHealthRestarting
+		health.NextRestartTime = time.Time{}
+		health.ConsecutiveFailures = 0
+		health.HealthySince = time.Time{}
+	case !health.NextRestartTime.IsZero():
+		if !now.Before(health.NextRestartTime) {
+			restartUnhealthyApp(ctx, config, status, now)
+			restarted = true
+		}
+	case !status.Activated:
+		health.State = types.AppHealthStarting
+	default:
+		evaluateAppHealth(ctx, config, status, now)
+	}
+	return restarted || status.Error != prevErr || !cmp.Equal(prev, *health)
+}
+
+// evaluateAppHealth looks at an activated app which is not being restarted
+func evaluateAppHealth(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, now time.Time) {
+
+	ds := lookupDomainStatus(ctx, status.Key())
+	if assessAppHealth(config, status, ds, now) {
+		maybeStartProbe(ctx, config, *status, now)
+	}
+}
+
+// assessAppHealth updates the health based on the domain and the probe
+// results so far, and returns true if the app should be probed
+func assessAppHealth(config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, ds *types.DomainStatus,
+	now time.Time) bool {
+
+	hc := config.HealthCheck
+	health := &status.Health
+	if ds == nil || !ds.Activated {
+		health.LastProbeError = "domain is not running"
+		health.ConsecutiveFailures = hc.FailureThreshold
+		markAppUnhealthy(config, status, now)
+		return false
+	}
+	if now.Sub(status.BootTime) < time.Duration(hc.GracePeriod)*time.Second {
+		health.State = types.AppHealthStarting
+		return false
+	}
+	probe := hc.Probe
+	if probe == types.AppProbeGuestAgent &&
+		(ds.Hypervisor != hypervisor.KvmName || status.IsContainer) {
+		// No guest agent channel; liveness only
+		probe = types.AppProbeNone
+	}
+	startProbe := probe != types.AppProbeNone
+	if hc.FailureThreshold != 0 &&
+		health.ConsecutiveFailures >= hc.FailureThreshold {
+		markAppUnhealthy(config, status, now)
+		return startProbe
+	}
+	if probe != types.AppProbeNone && health.ConsecutiveFailures == 0 &&
+		health.State != types.AppHealthHealthy &&
+		!health.LastProbeTime.After(status.BootTime) {
+		// Waiting for the first probe after boot
+		health.State = types.AppHealthStarting
+		return startProbe
+	}
+	if health.State != types.AppHealthHealthy {
+		health.State = types.AppHealthHealthy
+		health.HealthySince = now
+	}
+	if health.RestartCount != 0 &&
+		now.Sub(health.HealthySince) >= healthyResetTime {
+		log.Infof("assessAppHealth(%s) healthy since %v; clearing %d restarts\n",
+			status.Key(), health.HealthySince, health.RestartCount)
+		health.RestartCount = 0
+	}
+	return startProbe
+}
+
+// markAppUnhealthy schedules a restart if the policy allows it
+func markAppUnhealthy(config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, now time.Time) {
+
+	hc := config.HealthCheck
+	health := &status.Health
+	if health.State != types.AppHealthUnhealthy {
+		log.Warnf("markAppUnhealthy(%s) %s: %s\n", status.Key(),
+			status.DisplayName, health.LastProbeError)
+	}
+	health.State = types.AppHealthUnhealthy
+	health.HealthySince = time.Time{}
+	if hc.RestartPolicy != types.AppRestartOnFailure {
+		return
+	}
+	if hc.MaxRetries != 0 && health.RestartCount >= hc.MaxRetries {
+		log.Errorf("markAppUnhealthy(%s) %s giving up after %d restarts\n",
+			status.Key(), status.DisplayName, health.RestartCount)
+		health.State = types.AppHealthFailed
+		status.Error = fmt.Sprintf("Unhealthy after %d restarts: %s",
+			health.RestartCount, health.LastProbeError)
+		status.ErrorSource = pubsub.TypeToName(types.AppHealthStatus{})
+		status.ErrorTime = now
+		return
+	}
+	health.State = types.AppHealthRestarting
+	health.NextRestartTime = now.Add(restartBackoff(hc, health.RestartCount))
+	log.Infof("markAppUnhealthy(%s) restart %d at %v\n",
+		status.Key(), health.RestartCount+1, health.NextRestartTime)
+}
+
+// restartBackoff doubles BackoffInitial for each earlier restart up to
+// BackoffMax
+func restartBackoff(hc types.AppHealthCheck, restartCount uint32) time.Duration {
+	backoff := time.Duration(hc.BackoffInitial) * time.Second
+	max := time.Duration(hc.BackoffMax) * time.Second
+	for i := uint32(0); i < restartCount && backoff < max; i++ {
+		backoff *= 2
+	}
+	if max != 0 && backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+func restartUnhealthyApp(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, now time.Time) {
+
+	health := &status.Health
+	health.RestartCount++
+	health.LastRestartTime = now
+	health.NextRestartTime = time.Time{}
+	health.ConsecutiveFailures = 0
+	log.Warnf("restartUnhealthyApp(%s) %s restart %d\n", status.Key(),
+		status.DisplayName, health.RestartCount)
+	status.RestartInprogress = types.BRING_DOWN
+	status.State = types.RESTARTING
+	doUpdate(ctx, status.Key(), config, status)
+}
+
+// clearHealthError is used when the health is reset
+func clearHealthError(status *types.AppInstanceStatus) {
+	if status.ErrorSource == pubsub.TypeToName(types.AppHealthStatus{}) {
+		log.Infof("Clearing health error %s\n", status.Error)
+		status.Error = ""
+		status.ErrorSource = ""
+		status.ErrorTime = time.Time{}
+	}
+}
+
+// maybeStartProbe starts a probe unless one is in flight or the last one
+// was less than the interval ago
+func maybeStartProbe(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status types.AppInstanceStatus, now time.Time) {
+
+	hc := config.HealthCheck
+	key := status.Key()
+	p := lookupHealthProbeState(ctx, key)
+	if p.inflight ||
+		now.Sub(p.lastStart) < time.Duration(hc.Interval)*time.Second {
+		return
+	}
+	ipAddr := appProbeIPAddr(status)
+	socket := hypervisor.KvmGuestAgentSocket(status.DomainName)
+	bootTime := status.BootTime
+	p.inflight = true
+	p.lastStart = now
+	go func() {
+		err := runHealthProbe(hc, ipAddr, socket)
+		ctx.healthResults <- healthProbeResult{key: key,
+			bootTime: bootTime, time: time.Now(), err: err}
+	}()
+}
+
+// appProbeIPAddr returns the first address assigned to the app on an
+// underlay network
+func appProbeIPAddr(status types.AppInstanceStatus) string {
+	for _, ulStatus := range status.UnderlayNetworks {
+		if ulStatus.AllocatedIPAddr != "" {
+			return ulStatus.AllocatedIPAddr
+		}
+	}
+	return ""
+}
+
+// runHealthProbe is called in a goroutine hence must not touch ctx
+func runHealthProbe(hc types.AppHealthCheck, ipAddr string,
+	socket string) error {
+
+	timeout := time.Duration(hc.Timeout) * time.Second
+	switch hc.Probe {
+	case types.AppProbeGuestAgent:
+		return hypervisor.GuestAgentPing(socket, timeout)
+	case types.AppProbeTCP, types.AppProbeHTTP:
+		if ipAddr == "" {
+			return fmt.Errorf("no IP address for the app")
+		}
+	default:
+		return nil
+	}
+	hostPort := net.JoinHostPort(ipAddr, strconv.Itoa(int(hc.Port)))
+	if hc.Probe == types.AppProbeTCP {
+		conn, err := net.DialTimeout("tcp", hostPort, timeout)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+		return nil
+	}
+	client := http.Client{
+		Timeout: timeout,
+		// The redirect is good enough to tell the app is up
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get("http://" + hostPort + hc.Path)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("HTTP status %s", resp.Status)
+	}
+	return nil
+}
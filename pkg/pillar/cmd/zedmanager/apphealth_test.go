@@ -0,0 +1,377 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssessAppHealth(t *testing.T) {
+	now := time.Unix(1572949230, 0)
+	bootTime := now.Add(-10 * time.Minute)
+	probeTime := now.Add(-time.Minute)
+	tcpCheck := types.AppHealthCheck{
+		Probe:            types.AppProbeTCP,
+		FailureThreshold: 3,
+		GracePeriod:      60,
+	}
+	running := &types.DomainStatus{Activated: true,
+		Hypervisor: hypervisor.KvmName}
+
+	testMatrix := map[string]struct {
+		hc           types.AppHealthCheck
+		ds           *types.DomainStatus
+		bootTime     time.Time
+		health       types.AppHealthStatus
+		expectState  types.AppHealthState
+		expectProbe  bool
+		expectCount  uint32 // RestartCount
+		expectSince  time.Time
+		expectFailed uint32 // ConsecutiveFailures
+	}{
+		"Domain not running": {
+			hc:           tcpCheck,
+			bootTime:     bootTime,
+			health:       types.AppHealthStatus{State: types.AppHealthHealthy},
+			expectState:  types.AppHealthUnhealthy,
+			expectFailed: 3,
+		},
+		"Domain not activated": {
+			hc:           tcpCheck,
+			ds:           &types.DomainStatus{},
+			bootTime:     bootTime,
+			expectState:  types.AppHealthUnhealthy,
+			expectFailed: 3,
+		},
+		"Grace period suppresses failures": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: now.Add(-30 * time.Second),
+			health: types.AppHealthStatus{ConsecutiveFailures: 5,
+				LastProbeTime: probeTime},
+			expectState:  types.AppHealthStarting,
+			expectFailed: 5,
+		},
+		"Waiting for the first probe": {
+			hc:          tcpCheck,
+			ds:          running,
+			bootTime:    bootTime,
+			expectState: types.AppHealthStarting,
+			expectProbe: true,
+		},
+		"Probe from before the boot": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: bootTime,
+			health: types.AppHealthStatus{
+				LastProbeTime: bootTime.Add(-time.Second)},
+			expectState: types.AppHealthStarting,
+			expectProbe: true,
+		},
+		"First probe passed": {
+			hc:          tcpCheck,
+			ds:          running,
+			bootTime:    bootTime,
+			health:      types.AppHealthStatus{LastProbeTime: probeTime},
+			expectState: types.AppHealthHealthy,
+			expectProbe: true,
+			expectSince: now,
+		},
+		"Below the threshold": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: bootTime,
+			health: types.AppHealthStatus{State: types.AppHealthHealthy,
+				ConsecutiveFailures: 2, LastProbeTime: probeTime,
+				HealthySince: bootTime},
+			expectState:  types.AppHealthHealthy,
+			expectProbe:  true,
+			expectSince:  bootTime,
+			expectFailed: 2,
+		},
+		"At the threshold": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: bootTime,
+			health: types.AppHealthStatus{State: types.AppHealthHealthy,
+				ConsecutiveFailures: 3, LastProbeTime: probeTime,
+				HealthySince: bootTime},
+			expectState:  types.AppHealthUnhealthy,
+			expectProbe:  true,
+			expectFailed: 3,
+		},
+		"Recovered": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: bootTime,
+			health: types.AppHealthStatus{State: types.AppHealthUnhealthy,
+				LastProbeTime: probeTime},
+			expectState: types.AppHealthHealthy,
+			expectProbe: true,
+			expectSince: now,
+		},
+		"Healthy for long enough clears the restarts": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: bootTime,
+			health: types.AppHealthStatus{State: types.AppHealthHealthy,
+				LastProbeTime: probeTime, RestartCount: 2,
+				HealthySince: now.Add(-healthyResetTime)},
+			expectState: types.AppHealthHealthy,
+			expectProbe: true,
+			expectSince: now.Add(-healthyResetTime),
+		},
+		"Healthy for a short time keeps the restarts": {
+			hc:       tcpCheck,
+			ds:       running,
+			bootTime: bootTime,
+			health: types.AppHealthStatus{State: types.AppHealthHealthy,
+				LastProbeTime: probeTime, RestartCount: 2,
+				HealthySince: now.Add(-time.Minute)},
+			expectState: types.AppHealthHealthy,
+			expectProbe: true,
+			expectCount: 2,
+			expectSince: now.Add(-time.Minute),
+		},
+		"No threshold": {
+			hc: types.AppHealthCheck{Probe: types.AppProbeTCP},
+			ds: running,
+			health: types.AppHealthStatus{ConsecutiveFailures: 10,
+				LastProbeTime: probeTime},
+			bootTime:     bootTime,
+			expectState:  types.AppHealthHealthy,
+			expectProbe:  true,
+			expectSince:  now,
+			expectFailed: 10,
+		},
+		"Guest agent without KVM is liveness only": {
+			hc: types.AppHealthCheck{Probe: types.AppProbeGuestAgent,
+				FailureThreshold: 3},
+			ds: &types.DomainStatus{Activated: true,
+				Hypervisor: hypervisor.XenName},
+			bootTime:    bootTime,
+			expectState: types.AppHealthHealthy,
+			expectSince: now,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.AppInstanceConfig{HealthCheck: test.hc}
+		status := types.AppInstanceStatus{BootTime: test.bootTime,
+			Health: test.health}
+		probe := assessAppHealth(config, &status, test.ds, now)
+		assert.Equal(t, test.expectProbe, probe)
+		assert.Equal(t, test.expectState, status.Health.State)
+		assert.Equal(t, test.expectCount, status.Health.RestartCount)
+		assert.Equal(t, test.expectSince, status.Health.HealthySince)
+		assert.Equal(t, test.expectFailed,
+			status.Health.ConsecutiveFailures)
+	}
+}
+
+func TestMarkAppUnhealthy(t *testing.T) {
+	now := time.Unix(1572949230, 0)
+	onFailure := types.AppHealthCheck{
+		RestartPolicy:  types.AppRestartOnFailure,
+		MaxRetries:     5,
+		BackoffInitial: 10,
+		BackoffMax:     60,
+	}
+	testMatrix := map[string]struct {
+		hc           types.AppHealthCheck
+		restartCount uint32
+		expectState  types.AppHealthState
+		expectNext   time.Time
+		expectError  bool
+	}{
+		"Never restart": {
+			hc: types.AppHealthCheck{
+				RestartPolicy:  types.AppRestartNever,
+				BackoffInitial: 10,
+			},
+			expectState: types.AppHealthUnhealthy,
+		},
+		"First restart": {
+			hc:          onFailure,
+			expectState: types.AppHealthRestarting,
+			expectNext:  now.Add(10 * time.Second),
+		},
+		"Third restart": {
+			hc:           onFailure,
+			restartCount: 2,
+			expectState:  types.AppHealthRestarting,
+			expectNext:   now.Add(40 * time.Second),
+		},
+		"Backoff at the max": {
+			hc:           onFailure,
+			restartCount: 4,
+			expectState:  types.AppHealthRestarting,
+			expectNext:   now.Add(60 * time.Second),
+		},
+		"Out of retries": {
+			hc:           onFailure,
+			restartCount: 5,
+			expectState:  types.AppHealthFailed,
+			expectError:  true,
+		},
+		"No retry limit": {
+			hc: types.AppHealthCheck{
+				RestartPolicy:  types.AppRestartOnFailure,
+				BackoffInitial: 10,
+				BackoffMax:     60,
+			},
+			restartCount: 100,
+			expectState:  types.AppHealthRestarting,
+			expectNext:   now.Add(60 * time.Second),
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.AppInstanceConfig{HealthCheck: test.hc}
+		status := types.AppInstanceStatus{
+			Health: types.AppHealthStatus{
+				State:          types.AppHealthHealthy,
+				RestartCount:   test.restartCount,
+				LastProbeError: "connection refused",
+				HealthySince:   now.Add(-time.Hour),
+			},
+		}
+		markAppUnhealthy(config, &status, now)
+		assert.Equal(t, test.expectState, status.Health.State)
+		assert.Equal(t, test.expectNext, status.Health.NextRestartTime)
+		assert.True(t, status.Health.HealthySince.IsZero())
+		if test.expectError {
+			assert.Contains(t, status.Error, "connection refused")
+			assert.Equal(t, now, status.ErrorTime)
+		} else {
+			assert.Equal(t, "", status.Error)
+		}
+	}
+}
+
+func TestRestartBackoff(t *testing.T) {
+	testMatrix := map[string]struct {
+		initial      uint32
+		max          uint32
+		restartCount uint32
+		expected     time.Duration
+	}{
+		"Initial": {
+			initial:  10,
+			max:      60,
+			expected: 10 * time.Second,
+		},
+		"Doubled": {
+			initial:      10,
+			max:          60,
+			restartCount: 1,
+			expected:     20 * time.Second,
+		},
+		"Doubled twice": {
+			initial:      10,
+			max:          60,
+			restartCount: 2,
+			expected:     40 * time.Second,
+		},
+		"Capped": {
+			initial:      10,
+			max:          60,
+			restartCount: 3,
+			expected:     60 * time.Second,
+		},
+		"Capped after many restarts": {
+			initial:      10,
+			max:          60,
+			restartCount: 1000,
+			expected:     60 * time.Second,
+		},
+		"Initial above the max": {
+			initial:  100,
+			max:      60,
+			expected: 60 * time.Second,
+		},
+		"No backoff": {
+			max:          60,
+			restartCount: 3,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		hc := types.AppHealthCheck{BackoffInitial: test.initial,
+			BackoffMax: test.max}
+		assert.Equal(t, test.expected,
+			restartBackoff(hc, test.restartCount))
+	}
+}
+
+// The cases which do not need the context
+func TestUpdateAppHealth(t *testing.T) {
+	now := time.Unix(1572949230, 0)
+	failed := types.AppHealthStatus{State: types.AppHealthFailed,
+		RestartCount: 3}
+	scheduled := types.AppHealthStatus{State: types.AppHealthRestarting,
+		NextRestartTime: now.Add(time.Second), ConsecutiveFailures: 3}
+
+	testMatrix := map[string]struct {
+		activate      bool
+		status        types.AppInstanceStatus
+		expected      types.AppHealthStatus
+		expectError   string
+		expectChanged bool
+	}{
+		"Deactivated": {
+			status: types.AppInstanceStatus{Health: failed,
+				Error:       "Unhealthy after 3 restarts",
+				ErrorSource: "AppHealthStatus"},
+			expectChanged: true,
+		},
+		"Failed until the controller restarts it": {
+			activate: true,
+			status: types.AppInstanceStatus{Activated: true,
+				Health: failed, Error: "Unhealthy after 3 restarts",
+				ErrorSource: "AppHealthStatus"},
+			expected:    failed,
+			expectError: "Unhealthy after 3 restarts",
+		},
+		"Restart in progress": {
+			activate: true,
+			status: types.AppInstanceStatus{Health: scheduled,
+				RestartInprogress: types.BRING_DOWN},
+			expected: types.AppHealthStatus{
+				State: types.AppHealthRestarting},
+			expectChanged: true,
+		},
+		"Restart not due": {
+			activate: true,
+			status: types.AppInstanceStatus{Activated: true,
+				Health: scheduled},
+			expected: scheduled,
+		},
+		"Booting": {
+			activate: true,
+			status:   types.AppInstanceStatus{},
+			expected: types.AppHealthStatus{
+				State: types.AppHealthStarting},
+			expectChanged: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.AppInstanceConfig{Activate: test.activate}
+		status := test.status
+		changed := updateAppHealth(nil, config, &status, now)
+		assert.Equal(t, test.expectChanged, changed)
+		assert.Equal(t, test.expected, status.Health)
+		assert.Equal(t, test.expectError, status.Error)
+	}
+}
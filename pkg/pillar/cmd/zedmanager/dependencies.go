@@ -0,0 +1,251 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Start ordering between app instances. An app instance is not booted
+// until the app instances it depends on have booted, or are healthy, and
+// when several are halted or deleted at the same time the dependents are
+// halted first. Those which wait have AwaitingDependency set and are
+// retried from the main loop since the status of the other app instance
+// does not trigger any work for them.
+// The dependencies are taken from AppInstanceStatus so that we still
+// know them while the AppInstanceConfig is being deleted.
+
+package zedmanager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/cast"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+var dependencyErrorSource = pubsub.TypeToName(types.AppDependency{})
+
+// checkActivateDependencies returns false if the app instance can not be
+// booted yet
+func checkActivateDependencies(ctx *zedmanagerContext,
+	status *types.AppInstanceStatus) (bool, bool) {
+
+	changed := false
+	wait, err := activateDependencyWait(ctx, *status)
+	if err != nil {
+		if status.Error != err.Error() {
+			log.Errorf("checkActivateDependencies(%s): %s\n",
+				status.Key(), err)
+			status.Error = err.Error()
+			status.ErrorSource = dependencyErrorSource
+			status.ErrorTime = time.Now()
+			changed = true
+		}
+		// Retried in case the other app instance shows up
+		wait = status.Error
+	} else if status.ErrorSource == dependencyErrorSource {
+		log.Infof("Clearing dependency error %s\n", status.Error)
+		status.Error = ""
+		status.ErrorSource = ""
+		status.ErrorTime = time.Time{}
+		changed = true
+	}
+	if status.AwaitingDependency != wait {
+		if wait != "" {
+			log.Infof("checkActivateDependencies(%s) waiting for %s\n",
+				status.Key(), wait)
+		}
+		status.AwaitingDependency = wait
+		changed = true
+	}
+	return changed, wait == ""
+}
+
+// activateDependencyWait returns the app instances we are waiting for.
+// An error is returned for problems which need a config change.
+func activateDependencyWait(ctx *zedmanagerContext,
+	status types.AppInstanceStatus) (string, error) {
+
+	if len(status.Dependencies) == 0 {
+		return "", nil
+	}
+	return dependencyWait(status, appInstanceStatusMap(ctx),
+		configActivate(ctx))
+}
+
+// configActivate returns whether the config of an app instance asks for
+// it to be activated. Those without a config are being deleted.
+func configActivate(ctx *zedmanagerContext) func(key string) (bool, bool) {
+	return func(key string) (bool, bool) {
+		c, _ := ctx.subAppInstanceConfig.Get(key)
+		if c == nil {
+			return false, false
+		}
+		return cast.CastAppInstanceConfig(c).Activate, true
+	}
+}
+
+// dependencyWait is activateDependencyWait given all the app instances
+func dependencyWait(status types.AppInstanceStatus,
+	all map[string]types.AppInstanceStatus,
+	activate func(key string) (bool, bool)) (string, error) {
+
+	if cycle := findDependencyCycle(all, status.Key()); cycle != nil {
+		return "", fmt.Errorf("Dependency cycle %s",
+			strings.Join(cycle, " -> "))
+	}
+	var waiting []string
+	for _, dep := range status.Dependencies {
+		key := dep.UUID.String()
+		other, ok := all[key]
+		if !ok {
+			return "", fmt.Errorf("Depends on unknown app instance %s",
+				key)
+		}
+		if act, ok := activate(key); ok && !act {
+			waiting = append(waiting,
+				fmt.Sprintf("%s to be activated", other.DisplayName))
+			continue
+		}
+		switch dep.Condition {
+		case types.AppStartAfterHealthy:
+			if other.Health.State == types.AppHealthFailed {
+				return "", fmt.Errorf("Depends on %s which failed its health check",
+					other.DisplayName)
+			}
+			if other.Health.State != types.AppHealthHealthy {
+				waiting = append(waiting,
+					fmt.Sprintf("%s to be healthy", other.DisplayName))
+			}
+		default:
+			if !appStarted(other) {
+				waiting = append(waiting,
+					fmt.Sprintf("%s to start", other.DisplayName))
+			}
+		}
+	}
+	return strings.Join(waiting, ", "), nil
+}
+
+func appStarted(status types.AppInstanceStatus) bool {
+	return status.Activated && status.RestartInprogress == types.NONE &&
+		status.PurgeInprogress == types.NONE
+}
+
+// checkHaltDependents returns false if the app instance can not be halted
+// yet since some of its dependents are being halted as well.
+// Dependents which stay up do not hold us back, nor does a purge since
+// the app instance comes back right away.
+func checkHaltDependents(ctx *zedmanagerContext,
+	status *types.AppInstanceStatus) (bool, bool) {
+
+	wait := ""
+	if status.PurgeInprogress == types.NONE {
+		wait = haltDependentsWait(ctx, *status)
+	}
+	changed := false
+	if status.AwaitingDependency != wait {
+		if wait != "" {
+			log.Infof("checkHaltDependents(%s) waiting for %s\n",
+				status.Key(), wait)
+		}
+		status.AwaitingDependency = wait
+		changed = true
+	}
+	return changed, wait == ""
+}
+
+func haltDependentsWait(ctx *zedmanagerContext,
+	status types.AppInstanceStatus) string {
+
+	return dependentsWait(status, appInstanceStatusMap(ctx),
+		configActivate(ctx))
+}
+
+// dependentsWait is haltDependentsWait given all the app instances
+func dependentsWait(status types.AppInstanceStatus,
+	all map[string]types.AppInstanceStatus,
+	activate func(key string) (bool, bool)) string {
+
+	if findDependencyCycle(all, status.Key()) != nil {
+		// No order to follow
+		return ""
+	}
+	var waiting []string
+	for key, other := range all {
+		if !other.Activated || !dependsOn(other, status.Key()) {
+			continue
+		}
+		if act, ok := activate(key); ok && act {
+			continue
+		}
+		waiting = append(waiting,
+			fmt.Sprintf("%s to halt", other.DisplayName))
+	}
+	sort.Strings(waiting)
+	return strings.Join(waiting, ", ")
+}
+
+func dependsOn(status types.AppInstanceStatus, key string) bool {
+	for _, dep := range status.Dependencies {
+		if dep.UUID.String() == key {
+			return true
+		}
+	}
+	return false
+}
+
+func appInstanceStatusMap(ctx *zedmanagerContext) map[string]types.AppInstanceStatus {
+	all := make(map[string]types.AppInstanceStatus)
+	for key, st := range ctx.pubAppInstanceStatus.GetAll() {
+		all[key] = cast.CastAppInstanceStatus(st)
+	}
+	return all
+}
+
+// findDependencyCycle returns the display names along a cycle which
+// starts and ends with key, if there is one
+func findDependencyCycle(all map[string]types.AppInstanceStatus,
+	key string) []string {
+
+	visited := make(map[string]bool)
+	var path []string
+	var visit func(k string) bool
+	visit = func(k string) bool {
+		path = append(path, all[k].DisplayName)
+		for _, dep := range all[k].Dependencies {
+			depKey := dep.UUID.String()
+			if depKey == key {
+				path = append(path, all[depKey].DisplayName)
+				return true
+			}
+			if visited[depKey] {
+				continue
+			}
+			visited[depKey] = true
+			if visit(depKey) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if visit(key) {
+		return path
+	}
+	return nil
+}
+
+// retryAwaitingDependency is called periodically for the app instances
+// which wait for another one
+func retryAwaitingDependency(ctx *zedmanagerContext) {
+	for _, status := range appInstanceStatusMap(ctx) {
+		if status.AwaitingDependency == "" {
+			continue
+		}
+		log.Debugf("retryAwaitingDependency(%s) %s\n", status.Key(),
+			status.AwaitingDependency)
+		updateOrRemove(ctx, status)
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	appA = uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c1")
+	appB = uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c2")
+	appC = uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c3")
+	appX = uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430cf")
+)
+
+// testAppStatus returns a running app instance depending on deps with
+// the AppStartAfterActivated condition
+func testAppStatus(id uuid.UUID, name string,
+	deps ...uuid.UUID) types.AppInstanceStatus {
+
+	status := types.AppInstanceStatus{
+		UUIDandVersion: types.UUIDandVersion{UUID: id},
+		DisplayName:    name,
+		Activated:      true,
+	}
+	for _, dep := range deps {
+		status.Dependencies = append(status.Dependencies,
+			types.AppDependency{UUID: dep})
+	}
+	return status
+}
+
+func testAppStatusMap(list ...types.AppInstanceStatus) map[string]types.AppInstanceStatus {
+	all := make(map[string]types.AppInstanceStatus)
+	for _, status := range list {
+		all[status.Key()] = status
+	}
+	return all
+}
+
+// All the app instances have a config with Activate set unless in
+// deactivated or deleted
+func testActivate(deactivated []uuid.UUID,
+	deleted []uuid.UUID) func(key string) (bool, bool) {
+
+	return func(key string) (bool, bool) {
+		for _, id := range deleted {
+			if id.String() == key {
+				return false, false
+			}
+		}
+		for _, id := range deactivated {
+			if id.String() == key {
+				return false, true
+			}
+		}
+		return true, true
+	}
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	testMatrix := map[string]struct {
+		all      map[string]types.AppInstanceStatus
+		key      uuid.UUID
+		expected []string
+	}{
+		"No dependencies": {
+			all: testAppStatusMap(testAppStatus(appA, "a")),
+			key: appA,
+		},
+		"Chain": {
+			all: testAppStatusMap(testAppStatus(appA, "a", appB),
+				testAppStatus(appB, "b", appC),
+				testAppStatus(appC, "c")),
+			key: appA,
+		},
+		"Self cycle": {
+			all:      testAppStatusMap(testAppStatus(appA, "a", appA)),
+			key:      appA,
+			expected: []string{"a", "a"},
+		},
+		"Direct cycle": {
+			all: testAppStatusMap(testAppStatus(appA, "a", appB),
+				testAppStatus(appB, "b", appA)),
+			key:      appA,
+			expected: []string{"a", "b", "a"},
+		},
+		"Indirect cycle": {
+			all: testAppStatusMap(testAppStatus(appA, "a", appB),
+				testAppStatus(appB, "b", appC),
+				testAppStatus(appC, "c", appA)),
+			key:      appB,
+			expected: []string{"b", "c", "a", "b"},
+		},
+		"Cycle not through the app instance": {
+			all: testAppStatusMap(testAppStatus(appA, "a", appB),
+				testAppStatus(appB, "b", appC),
+				testAppStatus(appC, "c", appB)),
+			key: appA,
+		},
+		"Diamond": {
+			all: testAppStatusMap(testAppStatus(appA, "a", appB, appC),
+				testAppStatus(appB, "b", appC),
+				testAppStatus(appC, "c")),
+			key: appA,
+		},
+		"Unknown dependency": {
+			all: testAppStatusMap(testAppStatus(appA, "a", appX)),
+			key: appA,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected,
+			findDependencyCycle(test.all, test.key.String()))
+	}
+}
+
+func TestDependencyWait(t *testing.T) {
+	healthy := testAppStatus(appB, "b")
+	healthy.Health.State = types.AppHealthHealthy
+	unhealthy := testAppStatus(appB, "b")
+	unhealthy.Health.State = types.AppHealthUnhealthy
+	failed := testAppStatus(appB, "b")
+	failed.Health.State = types.AppHealthFailed
+	restarting := testAppStatus(appB, "b")
+	restarting.RestartInprogress = types.BRING_DOWN
+	stopped := testAppStatus(appB, "b")
+	stopped.Activated = false
+
+	afterHealthy := testAppStatus(appA, "a")
+	afterHealthy.Dependencies = []types.AppDependency{
+		{UUID: appB, Condition: types.AppStartAfterHealthy},
+	}
+
+	testMatrix := map[string]struct {
+		status      types.AppInstanceStatus
+		others      []types.AppInstanceStatus
+		deactivated []uuid.UUID
+		deleted     []uuid.UUID
+		expected    string
+		expectErr   string
+	}{
+		"No dependencies": {
+			status: testAppStatus(appA, "a"),
+		},
+		"Dependency started": {
+			status: testAppStatus(appA, "a", appB),
+			others: []types.AppInstanceStatus{testAppStatus(appB, "b")},
+		},
+		"Dependency not started": {
+			status:   testAppStatus(appA, "a", appB),
+			others:   []types.AppInstanceStatus{stopped},
+			expected: "b to start",
+		},
+		"Dependency restarting": {
+			status:   testAppStatus(appA, "a", appB),
+			others:   []types.AppInstanceStatus{restarting},
+			expected: "b to start",
+		},
+		"Dependency deactivated": {
+			status:      testAppStatus(appA, "a", appB),
+			others:      []types.AppInstanceStatus{stopped},
+			deactivated: []uuid.UUID{appB},
+			expected:    "b to be activated",
+		},
+		"Dependency being deleted": {
+			status:  testAppStatus(appA, "a", appB),
+			others:  []types.AppInstanceStatus{testAppStatus(appB, "b")},
+			deleted: []uuid.UUID{appB},
+		},
+		"Several dependencies": {
+			status: testAppStatus(appA, "a", appB, appC),
+			others: []types.AppInstanceStatus{stopped,
+				testAppStatus(appC, "c")},
+			expected: "b to start",
+		},
+		"Unknown dependency": {
+			status:    testAppStatus(appA, "a", appX),
+			expectErr: "Depends on unknown app instance " + appX.String(),
+		},
+		"Self cycle": {
+			status:    testAppStatus(appA, "a", appA),
+			expectErr: "Dependency cycle a -> a",
+		},
+		"Indirect cycle": {
+			status: testAppStatus(appA, "a", appB),
+			others: []types.AppInstanceStatus{
+				testAppStatus(appB, "b", appC),
+				testAppStatus(appC, "c", appA)},
+			expectErr: "Dependency cycle a -> b -> c -> a",
+		},
+		"After healthy with a healthy dependency": {
+			status: afterHealthy,
+			others: []types.AppInstanceStatus{healthy},
+		},
+		"After healthy with an unhealthy dependency": {
+			status:   afterHealthy,
+			others:   []types.AppInstanceStatus{unhealthy},
+			expected: "b to be healthy",
+		},
+		"After healthy with a started dependency": {
+			status:   afterHealthy,
+			others:   []types.AppInstanceStatus{testAppStatus(appB, "b")},
+			expected: "b to be healthy",
+		},
+		"After healthy with a failed dependency": {
+			status:    afterHealthy,
+			others:    []types.AppInstanceStatus{failed},
+			expectErr: "Depends on b which failed its health check",
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		all := testAppStatusMap(append(test.others, test.status)...)
+		wait, err := dependencyWait(test.status, all,
+			testActivate(test.deactivated, test.deleted))
+		if test.expectErr != "" {
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectErr, err.Error())
+			}
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, wait)
+	}
+}
+
+func TestDependentsWait(t *testing.T) {
+	stoppedC := testAppStatus(appC, "c", appA)
+	stoppedC.Activated = false
+
+	testMatrix := map[string]struct {
+		deps        []uuid.UUID // Of the app instance being halted
+		others      []types.AppInstanceStatus
+		deactivated []uuid.UUID
+		expected    string
+	}{
+		"No dependents": {
+			others: []types.AppInstanceStatus{testAppStatus(appB, "b")},
+		},
+		"Dependent stays up": {
+			others: []types.AppInstanceStatus{
+				testAppStatus(appB, "b", appA)},
+		},
+		"Dependents halting": {
+			others: []types.AppInstanceStatus{
+				testAppStatus(appC, "c", appA),
+				testAppStatus(appB, "b", appA)},
+			deactivated: []uuid.UUID{appA, appB, appC},
+			expected:    "b to halt, c to halt",
+		},
+		"Dependent halted": {
+			others:      []types.AppInstanceStatus{stoppedC},
+			deactivated: []uuid.UUID{appA, appC},
+		},
+		"Cycle": {
+			deps: []uuid.UUID{appB},
+			others: []types.AppInstanceStatus{
+				testAppStatus(appB, "b", appA)},
+			deactivated: []uuid.UUID{appA, appB},
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		status := testAppStatus(appA, "a", test.deps...)
+		all := testAppStatusMap(append(test.others, status)...)
+		assert.Equal(t, test.expected, dependentsWait(status, all,
+			testActivate(test.deactivated, nil)))
+	}
+}
@@ -6,23 +6,12 @@ package zedmanager
 import (
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"os"
 
 	"github.com/lf-edge/eve/pkg/pillar/cast"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	log "github.com/sirupsen/logrus"
 )
 
-const (
-	persistDir            = "/persist"
-	objectDownloadDirname = persistDir + "/downloads"
-	imgCatalogDirname     = objectDownloadDirname + "/" + appImgObj
-	pendingDirname        = imgCatalogDirname + "/pending"
-	verifierDirname       = imgCatalogDirname + "/verifier"
-	finalDirname          = imgCatalogDirname + "/verified"
-)
-
 func MaybeAddDomainConfig(ctx *zedmanagerContext,
 	aiConfig types.AppInstanceConfig,
 	aiStatus types.AppInstanceStatus,
@@ -82,16 +71,17 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 	i := 0
 	for _, sc := range aiConfig.StorageConfigList {
 		// Check that file is verified
-		locationDir := finalDirname + "/" + sc.ImageSha256
 		location := ""
 		if aiStatus.IsContainer {
 			location = "/persist/rkt"
 		} else {
-			var err error
-			location, err = locationFromDir(locationDir)
-			if err != nil {
-				return err
+			if !ctx.store.Has(sc.ImageSha256) {
+				errStr := fmt.Sprintf("Missing blob %s for %s",
+					sc.ImageSha256, displayName)
+				log.Errorln(errStr)
+				return errors.New(errStr)
 			}
+			location = ctx.store.Path(sc.ImageSha256)
 		}
 		switch sc.Target {
 		case "", "disk", "tgtunknown":
@@ -228,28 +218,3 @@ func handleDomainStatusDelete(ctxArg interface{}, key string,
 	removeAIStatusUUID(ctx, key)
 	log.Infof("handleDomainStatusDelete done for %s\n", key)
 }
-
-func locationFromDir(locationDir string) (string, error) {
-	if _, err := os.Stat(locationDir); err != nil {
-		log.Errorf("Missing directory: %s, %s\n", locationDir, err)
-		return "", err
-	}
-	// locationDir is a directory. Need to find single file inside
-	// which the verifier ensures.
-	locations, err := ioutil.ReadDir(locationDir)
-	if err != nil {
-		log.Errorln(err)
-		return "", err
-	}
-	if len(locations) != 1 {
-		log.Errorf("Multiple files in %s\n", locationDir)
-		return "", errors.New(fmt.Sprintf("Multiple files in %s\n",
-			locationDir))
-	}
-	if len(locations) == 0 {
-		log.Errorf("No files in %s\n", locationDir)
-		return "", errors.New(fmt.Sprintf("No files in %s\n",
-			locationDir))
-	}
-	return locationDir + "/" + locations[0].Name(), nil
-}
@@ -126,7 +126,8 @@ func handleDownloaderStatusModify(ctxArg interface{}, key string,
 	}
 
 	// Normal update case
-	updateAIStatusWithStorageSafename(ctx, key, true, status.ContainerImageID)
+	updateAIStatusWithStorageSafename(ctx, key, true, status.ContainerImageID,
+		status.LayerDigests)
 	log.Infof("handleDownloaderStatusModify done for %s\n",
 		status.Safename)
 }
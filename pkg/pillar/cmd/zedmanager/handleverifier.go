@@ -79,6 +79,7 @@ func MaybeAddVerifyImageConfig(ctx *zedmanagerContext, safename string,
 			SignatureKey:     ss.SignatureKey,
 			IsContainer:      ss.IsContainer,
 			ContainerImageID: ss.ContainerImageID,
+			LayerDigests:     ss.LayerDigests,
 		}
 		publishVerifyImageConfig(ctx, &n)
 		log.Debugf("MaybeAddVerifyImageConfig - config: %+v\n", n)
@@ -174,7 +175,7 @@ func handleVerifyImageStatusModify(ctxArg interface{}, key string,
 	}
 
 	// Normal update work
-	updateAIStatusWithStorageSafename(ctx, key, false, "")
+	updateAIStatusWithStorageSafename(ctx, key, false, "", nil)
 	updateAIStatusSha(ctx, config.ImageSha256)
 	log.Infof("handleVerifyImageStatusModify done for %s\n",
 		status.Safename)
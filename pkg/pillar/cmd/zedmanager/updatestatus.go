@@ -18,7 +18,8 @@ import (
 // Find all the config which refer to this safename.
 func updateAIStatusWithStorageSafename(ctx *zedmanagerContext,
 	safename string,
-	updateContainerImageID bool, containerImageID string) {
+	updateContainerImageID bool, containerImageID string,
+	layerDigests []string) {
 
 	log.Infof("updateAIStatusWithStorageSafename for %s - "+
 		"updateContainerImageID: %v, containerImageID: %s\n",
@@ -49,6 +50,7 @@ func updateAIStatusWithStorageSafename(ctx *zedmanagerContext,
 							containerImageID)
 						status.ContainerImageID = containerImageID
 						ssPtr.ContainerImageID = containerImageID
+						ssPtr.LayerDigests = layerDigests
 						changed = true
 					} else {
 						log.Debugf("No change in ContainerId in Status. "+
@@ -620,7 +622,7 @@ func doInstall(ctx *zedmanagerContext, uuidStr string,
 		case types.INITIAL:
 			// Nothing to do
 		default:
-			ss.ActiveFileLocation = finalDirname + "/" + vs.Safename
+			ss.ActiveFileLocation = vs.FileLocation
 			log.Infof("Update SSL ActiveFileLocation for %s: %s\n",
 				uuidStr, ss.ActiveFileLocation)
 			changed = true
@@ -786,6 +788,17 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 	}
 	log.Debugf("Done with AppNetworkStatus for %s\n", uuidStr)
 
+	// Hold back the boot until the app instances we depend on are up
+	if !status.Activated || status.RestartInprogress == types.BRING_UP ||
+		status.PurgeInprogress == types.BRING_UP {
+		c, ready := checkActivateDependencies(ctx, status)
+		changed = changed || c
+		if !ready {
+			log.Infof("Waiting for dependencies for %s\n", uuidStr)
+			return changed
+		}
+	}
+
 	// Make sure we have a DomainConfig
 	err := MaybeAddDomainConfig(ctx, config, *status, ns)
 	if err != nil {
@@ -1072,6 +1085,14 @@ func doInactivate(ctx *zedmanagerContext, uuidStr string,
 	changed := false
 	done := false
 
+	// Dependents which are going away are halted first
+	c, ready := checkHaltDependents(ctx, status)
+	changed = changed || c
+	if !ready {
+		log.Infof("Waiting for dependents to halt for %s\n", uuidStr)
+		return changed, done
+	}
+
 	// First halt the domain
 	unpublishDomainConfig(ctx, uuidStr)
 
@@ -1254,6 +1275,14 @@ func doInactivateHalt(ctx *zedmanagerContext, uuidStr string,
 	}
 	log.Debugf("Done with AppNetworkStatus for %s\n", uuidStr)
 
+	// Dependents which are also halted go first
+	c, ready := checkHaltDependents(ctx, status)
+	changed = changed || c
+	if !ready {
+		log.Infof("Waiting for dependents to halt for %s\n", uuidStr)
+		return changed
+	}
+
 	// Make sure we have a DomainConfig. Clears dc.Activate based
 	// on the AppInstanceConfig's Activate
 	err := MaybeAddDomainConfig(ctx, config, *status, ns)
@@ -15,6 +15,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/blobstore"
 	"github.com/lf-edge/eve/pkg/pillar/cast"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
@@ -28,6 +29,7 @@ const (
 	certObj   = "cert.obj"
 	agentName = "zedmanager"
 
+	persistDir         = "/persist"
 	certificateDirname = persistDir + "/certs"
 )
 
@@ -54,6 +56,9 @@ type zedmanagerContext struct {
 	subAppImgVerifierStatus *pubsub.Subscription
 	subGlobalConfig         *pubsub.Subscription
 	pubUuidToNum            *pubsub.Publication
+	store                   *blobstore.Store
+	healthResults           chan healthProbeResult
+	healthProbes            map[string]*healthProbeState // Key is app UUID
 }
 
 var deviceNetworkStatus types.DeviceNetworkStatus
@@ -94,7 +99,14 @@ func Run() {
 	agentlog.StillRunning(agentName)
 
 	// Any state needed by handler functions
-	ctx := zedmanagerContext{}
+	ctx := zedmanagerContext{
+		healthResults: make(chan healthProbeResult),
+		healthProbes:  make(map[string]*healthProbeState),
+	}
+	ctx.store, err = blobstore.New(blobstore.DefaultDir)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Create publish before subscribing and activating subscriptions
 	pubAppInstanceStatus, err := pubsub.Publish(agentName,
@@ -272,6 +284,8 @@ func Run() {
 		}
 	}
 
+	healthTicker := time.NewTicker(healthCheckInterval)
+
 	log.Infof("Handling all inputs\n")
 	for {
 		select {
@@ -303,6 +317,13 @@ func Run() {
 		case change := <-subDeviceNetworkStatus.C:
 			subDeviceNetworkStatus.ProcessChange(change)
 
+		case <-healthTicker.C:
+			checkAppHealth(&ctx)
+			retryAwaitingDependency(&ctx)
+
+		case res := <-ctx.healthResults:
+			handleHealthProbeResult(&ctx, res)
+
 		case <-stillRunning.C:
 			agentlog.StillRunning(agentName)
 		}
@@ -435,8 +456,9 @@ func handleCreate(ctxArg interface{}, key string,
 	configArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	config := cast.CastAppInstanceConfig(configArg)
+	alog := agentlog.WithApp(config.UUIDandVersion.UUID.String())
 
-	log.Infof("handleCreate(%v) for %s\n",
+	alog.Infof("handleCreate(%v) for %s\n",
 		config.UUIDandVersion, config.DisplayName)
 
 	status := types.AppInstanceStatus{
@@ -446,6 +468,7 @@ func handleCreate(ctxArg interface{}, key string,
 		OverlayNetworkList:  config.OverlayNetworkList,
 		UnderlayNetworkList: config.UnderlayNetworkList,
 		IoAdapterList:       config.IoAdapterList,
+		Dependencies:        config.Dependencies,
 		RestartCmd:          config.RestartCmd,
 		PurgeCmd:            config.PurgeCmd,
 	}
@@ -455,10 +478,10 @@ func handleCreate(ctxArg interface{}, key string,
 		config.UUIDandVersion.UUID, "purgeCmdCounter")
 	if err == nil {
 		if uint32(c) == status.PurgeCmd.Counter {
-			log.Infof("handleCreate(%v) for %s found matching purge counter %d\n",
+			alog.Infof("handleCreate(%v) for %s found matching purge counter %d\n",
 				config.UUIDandVersion, config.DisplayName, c)
 		} else {
-			log.Warnf("handleCreate(%v) for %s found different purge counter %d vs. %d\n",
+			alog.Warnf("handleCreate(%v) for %s found different purge counter %d vs. %d\n",
 				config.UUIDandVersion, config.DisplayName, c,
 				config.PurgeCmd.Counter)
 			status.PurgeCmd.Counter = config.PurgeCmd.Counter
@@ -469,7 +492,7 @@ func handleCreate(ctxArg interface{}, key string,
 		}
 	} else {
 		// Save this PurgeCmd.Counter as the baseline
-		log.Infof("handleCreate(%v) for %s saving purge counter %d\n",
+		alog.Infof("handleCreate(%v) for %s saving purge counter %d\n",
 			config.UUIDandVersion, config.DisplayName,
 			config.PurgeCmd.Counter)
 		uuidtonum.UuidToNumAllocate(ctx.pubUuidToNum,
@@ -496,10 +519,10 @@ func handleCreate(ctxArg interface{}, key string,
 		status.Error = ""
 		for i, errStr := range config.Errors {
 			status.Error += errStr
-			log.Errorf("App Instance %s-%s: Error(%d): %s",
+			alog.Errorf("App Instance %s-%s: Error(%d): %s",
 				config.DisplayName, config.UUIDandVersion.UUID, i, errStr)
 		}
-		log.Errorf("App Instance %s-%s: Errors in App Instance Create.",
+		alog.Errorf("App Instance %s-%s: Errors in App Instance Create.",
 			config.DisplayName, config.UUIDandVersion.UUID)
 	}
 	publishAppInstanceStatus(ctx, &status)
@@ -513,11 +536,11 @@ func handleCreate(ctxArg interface{}, key string,
 	uuidStr := status.Key()
 	changed := doUpdate(ctx, uuidStr, config, &status)
 	if changed {
-		log.Infof("handleCreate status change for %s\n",
+		alog.Infof("handleCreate status change for %s\n",
 			uuidStr)
 		publishAppInstanceStatus(ctx, &status)
 	}
-	log.Infof("handleCreate done for %s\n", config.DisplayName)
+	alog.Infof("handleCreate done for %s\n", config.DisplayName)
 }
 
 func handleModify(ctxArg interface{}, key string,
@@ -525,7 +548,8 @@ func handleModify(ctxArg interface{}, key string,
 	ctx := ctxArg.(*zedmanagerContext)
 	config := cast.CastAppInstanceConfig(configArg)
 	status := lookupAppInstanceStatus(ctx, key)
-	log.Infof("handleModify(%v) for %s\n",
+	alog := agentlog.WithApp(config.UUIDandVersion.UUID.String())
+	alog.Infof("handleModify(%v) for %s\n",
 		config.UUIDandVersion, config.DisplayName)
 
 	// We handle at least ACL and activate changes. XXX What else?
@@ -539,7 +563,7 @@ func handleModify(ctxArg interface{}, key string,
 	if needRestart ||
 		config.RestartCmd.Counter != status.RestartCmd.Counter {
 
-		log.Infof("handleModify(%v) for %s restartcmd from %d to %d need %v\n",
+		alog.Infof("handleModify(%v) for %s restartcmd from %d to %d need %v\n",
 			config.UUIDandVersion, config.DisplayName,
 			status.RestartCmd.Counter, config.RestartCmd.Counter,
 			needRestart)
@@ -550,14 +574,17 @@ func handleModify(ctxArg interface{}, key string,
 			status.RestartCmd.Counter = config.RestartCmd.Counter
 			status.RestartInprogress = types.BRING_DOWN
 			status.State = types.RESTARTING
+			// Start over after giving up on an unhealthy app
+			status.Health = types.AppHealthStatus{}
+			clearHealthError(status)
 		} else {
-			log.Infof("handleModify(%v) for %s restartcmd ignored config !Activate\n",
+			alog.Infof("handleModify(%v) for %s restartcmd ignored config !Activate\n",
 				config.UUIDandVersion, config.DisplayName)
 			status.RestartCmd.Counter = config.RestartCmd.Counter
 		}
 	}
 	if needPurge || config.PurgeCmd.Counter != status.PurgeCmd.Counter {
-		log.Infof("handleModify(%v) for %s purgecmd from %d to %d need %v\n",
+		alog.Infof("handleModify(%v) for %s purgecmd from %d to %d need %v\n",
 			config.UUIDandVersion, config.DisplayName,
 			status.PurgeCmd.Counter, config.PurgeCmd.Counter,
 			needPurge)
@@ -567,12 +594,13 @@ func handleModify(ctxArg interface{}, key string,
 		// We persist the PurgeCmd Counter when PurgeInprogress is done
 	}
 	status.UUIDandVersion = config.UUIDandVersion
+	status.Dependencies = config.Dependencies
 	publishAppInstanceStatus(ctx, status)
 
 	uuidStr := status.Key()
 	changed := doUpdate(ctx, uuidStr, config, status)
 	if changed {
-		log.Infof("handleModify status change for %s\n",
+		alog.Infof("handleModify status change for %s\n",
 			uuidStr)
 		publishAppInstanceStatus(ctx, status)
 	}
@@ -581,19 +609,20 @@ func handleModify(ctxArg interface{}, key string,
 	status.UnderlayNetworkList = config.UnderlayNetworkList
 	status.IoAdapterList = config.IoAdapterList
 	publishAppInstanceStatus(ctx, status)
-	log.Infof("handleModify done for %s\n", config.DisplayName)
+	alog.Infof("handleModify done for %s\n", config.DisplayName)
 }
 
 func handleDelete(ctx *zedmanagerContext, key string,
 	status *types.AppInstanceStatus) {
+	alog := agentlog.WithApp(status.UUIDandVersion.UUID.String())
 
-	log.Infof("handleDelete(%v) for %s\n",
+	alog.Infof("handleDelete(%v) for %s\n",
 		status.UUIDandVersion, status.DisplayName)
 
 	removeAIStatus(ctx, status)
 	// Remove the recorded PurgeCmd Counter
 	uuidtonum.UuidToNumDelete(ctx.pubUuidToNum, status.UUIDandVersion.UUID)
-	log.Infof("handleDelete done for %s\n", status.DisplayName)
+	alog.Infof("handleDelete done for %s\n", status.DisplayName)
 }
 
 // Returns needRestart, needPurge
@@ -342,21 +342,29 @@ func applyACLRules(aclArgs types.AppNetworkACLArgs,
 	log.Debugf("applyACLRules: ipVer %d, bridgeName %s appIP %s with %d rules\n",
 		aclArgs.IPVer, aclArgs.BridgeName, aclArgs.AppIP, len(rules))
 
+	// Determine the table and chain for all rules first so that the
+	// filter rules can be applied as one set using nftables
+	var prefixedRules types.IPTablesRuleList
+	for _, rule := range rules {
+		log.Debugf("createACLConfiglet: add rule %v\n", rule)
+		if err := rulePrefix(aclArgs, &rule); err != nil {
+			log.Debugf("createACLConfiglet: skipping rule %v\n", rule)
+			continue
+		}
+		prefixedRules = append(prefixedRules, rule)
+	}
+	activeRules, prefixedRules = applyNftACLRules(aclArgs, prefixedRules)
+
 	// the catch all log/drop rules are towards the end of the rule list
 	// hance we are inserting the rule in reverse order at
 	// the top of a target chain, to ensure the drop rules
 	// will be at the end of the rule stack, and the acl match
 	// rules will be at the top of the rule stack for an app
 	// network instance
-	numRules := len(rules)
+	numRules := len(prefixedRules)
 	for numRules > 0 {
 		numRules--
-		rule := rules[numRules]
-		log.Debugf("createACLConfiglet: add rule %v\n", rule)
-		if err := rulePrefix(aclArgs, &rule); err != nil {
-			log.Debugf("createACLConfiglet: skipping rule %v\n", rule)
-			continue
-		}
+		rule := prefixedRules[numRules]
 		err = executeIPTablesRule("-I", rule)
 		if err == nil {
 			activeRules = append(activeRules, rule)
@@ -482,6 +490,18 @@ func aclToRules(aclArgs types.AppNetworkACLArgs, ACLs []types.ACE) (types.IPTabl
 		aclRule5.ActionChainName = chainName
 		rulesList = append(rulesList, aclRule5)
 	}
+	// A Transparent network instance has no DHCP server of its own; let
+	// the applications use the one on the network of the Port.
+	if aclArgs.IPVer == 4 &&
+		aclArgs.NIType == types.NetworkInstanceTypeTransparent {
+		aclRule1.Rule = []string{"-i", aclArgs.BridgeName, "-p", "udp",
+			"--sport", "bootpc", "--dport", "bootps"}
+		aclRule1.Action = []string{"-j", "ACCEPT"}
+		aclRule2.Rule = []string{"-o", aclArgs.BridgeName, "-p", "udp",
+			"--sport", "bootps", "--dport", "bootpc"}
+		aclRule2.Action = []string{"-j", "ACCEPT"}
+		rulesList = append(rulesList, aclRule1, aclRule2)
+	}
 
 	// XXX isMgmt is painful; related to commenting out eidset accepts
 	// XXX won't need this when zedmanager is in a separate domU
@@ -544,6 +564,17 @@ func aclDropRules(aclArgs types.AppNetworkACLArgs) (types.IPTablesRuleList, erro
 		createMarkAndAcceptChain(aclArgs, chainName, -1)
 		aclRule3.Action = []string{"-j", chainName}
 		rulesList = append(rulesList, aclRule1, aclRule2, aclRule3)
+		if aclArgs.IPVer == 6 {
+			rulesList = append(rulesList, aclMarkedDropRule6(aclArgs))
+		}
+	case types.NetworkInstanceTypeHoneyPot:
+		// Nothing gets out of the bridge hence accept what the ACEs
+		// did not drop. honeyPotBridgeRules logs the flows.
+		aclRule3.Rule = []string{"-i", aclArgs.BridgeName}
+		aclRule3.Action = []string{"-j", "ACCEPT"}
+		aclRule4.Rule = []string{"-o", aclArgs.BridgeName}
+		aclRule4.Action = []string{"-j", "ACCEPT"}
+		rulesList = append(rulesList, aclRule3, aclRule4)
 	default:
 		aclRule3.Rule = []string{"-i", aclArgs.BridgeName}
 		aclRule3.Action = []string{"-j", "DROP"}
@@ -554,6 +585,23 @@ func aclDropRules(aclArgs types.AppNetworkACLArgs) (types.IPTablesRuleList, erro
 	return rulesList, nil
 }
 
+// aclMarkedDropRule6 drops the IPv6 flows which got the drop marking.
+// The route for the marking to flow-mon-dummy is IPv4 only hence they
+// would otherwise be forwarded. The explicit filter table keeps the rule
+// in ip6tables when the other FORWARD rules go to nftables, see
+// nftRuleFamily, since nftRuleExpr has no physdev nor mark match.
+func aclMarkedDropRule6(aclArgs types.AppNetworkACLArgs) types.IPTablesRule {
+	return types.IPTablesRule{
+		IPVer: 6,
+		Table: "filter",
+		Chain: "FORWARD",
+		Rule: []string{"-i", aclArgs.BridgeName,
+			"-m", "physdev", "--physdev-in", aclArgs.VifName + "+",
+			"-m", "mark", "--mark", "0xffffffff"},
+		Action: []string{"-j", "DROP"},
+	}
+}
+
 func aceToRules(aclArgs types.AppNetworkACLArgs, ace types.ACE) (types.IPTablesRuleList,
 	error) {
 	var rulesList types.IPTablesRuleList
@@ -656,6 +704,14 @@ func aceToRules(aclArgs types.AppNetworkACLArgs, ace types.ACE) (types.IPTablesR
 		return nil, errors.New(errStr)
 	}
 
+	// A dual-stack application gets the rules for both IP versions;
+	// an ip match only applies to one of them
+	if ip != "" && ipOrCIDRVer(ip) != aclArgs.IPVer {
+		log.Infof("ACE with ip %s skipped for IPv%d: %+v\n",
+			ip, aclArgs.IPVer, ace)
+		return nil, nil
+	}
+
 	if ip != "" {
 		outArgs = append(outArgs, "-d", ip)
 		inArgs = append(inArgs, "-s", ip)
@@ -727,7 +783,8 @@ func aceToRules(aclArgs types.AppNetworkACLArgs, ace types.ACE) (types.IPTablesR
 				return nil, errors.New(errStr)
 			}
 			targetPort := fmt.Sprintf("%d", action.TargetPort)
-			target := fmt.Sprintf("%s:%d", aclArgs.AppIP, action.TargetPort)
+			// IPv6 addresses are in brackets
+			target := net.JoinHostPort(aclArgs.AppIP, targetPort)
 			// These rules are applied on the upLink interfaces and port number.
 			// loop through the uplink interfaces
 			for _, upLink := range aclArgs.UpLinks {
@@ -857,7 +914,7 @@ func aceToRules(aclArgs types.AppNetworkACLArgs, ace types.ACE) (types.IPTablesR
 		}
 	case types.NetworkInstanceTypeCloud:
 		fallthrough
-	case types.NetworkInstanceTypeSwitch:
+	case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeTransparent:
 		if aclRule4.RuleID != -1 {
 			aclRule4.Table = "mangle"
 			aclRule4.Chain = "PREROUTING"
@@ -881,7 +938,8 @@ func aceToRules(aclArgs types.AppNetworkACLArgs, ace types.ACE) (types.IPTablesR
 			for _, uplink := range aclArgs.UpLinks {
 				aclRule3.Table = "mangle"
 				aclRule3.Chain = "PREROUTING"
-				if aclArgs.NIType == types.NetworkInstanceTypeSwitch {
+				if aclArgs.NIType == types.NetworkInstanceTypeSwitch ||
+					aclArgs.NIType == types.NetworkInstanceTypeTransparent {
 					aclRule3.Rule = append(aclRule3.Rule, "-m", "physdev",
 						"--physdev-in", uplink)
 				}
@@ -933,6 +991,18 @@ func isIPorCIDR(str string) bool {
 	return err == nil
 }
 
+// ipOrCIDRVer returns 6 for an IPv6 address or prefix and 4 otherwise
+func ipOrCIDRVer(str string) int {
+	ip := net.ParseIP(str)
+	if ip == nil {
+		ip, _, _ = net.ParseCIDR(str)
+	}
+	if ip != nil && ip.To4() == nil {
+		return 6
+	}
+	return 4
+}
+
 // Determine which rules to skip and what prefix/table to use
 // We append a '+' to the vifname to handle PV/qemu which for some
 // reason have a second <vifname>-emu bridge interface.
@@ -964,7 +1034,9 @@ func rulePrefix(aclArgs types.AppNetworkACLArgs, rule *types.IPTablesRule) error
 		return nil
 	}
 
-	if aclArgs.IPVer == 6 {
+	// A dual-stack Local network instance uses the same tables for IPv6
+	// as for IPv4 below
+	if aclArgs.IPVer == 6 && aclArgs.NIType != types.NetworkInstanceTypeLocal {
 		// The input rules (from domU are applied to raw to intercept
 		// before lisp/pcap can pick them up.
 		// The output rules (to domU) are applied in forwarding path
@@ -1022,6 +1094,10 @@ func rulePrefix(aclArgs types.AppNetworkACLArgs, rule *types.IPTablesRule) error
 		rule.Chain = "FORWARD"
 		if aclArgs.AppIP != "" {
 			rule.Prefix = []string{"-d", aclArgs.AppIP}
+		} else if aclArgs.NIType == types.NetworkInstanceTypeTransparent {
+			// Not our address to know; match on the bridge port
+			rule.Prefix = []string{"-m", "physdev", "--physdev-out",
+				vifName}
 		}
 		return nil
 	}
@@ -1101,22 +1177,53 @@ func updateACLConfiglet(aclArgs types.AppNetworkACLArgs, oldACLs []types.ACE, AC
 			aclArgs.BridgeName, aclArgs.VifName, aclArgs.AppIP)
 		return oldRules, nil
 	}
-	rules, err := deleteACLConfiglet(aclArgs, oldRules)
+	// The nftables chains are flushed and filled with the new rules in
+	// one transaction by createACLConfiglet hence we only delete the
+	// iptables rules here
+	nftRules, oldRules := splitNftRules(oldRules)
+	rules, err := deleteIPTablesRules(oldRules)
 	if err != nil {
 		log.Infof("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s: delete fail\n",
 			aclArgs.BridgeName, aclArgs.VifName, aclArgs.AppIP)
-		return rules, err
+		return append(rules, nftRules...), err
 	}
+	freeNftACEIds(nftRules)
 	return createACLConfiglet(aclArgs, ACLs)
 }
 
+// aclRulesForIPVer returns the rules of a dual-stack application for
+// one IP version
+func aclRulesForIPVer(rules types.IPTablesRuleList,
+	ipVer int) types.IPTablesRuleList {
+
+	var ipVerRules types.IPTablesRuleList
+	for _, rule := range rules {
+		if rule.IPVer == ipVer {
+			ipVerRules = append(ipVerRules, rule)
+		}
+	}
+	return ipVerRules
+}
+
 func deleteACLConfiglet(aclArgs types.AppNetworkACLArgs,
 	rules types.IPTablesRuleList) (types.IPTablesRuleList, error) {
-	var err error
-	var activeRules types.IPTablesRuleList
 	log.Infof("deleteACLConfiglet: ifname %s vifName %s ACLs %v\n",
 		aclArgs.BridgeName, aclArgs.VifName, rules)
 
+	nftRules, rules := splitNftRules(rules)
+	activeRules, err := deleteIPTablesRules(rules)
+	if err != nil {
+		return append(activeRules, nftRules...), err
+	}
+	if err := deleteNftACLRules(aclArgs, nftRules); err != nil {
+		return nftRules, err
+	}
+	return activeRules, nil
+}
+
+func deleteIPTablesRules(rules types.IPTablesRuleList) (types.IPTablesRuleList, error) {
+	var err error
+	var activeRules types.IPTablesRuleList
 	for _, rule := range rules {
 		log.Debugf("deleteACLConfiglet: rule %v\n", rule)
 		if err != nil {
@@ -1302,27 +1409,30 @@ func executeIPTablesRule(operation string, rule types.IPTablesRule) error {
 	if len(rule.Action) > 0 {
 		ruleStr = append(ruleStr, rule.Action...)
 	}
-	if rule.IPVer == 4 {
-		err = iptables.IptableCmd(ruleStr...)
-		if operation == "-D" && rule.Table == "mangle" {
-			if rule.ActionChainName != "" {
-				chainFlush := []string{"-t", "mangle", "--flush", rule.ActionChainName}
-				chainDelete := []string{"-t", "mangle", "-X", rule.ActionChainName}
-				err = iptables.IptableCmd(chainFlush...)
-				if err == nil {
-					iptables.IptableCmd(chainDelete...)
-				}
-			}
-		} else if operation == "-D" {
-			if rule.RuleID != 0 {
-				freeACEId(rule.RuleID)
+	var tableCmd func(args ...string) error
+	switch rule.IPVer {
+	case 4:
+		tableCmd = iptables.IptableCmd
+	case 6:
+		tableCmd = iptables.Ip6tableCmd
+	default:
+		errStr := fmt.Sprintf("ACL: Unknown IP version %d", rule.IPVer)
+		return errors.New(errStr)
+	}
+	err = tableCmd(ruleStr...)
+	if operation == "-D" && rule.Table == "mangle" {
+		if rule.ActionChainName != "" {
+			chainFlush := []string{"-t", "mangle", "--flush", rule.ActionChainName}
+			chainDelete := []string{"-t", "mangle", "-X", rule.ActionChainName}
+			err = tableCmd(chainFlush...)
+			if err == nil {
+				tableCmd(chainDelete...)
 			}
 		}
-	} else if rule.IPVer == 6 {
-		err = iptables.Ip6tableCmd(ruleStr...)
-	} else {
-		errStr := fmt.Sprintf("ACL: Unknown IP version %d", rule.IPVer)
-		err = errors.New(errStr)
+	} else if operation == "-D" {
+		if rule.RuleID != 0 {
+			freeACEId(rule.RuleID)
+		}
 	}
 	return err
 }
@@ -1387,10 +1497,12 @@ func networkInstanceBridgeRules(aclArgs types.AppNetworkACLArgs) types.IPTablesR
 	case types.NetworkInstanceTypeLocal:
 		rules := createFlowMatchRules(aclArgs)
 		rulesList = append(rulesList, rules...)
-	case types.NetworkInstanceTypeSwitch:
+	case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeTransparent:
 		rules := createFlowMatchRules(aclArgs)
 		rulesList = append(rulesList, rules...)
 		// XXX May be add the extra matches rules copied from filter FORWARD
+	case types.NetworkInstanceTypeHoneyPot:
+		rulesList = append(rulesList, honeyPotBridgeRules(aclArgs)...)
 	default:
 	}
 
@@ -1498,9 +1610,14 @@ func createMarkAndAcceptChain(aclArgs types.AppNetworkACLArgs,
 		return errors.New("Invalid chain creation")
 	}
 
+	tableCmd := iptables.IptableCmd
+	if aclArgs.IPVer == 6 {
+		tableCmd = iptables.Ip6tableCmd
+	}
+
 	newChain := []string{"-t", "mangle", "-N", name}
 	log.Infof("createMarkAndAcceptChain: Creating new chain (%s)", name)
-	err := iptables.IptableCmd(newChain...)
+	err := tableCmd(newChain...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New chain (%s) creation failed: %s",
 			name, err)
@@ -1525,44 +1642,44 @@ func createMarkAndAcceptChain(aclArgs types.AppNetworkACLArgs,
 	chainFlush := []string{"-t", "mangle", "--flush", name}
 	chainDelete := []string{"-t", "mangle", "-X", name}
 
-	err = iptables.IptableCmd(rule1...)
+	err = tableCmd(rule1...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
 			rule1, err)
-		iptables.IptableCmd(chainFlush...)
-		iptables.IptableCmd(chainDelete...)
+		tableCmd(chainFlush...)
+		tableCmd(chainDelete...)
 		return err
 	}
-	err = iptables.IptableCmd(rule2...)
+	err = tableCmd(rule2...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
 			rule2, err)
-		iptables.IptableCmd(chainFlush...)
-		iptables.IptableCmd(chainDelete...)
+		tableCmd(chainFlush...)
+		tableCmd(chainDelete...)
 		return err
 	}
-	err = iptables.IptableCmd(rule3...)
+	err = tableCmd(rule3...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
 			rule3, err)
-		iptables.IptableCmd(chainFlush...)
-		iptables.IptableCmd(chainDelete...)
+		tableCmd(chainFlush...)
+		tableCmd(chainDelete...)
 		return err
 	}
-	err = iptables.IptableCmd(rule4...)
+	err = tableCmd(rule4...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
 			rule4, err)
-		iptables.IptableCmd(chainFlush...)
-		iptables.IptableCmd(chainDelete...)
+		tableCmd(chainFlush...)
+		tableCmd(chainDelete...)
 		return err
 	}
-	err = iptables.IptableCmd(rule5...)
+	err = tableCmd(rule5...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
 			rule5, err)
-		iptables.IptableCmd(chainFlush...)
-		iptables.IptableCmd(chainDelete...)
+		tableCmd(chainFlush...)
+		tableCmd(chainDelete...)
 		return err
 	}
 	return nil
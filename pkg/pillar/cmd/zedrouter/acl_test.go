@@ -0,0 +1,76 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPOrCIDRVer(t *testing.T) {
+	testMatrix := map[string]struct {
+		str      string
+		expected int
+	}{
+		"IPv4 address":   {str: "10.1.0.2", expected: 4},
+		"IPv4 CIDR":      {str: "10.1.0.0/24", expected: 4},
+		"IPv6 address":   {str: "fd00:1::2", expected: 6},
+		"IPv6 CIDR":      {str: "fd00:1::/64", expected: 6},
+		"IPv4 in IPv6":   {str: "::ffff:10.1.0.2", expected: 4},
+		"Not an address": {str: "www.example.com", expected: 4},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, ipOrCIDRVer(test.str))
+	}
+}
+
+func TestACLRulesForIPVer(t *testing.T) {
+	rule4a := types.IPTablesRule{IPVer: 4, Rule: []string{"-s", "10.1.0.2"}}
+	rule4b := types.IPTablesRule{IPVer: 4, Rule: []string{"-d", "10.1.0.3"}}
+	rule6 := types.IPTablesRule{IPVer: 6, Rule: []string{"-s", "fd00:1::2"}}
+
+	testMatrix := map[string]struct {
+		rules    types.IPTablesRuleList
+		ipVer    int
+		expected types.IPTablesRuleList
+	}{
+		"No rules": {
+			ipVer: 4,
+		},
+		"IPv4 kept in order": {
+			rules:    types.IPTablesRuleList{rule4a, rule6, rule4b},
+			ipVer:    4,
+			expected: types.IPTablesRuleList{rule4a, rule4b},
+		},
+		"IPv6": {
+			rules:    types.IPTablesRuleList{rule4a, rule6, rule4b},
+			ipVer:    6,
+			expected: types.IPTablesRuleList{rule6},
+		},
+		"None of the version": {
+			rules: types.IPTablesRuleList{rule4a, rule4b},
+			ipVer: 6,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, aclRulesForIPVer(test.rules, test.ipVer))
+	}
+}
+
+func TestACLMarkedDropRule6(t *testing.T) {
+	aclArgs := types.AppNetworkACLArgs{IPVer: 6, BridgeName: "bn1",
+		VifName: "nbu1x1", NIType: types.NetworkInstanceTypeLocal}
+	rule := aclMarkedDropRule6(aclArgs)
+	assert.Equal(t, 6, rule.IPVer)
+	assert.Equal(t, []string{"-i", "bn1", "-m", "physdev",
+		"--physdev-in", "nbu1x1+", "-m", "mark", "--mark", "0xffffffff"},
+		rule.Rule)
+	assert.Equal(t, []string{"-j", "DROP"}, rule.Action)
+	// Stays in ip6tables even when the ACLs go to nftables
+	assert.Equal(t, "", nftRuleFamily(rule))
+}
@@ -223,6 +223,23 @@ func createDnsmasqConfiglet(
 		file.WriteString(fmt.Sprintf("dhcp-range=%s,static,%s,10m\n",
 			dhcpRange, ipv4Netmask))
 	}
+	// Stateful DHCPv6 for the dual-stack case. radvd sends the router
+	// advertisements with the prefix.
+	if netconf.IsDualStack() {
+		file.WriteString(fmt.Sprintf("listen-address=%s\n",
+			netconf.Gateway6.String()))
+		for _, ns := range netconf.DnsServers6 {
+			file.WriteString(fmt.Sprintf("dhcp-option=option6:dns-server,[%s]\n",
+				ns.String()))
+		}
+		if netconf.DomainName != "" {
+			file.WriteString(fmt.Sprintf("dhcp-option=option6:domain-search,%s\n",
+				netconf.DomainName))
+		}
+		prefixLen, _ := netconf.Subnet6.Mask.Size()
+		file.WriteString(fmt.Sprintf("dhcp-range=%s,static,%d,10m\n",
+			netconf.Subnet6.IP.String(), prefixLen))
+	}
 }
 
 func addhostDnsmasq(bridgeName string, appMac string, appIPAddr string,
@@ -475,6 +492,11 @@ func readLeases() []dnsmasqLease {
 
 		// Should have 5 space-separated fields. We only use 4.
 		tokens := strings.Split(line, " ")
+		if tokens[0] == "duid" {
+			// The DHCPv6 leases which follow have the IAID instead
+			// of the MAC address in the second field
+			break
+		}
 		if len(tokens) < 4 {
 			log.Errorf("Less than 4 fields in leases file: %v",
 				tokens)
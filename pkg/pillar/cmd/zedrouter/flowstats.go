@@ -597,6 +597,11 @@ func DNSMonitor(bn string, bnNum int) {
 // DNSStopMonitor : Stop DNS Query monitoring
 func DNSStopMonitor(bnNum int) {
 	log.Infof("(FlowStats) Stop DNS Monitor on bridge-num %d", bnNum)
+	if dnssys[bnNum].Done == nil {
+		// Not started for a bridge without an IP address such as
+		// for a Transparent network instance
+		return
+	}
 	dnssys[bnNum].Done <- true
 	dnssys[bnNum].Lock()
 	dnsDataRemove(bnNum)
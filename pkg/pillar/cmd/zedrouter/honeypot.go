@@ -0,0 +1,357 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// HoneyPot network instances. The bridge has no Port and the mangle
+// FORWARD rules drop anything routed into or out of it, hence the
+// applications only reach each other and dnsmasq on the bridge IP.
+// New flows are logged and while the network instance is activated all
+// frames on the bridge are written to a pcap file. When the file is full
+// it is renamed with a .1 suffix and a new one is started.
+// The captures are kept in /persist until the network instance is
+// deleted. All of them together stay within captureMaxTotalSize: each
+// network instance reserves space for its two files when activated, and
+// the files are smaller when other network instances reserved the rest.
+
+package zedrouter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcap"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	honeyPotDirname     = "/persist/honeypot"
+	honeyPotLogPrefix   = "HONEYPOT:"
+	captureSnapLen      = 65535
+	captureMaxFileSize  = 8 * 1024 * 1024
+	captureMinFileSize  = 1024 * 1024
+	captureMaxTotalSize = 64 * 1024 * 1024
+	captureReadTimeout  = time.Second
+	captureStatsTimeout = 10 * time.Second
+)
+
+type captureSys struct {
+	sync.Mutex
+	Done    chan bool
+	Metrics types.CaptureMetrics
+}
+
+var honeyPotCapture [maxBridgeNumber]captureSys // per bridge capture
+
+// Bytes reserved in honeyPotDirname per bridge, from activate to delete
+var (
+	captureReservedLock sync.Mutex
+	captureReserved     = make(map[int]int)
+)
+
+// reserveCaptureSpace returns the maximum size of the capture files of
+// the bridge given what the other bridges reserved. Replaces any earlier
+// reservation of the bridge.
+func reserveCaptureSpace(bridgeNum int) (int, error) {
+	captureReservedLock.Lock()
+	defer captureReservedLock.Unlock()
+	used := 0
+	for num, reserved := range captureReserved {
+		if num != bridgeNum {
+			used += reserved
+		}
+	}
+	// The current file and the .1 file
+	fileSize := (captureMaxTotalSize - used) / 2
+	if fileSize > captureMaxFileSize {
+		fileSize = captureMaxFileSize
+	}
+	if fileSize < captureMinFileSize {
+		return 0, fmt.Errorf("No space for a capture; %d bytes used by other captures",
+			used)
+	}
+	captureReserved[bridgeNum] = 2 * fileSize
+	return fileSize, nil
+}
+
+func releaseCaptureSpace(bridgeNum int) {
+	captureReservedLock.Lock()
+	defer captureReservedLock.Unlock()
+	delete(captureReserved, bridgeNum)
+}
+
+// honeyPotBridgeRules are added for the bridge when the network instance
+// is activated and removed when it is deleted
+func honeyPotBridgeRules(aclArgs types.AppNetworkACLArgs) types.IPTablesRuleList {
+	var rulesList types.IPTablesRuleList
+
+	bridgeName := aclArgs.BridgeName
+	logPrefix := honeyPotLogPrefix + bridgeName + ":"
+	for _, ipVer := range []int{4, 6} {
+		var aclRule types.IPTablesRule
+		aclRule.IPVer = ipVer
+		aclRule.Table = "mangle"
+
+		// The mangle table sees the flows before the filter ACCEPT
+		// rules of the applications
+		aclRule.Chain = "PREROUTING"
+		aclRule.Rule = []string{"-i", bridgeName, "-m", "conntrack",
+			"--ctstate", "NEW"}
+		aclRule.Action = []string{"-j", "LOG", "--log-prefix",
+			logPrefix, "--log-level", "4"}
+		rulesList = append(rulesList, aclRule)
+
+		// Bridged packets have the bridge as input and output
+		aclRule.Chain = "FORWARD"
+		aclRule.Rule = []string{"-i", bridgeName, "!", "-o", bridgeName}
+		aclRule.Action = []string{"-j", "DROP"}
+		rulesList = append(rulesList, aclRule)
+		aclRule.Rule = []string{"!", "-i", bridgeName, "-o", bridgeName}
+		rulesList = append(rulesList, aclRule)
+	}
+	return rulesList
+}
+
+// honeyPotActivate starts the capture
+func honeyPotActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Infof("honeyPotActivate(%s)\n", status.DisplayName)
+	bridgeNum := status.BridgeNum
+	if bridgeNum >= maxBridgeNumber {
+		return fmt.Errorf("Can not capture on bridge number %d", bridgeNum)
+	}
+	if err := os.MkdirAll(honeyPotDirname, 0700); err != nil {
+		return err
+	}
+	maxFileSize, err := reserveCaptureSpace(bridgeNum)
+	if err != nil {
+		return err
+	}
+	// Files from an earlier activation keep their reservation
+	release := func() {
+		if status.CaptureFile == "" {
+			releaseCaptureSpace(bridgeNum)
+		}
+	}
+	handle, err := pcap.OpenLive(status.BridgeName, captureSnapLen, true,
+		captureReadTimeout)
+	if err != nil {
+		release()
+		return fmt.Errorf("Can not capture on bridge %s: %s",
+			status.BridgeName, err)
+	}
+	filename := filepath.Join(honeyPotDirname, status.Key()+".pcap")
+	w, err := newCaptureWriter(filename, handle.LinkType(), maxFileSize)
+	if err != nil {
+		handle.Close()
+		release()
+		return err
+	}
+	cs := &honeyPotCapture[bridgeNum]
+	cs.Lock()
+	cs.Done = make(chan bool)
+	cs.Metrics = types.CaptureMetrics{}
+	cs.Unlock()
+	status.CaptureFile = filename
+	go honeyPotCaptureLoop(status.BridgeName, cs, cs.Done, handle, w)
+	return nil
+}
+
+// honeyPotInactivate stops the capture
+func honeyPotInactivate(status *types.NetworkInstanceStatus) {
+
+	log.Infof("honeyPotInactivate(%s)\n", status.DisplayName)
+	if status.BridgeNum >= maxBridgeNumber {
+		return
+	}
+	cs := &honeyPotCapture[status.BridgeNum]
+	cs.Lock()
+	done := cs.Done
+	cs.Done = nil
+	cs.Unlock()
+	if done != nil {
+		done <- true
+	}
+}
+
+// honeyPotDelete removes the capture files
+func honeyPotDelete(status *types.NetworkInstanceStatus) {
+
+	log.Infof("honeyPotDelete(%s)\n", status.DisplayName)
+	if status.CaptureFile == "" {
+		return
+	}
+	releaseCaptureSpace(status.BridgeNum)
+	for _, filename := range []string{status.CaptureFile,
+		status.CaptureFile + ".1"} {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			log.Errorf("honeyPotDelete(%s): %s\n",
+				status.DisplayName, err)
+		}
+	}
+	status.CaptureFile = ""
+}
+
+func honeyPotCaptureMetrics(status *types.NetworkInstanceStatus) *types.CaptureMetrics {
+
+	if status.BridgeNum >= maxBridgeNumber {
+		return nil
+	}
+	cs := &honeyPotCapture[status.BridgeNum]
+	cs.Lock()
+	defer cs.Unlock()
+	metrics := cs.Metrics
+	return &metrics
+}
+
+func honeyPotCaptureLoop(bridgeName string, cs *captureSys, done chan bool,
+	handle *pcap.Handle, w *captureWriter) {
+
+	log.Infof("honeyPotCaptureLoop(%s) started\n", bridgeName)
+	defer handle.Close()
+	defer w.close()
+	lastStats := time.Now()
+	for {
+		select {
+		case <-done:
+			log.Infof("honeyPotCaptureLoop(%s) done\n", bridgeName)
+			return
+		default:
+		}
+		data, ci, err := handle.ReadPacketData()
+		if err != nil && err != pcap.NextErrorTimeoutExpired {
+			log.Errorf("honeyPotCaptureLoop(%s) stopped: %s\n",
+				bridgeName, err)
+			<-done
+			return
+		}
+		if err == nil {
+			rotated, err := w.writePacket(ci, data)
+			if err != nil {
+				log.Errorf("honeyPotCaptureLoop(%s) stopped: %s\n",
+					bridgeName, err)
+				<-done
+				return
+			}
+			cs.Lock()
+			cs.Metrics.Pkts++
+			cs.Metrics.Bytes += uint64(ci.Length)
+			if rotated {
+				cs.Metrics.Rotations++
+			}
+			cs.Unlock()
+		} else if err := w.flush(); err != nil {
+			log.Errorf("honeyPotCaptureLoop(%s): %s\n", bridgeName, err)
+		}
+		if time.Since(lastStats) < captureStatsTimeout {
+			continue
+		}
+		lastStats = time.Now()
+		stats, err := handle.Stats()
+		if err != nil {
+			log.Errorf("honeyPotCaptureLoop(%s): %s\n", bridgeName, err)
+			continue
+		}
+		cs.Lock()
+		cs.Metrics.Drops = uint64(stats.PacketsDropped +
+			stats.PacketsIfDropped)
+		cs.Unlock()
+	}
+}
+
+// captureWriter writes the libpcap file format
+type captureWriter struct {
+	filename string
+	linkType layers.LinkType
+	maxSize  int // Of each of the files
+	file     *os.File
+	buf      *bufio.Writer
+	size     int
+}
+
+// newCaptureWriter keeps any previous capture as the .1 file
+func newCaptureWriter(filename string, linkType layers.LinkType,
+	maxSize int) (*captureWriter, error) {
+
+	w := &captureWriter{filename: filename, linkType: linkType,
+		maxSize: maxSize}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *captureWriter) open() error {
+
+	if err := os.Rename(w.filename, w.filename+".1"); err != nil &&
+		!os.IsNotExist(err) {
+		return err
+	}
+	file, err := os.OpenFile(w.filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+		0600)
+	if err != nil {
+		return err
+	}
+	w.file = file
+	w.buf = bufio.NewWriter(file)
+	w.size = 0
+
+	hdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(hdr[0:], 0xa1b2c3d4) // microseconds
+	binary.LittleEndian.PutUint16(hdr[4:], 2)          // version 2.4
+	binary.LittleEndian.PutUint16(hdr[6:], 4)
+	binary.LittleEndian.PutUint32(hdr[16:], captureSnapLen)
+	binary.LittleEndian.PutUint32(hdr[20:], uint32(w.linkType))
+	return w.write(hdr)
+}
+
+func (w *captureWriter) write(b []byte) error {
+	n, err := w.buf.Write(b)
+	w.size += n
+	return err
+}
+
+// writePacket returns true if it started a new file
+func (w *captureWriter) writePacket(ci gopacket.CaptureInfo,
+	data []byte) (bool, error) {
+
+	rotated := false
+	if w.size+16+len(data) > w.maxSize {
+		if err := w.close(); err != nil {
+			return false, err
+		}
+		if err := w.open(); err != nil {
+			return false, err
+		}
+		rotated = true
+	}
+	hdr := make([]byte, 16)
+	binary.LittleEndian.PutUint32(hdr[0:], uint32(ci.Timestamp.Unix()))
+	binary.LittleEndian.PutUint32(hdr[4:],
+		uint32(ci.Timestamp.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(hdr[8:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(hdr[12:], uint32(ci.Length))
+	if err := w.write(hdr); err != nil {
+		return rotated, err
+	}
+	return rotated, w.write(data)
+}
+
+func (w *captureWriter) flush() error {
+	return w.buf.Flush()
+}
+
+func (w *captureWriter) close() error {
+	err := w.buf.Flush()
+	if err2 := w.file.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
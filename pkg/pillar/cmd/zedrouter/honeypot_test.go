@@ -0,0 +1,232 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPcapPacket struct {
+	ci   gopacket.CaptureInfo
+	data []byte
+}
+
+// readTestPcap parses the little endian microsecond libpcap format
+// written by captureWriter
+func readTestPcap(t *testing.T, filename string) (layers.LinkType, []testPcapPacket) {
+	b, err := ioutil.ReadFile(filename)
+	if !assert.NoError(t, err) || !assert.True(t, len(b) >= 24) {
+		return 0, nil
+	}
+	assert.Equal(t, uint32(0xa1b2c3d4), binary.LittleEndian.Uint32(b[0:]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(b[4:]))
+	assert.Equal(t, uint16(4), binary.LittleEndian.Uint16(b[6:]))
+	assert.Equal(t, uint32(captureSnapLen), binary.LittleEndian.Uint32(b[16:]))
+	linkType := layers.LinkType(binary.LittleEndian.Uint32(b[20:]))
+	var packets []testPcapPacket
+	for b = b[24:]; len(b) > 0; {
+		if !assert.True(t, len(b) >= 16, "truncated record header") {
+			break
+		}
+		capLen := int(binary.LittleEndian.Uint32(b[8:]))
+		if !assert.True(t, len(b) >= 16+capLen, "truncated record") {
+			break
+		}
+		ts := time.Unix(int64(binary.LittleEndian.Uint32(b[0:])),
+			int64(binary.LittleEndian.Uint32(b[4:]))*1000)
+		packets = append(packets, testPcapPacket{
+			ci: gopacket.CaptureInfo{
+				Timestamp:     ts,
+				CaptureLength: capLen,
+				Length:        int(binary.LittleEndian.Uint32(b[12:])),
+			},
+			data: b[16 : 16+capLen],
+		})
+		b = b[16+capLen:]
+	}
+	return linkType, packets
+}
+
+func testPacket(i int) testPcapPacket {
+	data := make([]byte, 100)
+	for j := range data {
+		data[j] = byte(i)
+	}
+	return testPcapPacket{
+		ci: gopacket.CaptureInfo{
+			Timestamp:     time.Unix(1560000000+int64(i), int64(i)*1000),
+			CaptureLength: len(data),
+			Length:        len(data) + i,
+		},
+		data: data,
+	}
+}
+
+func TestCaptureWriter(t *testing.T) {
+	// Room for the header and three packets per file
+	const maxSize = 24 + 3*(16+100)
+
+	testMatrix := map[string]struct {
+		previous        bool
+		packets         int
+		expectedRotated []bool
+		expectedCurrent []int
+		expectedOld     []int
+	}{
+		"One packet": {
+			packets:         1,
+			expectedRotated: []bool{false},
+			expectedCurrent: []int{0},
+		},
+		"Full file": {
+			packets:         3,
+			expectedRotated: []bool{false, false, false},
+			expectedCurrent: []int{0, 1, 2},
+		},
+		"Rotate once": {
+			packets:         5,
+			expectedRotated: []bool{false, false, false, true, false},
+			expectedCurrent: []int{3, 4},
+			expectedOld:     []int{0, 1, 2},
+		},
+		"Rotate twice": {
+			packets: 7,
+			expectedRotated: []bool{false, false, false, true, false,
+				false, true},
+			expectedCurrent: []int{6},
+			expectedOld:     []int{3, 4, 5},
+		},
+		"Previous capture kept": {
+			previous:        true,
+			packets:         1,
+			expectedRotated: []bool{false},
+			expectedCurrent: []int{0},
+			expectedOld:     []int{},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dir, err := ioutil.TempDir("", "honeypot")
+		if !assert.NoError(t, err) {
+			continue
+		}
+		filename := filepath.Join(dir, "ni.pcap")
+		if test.previous {
+			w, err := newCaptureWriter(filename, layers.LinkTypeEthernet,
+				maxSize)
+			if assert.NoError(t, err) {
+				assert.NoError(t, w.close())
+			}
+		}
+		w, err := newCaptureWriter(filename, layers.LinkTypeEthernet, maxSize)
+		if !assert.NoError(t, err) {
+			os.RemoveAll(dir)
+			continue
+		}
+		var rotated []bool
+		for i := 0; i < test.packets; i++ {
+			p := testPacket(i)
+			r, err := w.writePacket(p.ci, p.data)
+			assert.NoError(t, err)
+			rotated = append(rotated, r)
+		}
+		assert.NoError(t, w.close())
+		assert.Equal(t, test.expectedRotated, rotated)
+
+		checkFile := func(filename string, expected []int) {
+			linkType, packets := readTestPcap(t, filename)
+			assert.Equal(t, layers.LinkTypeEthernet, linkType)
+			if !assert.Equal(t, len(expected), len(packets)) {
+				return
+			}
+			for i, p := range packets {
+				e := testPacket(expected[i])
+				assert.True(t, e.ci.Timestamp.Equal(p.ci.Timestamp))
+				assert.Equal(t, e.ci.CaptureLength, p.ci.CaptureLength)
+				assert.Equal(t, e.ci.Length, p.ci.Length)
+				assert.Equal(t, e.data, p.data)
+			}
+		}
+		checkFile(filename, test.expectedCurrent)
+		if test.expectedOld == nil {
+			_, err := os.Stat(filename + ".1")
+			assert.True(t, os.IsNotExist(err))
+		} else {
+			checkFile(filename+".1", test.expectedOld)
+		}
+		for _, name := range []string{filename, filename + ".1"} {
+			if info, err := os.Stat(name); err == nil {
+				assert.True(t, info.Size() <= maxSize)
+			}
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestReserveCaptureSpace(t *testing.T) {
+	testMatrix := map[string]struct {
+		reserved   map[int]int
+		bridgeNum  int
+		expected   int
+		expectFail bool
+	}{
+		"No other capture": {
+			bridgeNum: 1,
+			expected:  captureMaxFileSize,
+		},
+		"Own reservation replaced": {
+			reserved:  map[int]int{1: captureMaxTotalSize},
+			bridgeNum: 1,
+			expected:  captureMaxFileSize,
+		},
+		"Smaller files": {
+			reserved:  map[int]int{2: captureMaxTotalSize - 4*1024*1024},
+			bridgeNum: 1,
+			expected:  2 * 1024 * 1024,
+		},
+		"Minimum file size": {
+			reserved:  map[int]int{2: captureMaxTotalSize - 2*captureMinFileSize},
+			bridgeNum: 1,
+			expected:  captureMinFileSize,
+		},
+		"No space": {
+			reserved: map[int]int{
+				2: captureMaxTotalSize / 2,
+				3: captureMaxTotalSize / 2,
+			},
+			bridgeNum:  1,
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		captureReserved = make(map[int]int)
+		for num, reserved := range test.reserved {
+			captureReserved[num] = reserved
+		}
+		fileSize, err := reserveCaptureSpace(test.bridgeNum)
+		if test.expectFail {
+			assert.Error(t, err)
+			_, ok := captureReserved[test.bridgeNum]
+			assert.False(t, ok)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, fileSize)
+			assert.Equal(t, 2*test.expected, captureReserved[test.bridgeNum])
+		}
+		releaseCaptureSpace(test.bridgeNum)
+		_, ok := captureReserved[test.bridgeNum]
+		assert.False(t, ok)
+	}
+	captureReserved = make(map[int]int)
+}
@@ -2,6 +2,11 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // default ipset configlet for interfaces towards domU
+//
+// With nftables the sets we fill in ourselves are mirrored in named sets
+// in the nftables tables. The host ipsets which dnsmasq fills in are not
+// since that needs its nftset= option from dnsmasq 2.87; the application
+// interfaces using them keep all their ACLs in iptables.
 
 package zedrouter
 
@@ -27,30 +32,38 @@ func createDefaultIpset() {
 	set4 := "ipv4." + ipsetName
 	set6 := "ipv6." + ipsetName
 
-	prefixes := []string{"fe80::/10", "ff02::/16"}
-	for _, prefix := range prefixes {
+	prefixes6 := []string{"fe80::/10", "ff02::/16"}
+	for _, prefix := range prefixes6 {
 		err := ipsetAdd(set6, prefix)
 		if err != nil {
 			log.Errorln("ipset add ", set6, prefix, err)
 		}
 	}
-	prefixes = []string{"0.0.0.0/32", "255.255.255.255/32", "224.0.0.0/4"}
-	for _, prefix := range prefixes {
+	prefixes4 := []string{"0.0.0.0/32", "255.255.255.255/32", "224.0.0.0/4"}
+	for _, prefix := range prefixes4 {
 		err := ipsetAdd(set4, prefix)
 		if err != nil {
 			log.Errorln("ipset add ", set4, prefix, err)
 		}
 	}
+	if useNftables {
+		if err := nftSetCreate(set6, prefixes6); err != nil {
+			log.Errorln("nft set create ", set6, err)
+		}
+		if err := nftSetCreate(set4, prefixes4); err != nil {
+			log.Errorln("nft set create ", set4, err)
+		}
+	}
 }
 
 // Create an ipset called eids.<vifname> with all the addresses from
 // the DnsNameToIPList.
 // Would be more polite to return an error then to Fatal
 func createDefaultIpsetConfiglet(vifname string, nameToIPList []types.DnsNameToIP,
-	appIPAddr string) {
+	appIPAddrs ...string) {
 
-	log.Debugf("createDefaultIpsetConfiglet: olifName %s nameToIPList %v appIPAddr %s\n",
-		vifname, nameToIPList, appIPAddr)
+	log.Debugf("createDefaultIpsetConfiglet: olifName %s nameToIPList %v appIPAddrs %v\n",
+		vifname, nameToIPList, appIPAddrs)
 	ipsetName := "eids." + vifname
 	err := ipsetCreatePair(ipsetName, "hash:ip")
 	if err != nil {
@@ -58,16 +71,7 @@ func createDefaultIpsetConfiglet(vifname string, nameToIPList []types.DnsNameToI
 	}
 	set4 := "ipv4." + ipsetName
 	set6 := "ipv6." + ipsetName
-	var appIP net.IP
-	if appIPAddr != "" {
-		// XXX should we change strings to net.IP across the board
-		// to avoid parsing in places like this?
-		appIP = net.ParseIP(appIPAddr)
-		if appIP == nil {
-			log.Errorf("ipset failed to parse appIPAddr %s\n",
-				appIPAddr)
-		}
-	}
+	members := make(map[string][]string)
 	for _, ne := range nameToIPList {
 		for _, ip := range ne.IPs {
 			var set string
@@ -81,13 +85,26 @@ func createDefaultIpsetConfiglet(vifname string, nameToIPList []types.DnsNameToI
 				log.Errorln("ipset add ", set,
 					ip.String(), err)
 			}
-			// Is appIP in nameToIPList?
-			if appIP != nil && ip.Equal(appIP) {
-				appIP = nil
-			}
+			members[set] = append(members[set], ip.String())
 		}
 	}
-	if appIP != nil {
+	// A dual-stack application has both an IPv4 and an IPv6 address
+	for _, appIPAddr := range appIPAddrs {
+		if appIPAddr == "" {
+			continue
+		}
+		// XXX should we change strings to net.IP across the board
+		// to avoid parsing in places like this?
+		appIP := net.ParseIP(appIPAddr)
+		if appIP == nil {
+			log.Errorf("ipset failed to parse appIPAddr %s\n",
+				appIPAddr)
+			continue
+		}
+		// Is appIP in nameToIPList?
+		if containsIP(nameToIPList, appIP) {
+			continue
+		}
 		var set string
 		if appIP.To4() == nil {
 			set = set6
@@ -98,6 +115,14 @@ func createDefaultIpsetConfiglet(vifname string, nameToIPList []types.DnsNameToI
 		if err != nil {
 			log.Errorln("ipset add ", set, appIP.String(), err)
 		}
+		members[set] = append(members[set], appIP.String())
+	}
+	if useNftables {
+		for _, set := range []string{set4, set6} {
+			if err := nftSetCreate(set, members[set]); err != nil {
+				log.Errorln("nft set create ", set, err)
+			}
+		}
 	}
 }
 
@@ -109,6 +134,8 @@ func updateDefaultIpsetConfiglet(vifname string,
 	ipsetName := "eids." + vifname
 	set4 := "ipv4." + ipsetName
 	set6 := "ipv6." + ipsetName
+	added := make(map[string][]string)
+	deleted := make(map[string][]string)
 
 	// Look for IPs which should be deleted
 	for _, ne := range oldList {
@@ -125,6 +152,7 @@ func updateDefaultIpsetConfiglet(vifname string,
 					log.Errorln("ipset del ", set,
 						ip.String(), err)
 				}
+				deleted[set] = append(deleted[set], ip.String())
 			}
 		}
 	}
@@ -144,6 +172,15 @@ func updateDefaultIpsetConfiglet(vifname string,
 					log.Errorln("ipset add ", set,
 						ip.String(), err)
 				}
+				added[set] = append(added[set], ip.String())
+			}
+		}
+	}
+	if useNftables {
+		for _, set := range []string{set4, set6} {
+			err := nftSetUpdate(set, added[set], deleted[set])
+			if err != nil {
+				log.Errorln("nft set update ", set, err)
 			}
 		}
 	}
@@ -164,6 +201,14 @@ func deleteDefaultIpsetConfiglet(vifname string, printOnError bool) {
 	if err != nil && printOnError {
 		log.Errorln("ipset destroy ", set6, err)
 	}
+	if useNftables {
+		for _, set := range []string{set4, set6} {
+			err := nftSetDestroy(set)
+			if err != nil && printOnError {
+				log.Errorln("nft set destroy ", set, err)
+			}
+		}
+	}
 }
 
 // If doesn't exist create the ipv4/ipv6 pair of sets.
@@ -14,6 +14,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/eriknordmark/netlink"
 	"github.com/lf-edge/eve/pkg/pillar/cast"
@@ -24,7 +25,8 @@ import (
 )
 
 func allowSharedPort(status *types.NetworkInstanceStatus) bool {
-	return status.Type != types.NetworkInstanceTypeSwitch
+	return status.Type != types.NetworkInstanceTypeSwitch &&
+		status.Type != types.NetworkInstanceTypeTransparent
 }
 
 // isSharedPortLabel
@@ -32,8 +34,9 @@ func allowSharedPort(status *types.NetworkInstanceStatus) bool {
 //	we used for ports used by Dom0 itself to reach the cloud. But
 //  these can also be shared as L3 ports by the applications ie.,
 //	NI of kind Local can use them as well. Infact, except
-//  NetworkInstanceTypeSwitch, all other current types of network instance
-//  can share the port. Whether such ports can be used by network instance
+//  NetworkInstanceTypeSwitch and NetworkInstanceTypeTransparent, all other
+//  current types of network instance can share the port. Whether such
+//  ports can be used by network instance
 //  can be checked  using allowSharedPort() function
 func isSharedPortLabel(port string) bool {
 	// XXX - I think we can get rid of these built-in labels (uplink/freeuplink).
@@ -92,8 +95,9 @@ func checkPortAvailable(
 	}
 
 	if allowSharedPort(status) {
-		// Make sure it is configured for IP or will be
-		if portStatus.Dhcp == types.DT_NONE {
+		// Make sure it is configured for IP or will be. With a VlanID
+		// that is checked for the VLAN port in vlanActivate.
+		if status.VlanID == 0 && portStatus.Dhcp == types.DT_NONE {
 			errStr := fmt.Sprintf("Port %s not configured for shared use. "+
 				"Cannot be used by Switch Network Instance %s-%s\n",
 				status.Port, status.UUID, status.DisplayName)
@@ -104,7 +108,8 @@ func checkPortAvailable(
 			if status == iterStatusEntry {
 				continue
 			}
-			if !iterStatusEntry.IsUsingPort(status.Port) {
+			if !iterStatusEntry.IsUsingPort(status.Port) ||
+				!vlanOverlaps(status, iterStatusEntry) {
 				continue
 			}
 			if !allowSharedPort(iterStatusEntry) {
@@ -118,8 +123,10 @@ func checkPortAvailable(
 			}
 		}
 	} else {
-		// Make sure it will not be configured for IP
-		if portStatus.Dhcp != types.DT_NONE {
+		// Make sure it will not be configured for IP. Tagged traffic
+		// does not reach the IP configuration of the port; with a
+		// VlanID the VLAN port is checked in vlanActivate.
+		if status.VlanID == 0 && portStatus.Dhcp != types.DT_NONE {
 			errStr := fmt.Sprintf("Port %s configured for shared use with DHCP type %d. "+
 				"Cannot be used by Switch Network Instance %s-%s\n",
 				status.Port, portStatus.Dhcp, status.UUID, status.DisplayName)
@@ -130,7 +137,8 @@ func checkPortAvailable(
 			if status == iterStatusEntry {
 				continue
 			}
-			if iterStatusEntry.IsUsingPort(status.Port) {
+			if iterStatusEntry.IsUsingPort(status.Port) &&
+				vlanOverlaps(status, iterStatusEntry) {
 				errStr := fmt.Sprintf("Port %s already used by NetworkInstance %s-%s. "+
 					"Cannot be used by Switch Network Instance %s-%s\n",
 					status.Port, iterStatusEntry.UUID, iterStatusEntry.DisplayName,
@@ -142,6 +150,23 @@ func checkPortAvailable(
 	return nil
 }
 
+// vlanOverlaps returns true if the two network instances on the same port
+// would see each other's traffic. An untagged Switch gets all of it.
+func vlanOverlaps(status1 *types.NetworkInstanceStatus,
+	status2 *types.NetworkInstanceStatus) bool {
+
+	if status1.VlanID == status2.VlanID {
+		return true
+	}
+	if status1.VlanID == 0 && !allowSharedPort(status1) {
+		return true
+	}
+	if status2.VlanID == 0 && !allowSharedPort(status2) {
+		return true
+	}
+	return false
+}
+
 func isOverlay(netType types.NetworkInstanceType) bool {
 	if netType == types.NetworkInstanceTypeMesh {
 		return true
@@ -409,8 +434,9 @@ func handleNetworkInstanceCreate(
 	status := types.NetworkInstanceStatus{
 		NetworkInstanceConfig: config,
 		NetworkInstanceInfo: types.NetworkInstanceInfo{
-			IPAssignments: make(map[string]net.IP),
-			VifMetricMap:  make(map[string]types.NetworkMetric),
+			IPAssignments:   make(map[string]net.IP),
+			IPv6Assignments: make(map[string]net.IP),
+			VifMetricMap:    make(map[string]types.NetworkMetric),
 		},
 	}
 
@@ -515,7 +541,7 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	if status.BridgeIPAddr != "" {
 		// XXX arbitrary name "router"!!
 		addToHostsConfiglet(hostsDirpath, "router",
-			[]string{status.BridgeIPAddr})
+			bridgeIPAddrs(status))
 	}
 
 	// Start clean
@@ -566,6 +592,31 @@ func doNetworkInstanceSanityCheck(
 		// Do nothing
 	case types.NetworkInstanceTypeMesh:
 		// Do nothing
+	case types.NetworkInstanceTypeTransparent:
+		// The applications are on the network of the Port
+		if status.Port == "" {
+			err := fmt.Sprintf("Transparent network instance %s-%s without a Port",
+				status.DisplayName, status.UUID)
+			return errors.New(err)
+		}
+		if status.IpType != types.AddressTypeNone {
+			err := fmt.Sprintf("IpType %d not supported for Transparent network instance %s-%s",
+				status.IpType, status.DisplayName, status.UUID)
+			return errors.New(err)
+		}
+	case types.NetworkInstanceTypeHoneyPot:
+		// Isolated hence needs its own subnet for the applications
+		if status.Port != "" {
+			err := fmt.Sprintf("HoneyPot network instance %s-%s can not use Port %s",
+				status.DisplayName, status.UUID, status.Port)
+			return errors.New(err)
+		}
+		if status.IpType != types.AddressTypeIPV4 &&
+			status.IpType != types.AddressTypeIPV6 {
+			err := fmt.Sprintf("IpType %d not supported for HoneyPot network instance %s-%s",
+				status.IpType, status.DisplayName, status.UUID)
+			return errors.New(err)
+		}
 	default:
 		err := fmt.Sprintf("Instance type %d not supported", status.Type)
 		return errors.New(err)
@@ -604,6 +655,50 @@ func doNetworkInstanceSanityCheck(
 		return errors.New(err)
 	}
 
+	if status.IsDualStack() {
+		if err := doNetworkInstanceSubnet6SanityCheck(ctx, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doNetworkInstanceSubnet6SanityCheck checks the IPv6 subnet of a
+// dual-stack network instance. The applications use SLAAC or DHCPv6 to
+// get their EUI-64 address hence the subnet has to be a /64
+func doNetworkInstanceSubnet6SanityCheck(
+	ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	if status.Type != types.NetworkInstanceTypeLocal ||
+		status.IpType != types.AddressTypeIPV4 {
+		err := fmt.Sprintf("IPv6 subnet %s only supported for IPv4 Local network instance %s-%s",
+			status.Subnet6.String(), status.DisplayName, status.UUID)
+		return errors.New(err)
+	}
+	if ones, bits := status.Subnet6.Mask.Size(); ones != 64 || bits != 128 {
+		err := fmt.Sprintf("IPv6 subnet %s is not a /64",
+			status.Subnet6.String())
+		return errors.New(err)
+	}
+	if status.Gateway6 == nil || !status.Subnet6.Contains(status.Gateway6) {
+		err := fmt.Sprintf("IPv6 gateway %v not within subnet %s",
+			status.Gateway6, status.Subnet6.String())
+		return errors.New(err)
+	}
+	for _, iterStatusEntry := range ctx.networkInstanceStatusMap {
+		if status == iterStatusEntry || !iterStatusEntry.IsDualStack() {
+			continue
+		}
+		// Both are /64 hence it is enough to compare the prefixes
+		if iterStatusEntry.Subnet6.IP.Equal(status.Subnet6.IP) {
+			errStr := fmt.Sprintf("IPv6 subnet(%s) overlaps with another "+
+				"network instance(%s-%s)",
+				status.Subnet6.String(),
+				iterStatusEntry.DisplayName, iterStatusEntry.UUID)
+			return errors.New(errStr)
+		}
+	}
 	return nil
 }
 
@@ -706,6 +801,12 @@ func doNetworkInstanceModify(ctx *zedrouterContext,
 		return
 	}
 
+	if config.VlanID != status.VlanID {
+		status.SetError(
+			errors.New("Changing VlanID in NetworkInstance is not yet supported"))
+		return
+	}
+
 	if config.Activate && !status.Activated {
 		err := doNetworkInstanceActivate(ctx, status)
 		if err != nil {
@@ -733,8 +834,7 @@ func getSwitchNetworkInstanceUsingPort(
 
 	for _, st := range items {
 		status := cast.CastNetworkInstanceStatus(st)
-		ifname2 := types.AdapterToIfName(ctx.deviceNetworkStatus,
-			status.Port)
+		ifname2 := bridgePortIfName(ctx, &status)
 		if ifname2 != ifname {
 			log.Infof("maybeUpdateBridgeIPAddr - NI (%s) not using %s\n",
 				status.DisplayName, ifname)
@@ -766,8 +866,7 @@ func restartDnsmasq(status *types.NetworkInstanceStatus) {
 
 	hostsDirpath := runDirname + "/hosts." + bridgeName
 	// XXX arbitrary name "router"!!
-	addToHostsConfiglet(hostsDirpath, "router",
-		[]string{status.BridgeIPAddr})
+	addToHostsConfiglet(hostsDirpath, "router", bridgeIPAddrs(status))
 
 	// Use existing BridgeIPSets
 	createDnsmasqConfiglet(bridgeName, status.BridgeIPAddr,
@@ -776,6 +875,15 @@ func restartDnsmasq(status *types.NetworkInstanceStatus) {
 	startDnsmasq(bridgeName)
 }
 
+// bridgeIPAddrs returns the IPv4 and any IPv6 address of the bridge
+func bridgeIPAddrs(status *types.NetworkInstanceStatus) []string {
+	addrs := []string{status.BridgeIPAddr}
+	if status.BridgeIPv6Addr != "" {
+		addrs = append(addrs, status.BridgeIPv6Addr)
+	}
+	return addrs
+}
+
 // Returns an IP address as a string, or "" if not found.
 func lookupOrAllocateIPv4(
 	ctx *zedrouterContext,
@@ -796,7 +904,8 @@ func lookupOrAllocateIPv4(
 		status.DhcpRange.Start, status.DhcpRange.End)
 
 	if status.DhcpRange.Start == nil {
-		if status.Type == types.NetworkInstanceTypeSwitch {
+		if status.Type == types.NetworkInstanceTypeSwitch ||
+			status.Type == types.NetworkInstanceTypeTransparent {
 			log.Infof("%s-%s switch means no bridgeIpAddr",
 				status.DisplayName, status.Key())
 			return "", nil
@@ -864,6 +973,66 @@ func releaseIPv4FromNetworkInstance(ctx *zedrouterContext,
 	return nil
 }
 
+// lookupOrAllocateIPv6 returns the address the application gets from
+// SLAAC with an EUI-64 interface identifier. We hand out the same using
+// DHCPv6, hence there is nothing to allocate. It is recorded for reporting.
+func lookupOrAllocateIPv6(
+	ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus,
+	mac net.HardwareAddr) (string, error) {
+
+	log.Infof("lookupOrAllocateIPv6(%s-%s): mac:%s\n",
+		status.DisplayName, status.Key(), mac.String())
+	if ip, ok := status.IPv6Assignments[mac.String()]; ok {
+		return ip.String(), nil
+	}
+	a, err := eui64Addr(status.Subnet6.IP, mac)
+	if err != nil {
+		errStr := fmt.Sprintf("lookupOrAllocateIPv6(%s) %s",
+			status.Key(), err)
+		return "", errors.New(errStr)
+	}
+	log.Infof("lookupOrAllocateIPv6(%s) using %s\n",
+		mac.String(), a.String())
+	status.IPv6Assignments[mac.String()] = a
+	publishNetworkInstanceStatus(ctx, status)
+	return a.String(), nil
+}
+
+// eui64Addr returns the address SLAAC would pick for the MAC in the /64
+// prefix
+func eui64Addr(prefix net.IP, mac net.HardwareAddr) (net.IP, error) {
+	if len(mac) != 6 {
+		return nil, fmt.Errorf("not an EUI-48 %s", mac.String())
+	}
+	if prefix.To4() != nil || prefix.To16() == nil {
+		return nil, fmt.Errorf("not an IPv6 prefix %s", prefix.String())
+	}
+	a := make(net.IP, net.IPv6len)
+	copy(a, prefix.To16()[:8])
+	a[8] = mac[0] ^ 0x02
+	a[9] = mac[1]
+	a[10] = mac[2]
+	a[11] = 0xff
+	a[12] = 0xfe
+	a[13] = mac[3]
+	a[14] = mac[4]
+	a[15] = mac[5]
+	return a, nil
+}
+
+func releaseIPv6FromNetworkInstance(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus,
+	mac net.HardwareAddr) {
+
+	log.Infof("releaseIPv6(%s)\n", mac.String())
+	if _, ok := status.IPv6Assignments[mac.String()]; !ok {
+		return
+	}
+	delete(status.IPv6Assignments, mac.String())
+	publishNetworkInstanceStatus(ctx, status)
+}
+
 func getPrefixLenForBridgeIP(
 	status *types.NetworkInstanceStatus) int {
 	var prefixLen int
@@ -915,7 +1084,7 @@ func getPortIPv4Addr(ctx *zedrouterContext,
 	}
 
 	// Get IP address from adapter
-	ifname := types.AdapterToIfName(ctx.deviceNetworkStatus, status.Port)
+	ifname := bridgePortIfName(ctx, status)
 	ifindex, err := devicenetwork.IfnameToIndex(ifname)
 	if err != nil {
 		return "", err
@@ -1016,6 +1185,11 @@ func setBridgeIPAddr(
 		log.Infof("BridgeMac: %s, ipAddr: %s\n",
 			bridgeMac.String(), ipAddr)
 	}
+	if status.IsDualStack() && status.BridgeIPv6Addr == "" {
+		if err := setBridgeIPv6Addr(status, link); err != nil {
+			return err
+		}
+	}
 	status.BridgeIPAddr = ipAddr
 	publishNetworkInstanceStatus(ctx, status)
 	log.Infof("Published NetworkStatus. BridgeIpAddr: %s\n",
@@ -1041,6 +1215,38 @@ func setBridgeIPAddr(
 	return nil
 }
 
+// setBridgeIPv6Addr assigns Gateway6 to the bridge of a dual-stack
+// network instance and starts radvd to advertise the subnet
+func setBridgeIPv6Addr(status *types.NetworkInstanceStatus,
+	link netlink.Link) error {
+
+	ipAddr := status.Gateway6.String()
+	prefixLen, _ := status.Subnet6.Mask.Size()
+	addr, err := netlink.ParseAddr(fmt.Sprintf("%s/%d", ipAddr, prefixLen))
+	if err != nil {
+		errStr := fmt.Sprintf("ParseAddr %s failed: %s", ipAddr, err)
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	// dnsmasq can not bind to a tentative address
+	addr.Flags = syscall.IFA_F_NODAD
+	if err := netlink.AddrReplace(link, addr); err != nil {
+		errStr := fmt.Sprintf("AddrReplace %s failed: %s", addr, err)
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	status.IPv6Assignments[link.Attrs().HardwareAddr.String()] = status.Gateway6
+	status.BridgeIPv6Addr = ipAddr
+	log.Infof("setBridgeIPv6Addr(%s): %s\n", status.BridgeName, addr)
+
+	dnsServers := status.DnsServers6
+	if len(dnsServers) == 0 {
+		dnsServers = []net.IP{status.Gateway6}
+	}
+	restartRadvdWithPrefix(status.BridgeName, status.Subnet6, dnsServers)
+	return nil
+}
+
 // updateBridgeIPAddr
 // 	Called a bridge service has been added/updated/deleted
 func updateBridgeIPAddr(
@@ -1102,8 +1308,17 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 		return err
 	}
 
-	// Get a list of IfNames to the ones we have an ifIndex for.
-	status.IfNameList = getIfNameListForPort(ctx, status.Port)
+	if status.VlanID != 0 {
+		if err := vlanActivate(ctx, status); err != nil {
+			log.Errorf("vlanActivate failed: Port: %s, VlanID: %d, err:%s",
+				status.Port, status.VlanID, err)
+			return err
+		}
+		status.IfNameList = []string{status.VlanIfName}
+	} else {
+		// Get a list of IfNames to the ones we have an ifIndex for.
+		status.IfNameList = getIfNameListForPort(ctx, status.Port)
+	}
 	log.Infof("IfNameList: %+v", status.IfNameList)
 
 	switch status.Type {
@@ -1112,12 +1327,16 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 		if err != nil {
 			updateBridgeIPAddr(ctx, status)
 		}
+	case types.NetworkInstanceTypeTransparent:
+		err = bridgeActivate(ctx, status)
 	case types.NetworkInstanceTypeLocal:
 		err = natActivate(ctx, status)
 	case types.NetworkInstanceTypeCloud:
 		err = vpnActivate(ctx, status)
 	case types.NetworkInstanceTypeMesh:
 		err = lispActivate(ctx, status)
+	case types.NetworkInstanceTypeHoneyPot:
+		err = honeyPotActivate(ctx, status)
 	default:
 		errStr := fmt.Sprintf("doNetworkInstanceActivate: NetworkInstance %d not yet supported",
 			status.Type)
@@ -1187,11 +1406,14 @@ func doNetworkInstanceInactivate(
 
 	bridgeInactivateforNetworkInstance(ctx, status)
 	natInactivate(ctx, status)
+	vlanInactivate(status)
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
 		vpnInactivate(ctx, status)
 	case types.NetworkInstanceTypeMesh:
 		lispInactivate(ctx, status)
+	case types.NetworkInstanceTypeHoneyPot:
+		honeyPotInactivate(status)
 	}
 
 	return
@@ -1206,12 +1428,14 @@ func doNetworkInstanceDelete(
 
 	// Anything to do except the inactivate already done?
 	switch status.Type {
-	case types.NetworkInstanceTypeSwitch:
+	case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeTransparent:
 		// Nothing to do.
 	case types.NetworkInstanceTypeLocal:
 		natDelete(status)
 	case types.NetworkInstanceTypeCloud:
 		vpnDelete(ctx, status)
+	case types.NetworkInstanceTypeHoneyPot:
+		honeyPotDelete(status)
 	default:
 		log.Errorf("NetworkInstance(%s-%s): Type %d not yet supported",
 			status.DisplayName, status.UUID, status.Type)
@@ -1221,7 +1445,7 @@ func doNetworkInstanceDelete(
 	if status.BridgeName != "" {
 		stopDnsmasq(status.BridgeName, false, false)
 
-		if status.IsIPv6() {
+		if status.IsIPv6() || status.IsDualStack() {
 			stopRadvd(status.BridgeName, true)
 		}
 		DNSStopMonitor(status.BridgeNum)
@@ -1290,6 +1514,8 @@ func createNetworkInstanceMetrics(ctx *zedrouterContext,
 		if strongSwanVpnStatusGet(ctx, status, &niMetrics) {
 			publishNetworkInstanceStatus(ctx, status)
 		}
+	case types.NetworkInstanceTypeHoneyPot:
+		niMetrics.CaptureMetrics = honeyPotCaptureMetrics(status)
 	default:
 	}
 
@@ -1346,7 +1572,7 @@ func bridgeActivate(ctx *zedrouterContext,
 		return errors.New(errStr)
 	}
 	// Find adapter
-	ifname := types.AdapterToIfName(ctx.deviceNetworkStatus, status.Port)
+	ifname := bridgePortIfName(ctx, status)
 	alink, _ := netlink.LinkByName(ifname)
 	if alink == nil {
 		errStr := fmt.Sprintf("Unknown adapter %s, %s",
@@ -1376,7 +1602,7 @@ func bridgeInactivateforNetworkInstance(ctx *zedrouterContext,
 
 	log.Infof("bridgeInactivateforNetworkInstance(%s)\n", status.DisplayName)
 	// Find adapter
-	ifname := types.AdapterToIfName(ctx.deviceNetworkStatus, status.Port)
+	ifname := bridgePortIfName(ctx, status)
 	alink, _ := netlink.LinkByName(ifname)
 	if alink == nil {
 		errStr := fmt.Sprintf("Unknown adapter %s, %s",
@@ -1468,6 +1694,18 @@ func natActivate(ctx *zedrouterContext,
 		log.Errorf("PbrNATAdd failed for port %s - err = %s\n", status.Port, err)
 		return err
 	}
+	// NAT66 to the uplinks. The IPv6 traffic uses the main routing table
+	// hence it can leave on any port, not only those in IfNameList.
+	if status.IsDualStack() {
+		log.Infof("Adding ip6tables rule for %s\n", status.BridgeName)
+		err := iptables.Ip6tableCmd("-t", "nat", "-A", "POSTROUTING",
+			"-s", status.Subnet6.String(), "!", "-o", status.BridgeName,
+			"-j", "MASQUERADE")
+		if err != nil {
+			log.Errorf("Ip6tableCmd failed: %s", err)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1527,6 +1765,14 @@ func natInactivate(ctx *zedrouterContext,
 	if err != nil {
 		log.Errorf("natInactivate: PbrNATDel failed %s\n", err)
 	}
+	if status.IsDualStack() {
+		err := iptables.Ip6tableCmd("-t", "nat", "-D", "POSTROUTING",
+			"-s", status.Subnet6.String(), "!", "-o", status.BridgeName,
+			"-j", "MASQUERADE")
+		if err != nil {
+			log.Errorf("natInactivate: ip6tableCmd failed %s\n", err)
+		}
+	}
 }
 
 func natDelete(status *types.NetworkInstanceStatus) {
@@ -0,0 +1,60 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEui64Addr(t *testing.T) {
+	testMatrix := map[string]struct {
+		prefix     string
+		mac        string
+		expected   string
+		expectFail bool
+	}{
+		"Universal MAC": {
+			prefix:   "fd00:1::",
+			mac:      "00:16:3e:01:02:03",
+			expected: "fd00:1::216:3eff:fe01:203",
+		},
+		"Local MAC": {
+			prefix:   "fd00:1::",
+			mac:      "02:16:3e:01:02:03",
+			expected: "fd00:1::16:3eff:fe01:203",
+		},
+		"Host bits in prefix": {
+			prefix:   "2001:db8:1:2:ffff::1",
+			mac:      "00:16:3e:01:02:03",
+			expected: "2001:db8:1:2:216:3eff:fe01:203",
+		},
+		"EUI-64 MAC": {
+			prefix:     "fd00:1::",
+			mac:        "00:16:3e:ff:fe:01:02:03",
+			expectFail: true,
+		},
+		"IPv4 prefix": {
+			prefix:     "10.1.0.0",
+			mac:        "00:16:3e:01:02:03",
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		mac, err := net.ParseMAC(test.mac)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		a, err := eui64Addr(net.ParseIP(test.prefix), mac)
+		if test.expectFail {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, a.String())
+	}
+}
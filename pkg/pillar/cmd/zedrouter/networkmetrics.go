@@ -24,6 +24,9 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 	}
 	// Call iptables once to get counters
 	ac := iptables.FetchIprulesCounters()
+	if useNftables {
+		ac = append(ac, iptables.FetchNftCounters()...)
+	}
 
 	for _, ni := range network {
 		metric := types.NetworkMetric{
@@ -0,0 +1,564 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// ACL filter rules for the application interfaces using nftables.
+// The rules which iptables has in raw PREROUTING with a physdev match on
+// the vif go in a chain in the bridge family which matches on the vif
+// itself. Those in filter FORWARD go in a chain in the inet family on the
+// forward hook. There are chains per IP version for a dual-stack
+// application. The chains and their named counters are replaced in one
+// transaction when the ACLs change. The transaction is the netlink batch
+// which nft -f sends; there is no netlink nftables library in the tree.
+// This is a partial move to nftables. The mangle marking rules and the
+// NAT rules for port maps stay in iptables. So do all the rules of an
+// application interface if one of them matches on a host ipset: dnsmasq
+// fills in those sets from the DNS answers, and filling in nftables sets
+// with nftset= needs dnsmasq 2.87 or later while we ship 2.78. Once we
+// have it those interfaces can move as well.
+// The ipsets for the EIDs and the local addresses are mirrored in named
+// sets by ipset.go.
+// Only the x86_64 kernels have the nftables set, counter object and
+// bridge family support. On the others nftablesInit fails its probe and
+// all ACLs use iptables.
+
+package zedrouter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// Set by nftablesInit; when false all ACLs use iptables
+var useNftables bool
+
+var nftFamilies = []string{"bridge", "inet"}
+
+const (
+	nftInPriority  = -300 // Ahead of br_netfilter like the raw table
+	nftOutPriority = 0    // Same as the filter table
+)
+
+var nftCounterKinds = []string{iptables.NftCounterDrop,
+	iptables.NftCounterLog, iptables.NftCounterLimit}
+
+// nftablesInit recreates the tables and checks that nft and the kernel
+// support all the statements used for the ACLs. Returns false if not.
+func nftablesInit() bool {
+
+	log.Infof("nftablesInit()\n")
+	var b strings.Builder
+	for _, family := range nftFamilies {
+		// Start from scratch after a restart
+		fmt.Fprintf(&b, "add table %s %s\n", family, iptables.NftTable)
+		fmt.Fprintf(&b, "delete table %s %s\n", family, iptables.NftTable)
+		fmt.Fprintf(&b, "add table %s %s\n", family, iptables.NftTable)
+
+		// The kernel checks the expressions when the rule is added
+		// hence a probe which is removed in the same transaction
+		prefix := fmt.Sprintf("%s %s", family, iptables.NftTable)
+		fmt.Fprintf(&b, "add chain %s probe\n", prefix)
+		fmt.Fprintf(&b, "add counter %s probe\n", prefix)
+		fmt.Fprintf(&b, "add set %s probe { type ipv4_addr; flags interval; }\n",
+			prefix)
+		fmt.Fprintf(&b, "add rule %s probe ip saddr @probe meta l4proto tcp "+
+			"tcp dport 1-2 limit rate 1/second burst 5 packets "+
+			"counter name \"probe\" log prefix \"probe\" level err drop\n",
+			prefix)
+		fmt.Fprintf(&b, "flush chain %s probe\n", prefix)
+		fmt.Fprintf(&b, "delete chain %s probe\n", prefix)
+		fmt.Fprintf(&b, "delete counter %s probe\n", prefix)
+		fmt.Fprintf(&b, "delete set %s probe\n", prefix)
+	}
+	if err := iptables.NftApply(b.String()); err != nil {
+		log.Warnf("nftablesInit: using iptables for ACLs: %s\n", err)
+		return false
+	}
+	log.Infof("nftablesInit: using nftables for ACLs\n")
+	return true
+}
+
+// nftSetSpec only accepts the names of the sets which zedrouter fills in
+func nftSetSpec(setName string) (string, error) {
+	var addrType string
+	var name string
+	switch {
+	case strings.HasPrefix(setName, "ipv4."):
+		addrType = "ipv4_addr"
+		name = strings.TrimPrefix(setName, "ipv4.")
+	case strings.HasPrefix(setName, "ipv6."):
+		addrType = "ipv6_addr"
+		name = strings.TrimPrefix(setName, "ipv6.")
+	default:
+		return "", fmt.Errorf("Unknown set %s", setName)
+	}
+	switch {
+	case name == "local":
+		return fmt.Sprintf("{ type %s; flags interval; }", addrType), nil
+	case strings.HasPrefix(name, "eids."):
+		return fmt.Sprintf("{ type %s; }", addrType), nil
+	default:
+		return "", fmt.Errorf("Set %s is only available as an ipset",
+			setName)
+	}
+}
+
+// nftSetCreate creates the set in both tables, or replaces its members
+// if it exists
+func nftSetCreate(setName string, members []string) error {
+
+	log.Debugf("nftSetCreate(%s) %v\n", setName, members)
+	spec, err := nftSetSpec(setName)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, family := range nftFamilies {
+		prefix := fmt.Sprintf("%s %s %s", family, iptables.NftTable, setName)
+		fmt.Fprintf(&b, "add set %s %s\n", prefix, spec)
+		fmt.Fprintf(&b, "flush set %s\n", prefix)
+		if len(members) != 0 {
+			fmt.Fprintf(&b, "add element %s { %s }\n", prefix,
+				strings.Join(members, ", "))
+		}
+	}
+	return iptables.NftApply(b.String())
+}
+
+func nftSetUpdate(setName string, add []string, del []string) error {
+
+	log.Debugf("nftSetUpdate(%s) add %v del %v\n", setName, add, del)
+	if len(add) == 0 && len(del) == 0 {
+		return nil
+	}
+	spec, err := nftSetSpec(setName)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, family := range nftFamilies {
+		prefix := fmt.Sprintf("%s %s %s", family, iptables.NftTable, setName)
+		fmt.Fprintf(&b, "add set %s %s\n", prefix, spec)
+		if len(del) != 0 {
+			fmt.Fprintf(&b, "delete element %s { %s }\n", prefix,
+				strings.Join(del, ", "))
+		}
+		if len(add) != 0 {
+			fmt.Fprintf(&b, "add element %s { %s }\n", prefix,
+				strings.Join(add, ", "))
+		}
+	}
+	return iptables.NftApply(b.String())
+}
+
+// nftSetDestroy is a no-op if the set does not exist
+func nftSetDestroy(setName string) error {
+
+	log.Debugf("nftSetDestroy(%s)\n", setName)
+	spec, err := nftSetSpec(setName)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, family := range nftFamilies {
+		prefix := fmt.Sprintf("%s %s %s", family, iptables.NftTable, setName)
+		fmt.Fprintf(&b, "add set %s %s\n", prefix, spec)
+		fmt.Fprintf(&b, "delete set %s\n", prefix)
+	}
+	return iptables.NftApply(b.String())
+}
+
+// nftACLApplies is false for the interfaces which always use iptables.
+// A Transparent network instance matches on the output bridge port which
+// the inet family does not see.
+func nftACLApplies(aclArgs types.AppNetworkACLArgs) bool {
+	return useNftables && !aclArgs.IsMgmt && aclArgs.VifName != "" &&
+		aclArgs.BridgeName != "" &&
+		aclArgs.NIType != types.NetworkInstanceTypeTransparent
+}
+
+// nftRuleFamily returns the family of the chain for a rule after
+// rulePrefix, or "" if the rule stays in iptables
+func nftRuleFamily(rule types.IPTablesRule) string {
+	switch {
+	case rule.Table == "raw" && rule.Chain == "PREROUTING":
+		return "bridge"
+	case rule.Table == "" && rule.Chain == "FORWARD":
+		return "inet"
+	default:
+		return ""
+	}
+}
+
+// nftACLChain returns the name of the chain of the vif for one IP
+// version; a dual-stack application has both
+func nftACLChain(family string, ipVer int, vifName string) string {
+	if family == "bridge" {
+		return fmt.Sprintf("in.%d.%s", ipVer, vifName)
+	}
+	return fmt.Sprintf("out.%d.%s", ipVer, vifName)
+}
+
+// applyNftACLRules replaces the chains of the vif with the filter rules.
+// Returns the rules which were applied and those which are left for
+// iptables. On any failure all of the rules are left for iptables.
+func applyNftACLRules(aclArgs types.AppNetworkACLArgs,
+	rules types.IPTablesRuleList) (types.IPTablesRuleList, types.IPTablesRuleList) {
+
+	if !nftACLApplies(aclArgs) {
+		return nil, rules
+	}
+	script, err := nftACLScript(aclArgs, rules)
+	if err == nil {
+		err = iptables.NftApply(script)
+	}
+	if err != nil {
+		log.Warnf("applyNftACLRules(%s): using iptables: %s\n",
+			aclArgs.VifName, err)
+		// Remove the chains for any previous ACLs
+		if err := deleteNftACLChains(aclArgs, aclArgs.IPVer); err != nil {
+			log.Errorf("applyNftACLRules(%s): %s\n",
+				aclArgs.VifName, err)
+		}
+		return nil, rules
+	}
+	var nftRules, otherRules types.IPTablesRuleList
+	for _, rule := range rules {
+		if nftRuleFamily(rule) == "" {
+			otherRules = append(otherRules, rule)
+			continue
+		}
+		rule.IsNftRule = true
+		nftRules = append(nftRules, rule)
+	}
+	return nftRules, otherRules
+}
+
+// nftACLScript flushes and fills both chains in the same transaction
+func nftACLScript(aclArgs types.AppNetworkACLArgs,
+	rules types.IPTablesRuleList) (string, error) {
+
+	counterName := func(kind string) string {
+		return iptables.NftCounterName(kind, aclArgs.IPVer,
+			aclArgs.BridgeName, aclArgs.VifName)
+	}
+	sets := make(map[string]bool)
+	exprs := make(map[string][]string)
+	for _, rule := range rules {
+		family := nftRuleFamily(rule)
+		if family == "" {
+			continue
+		}
+		if len(rule.Rule) < 2 || rule.Rule[1] != aclArgs.BridgeName {
+			return "", fmt.Errorf("Unexpected rule %v", rule.Rule)
+		}
+		// The chain matches on the vif instead of the physdev prefix
+		// and the bridge
+		args := rule.Rule[2:]
+		if family == "inet" {
+			args = append(append([]string{}, rule.Prefix...), args...)
+		}
+		expr, err := nftRuleExpr(aclArgs.IPVer, args, rule.Action,
+			counterName, sets)
+		if err != nil {
+			return "", err
+		}
+		exprs[family] = append(exprs[family], expr)
+	}
+
+	etherType := "ip"
+	nfproto := "ipv4"
+	if aclArgs.IPVer == 6 {
+		etherType = "ip6"
+		nfproto = "ipv6"
+	}
+	var b strings.Builder
+	for _, family := range nftFamilies {
+		table := fmt.Sprintf("%s %s", family, iptables.NftTable)
+		chain := nftACLChain(family, aclArgs.IPVer, aclArgs.VifName)
+		switch family {
+		case "bridge":
+			fmt.Fprintf(&b, "add chain %s %s { type filter hook prerouting priority %d; }\n",
+				table, chain, nftInPriority)
+		case "inet":
+			fmt.Fprintf(&b, "add chain %s %s { type filter hook forward priority %d; }\n",
+				table, chain, nftOutPriority)
+		}
+		fmt.Fprintf(&b, "flush chain %s %s\n", table, chain)
+		for _, kind := range nftCounterKinds {
+			fmt.Fprintf(&b, "add counter %s %s\n", table, counterName(kind))
+		}
+		for setName := range sets {
+			spec, _ := nftSetSpec(setName)
+			fmt.Fprintf(&b, "add set %s %s %s\n", table, setName, spec)
+		}
+		// Leave other packets to the remaining chains
+		switch family {
+		case "bridge":
+			// acl.go appends a '+' to the vifname for the
+			// <vifname>-emu interface
+			fmt.Fprintf(&b, "add rule %s %s iifname != \"%s*\" return\n",
+				table, chain, aclArgs.VifName)
+			fmt.Fprintf(&b, "add rule %s %s ether type != %s return\n",
+				table, chain, etherType)
+		case "inet":
+			fmt.Fprintf(&b, "add rule %s %s meta nfproto != %s return\n",
+				table, chain, nfproto)
+			fmt.Fprintf(&b, "add rule %s %s oifname != \"%s\" return\n",
+				table, chain, aclArgs.BridgeName)
+		}
+		for _, expr := range exprs[family] {
+			fmt.Fprintf(&b, "add rule %s %s %s\n", table, chain, expr)
+		}
+	}
+	return b.String(), nil
+}
+
+// nftRuleExpr translates the iptables match and action arguments used
+// by aceToRules and aclDropRules
+func nftRuleExpr(ipVer int, args []string, action []string,
+	counterName func(kind string) string, sets map[string]bool) (string, error) {
+
+	addr := "ip"
+	if ipVer == 6 {
+		addr = "ip6"
+	}
+	var exprs []string
+	var protocol string
+	var limited bool
+	var rate, burst string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		value := func() (string, error) {
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("Missing value for %s in %v",
+					arg, args)
+			}
+			i++
+			return args[i], nil
+		}
+		// All of them have at least one value
+		val, err := value()
+		if err != nil {
+			return "", err
+		}
+		switch arg {
+		case "-s":
+			exprs = append(exprs, fmt.Sprintf("%s saddr %s", addr, val))
+		case "-d":
+			exprs = append(exprs, fmt.Sprintf("%s daddr %s", addr, val))
+		case "-p":
+			protocol = nftProtocol(val)
+			exprs = append(exprs, "meta l4proto "+protocol)
+		case "--sport", "--dport":
+			switch protocol {
+			case "tcp", "udp", "sctp", "dccp":
+			default:
+				return "", fmt.Errorf("%s without a port protocol in %v",
+					arg, args)
+			}
+			exprs = append(exprs, fmt.Sprintf("%s %s %s", protocol,
+				strings.TrimPrefix(arg, "--"),
+				strings.Replace(val, ":", "-", 1)))
+		case "-m":
+			switch val {
+			case "set":
+			case "limit":
+				limited = true
+			default:
+				return "", fmt.Errorf("Unsupported match %s in %v",
+					val, args)
+			}
+		case "--match-set":
+			setName := val
+			dir, err := value()
+			if err != nil {
+				return "", err
+			}
+			if _, err := nftSetSpec(setName); err != nil {
+				return "", err
+			}
+			sets[setName] = true
+			switch dir {
+			case "src":
+				exprs = append(exprs, fmt.Sprintf("%s saddr @%s",
+					addr, setName))
+			case "dst":
+				exprs = append(exprs, fmt.Sprintf("%s daddr @%s",
+					addr, setName))
+			default:
+				return "", fmt.Errorf("Unsupported set direction %s in %v",
+					dir, args)
+			}
+		case "--limit":
+			rate, err = nftRate(val)
+			if err != nil {
+				return "", err
+			}
+		case "--limit-burst":
+			burst = val
+		default:
+			return "", fmt.Errorf("Unsupported argument %s in %v",
+				arg, args)
+		}
+	}
+	if limited {
+		// The iptables defaults
+		if rate == "" {
+			rate = "3/hour"
+		}
+		if burst == "" {
+			burst = "5"
+		}
+		exprs = append(exprs, fmt.Sprintf("limit rate %s burst %s packets",
+			rate, burst))
+	}
+
+	if len(action) < 2 || action[0] != "-j" {
+		return "", fmt.Errorf("Unsupported action %v", action)
+	}
+	switch action[1] {
+	case "ACCEPT":
+		if limited {
+			exprs = append(exprs, fmt.Sprintf("counter name \"%s\"",
+				counterName(iptables.NftCounterLimit)))
+		}
+		exprs = append(exprs, "accept")
+	case "DROP":
+		exprs = append(exprs, fmt.Sprintf("counter name \"%s\"",
+			counterName(iptables.NftCounterDrop)), "drop")
+	case "LOG":
+		logExpr, err := nftLog(action[2:])
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, fmt.Sprintf("counter name \"%s\"",
+			counterName(iptables.NftCounterLog)), logExpr)
+	default:
+		return "", fmt.Errorf("Unsupported action %v", action)
+	}
+	return strings.Join(exprs, " "), nil
+}
+
+func nftProtocol(protocol string) string {
+	protocol = strings.ToLower(protocol)
+	switch protocol {
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	default:
+		return protocol
+	}
+}
+
+// nftRate converts the iptables rate such as 4/s
+func nftRate(rate string) (string, error) {
+	items := strings.Split(rate, "/")
+	if len(items) != 2 || items[1] == "" {
+		return "", fmt.Errorf("Unsupported rate %s", rate)
+	}
+	// iptables accepts any prefix of the unit
+	unit := strings.ToLower(items[1])
+	for _, u := range []string{"second", "minute", "hour", "day"} {
+		if strings.HasPrefix(u, unit) {
+			return items[0] + "/" + u, nil
+		}
+	}
+	return "", fmt.Errorf("Unsupported rate unit in %s", rate)
+}
+
+var nftLogLevels = []string{"emerg", "alert", "crit", "err", "warn",
+	"notice", "info", "debug"}
+
+func nftLog(args []string) (string, error) {
+	expr := "log"
+	for i := 0; i+1 < len(args); i += 2 {
+		switch args[i] {
+		case "--log-prefix":
+			expr += fmt.Sprintf(" prefix \"%s\"", args[i+1])
+		case "--log-level":
+			level := args[i+1]
+			for l, name := range nftLogLevels {
+				if level == fmt.Sprintf("%d", l) {
+					level = name
+				}
+			}
+			expr += " level " + level
+		default:
+			return "", fmt.Errorf("Unsupported log option %s", args[i])
+		}
+	}
+	if len(args)%2 != 0 {
+		return "", fmt.Errorf("Unsupported log options %v", args)
+	}
+	return expr, nil
+}
+
+// deleteNftACLChains removes the chains and counters of the vif for the
+// IP versions; it is a no-op if they do not exist
+func deleteNftACLChains(aclArgs types.AppNetworkACLArgs, ipVers ...int) error {
+
+	log.Infof("deleteNftACLChains(%s) %v\n", aclArgs.VifName, ipVers)
+	var b strings.Builder
+	for _, family := range nftFamilies {
+		table := fmt.Sprintf("%s %s", family, iptables.NftTable)
+		for _, ipVer := range ipVers {
+			chain := nftACLChain(family, ipVer, aclArgs.VifName)
+			fmt.Fprintf(&b, "add chain %s %s\n", table, chain)
+			fmt.Fprintf(&b, "flush chain %s %s\n", table, chain)
+			fmt.Fprintf(&b, "delete chain %s %s\n", table, chain)
+			for _, kind := range nftCounterKinds {
+				name := iptables.NftCounterName(kind, ipVer,
+					aclArgs.BridgeName, aclArgs.VifName)
+				fmt.Fprintf(&b, "add counter %s %s\n", table, name)
+				fmt.Fprintf(&b, "delete counter %s %s\n", table, name)
+			}
+		}
+	}
+	if err := iptables.NftApply(b.String()); err != nil {
+		return errors.New("deleteNftACLChains: " + err.Error())
+	}
+	return nil
+}
+
+// deleteNftACLRules removes the chains and frees the ACE ids the same
+// way as executeIPTablesRule
+func deleteNftACLRules(aclArgs types.AppNetworkACLArgs,
+	rules types.IPTablesRuleList) error {
+
+	if nftACLApplies(aclArgs) {
+		if err := deleteNftACLChains(aclArgs, 4, 6); err != nil {
+			return err
+		}
+	}
+	freeNftACEIds(rules)
+	return nil
+}
+
+func freeNftACEIds(rules types.IPTablesRuleList) {
+	for _, rule := range rules {
+		if rule.RuleID != 0 {
+			freeACEId(rule.RuleID)
+		}
+	}
+}
+
+// splitNftRules separates the rules applied by applyNftACLRules
+func splitNftRules(rules types.IPTablesRuleList) (types.IPTablesRuleList,
+	types.IPTablesRuleList) {
+
+	var nftRules, otherRules types.IPTablesRuleList
+	for _, rule := range rules {
+		if rule.IsNftRule {
+			nftRules = append(nftRules, rule)
+		} else {
+			otherRules = append(otherRules, rule)
+		}
+	}
+	return nftRules, otherRules
+}
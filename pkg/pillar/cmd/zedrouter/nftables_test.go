@@ -0,0 +1,386 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCounterName(kind string) string {
+	return kind + ".4.bn1.nbu1x1"
+}
+
+func TestNftRuleExpr(t *testing.T) {
+	testMatrix := map[string]struct {
+		ipVer        int
+		args         []string
+		action       []string
+		expected     string
+		expectedSets []string
+		expectFail   bool
+	}{
+		"Source address": {
+			ipVer:    4,
+			args:     []string{"-s", "10.1.0.2"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "ip saddr 10.1.0.2 accept",
+		},
+		"IPv6 destination address": {
+			ipVer:    6,
+			args:     []string{"-d", "fd00::2"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "ip6 daddr fd00::2 accept",
+		},
+		"Protocol by name": {
+			ipVer:    4,
+			args:     []string{"-p", "UDP"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "meta l4proto udp accept",
+		},
+		"Protocol by number": {
+			ipVer:    4,
+			args:     []string{"-p", "6", "--dport", "80"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "meta l4proto tcp tcp dport 80 accept",
+		},
+		"Other protocol": {
+			ipVer:    4,
+			args:     []string{"-p", "icmp"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "meta l4proto icmp accept",
+		},
+		"Source port": {
+			ipVer:    4,
+			args:     []string{"-p", "tcp", "--sport", "8080"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "meta l4proto tcp tcp sport 8080 accept",
+		},
+		"Port range": {
+			ipVer:    4,
+			args:     []string{"-p", "udp", "--dport", "5000:5010"},
+			action:   []string{"-j", "ACCEPT"},
+			expected: "meta l4proto udp udp dport 5000-5010 accept",
+		},
+		"Port without a protocol": {
+			ipVer:      4,
+			args:       []string{"--dport", "80"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Port with icmp": {
+			ipVer:      4,
+			args:       []string{"-p", "icmp", "--dport", "80"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Source set": {
+			ipVer: 4,
+			args: []string{"-m", "set", "--match-set",
+				"ipv4.eids.nbu1x1", "src"},
+			action:       []string{"-j", "ACCEPT"},
+			expected:     "ip saddr @ipv4.eids.nbu1x1 accept",
+			expectedSets: []string{"ipv4.eids.nbu1x1"},
+		},
+		"Destination set": {
+			ipVer: 6,
+			args: []string{"-m", "set", "--match-set",
+				"ipv6.local", "dst"},
+			action:       []string{"-j", "ACCEPT"},
+			expected:     "ip6 daddr @ipv6.local accept",
+			expectedSets: []string{"ipv6.local"},
+		},
+		"Host set": {
+			ipVer: 4,
+			args: []string{"-m", "set", "--match-set",
+				"ipv4.zededa.net", "dst"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Bad set direction": {
+			ipVer: 4,
+			args: []string{"-m", "set", "--match-set",
+				"ipv4.local", "both"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Set without direction": {
+			ipVer:      4,
+			args:       []string{"-m", "set", "--match-set", "ipv4.local"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Limit defaults": {
+			ipVer:  4,
+			args:   []string{"-m", "limit"},
+			action: []string{"-j", "ACCEPT"},
+			expected: "limit rate 3/hour burst 5 packets " +
+				"counter name \"limit.4.bn1.nbu1x1\" accept",
+		},
+		"Limit": {
+			ipVer: 4,
+			args: []string{"-p", "tcp", "-m", "limit", "--limit", "4/s",
+				"--limit-burst", "10"},
+			action: []string{"-j", "ACCEPT"},
+			expected: "meta l4proto tcp limit rate 4/second burst 10 packets " +
+				"counter name \"limit.4.bn1.nbu1x1\" accept",
+		},
+		"Limit without burst": {
+			ipVer:  4,
+			args:   []string{"-m", "limit", "--limit", "100/min"},
+			action: []string{"-j", "ACCEPT"},
+			expected: "limit rate 100/minute burst 5 packets " +
+				"counter name \"limit.4.bn1.nbu1x1\" accept",
+		},
+		"Bad limit": {
+			ipVer:      4,
+			args:       []string{"-m", "limit", "--limit", "4"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Unsupported match": {
+			ipVer:      4,
+			args:       []string{"-m", "physdev", "--physdev-in", "nbu1x1+"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Unsupported argument": {
+			ipVer:      4,
+			args:       []string{"-i", "bn1"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Missing value": {
+			ipVer:      4,
+			args:       []string{"-s"},
+			action:     []string{"-j", "ACCEPT"},
+			expectFail: true,
+		},
+		"Drop": {
+			ipVer:    4,
+			args:     []string{"-d", "10.1.0.2"},
+			action:   []string{"-j", "DROP"},
+			expected: "ip daddr 10.1.0.2 counter name \"drop.4.bn1.nbu1x1\" drop",
+		},
+		"Log": {
+			ipVer: 4,
+			action: []string{"-j", "LOG", "--log-prefix",
+				"FORWARD:FROM:", "--log-level", "3"},
+			expected: "counter name \"log.4.bn1.nbu1x1\" " +
+				"log prefix \"FORWARD:FROM:\" level err",
+		},
+		"Unsupported action": {
+			ipVer:      4,
+			action:     []string{"-j", "MARK", "--set-mark", "1"},
+			expectFail: true,
+		},
+		"Chain action": {
+			ipVer:      4,
+			action:     []string{"-j", "drop-all-bn1-nbu1x1"},
+			expectFail: true,
+		},
+		"No action": {
+			ipVer:      4,
+			expectFail: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		sets := make(map[string]bool)
+		expr, err := nftRuleExpr(test.ipVer, test.args, test.action,
+			testCounterName, sets)
+		if test.expectFail {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, expr)
+		var setNames []string
+		for name := range sets {
+			setNames = append(setNames, name)
+		}
+		assert.Equal(t, test.expectedSets, setNames)
+	}
+}
+
+func TestNftRate(t *testing.T) {
+	testMatrix := map[string]struct {
+		rate       string
+		expected   string
+		expectFail bool
+	}{
+		"Second":          {rate: "4/s", expected: "4/second"},
+		"Second spelled":  {rate: "4/second", expected: "4/second"},
+		"Minute":          {rate: "10/min", expected: "10/minute"},
+		"Hour":            {rate: "3/HOUR", expected: "3/hour"},
+		"Day":             {rate: "1/d", expected: "1/day"},
+		"No unit":         {rate: "4", expectFail: true},
+		"Empty unit":      {rate: "4/", expectFail: true},
+		"Unknown unit":    {rate: "4/week", expectFail: true},
+		"Too many fields": {rate: "4/s/s", expectFail: true},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		rate, err := nftRate(test.rate)
+		if test.expectFail {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, rate)
+	}
+}
+
+func TestNftLog(t *testing.T) {
+	testMatrix := map[string]struct {
+		args       []string
+		expected   string
+		expectFail bool
+	}{
+		"No options": {
+			expected: "log",
+		},
+		"Prefix": {
+			args:     []string{"--log-prefix", "FORWARD:TO:"},
+			expected: "log prefix \"FORWARD:TO:\"",
+		},
+		"Numeric levels": {
+			args:     []string{"--log-level", "0", "--log-level", "7"},
+			expected: "log level emerg level debug",
+		},
+		"Warning level": {
+			args:     []string{"--log-level", "4"},
+			expected: "log level warn",
+		},
+		"Named level": {
+			args:     []string{"--log-level", "info"},
+			expected: "log level info",
+		},
+		"Prefix and level": {
+			args: []string{"--log-prefix", "FORWARD:FROM:",
+				"--log-level", "3"},
+			expected: "log prefix \"FORWARD:FROM:\" level err",
+		},
+		"Unsupported option": {
+			args:       []string{"--log-uid", "1"},
+			expectFail: true,
+		},
+		"Missing value": {
+			args:       []string{"--log-prefix"},
+			expectFail: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		expr, err := nftLog(test.args)
+		if test.expectFail {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, expr)
+	}
+}
+
+func TestNftRuleFamily(t *testing.T) {
+	testMatrix := map[string]struct {
+		table    string
+		chain    string
+		expected string
+	}{
+		"Input":          {table: "raw", chain: "PREROUTING", expected: "bridge"},
+		"Output":         {chain: "FORWARD", expected: "inet"},
+		"Explicit table": {table: "filter", chain: "FORWARD"},
+		"Marking":        {table: "mangle", chain: "PREROUTING"},
+		"Port map":       {table: "nat", chain: "PREROUTING"},
+		"Mgmt output":    {chain: "OUTPUT"},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		rule := types.IPTablesRule{Table: test.table, Chain: test.chain}
+		assert.Equal(t, test.expected, nftRuleFamily(rule))
+	}
+}
+
+func TestNftACLScript(t *testing.T) {
+	aclArgs := types.AppNetworkACLArgs{IPVer: 4, BridgeName: "bn1",
+		VifName: "nbu1x1", AppIP: "10.1.0.2"}
+	rules := types.IPTablesRuleList{
+		{Table: "raw", Chain: "PREROUTING",
+			Prefix: []string{"-m", "physdev", "--physdev-in", "nbu1x1+"},
+			Rule: []string{"-i", "bn1", "-p", "tcp", "--dport", "80",
+				"-m", "set", "--match-set", "ipv4.eids.nbu1x1", "dst"},
+			Action: []string{"-j", "ACCEPT"}},
+		{Chain: "FORWARD", Prefix: []string{"-d", "10.1.0.2"},
+			Rule:   []string{"-o", "bn1", "-s", "192.168.1.0/24"},
+			Action: []string{"-j", "DROP"}},
+		// Stays in iptables
+		{Table: "mangle", Chain: "PREROUTING",
+			Rule:   []string{"-i", "bn1"},
+			Action: []string{"-j", "drop-all-bn1-nbu1x1"}},
+	}
+	expected := []string{
+		"add chain bridge eve-acl in.4.nbu1x1 { type filter hook prerouting priority -300; }",
+		"flush chain bridge eve-acl in.4.nbu1x1",
+		"add counter bridge eve-acl drop.4.bn1.nbu1x1",
+		"add counter bridge eve-acl log.4.bn1.nbu1x1",
+		"add counter bridge eve-acl limit.4.bn1.nbu1x1",
+		"add set bridge eve-acl ipv4.eids.nbu1x1 { type ipv4_addr; }",
+		"add rule bridge eve-acl in.4.nbu1x1 iifname != \"nbu1x1*\" return",
+		"add rule bridge eve-acl in.4.nbu1x1 ether type != ip return",
+		"add rule bridge eve-acl in.4.nbu1x1 meta l4proto tcp tcp dport 80 ip daddr @ipv4.eids.nbu1x1 accept",
+		"add chain inet eve-acl out.4.nbu1x1 { type filter hook forward priority 0; }",
+		"flush chain inet eve-acl out.4.nbu1x1",
+		"add counter inet eve-acl drop.4.bn1.nbu1x1",
+		"add counter inet eve-acl log.4.bn1.nbu1x1",
+		"add counter inet eve-acl limit.4.bn1.nbu1x1",
+		"add set inet eve-acl ipv4.eids.nbu1x1 { type ipv4_addr; }",
+		"add rule inet eve-acl out.4.nbu1x1 meta nfproto != ipv4 return",
+		"add rule inet eve-acl out.4.nbu1x1 oifname != \"bn1\" return",
+		"add rule inet eve-acl out.4.nbu1x1 ip daddr 10.1.0.2 ip saddr 192.168.1.0/24 counter name \"drop.4.bn1.nbu1x1\" drop",
+	}
+	script, err := nftACLScript(aclArgs, rules)
+	assert.NoError(t, err)
+	assert.Equal(t, expected,
+		strings.Split(strings.TrimSuffix(script, "\n"), "\n"))
+
+	t.Logf("Running test case IPv6")
+	aclArgs.IPVer = 6
+	script, err = nftACLScript(aclArgs, types.IPTablesRuleList{
+		{Table: "raw", Chain: "PREROUTING",
+			Rule:   []string{"-i", "bn1", "-s", "fd00::/64"},
+			Action: []string{"-j", "ACCEPT"}},
+	})
+	assert.NoError(t, err)
+	lines := strings.Split(script, "\n")
+	assert.Contains(t, lines,
+		"add rule bridge eve-acl in.6.nbu1x1 ether type != ip6 return")
+	assert.Contains(t, lines,
+		"add rule inet eve-acl out.6.nbu1x1 meta nfproto != ipv6 return")
+	assert.Contains(t, lines,
+		"add rule bridge eve-acl in.6.nbu1x1 ip6 saddr fd00::/64 accept")
+
+	t.Logf("Running test case Rule for another bridge")
+	_, err = nftACLScript(aclArgs, types.IPTablesRuleList{
+		{Table: "raw", Chain: "PREROUTING",
+			Rule:   []string{"-i", "bn2"},
+			Action: []string{"-j", "ACCEPT"}},
+	})
+	assert.Error(t, err)
+
+	t.Logf("Running test case Unsupported rule")
+	_, err = nftACLScript(aclArgs, types.IPTablesRuleList{
+		{Table: "raw", Chain: "PREROUTING",
+			Rule:   []string{"-i", "bn1", "-m", "conntrack"},
+			Action: []string{"-j", "ACCEPT"}},
+	})
+	assert.Error(t, err)
+}
@@ -7,7 +7,9 @@ package zedrouter
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"strings"
 
 	"github.com/lf-edge/eve/pkg/pillar/wrap"
 	log "github.com/sirupsen/logrus"
@@ -32,6 +34,28 @@ interface %s {
 };
 `
 
+// Need to fill in the bridge name, the prefix and the RDNSS addresses.
+// The applications can use SLAAC or DHCPv6 from dnsmasq; both give them
+// the EUI-64 address.
+const radvdPrefixTemplate = `
+# Automatically generated by zedrouter
+interface %s {
+	IgnoreIfMissing on;
+	AdvSendAdvert on;
+	MaxRtrAdvInterval 600;
+	AdvManagedFlag on;
+	AdvOtherConfigFlag on;
+	prefix %s
+	{
+		AdvOnLink on;
+		AdvAutonomous on;
+	};
+	RDNSS %s
+	{
+	};
+};
+`
+
 // Create the radvd config file for the overlay
 // Would be more polite to return an error then to Fatal
 //	olIfname - Overlay Interface Name
@@ -97,3 +121,26 @@ func restartRadvdWithNewConfig(bridgeName string) {
 	createRadvdConfiglet(cfgPathname, bridgeName)
 	startRadvd(cfgPathname, bridgeName)
 }
+
+// restartRadvdWithPrefix is used for the bridge of a dual-stack Local
+// network instance
+func restartRadvdWithPrefix(bridgeName string, prefix net.IPNet,
+	dnsServers []net.IP) {
+
+	_, cfgPathname := getBridgeRadvdCfgFileName(bridgeName)
+	log.Debugf("restartRadvdWithPrefix: %s %s\n", bridgeName, prefix.String())
+
+	stopRadvd(bridgeName, false)
+	file, err := os.Create(cfgPathname)
+	if err != nil {
+		log.Fatal("restartRadvdWithPrefix failed ", err)
+	}
+	var rdnss []string
+	for _, ns := range dnsServers {
+		rdnss = append(rdnss, ns.String())
+	}
+	file.WriteString(fmt.Sprintf(radvdPrefixTemplate, bridgeName,
+		prefix.String(), strings.Join(rdnss, " ")))
+	file.Close()
+	startRadvd(cfgPathname, bridgeName)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Network instances on an 802.1Q VLAN of their Port. A Local network
+// instance uses a VLAN port from the DevicePortConfig since it needs the
+// IP configuration of the uplink. A Switch or Transparent network instance
+// uses such a port if there is one and otherwise creates the sub-interface
+// on the Port itself.
+
+package zedrouter
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// vlanActivate sets VlanIfName and creates the sub-interface if needed
+func vlanActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Infof("vlanActivate(%s) VLAN %d on %s\n", status.DisplayName,
+		status.VlanID, status.Port)
+	switch status.Type {
+	case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeLocal,
+		types.NetworkInstanceTypeTransparent:
+	default:
+		return fmt.Errorf("VlanID not supported for NetworkInstance type %d",
+			status.Type)
+	}
+	if isSharedPortLabel(status.Port) {
+		return fmt.Errorf("VlanID not supported with port label %s",
+			status.Port)
+	}
+	parent := types.AdapterToIfName(ctx.deviceNetworkStatus, status.Port)
+	portStatus := ctx.deviceNetworkStatus.GetVlanPort(parent, status.VlanID)
+	if status.Type == types.NetworkInstanceTypeLocal {
+		if portStatus == nil {
+			return fmt.Errorf("No port for VLAN %d on %s",
+				status.VlanID, status.Port)
+		}
+		if portStatus.Dhcp == types.DT_NONE {
+			return fmt.Errorf("Port %s for VLAN %d on %s not configured for IP",
+				portStatus.Name, status.VlanID, status.Port)
+		}
+	}
+	if status.Type == types.NetworkInstanceTypeSwitch && portStatus != nil &&
+		portStatus.Dhcp != types.DT_NONE {
+		// Same as for an untagged Switch; the bridge would take the
+		// traffic from the IP configuration of the VLAN port
+		return fmt.Errorf("Port %s for VLAN %d on %s configured for shared use with DHCP type %d",
+			portStatus.Name, status.VlanID, status.Port, portStatus.Dhcp)
+	}
+	if portStatus != nil {
+		status.VlanIfName = portStatus.IfName
+		return nil
+	}
+	ifname := devicenetwork.VlanIfName(parent, status.VlanID)
+	if err := devicenetwork.CreateVlanLink(ifname, parent,
+		status.VlanID); err != nil {
+		return err
+	}
+	status.VlanIfName = ifname
+	status.VlanIfCreated = true
+	return nil
+}
+
+func vlanInactivate(status *types.NetworkInstanceStatus) {
+
+	if status.VlanIfCreated {
+		if err := devicenetwork.DeleteVlanLink(status.VlanIfName); err != nil {
+			log.Errorf("vlanInactivate(%s): %s\n",
+				status.DisplayName, err)
+		}
+	}
+	status.VlanIfName = ""
+	status.VlanIfCreated = false
+}
+
+// bridgePortIfName returns the interface which is added to the bridge
+func bridgePortIfName(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) string {
+
+	if status.VlanIfName != "" {
+		return status.VlanIfName
+	}
+	return types.AdapterToIfName(ctx.deviceNetworkStatus, status.Port)
+}
@@ -507,6 +507,9 @@ func handleInit(runDirname string) {
 	// Setup initial iptables rules
 	iptables.IptablesInit()
 
+	// Use nftables for the ACLs if the kernel supports it
+	useNftables = nftablesInit()
+
 	// ipsets which are independent of config
 	createDefaultIpset()
 
@@ -1184,16 +1187,29 @@ func appNetworkDoActivateUnderlayNetwork(
 	ulStatus.BridgeIPAddr = bridgeIPAddr
 	// XXX appIPAddr is "" if bridge service
 	ulStatus.AllocatedIPAddr = appIPAddr
+
+	var appIPv6Addr string
+	if netInstStatus.IsDualStack() && netInstStatus.BridgeIPv6Addr != "" {
+		appIPv6Addr = getUlIPv6Addr(ctx, ulStatus, netInstStatus)
+		ulStatus.BridgeIPv6Addr = netInstStatus.BridgeIPv6Addr
+		ulStatus.AllocatedIPv6Addr = appIPv6Addr
+		log.Infof("bridgeIPv6Addr %s appIPv6Addr %s\n",
+			ulStatus.BridgeIPv6Addr, appIPv6Addr)
+	}
 	hostsDirpath := runDirname + "/hosts." + bridgeName
 	if appIPAddr != "" {
+		appIPAddrs := []string{appIPAddr}
+		if appIPv6Addr != "" {
+			appIPAddrs = append(appIPAddrs, appIPv6Addr)
+		}
 		addToHostsConfiglet(hostsDirpath, config.DisplayName,
-			[]string{appIPAddr})
+			appIPAddrs)
 	}
 
 	// Default ipset
 	deleteDefaultIpsetConfiglet(vifName, false)
 	createDefaultIpsetConfiglet(vifName, netInstStatus.DnsNameToIPList,
-		appIPAddr)
+		appIPAddr, appIPv6Addr)
 
 	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: bridgeName,
 		VifName: vifName, BridgeIP: bridgeIPAddr, AppIP: appIPAddr,
@@ -1205,6 +1221,16 @@ func appNetworkDoActivateUnderlayNetwork(
 	if err != nil {
 		addError(ctx, status, "createACL", err)
 	}
+	if appIPv6Addr != "" {
+		// The same ACEs again for IPv6
+		aclArgs.BridgeIP = ulStatus.BridgeIPv6Addr
+		aclArgs.AppIP = appIPv6Addr
+		ruleList6, err := createACLConfiglet(aclArgs, ulStatus.ACLs)
+		if err != nil {
+			addError(ctx, status, "createACL IPv6", err)
+		}
+		ruleList = append(ruleList, ruleList6...)
+	}
 	ulStatus.ACLRules = ruleList
 
 	if appIPAddr != "" {
@@ -1213,6 +1239,10 @@ func appNetworkDoActivateUnderlayNetwork(
 		addhostDnsmasq(bridgeName, appMac, appIPAddr,
 			config.UUIDandVersion.UUID.String())
 	}
+	if appIPv6Addr != "" {
+		addhostDnsmasq(bridgeName, appMac, appIPv6Addr,
+			config.UUIDandVersion.UUID.String())
+	}
 
 	// Look for added or deleted ipsets
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
@@ -1823,6 +1853,26 @@ func getUlAddrs(ctx *zedrouterContext,
 	return bridgeIPAddr, appIPAddr
 }
 
+// getUlIPv6Addr returns "" if there is no MAC address yet
+func getUlIPv6Addr(ctx *zedrouterContext,
+	status *types.UnderlayNetworkStatus,
+	netInstStatus *types.NetworkInstanceStatus) string {
+
+	if status.Mac == "" {
+		return ""
+	}
+	mac, err := net.ParseMAC(status.Mac)
+	if err != nil {
+		log.Fatal("ParseMAC failed: ", status.Mac, err)
+	}
+	addr, err := lookupOrAllocateIPv6(ctx, netInstStatus, mac)
+	if err != nil {
+		log.Errorf("lookupOrAllocateIPv6 failed %s\n", err)
+		return ""
+	}
+	return addr
+}
+
 // Caller should clear the appropriate status.Pending* if the the caller will
 // return after adding the error.
 func addError(ctx *zedrouterContext,
@@ -2030,10 +2080,22 @@ func doAppNetworkModifyUnderlayNetwork(
 	// If so updateNetworkACLConfiglet needs to know old and new
 	// XXX Could ulStatus.Vif not be set? Means we didn't add
 	ruleList, err := updateACLConfiglet(aclArgs,
-		ulStatus.ACLs, ulConfig.ACLs, ulStatus.ACLRules)
+		ulStatus.ACLs, ulConfig.ACLs,
+		aclRulesForIPVer(ulStatus.ACLRules, 4))
 	if err != nil {
 		addError(ctx, status, "updateACL", err)
 	}
+	if ulStatus.AllocatedIPv6Addr != "" {
+		aclArgs.BridgeIP = ulStatus.BridgeIPv6Addr
+		aclArgs.AppIP = ulStatus.AllocatedIPv6Addr
+		ruleList6, err := updateACLConfiglet(aclArgs,
+			ulStatus.ACLs, ulConfig.ACLs,
+			aclRulesForIPVer(ulStatus.ACLRules, 6))
+		if err != nil {
+			addError(ctx, status, "updateACL IPv6", err)
+		}
+		ruleList = append(ruleList, ruleList6...)
+	}
 	ulStatus.ACLRules = ruleList
 
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
@@ -2299,6 +2361,9 @@ func appNetworkDoInactivateUnderlayNetwork(
 			// XXX publish error?
 			addError(ctx, status, "releaseIPv4", err)
 		}
+		if netstatus.IsDualStack() {
+			releaseIPv6FromNetworkInstance(ctx, netstatus, mac)
+		}
 	}
 
 	appIPAddr := ulStatus.AllocatedIPAddr
@@ -2306,6 +2371,10 @@ func appNetworkDoInactivateUnderlayNetwork(
 		removehostDnsmasq(bridgeName, ulStatus.Mac,
 			appIPAddr)
 	}
+	if ulStatus.AllocatedIPv6Addr != "" {
+		removehostDnsmasq(bridgeName, ulStatus.Mac,
+			ulStatus.AllocatedIPv6Addr)
+	}
 
 	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: bridgeName,
 		VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIPAddr,
@@ -0,0 +1,273 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Create and delete the bond interfaces for the ports in the
+// DevicePortConfig which aggregate other interfaces, and report the link
+// state of the members. The bonds are created before the VLAN ports since
+// a VLAN can be on top of a bond.
+
+package devicenetwork
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/eriknordmark/netlink"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultMIIMonitor = 100 // milliseconds
+
+func bondModeToNetlink(mode types.BondMode) (netlink.BondMode, error) {
+	switch mode {
+	case types.BondModeActiveBackup:
+		return netlink.BOND_MODE_ACTIVE_BACKUP, nil
+	case types.BondMode8023ad:
+		return netlink.BOND_MODE_802_3AD, nil
+	default:
+		return 0, fmt.Errorf("Unsupported bond mode %d", mode)
+	}
+}
+
+// CreateBondLink creates the bond unless it already exists with the same
+// mode, and makes sure it has the configured members and is up
+func CreateBondLink(ifname string, bondConfig types.BondConfig) error {
+
+	log.Infof("CreateBondLink(%s) %+v\n", ifname, bondConfig)
+	if len(ifname) > maxIfNameLen {
+		return fmt.Errorf("Bond interface name %s longer than %d",
+			ifname, maxIfNameLen)
+	}
+	if len(bondConfig.Members) == 0 {
+		return fmt.Errorf("Bond %s has no members", ifname)
+	}
+	mode, err := bondModeToNetlink(bondConfig.Mode)
+	if err != nil {
+		return err
+	}
+	// Look up the members first to not leave a bond without them
+	members := make([]netlink.Link, len(bondConfig.Members))
+	primary := -1
+	for i, m := range bondConfig.Members {
+		members[i], err = netlink.LinkByName(m)
+		if err != nil {
+			return fmt.Errorf("Bond %s member %s: %s", ifname, m, err)
+		}
+		if m == bondConfig.Primary {
+			primary = members[i].Attrs().Index
+		}
+	}
+	link, _ := netlink.LinkByName(ifname)
+	if link != nil {
+		bond, ok := link.(*netlink.Bond)
+		if !ok {
+			return fmt.Errorf("%s exists but is not a bond", ifname)
+		}
+		if bond.Mode != mode {
+			// The mode can not be changed while there are members
+			log.Infof("CreateBondLink(%s) mode %s to %s\n", ifname,
+				bond.Mode, mode)
+			if err := netlink.LinkDel(link); err != nil {
+				return fmt.Errorf("LinkDel %s failed: %s", ifname, err)
+			}
+			link = nil
+		}
+	}
+	if link == nil {
+		bond := netlink.NewLinkBond(netlink.LinkAttrs{Name: ifname})
+		bond.Mode = mode
+		bond.Miimon = defaultMIIMonitor
+		if bondConfig.MIIMonitor != 0 {
+			bond.Miimon = int(bondConfig.MIIMonitor)
+		}
+		if mode == netlink.BOND_MODE_ACTIVE_BACKUP && primary >= 0 {
+			bond.Primary = primary
+		}
+		if err := netlink.LinkAdd(bond); err != nil {
+			return fmt.Errorf("LinkAdd bond %s failed: %s", ifname, err)
+		}
+		// Refresh to get the index
+		link, err = netlink.LinkByName(ifname)
+		if err != nil {
+			return fmt.Errorf("Bond %s not found after LinkAdd: %s",
+				ifname, err)
+		}
+	}
+	bondIndex := link.Attrs().Index
+	// Release interfaces which are no longer members
+	for _, other := range bondSlaves(bondIndex) {
+		if !stringInSlice(other.Attrs().Name, bondConfig.Members) {
+			log.Infof("CreateBondLink(%s) releasing %s\n", ifname,
+				other.Attrs().Name)
+			if err := netlink.LinkSetNoMaster(other); err != nil {
+				log.Errorf("LinkSetNoMaster %s failed: %s\n",
+					other.Attrs().Name, err)
+			}
+		}
+	}
+	for _, member := range members {
+		if member.Attrs().MasterIndex == bondIndex {
+			continue
+		}
+		// The kernel only enslaves interfaces which are down
+		if err := netlink.LinkSetDown(member); err != nil {
+			return fmt.Errorf("LinkSetDown on %s failed: %s",
+				member.Attrs().Name, err)
+		}
+		if err := netlink.LinkSetMasterByIndex(member, bondIndex); err != nil {
+			return fmt.Errorf("Adding %s to bond %s failed: %s",
+				member.Attrs().Name, ifname, err)
+		}
+		if err := netlink.LinkSetUp(member); err != nil {
+			return fmt.Errorf("LinkSetUp on %s failed: %s",
+				member.Attrs().Name, err)
+		}
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("LinkSetUp on %s failed: %s", ifname, err)
+	}
+	return nil
+}
+
+// DeleteBondLink is a no-op if the bond is already gone. The kernel
+// releases the members.
+func DeleteBondLink(ifname string) error {
+
+	log.Infof("DeleteBondLink(%s)\n", ifname)
+	link, _ := netlink.LinkByName(ifname)
+	if link == nil {
+		log.Warnf("DeleteBondLink(%s) not found\n", ifname)
+		return nil
+	}
+	if _, ok := link.(*netlink.Bond); !ok {
+		return fmt.Errorf("%s is not a bond interface", ifname)
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		return fmt.Errorf("LinkDel %s failed: %s", ifname, err)
+	}
+	return nil
+}
+
+// UpdateBondPorts deletes the bonds which are gone or changed and creates
+// those in newConfig. Failures are logged; the port then does not exist
+// in the DeviceNetworkStatus.
+func UpdateBondPorts(newConfig, oldConfig types.DevicePortConfig) {
+
+	for _, oldU := range oldConfig.Ports {
+		if len(oldU.Bond.Members) == 0 {
+			continue
+		}
+		newU := lookupOnIfname(newConfig, oldU.IfName)
+		if newU != nil && reflect.DeepEqual(newU.Bond, oldU.Bond) {
+			continue
+		}
+		if newU != nil && len(newU.Bond.Members) != 0 &&
+			newU.Bond.Mode == oldU.Bond.Mode &&
+			newU.Bond.MIIMonitor == oldU.Bond.MIIMonitor &&
+			newU.Bond.Primary == oldU.Bond.Primary {
+			// Only the members changed; CreateBondLink updates those
+			continue
+		}
+		if err := DeleteBondLink(oldU.IfName); err != nil {
+			log.Errorf("UpdateBondPorts: %s\n", err)
+		}
+	}
+	for _, newU := range newConfig.Ports {
+		if len(newU.Bond.Members) == 0 {
+			continue
+		}
+		if err := CreateBondLink(newU.IfName, newU.Bond); err != nil {
+			log.Errorf("UpdateBondPorts: %s\n", err)
+		}
+	}
+}
+
+// IsBondMember returns true if ifname is a member of a bond port
+func IsBondMember(portConfig types.DevicePortConfig, ifname string) bool {
+	for _, port := range portConfig.Ports {
+		if stringInSlice(ifname, port.Bond.Members) {
+			return true
+		}
+	}
+	return false
+}
+
+// getBondMembers returns the link state of the members of the bond
+func getBondMembers(ifname string,
+	bondConfig types.BondConfig) []types.BondMemberStatus {
+
+	var activeSlave, aggregator int
+	link, _ := netlink.LinkByName(ifname)
+	if bond, ok := link.(*netlink.Bond); ok {
+		activeSlave = bond.ActiveSlave
+		if bond.AdInfo != nil {
+			aggregator = bond.AdInfo.AggregatorId
+		}
+	}
+	members := make([]types.BondMemberStatus, len(bondConfig.Members))
+	for i, m := range bondConfig.Members {
+		members[i].IfName = m
+		mlink, err := netlink.LinkByName(m)
+		if err != nil {
+			log.Warnf("getBondMembers(%s) member %s: %s\n", ifname, m, err)
+			continue
+		}
+		attrs := mlink.Attrs()
+		if link == nil || attrs.MasterIndex != link.Attrs().Index {
+			continue
+		}
+		members[i].Up = attrs.OperState == netlink.OperUp
+		switch bondConfig.Mode {
+		case types.BondModeActiveBackup:
+			members[i].Active = attrs.Index == activeSlave
+		case types.BondMode8023ad:
+			members[i].Active = members[i].Up && aggregator != 0 &&
+				slaveAggregatorID(m) == aggregator
+		}
+	}
+	return members
+}
+
+// slaveAggregatorID is not in the netlink attributes we parse
+func slaveAggregatorID(ifname string) int {
+	filename := fmt.Sprintf("/sys/class/net/%s/bonding_slave/ad_aggregator_id",
+		ifname)
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return 0
+	}
+	id, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// bondSlaves returns the links which have the bond as master
+func bondSlaves(bondIndex int) []netlink.Link {
+	var slaves []netlink.Link
+	links, err := netlink.LinkList()
+	if err != nil {
+		log.Errorf("LinkList failed: %s\n", err)
+		return nil
+	}
+	for _, link := range links {
+		if link.Attrs().MasterIndex == bondIndex {
+			slaves = append(slaves, link)
+		}
+	}
+	return slaves
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
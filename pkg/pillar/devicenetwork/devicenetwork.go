@@ -166,6 +166,12 @@ func MakeDeviceNetworkStatus(globalConfig types.DevicePortConfig, oldStatus type
 		globalStatus.Ports[ix].Name = u.Name
 		globalStatus.Ports[ix].IsMgmt = u.IsMgmt
 		globalStatus.Ports[ix].Free = u.Free
+		globalStatus.Ports[ix].Vlan = u.Vlan
+		globalStatus.Ports[ix].Bond = u.Bond
+		if len(u.Bond.Members) != 0 {
+			globalStatus.Ports[ix].BondMembers = getBondMembers(u.IfName,
+				u.Bond)
+		}
 		globalStatus.Ports[ix].ProxyConfig = u.ProxyConfig
 		// Set fields from the config...
 		globalStatus.Ports[ix].Dhcp = u.Dhcp
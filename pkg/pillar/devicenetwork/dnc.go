@@ -287,6 +287,8 @@ func VerifyPending(pending *DPCPending,
 
 	if !reflect.DeepEqual(pending.PendDPC.Ports, pending.OldDPC.Ports) {
 		log.Infof("VerifyPending: DPC changed. update DhcpClient.\n")
+		UpdateBondPorts(pending.PendDPC, pending.OldDPC)
+		UpdateVlanPorts(pending.PendDPC, pending.OldDPC)
 		UpdateDhcpClient(pending.PendDPC, pending.OldDPC)
 		pending.OldDPC = pending.PendDPC
 	}
@@ -679,6 +681,8 @@ func (ctx *DeviceNetworkContext) doApplyDevicePortConfig(delete bool) {
 	if !reflect.DeepEqual(*ctx.DevicePortConfig, portConfig) {
 		log.Infof("doApplyDevicePortConfig: DevicePortConfig changed. " +
 			"update DhcpClient.\n")
+		UpdateBondPorts(portConfig, *ctx.DevicePortConfig)
+		UpdateVlanPorts(portConfig, *ctx.DevicePortConfig)
 		UpdateDhcpClient(portConfig, *ctx.DevicePortConfig)
 		*ctx.DevicePortConfig = portConfig
 	} else {
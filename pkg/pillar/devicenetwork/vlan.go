@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Create and delete the 802.1Q sub-interfaces for the VLAN ports in the
+// DevicePortConfig. zedrouter uses the same functions for the
+// sub-interfaces of Switch network instances which are not ports.
+
+package devicenetwork
+
+import (
+	"fmt"
+
+	"github.com/eriknordmark/netlink"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const maxIfNameLen = 15 // IFNAMSIZ without the terminating NUL
+
+// VlanIfName is the name we pick for a sub-interface
+func VlanIfName(parentIfName string, vlanID uint16) string {
+	return fmt.Sprintf("%s.%d", parentIfName, vlanID)
+}
+
+// CheckVlan validates the VLAN ID and the name of the sub-interface
+func CheckVlan(ifname string, vlanID uint16) error {
+	if vlanID < 1 || vlanID > 4094 {
+		return fmt.Errorf("VLAN ID %d for %s out of range", vlanID, ifname)
+	}
+	if len(ifname) > maxIfNameLen {
+		return fmt.Errorf("VLAN interface name %s longer than %d",
+			ifname, maxIfNameLen)
+	}
+	return nil
+}
+
+// CreateVlanLink creates the sub-interface unless it already exists with
+// the same parent and VLAN ID, and sets both up
+func CreateVlanLink(ifname string, parentIfName string, vlanID uint16) error {
+
+	log.Infof("CreateVlanLink(%s) VLAN %d on %s\n", ifname, vlanID,
+		parentIfName)
+	if err := CheckVlan(ifname, vlanID); err != nil {
+		return err
+	}
+	parent, err := netlink.LinkByName(parentIfName)
+	if err != nil {
+		return fmt.Errorf("VLAN %s parent %s: %s", ifname, parentIfName, err)
+	}
+	link, _ := netlink.LinkByName(ifname)
+	if link != nil {
+		vlan, ok := link.(*netlink.Vlan)
+		if !ok || vlan.VlanId != int(vlanID) ||
+			vlan.ParentIndex != parent.Attrs().Index {
+			return fmt.Errorf("%s exists but is not VLAN %d on %s",
+				ifname, vlanID, parentIfName)
+		}
+	} else {
+		vlan := &netlink.Vlan{
+			LinkAttrs: netlink.LinkAttrs{Name: ifname,
+				ParentIndex: parent.Attrs().Index},
+			VlanId: int(vlanID),
+		}
+		if err := netlink.LinkAdd(vlan); err != nil {
+			return fmt.Errorf("LinkAdd VLAN %s failed: %s", ifname, err)
+		}
+		link = vlan
+	}
+	// The sub-interface only passes traffic when the parent is up
+	if err := netlink.LinkSetUp(parent); err != nil {
+		return fmt.Errorf("LinkSetUp on %s failed: %s", parentIfName, err)
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("LinkSetUp on %s failed: %s", ifname, err)
+	}
+	return nil
+}
+
+// DeleteVlanLink is a no-op if the sub-interface is already gone
+func DeleteVlanLink(ifname string) error {
+
+	log.Infof("DeleteVlanLink(%s)\n", ifname)
+	link, _ := netlink.LinkByName(ifname)
+	if link == nil {
+		log.Warnf("DeleteVlanLink(%s) not found\n", ifname)
+		return nil
+	}
+	if _, ok := link.(*netlink.Vlan); !ok {
+		return fmt.Errorf("%s is not a VLAN interface", ifname)
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		return fmt.Errorf("LinkDel %s failed: %s", ifname, err)
+	}
+	return nil
+}
+
+// UpdateVlanPorts deletes the sub-interfaces which are gone or changed
+// and creates those in newConfig. Failures are logged; the port then
+// does not exist in the DeviceNetworkStatus.
+func UpdateVlanPorts(newConfig, oldConfig types.DevicePortConfig) {
+
+	for _, oldU := range oldConfig.Ports {
+		if oldU.Vlan.VlanID == 0 {
+			continue
+		}
+		newU := lookupOnIfname(newConfig, oldU.IfName)
+		if newU != nil && newU.Vlan == oldU.Vlan {
+			continue
+		}
+		if err := DeleteVlanLink(oldU.IfName); err != nil {
+			log.Errorf("UpdateVlanPorts: %s\n", err)
+		}
+	}
+	for _, newU := range newConfig.Ports {
+		if newU.Vlan.VlanID == 0 {
+			continue
+		}
+		err := CreateVlanLink(newU.IfName, newU.Vlan.ParentIfName,
+			newU.Vlan.VlanID)
+		if err != nil {
+			log.Errorf("UpdateVlanPorts: %s\n", err)
+		}
+	}
+}
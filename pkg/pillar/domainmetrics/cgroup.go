@@ -0,0 +1,363 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// With KVM the guests and containers are processes. When the process
+// has its own cgroup e.g., a rkt pod, the cpuacct and memory controllers
+// account for everything in the domain. Otherwise e.g., a qemu in our
+// cgroup, we use the /proc entries of the process itself.
+
+package domainmetrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	hostServicesName = "host"
+	userHz           = 100 // Clock ticks in /proc/<pid>/stat and /proc/stat
+	nanoSecond       = 1000 * 1000 * 1000
+)
+
+type cgroupCollector struct {
+	procDir   string
+	cgroupDir string
+}
+
+func newCgroupCollector() Collector {
+	return cgroupCollector{procDir: "/proc", cgroupDir: "/sys/fs/cgroup"}
+}
+
+func (c cgroupCollector) Host() (HostMetric, error) {
+	ncpus, _, totalKbytes, availKbytes, err := c.hostStats()
+	if err != nil {
+		return HostMetric{}, err
+	}
+	return hostMetric(ncpus, totalKbytes, availKbytes), nil
+}
+
+func (c cgroupCollector) Collect(domains []DomainRef) (Metrics, error) {
+	var m Metrics
+	ncpus, cpuTicks, totalKbytes, availKbytes, err := c.hostStats()
+	if err != nil {
+		return m, err
+	}
+	m.Host = hostMetric(ncpus, totalKbytes, availKbytes)
+	selfCgroups, err := c.readCgroups("self")
+	if err != nil {
+		return m, err
+	}
+
+	m.Domains = make(map[string]DomainMetric)
+	servicesCPU := cpuTicks / userHz
+	servicesMemory := uint64(roundFromKbytesToMbytes(totalKbytes - availKbytes))
+	for _, d := range domains {
+		if d.DomainID <= 0 {
+			continue
+		}
+		dm, err := c.domainMetric(d.DomainID, selfCgroups, totalKbytes*1024)
+		if err != nil {
+			log.Debugf("Collect %s pid %d: %s\n", d.Name, d.DomainID, err)
+			continue
+		}
+		dm.Disks = diskMetrics(d.Name, hypervisor.KvmQmpSocket(d.Name),
+			d.Disks)
+		m.Domains[d.Name] = dm
+		if servicesCPU > dm.CPUTotal {
+			servicesCPU -= dm.CPUTotal
+		} else {
+			servicesCPU = 0
+		}
+		if servicesMemory > uint64(dm.UsedMemory) {
+			servicesMemory -= uint64(dm.UsedMemory)
+		} else {
+			servicesMemory = 0
+		}
+	}
+	m.Domains[hostServicesName] = DomainMetric{
+		CPUTotal:          servicesCPU,
+		UsedMemory:        uint32(servicesMemory),
+		UsedMemoryPercent: percent(servicesMemory, m.Host.TotalMemory),
+	}
+	return m, nil
+}
+
+// hostStats returns the CPUs, the non-idle clock ticks, and the total and
+// available memory in kbytes
+func (c cgroupCollector) hostStats() (uint32, uint64, uint64, uint64, error) {
+	f, err := os.Open(filepath.Join(c.procDir, "meminfo"))
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	totalKbytes, availKbytes, err := parseMeminfo(f)
+	f.Close()
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	f, err = os.Open(filepath.Join(c.procDir, "stat"))
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	ncpus, cpuTicks, err := parseProcStat(f)
+	f.Close()
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return ncpus, cpuTicks, totalKbytes, availKbytes, nil
+}
+
+func hostMetric(ncpus uint32, totalKbytes uint64, availKbytes uint64) HostMetric {
+	return HostMetric{
+		Ncpus:        ncpus,
+		TotalMemory:  roundFromKbytesToMbytes(totalKbytes),
+		FreeMemory:   roundFromKbytesToMbytes(availKbytes),
+		UsedPercent:  percent(totalKbytes-availKbytes, totalKbytes),
+		ServicesName: hostServicesName,
+	}
+}
+
+// domainMetric uses the cgroup of the process unless it shares it with us
+func (c cgroupCollector) domainMetric(pid int, selfCgroups map[string]string,
+	hostBytes uint64) (DomainMetric, error) {
+
+	var dm DomainMetric
+	pidStr := strconv.Itoa(pid)
+	cgroups, err := c.readCgroups(pidStr)
+	if err != nil {
+		return dm, err
+	}
+	f, err := os.Open(filepath.Join(c.procDir, pidStr, "stat"))
+	if err != nil {
+		return dm, err
+	}
+	cpuTicks, err := parsePidStat(f)
+	f.Close()
+	if err != nil {
+		return dm, err
+	}
+	dm.CPUTotal = cpuTicks / userHz
+	if path, ok := ownCgroup(cgroups, selfCgroups, "cpuacct"); ok {
+		usage, err := c.readCgroupUint("cpuacct", path, "cpuacct.usage")
+		if err == nil {
+			dm.CPUTotal = usage / nanoSecond
+		} else {
+			log.Warnf("domainMetric %d: %s\n", pid, err)
+		}
+	}
+
+	var usedBytes, maxBytes uint64
+	if path, ok := ownCgroup(cgroups, selfCgroups, "memory"); ok {
+		usedBytes, err = c.readCgroupUint("memory", path,
+			"memory.usage_in_bytes")
+		if err != nil {
+			log.Warnf("domainMetric %d: %s\n", pid, err)
+		}
+		maxBytes, err = c.readCgroupUint("memory", path,
+			"memory.limit_in_bytes")
+		if err != nil {
+			log.Warnf("domainMetric %d: %s\n", pid, err)
+		}
+	}
+	if usedBytes == 0 {
+		f, err := os.Open(filepath.Join(c.procDir, pidStr, "status"))
+		if err != nil {
+			return dm, err
+		}
+		rssKbytes, err := parsePidStatus(f)
+		f.Close()
+		if err != nil {
+			return dm, err
+		}
+		usedBytes = rssKbytes * 1024
+	}
+	// An unlimited cgroup reports a huge limit
+	if maxBytes >= hostBytes {
+		maxBytes = 0
+	}
+	dm.UsedMemory = uint32(roundToMbytes(usedBytes))
+	dm.MaxMemory = uint32(roundToMbytes(maxBytes))
+	if maxBytes != 0 {
+		dm.UsedMemoryPercent = percent(usedBytes, maxBytes)
+	} else {
+		dm.UsedMemoryPercent = percent(usedBytes, hostBytes)
+	}
+	return dm, nil
+}
+
+// readCgroups returns the cgroup v1 path per controller of the process
+func (c cgroupCollector) readCgroups(pid string) (map[string]string, error) {
+	f, err := os.Open(filepath.Join(c.procDir, pid, "cgroup"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseCgroups(f)
+}
+
+func (c cgroupCollector) readCgroupUint(controller string, path string,
+	filename string) (uint64, error) {
+
+	b, err := ioutil.ReadFile(filepath.Join(c.cgroupDir, controller, path,
+		filename))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+}
+
+// ownCgroup returns the path if the process is in a cgroup other than
+// ours and the root
+func ownCgroup(cgroups map[string]string, selfCgroups map[string]string,
+	controller string) (string, bool) {
+
+	path, ok := cgroups[controller]
+	if !ok || path == "/" || path == selfCgroups[controller] {
+		return "", false
+	}
+	return path, true
+}
+
+// parseCgroups handles the "id:controller,controller:path" lines
+func parseCgroups(r io.Reader) (map[string]string, error) {
+	res := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		for _, controller := range strings.Split(parts[1], ",") {
+			if controller != "" {
+				res[controller] = parts[2]
+			}
+		}
+	}
+	return res, scanner.Err()
+}
+
+// parseMeminfo returns the total and available memory in kbytes.
+// Older kernels do not have MemAvailable hence we fall back to MemFree.
+func parseMeminfo(r io.Reader) (uint64, uint64, error) {
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		val, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		values[strings.TrimSuffix(fields[0], ":")] = val
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+	total, ok := values["MemTotal"]
+	if !ok {
+		return 0, 0, fmt.Errorf("no MemTotal in meminfo")
+	}
+	avail, ok := values["MemAvailable"]
+	if !ok {
+		avail = values["MemFree"]
+	}
+	if avail > total {
+		avail = total
+	}
+	return total, avail, nil
+}
+
+// parseProcStat returns the number of CPUs and the clock ticks they
+// have spent doing something other than idle and iowait
+func parseProcStat(r io.Reader) (uint32, uint64, error) {
+	var ncpus uint32
+	var ticks uint64
+	found := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+		if fields[0] != "cpu" {
+			ncpus++
+			continue
+		}
+		// user nice system idle iowait irq softirq steal; guest is
+		// included in user
+		for i, f := range fields[1:] {
+			if i == 3 || i == 4 || i > 7 {
+				continue
+			}
+			val, err := strconv.ParseUint(f, 10, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("bad cpu line in stat: %s", err)
+			}
+			ticks += val
+		}
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+	if !found {
+		return 0, 0, fmt.Errorf("no cpu line in stat")
+	}
+	return ncpus, ticks, nil
+}
+
+// parsePidStat returns utime plus stime in clock ticks. The command name
+// is in parenthesis and can contain spaces.
+func parsePidStat(r io.Reader) (uint64, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	line := string(b)
+	end := strings.LastIndex(line, ")")
+	if end < 0 {
+		return 0, fmt.Errorf("no command in stat")
+	}
+	// Starts with the state which is the third field
+	fields := strings.Fields(line[end+1:])
+	const utime = 14 - 3
+	const stime = 15 - 3
+	if len(fields) <= stime {
+		return 0, fmt.Errorf("short stat: %d fields", len(fields))
+	}
+	var ticks uint64
+	for _, i := range []int{utime, stime} {
+		val, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("bad stat: %s", err)
+		}
+		ticks += val
+	}
+	return ticks, nil
+}
+
+// parsePidStatus returns VmRSS in kbytes
+func parsePidStatus(r io.Reader) (uint64, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "VmRSS:" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	// Kernel threads and zombies
+	return 0, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// CPU and memory usage of the domains and of the host. The collector is
+// picked based on the hypervisor the host was booted with: with Xen the
+// hypervisor owns the memory and the stats come from the toolstack,
+// while with KVM the guests and containers are processes hence we read
+// their cgroup or /proc entries. For both the disk I/O comes from the
+// qemu of the domain, if any.
+
+package domainmetrics
+
+import (
+	"os"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	log "github.com/sirupsen/logrus"
+)
+
+// DomainRef identifies a domain to collect metrics for.
+// DomainID is taken from DomainStatus.DomainId i.e., the domid with Xen
+// and the process ID of the qemu or rkt pod with KVM.
+type DomainRef struct {
+	Name     string
+	DomainID int
+	Disks    []DiskRef
+}
+
+// DiskRef identifies a disk of the domain in the qemu of the domain
+type DiskRef struct {
+	Vdev    string // From DiskStatus
+	DriveID string // From hypervisor.QemuDriveID
+}
+
+// DomainMetric is the usage of one domain, or of the services running
+// outside of the domains
+type DomainMetric struct {
+	CPUTotal          uint64                // Seconds
+	UsedMemory        uint32                // MBytes
+	MaxMemory         uint32                // MBytes; zero if there is no limit
+	UsedMemoryPercent float64               // Of MaxMemory, or of the host if no limit
+	Disks             map[string]DiskMetric // Key is DiskRef.Vdev
+}
+
+// DiskMetric is the I/O of one disk since the domain booted
+type DiskMetric struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+}
+
+// AvailableMemory is what the domain can still use given its limit
+func (m DomainMetric) AvailableMemory() uint32 {
+	if m.MaxMemory <= m.UsedMemory {
+		return 0
+	}
+	return m.MaxMemory - m.UsedMemory
+}
+
+// HostMetric is for the device as a whole
+type HostMetric struct {
+	Ncpus        uint32 // Physical CPUs, not those assigned to dom0
+	TotalMemory  uint64 // MBytes
+	FreeMemory   uint64 // MBytes
+	UsedPercent  float64
+	ServicesName string // Key of the services in Metrics.Domains
+}
+
+// Metrics is the result of one collection
+type Metrics struct {
+	Host    HostMetric
+	Domains map[string]DomainMetric // Key is DomainRef.Name
+}
+
+// Services returns the usage of what runs outside of the domains i.e.,
+// dom0 with Xen and the host with KVM
+func (m Metrics) Services() DomainMetric {
+	return m.Domains[m.Host.ServicesName]
+}
+
+// Collector collects the metrics for the host and the domains
+type Collector interface {
+	// Host returns the device CPUs and memory
+	Host() (HostMetric, error)
+
+	// Collect returns the host and the domain metrics. Domains which
+	// are not running are omitted from the result.
+	Collect(domains []DomainRef) (Metrics, error)
+}
+
+// NewCollector returns the collector for the boot time hypervisor
+func NewCollector() Collector {
+	name := hypervisor.BootTimeHypervisor()
+	log.Infof("domainmetrics.NewCollector for %s\n", name)
+	if name == hypervisor.XenName {
+		return newXenCollector()
+	}
+	return newCgroupCollector()
+}
+
+// diskMetrics queries the qemu of the domain. There is none for
+// containers and Xen PV domains without a qdisk backend.
+func diskMetrics(name string, socket string, disks []DiskRef) map[string]DiskMetric {
+	if len(disks) == 0 {
+		return nil
+	}
+	if _, err := os.Stat(socket); err != nil {
+		return nil
+	}
+	stats, err := hypervisor.QmpBlockStats(socket)
+	if err != nil {
+		log.Warnf("diskMetrics %s: %s\n", name, err)
+		return nil
+	}
+	return matchBlockStats(stats, disks)
+}
+
+// matchBlockStats picks the drives of the disks by their id since qemu
+// has drives of its own and the PV disks of Xen domains have none
+func matchBlockStats(stats []hypervisor.BlockStats,
+	disks []DiskRef) map[string]DiskMetric {
+
+	res := make(map[string]DiskMetric)
+	for _, disk := range disks {
+		for _, st := range stats {
+			if !st.Matches(disk.DriveID) {
+				continue
+			}
+			res[disk.Vdev] = DiskMetric{
+				ReadBytes:  st.ReadBytes,
+				WriteBytes: st.WriteBytes,
+				ReadOps:    st.ReadOps,
+				WriteOps:   st.WriteOps,
+			}
+			break
+		}
+	}
+	return res
+}
+
+// Percentage of used with a zero total resulting in zero
+func percent(used uint64, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(100) * float64(used) / float64(total)
+}
+
+func roundFromKbytesToMbytes(kbytes uint64) uint64 {
+	const kbyte = 1024
+
+	return (kbytes + kbyte/2) / kbyte
+}
+
+func roundToMbytes(byteCount uint64) uint64 {
+	const mbyte = 1024 * 1024
+
+	return (byteCount + mbyte/2) / mbyte
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmetrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/stretchr/testify/assert"
+)
+
+// Compare with a tolerance for the percentages
+func assertDomainMetric(t *testing.T, name string, expected DomainMetric,
+	actual DomainMetric) {
+
+	assert.Equal(t, expected.CPUTotal, actual.CPUTotal, name)
+	assert.Equal(t, expected.UsedMemory, actual.UsedMemory, name)
+	assert.Equal(t, expected.MaxMemory, actual.MaxMemory, name)
+	assert.InDelta(t, expected.UsedMemoryPercent, actual.UsedMemoryPercent,
+		0.01, name)
+}
+
+func TestXenstatMetrics(t *testing.T) {
+	const mbyte = 1024 * 1024
+	host := xenstatHostMetric(4, 8192*mbyte, 5120*mbyte)
+	assert.Equal(t, uint32(4), host.Ncpus)
+	assert.Equal(t, uint64(8192), host.TotalMemory)
+	assert.Equal(t, uint64(5120), host.FreeMemory)
+	assert.InDelta(t, 37.5, host.UsedPercent, 0.01)
+	assert.Equal(t, xenServicesName, host.ServicesName)
+
+	testMatrix := map[string]struct {
+		cpuNs    uint64
+		curBytes uint64
+		maxBytes uint64
+		expected DomainMetric
+	}{
+		"No limit": {
+			cpuNs:    22436*1000000000 + 999999999,
+			curBytes: 1024 * mbyte,
+			maxBytes: xenstatNoLimit,
+			expected: DomainMetric{CPUTotal: 22436, UsedMemory: 1024,
+				UsedMemoryPercent: 12.5},
+		},
+		"Limit": {
+			cpuNs:    1201 * 1000000000,
+			curBytes: 512 * mbyte,
+			maxBytes: 513 * mbyte,
+			expected: DomainMetric{CPUTotal: 1201, UsedMemory: 512,
+				MaxMemory: 513, UsedMemoryPercent: 99.81},
+		},
+		"Zero limit": {
+			expected: DomainMetric{},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dm := xenstatDomainMetric(test.cpuNs, test.curBytes,
+			test.maxBytes, 8192*mbyte)
+		assertDomainMetric(t, testname, test.expected, dm)
+	}
+}
+
+func TestMatchBlockStats(t *testing.T) {
+	stats := []hypervisor.BlockStats{
+		{Device: "floppy0"},
+		{Device: "ide1-cd0"},
+		{Device: "drive0", ReadBytes: 1, WriteBytes: 2, ReadOps: 3,
+			WriteOps: 4},
+		{Device: "drive1", ReadBytes: 5},
+	}
+	testMatrix := map[string]struct {
+		disks    []DiskRef
+		expected map[string]DiskMetric
+	}{
+		"Default drives skipped": {
+			disks: []DiskRef{
+				{Vdev: "xvda", DriveID: "drive0"},
+				{Vdev: "xvdb", DriveID: "drive1"},
+			},
+			expected: map[string]DiskMetric{
+				"xvda": {ReadBytes: 1, WriteBytes: 2, ReadOps: 3,
+					WriteOps: 4},
+				"xvdb": {ReadBytes: 5},
+			},
+		},
+		"No drive": {
+			disks: []DiskRef{
+				{Vdev: "xvda", DriveID: "drive0"},
+				{Vdev: "xvde"},
+				{Vdev: "xvdf", DriveID: "drive5"},
+			},
+			expected: map[string]DiskMetric{
+				"xvda": {ReadBytes: 1, WriteBytes: 2, ReadOps: 3,
+					WriteOps: 4},
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, matchBlockStats(stats, test.disks))
+	}
+}
+
+func TestCgroupCollect(t *testing.T) {
+	c := cgroupCollector{procDir: "testdata/proc", cgroupDir: "testdata/cgroup"}
+	testMatrix := map[string]struct {
+		domains  []DomainRef
+		expected map[string]DomainMetric
+	}{
+		"No domains": {
+			expected: map[string]DomainMetric{
+				hostServicesName: {CPUTotal: 1510, UsedMemory: 2888,
+					UsedMemoryPercent: 36.21},
+			},
+		},
+		"Qemu and pod": {
+			domains: []DomainRef{
+				{Name: "ubuntu.1", DomainID: 1234},
+				{Name: "nginx.2", DomainID: 5678},
+			},
+			expected: map[string]DomainMetric{
+				// From /proc since it is in our cgroup
+				"ubuntu.1": {CPUTotal: 501, UsedMemory: 1024,
+					UsedMemoryPercent: 12.84},
+				// From its own cgroup
+				"nginx.2": {CPUTotal: 90, UsedMemory: 256,
+					MaxMemory: 512, UsedMemoryPercent: 50},
+				hostServicesName: {CPUTotal: 919, UsedMemory: 1608,
+					UsedMemoryPercent: 20.16},
+			},
+		},
+		"Not running": {
+			domains: []DomainRef{
+				{Name: "ubuntu.1", DomainID: 0},
+				{Name: "nginx.2", DomainID: 9999},
+			},
+			expected: map[string]DomainMetric{
+				hostServicesName: {CPUTotal: 1510, UsedMemory: 2888,
+					UsedMemoryPercent: 36.21},
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		m, err := c.Collect(test.domains)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, uint32(4), m.Host.Ncpus)
+		assert.Equal(t, uint64(7976), m.Host.TotalMemory)
+		assert.Equal(t, uint64(5088), m.Host.FreeMemory)
+		assert.InDelta(t, 36.21, m.Host.UsedPercent, 0.01)
+		assert.Equal(t, len(test.expected), len(m.Domains))
+		for name, dm := range test.expected {
+			assertDomainMetric(t, name, dm, m.Domains[name])
+		}
+		assertDomainMetric(t, "Services", test.expected[hostServicesName],
+			m.Services())
+	}
+}
+
+func TestParseMeminfo(t *testing.T) {
+	testMatrix := map[string]struct {
+		meminfo       string
+		expectedTotal uint64
+		expectedAvail uint64
+		expectFail    bool
+	}{
+		"Available": {
+			meminfo:       "MemTotal: 1000 kB\nMemFree: 100 kB\nMemAvailable: 400 kB\n",
+			expectedTotal: 1000,
+			expectedAvail: 400,
+		},
+		"Old kernel": {
+			meminfo:       "MemTotal: 1000 kB\nMemFree: 100 kB\n",
+			expectedTotal: 1000,
+			expectedAvail: 100,
+		},
+		"No total": {
+			meminfo:    "MemFree: 100 kB\n",
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		total, avail, err := parseMeminfo(strings.NewReader(test.meminfo))
+		if test.expectFail {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedTotal, total)
+		assert.Equal(t, test.expectedAvail, avail)
+	}
+}
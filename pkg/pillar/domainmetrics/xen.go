@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// With Xen the hypervisor owns the memory and the CPU time is accounted
+// per domain, hence the stats come from the toolstack. Built with the
+// xenstat tag they are read through libxenstat, which gets them from the
+// hypervisor and xenstore without forking; otherwise we fall back to
+// running xl info and xentop and parsing their output.
+// The disk I/O of HVM domains comes from their qemu device model.
+
+package domainmetrics
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+)
+
+const (
+	xenServicesName = "Domain-0"
+	// What libxenstat reports as the maximum memory of a domain without
+	// a limit
+	xenstatNoLimit = ^uint64(0)
+)
+
+type xenCollector struct{}
+
+func newXenCollector() Collector {
+	return xenCollector{}
+}
+
+func (c xenCollector) Host() (HostMetric, error) {
+	return xenHost()
+}
+
+func (c xenCollector) Collect(domains []DomainRef) (Metrics, error) {
+	var m Metrics
+	var err error
+	m.Host, err = c.Host()
+	if err != nil {
+		return m, err
+	}
+	all, err := xenDomains()
+	if err != nil {
+		return m, err
+	}
+	m.Domains = make(map[string]DomainMetric)
+	m.Domains[xenServicesName] = all[xenServicesName]
+	for _, d := range domains {
+		if dm, ok := all[d.Name]; ok {
+			if d.DomainID > 0 {
+				dm.Disks = diskMetrics(d.Name,
+					hypervisor.XenQmpSocket(d.DomainID), d.Disks)
+			}
+			m.Domains[d.Name] = dm
+		}
+	}
+	return m, nil
+}
+
+// xenstatHostMetric converts the libxenstat node counters in bytes
+func xenstatHostMetric(ncpus uint32, totBytes uint64,
+	freeBytes uint64) HostMetric {
+
+	return HostMetric{
+		Ncpus:        ncpus,
+		TotalMemory:  roundFromKbytesToMbytes(totBytes / 1024),
+		FreeMemory:   roundFromKbytesToMbytes(freeBytes / 1024),
+		UsedPercent:  percent(totBytes-freeBytes, totBytes),
+		ServicesName: xenServicesName,
+	}
+}
+
+// xenstatDomainMetric converts the libxenstat domain counters in
+// nanoseconds and bytes. Without a limit the percentage is of the host
+// memory like xentop does.
+func xenstatDomainMetric(cpuNs uint64, curBytes uint64, maxBytes uint64,
+	hostBytes uint64) DomainMetric {
+
+	dm := DomainMetric{
+		CPUTotal:   cpuNs / 1000000000,
+		UsedMemory: uint32(roundFromKbytesToMbytes(curBytes / 1024)),
+	}
+	if maxBytes == xenstatNoLimit {
+		dm.UsedMemoryPercent = percent(curBytes, hostBytes)
+	} else {
+		dm.MaxMemory = uint32(roundFromKbytesToMbytes(maxBytes / 1024))
+		dm.UsedMemoryPercent = percent(curBytes, maxBytes)
+	}
+	return dm
+}
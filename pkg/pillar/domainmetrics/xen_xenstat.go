@@ -0,0 +1,68 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// +build xenstat
+
+// libxenstat is what xentop uses. Each call takes a new snapshot of the
+// node since the handle is cheap compared to the collection interval.
+
+package domainmetrics
+
+// #cgo LDFLAGS: -lxenstat
+// #include <xenstat.h>
+import "C"
+
+import (
+	"errors"
+)
+
+// withXenstatNode runs fn on a snapshot of the node and its domains
+func withXenstatNode(fn func(node *C.xenstat_node)) error {
+	handle := C.xenstat_init()
+	if handle == nil {
+		return errors.New("xenstat_init failed")
+	}
+	defer C.xenstat_uninit(handle)
+	// No VCPU, network or VBD details; the disks come from qemu
+	node := C.xenstat_get_node(handle, 0)
+	if node == nil {
+		return errors.New("xenstat_get_node failed")
+	}
+	defer C.xenstat_free_node(node)
+	fn(node)
+	return nil
+}
+
+func xenHost() (HostMetric, error) {
+	var host HostMetric
+	err := withXenstatNode(func(node *C.xenstat_node) {
+		host = xenstatHostMetric(uint32(C.xenstat_node_num_cpus(node)),
+			uint64(C.xenstat_node_tot_mem(node)),
+			uint64(C.xenstat_node_free_mem(node)))
+	})
+	return host, err
+}
+
+func xenDomains() (map[string]DomainMetric, error) {
+	res := make(map[string]DomainMetric)
+	err := withXenstatNode(func(node *C.xenstat_node) {
+		hostBytes := uint64(C.xenstat_node_tot_mem(node))
+		num := C.xenstat_node_num_domains(node)
+		for i := C.uint(0); i < num; i++ {
+			domain := C.xenstat_node_domain_by_index(node, i)
+			if domain == nil {
+				continue
+			}
+			name := C.GoString(C.xenstat_domain_name(domain))
+			res[name] = xenstatDomainMetric(
+				uint64(C.xenstat_domain_cpu_ns(domain)),
+				uint64(C.xenstat_domain_cur_mem(domain)),
+				uint64(C.xenstat_domain_max_mem(domain)),
+				hostBytes)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// +build !xenstat
+
+// Without libxenstat, as in the cross builds, we run xl info and xentop
+// and parse their output using the column and field names
+
+package domainmetrics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	xenCmdTimeout = 10 * time.Second
+	xentopNoLimit = "no limit"
+)
+
+func xenHost() (HostMetric, error) {
+	out, err := execWithTimeout("xl", "info")
+	if err != nil {
+		return HostMetric{}, err
+	}
+	return parseXlInfo(bytes.NewReader(out))
+}
+
+func xenDomains() (map[string]DomainMetric, error) {
+	// A single iteration since we do not use CPU(%)
+	out, err := execWithTimeout("xentop", "-b", "-f", "-i", "1")
+	if err != nil {
+		return nil, err
+	}
+	return parseXentop(bytes.NewReader(out))
+}
+
+func execWithTimeout(command string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), xenCmdTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, command, args...).Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("%s timed out", command)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %s", command, err)
+	}
+	return out, nil
+}
+
+// parseXlInfo extracts the physical CPUs and the memory in MBytes from
+// the "name : value" lines of xl info
+func parseXlInfo(r io.Reader) (HostMetric, error) {
+	host := HostMetric{ServicesName: xenServicesName}
+	dict := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		res := strings.SplitN(scanner.Text(), ":", 2)
+		if len(res) == 2 {
+			dict[strings.TrimSpace(res[0])] = strings.TrimSpace(res[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return host, err
+	}
+	ncpus, err := strconv.ParseUint(dict["nr_cpus"], 10, 32)
+	if err != nil {
+		return host, fmt.Errorf("bad nr_cpus: %s", err)
+	}
+	host.Ncpus = uint32(ncpus)
+	host.TotalMemory, err = strconv.ParseUint(dict["total_memory"], 10, 64)
+	if err != nil {
+		return host, fmt.Errorf("bad total_memory: %s", err)
+	}
+	host.FreeMemory, err = strconv.ParseUint(dict["free_memory"], 10, 64)
+	if err != nil {
+		return host, fmt.Errorf("bad free_memory: %s", err)
+	}
+	host.UsedPercent = percent(host.TotalMemory-host.FreeMemory,
+		host.TotalMemory)
+	return host, nil
+}
+
+// parseXentop returns the metrics for the domains in the last iteration
+// of the xentop batch output, keyed by domain name
+func parseXentop(r io.Reader) (map[string]DomainMetric, error) {
+	var header []string
+	var rows [][]string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := xentopFields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "NAME" {
+			// Start of a new iteration
+			header = fields
+			rows = nil
+			continue
+		}
+		if header != nil {
+			rows = append(rows, fields)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("no header in xentop output")
+	}
+	column := make(map[string]int)
+	for i, name := range header {
+		column[name] = i
+	}
+	for _, name := range []string{"CPU(sec)", "MEM(k)", "MEM(%)", "MAXMEM(k)"} {
+		if _, ok := column[name]; !ok {
+			return nil, fmt.Errorf("no %s in xentop header", name)
+		}
+	}
+	res := make(map[string]DomainMetric)
+	for _, fields := range rows {
+		if len(fields) != len(header) {
+			log.Warnf("parseXentop: %d fields for %d columns: %v\n",
+				len(fields), len(header), fields)
+			continue
+		}
+		name := fields[column["NAME"]]
+		var dm DomainMetric
+		var err error
+		dm.CPUTotal, err = strconv.ParseUint(fields[column["CPU(sec)"]], 10, 64)
+		if err != nil {
+			log.Errorf("parseXentop CPU(sec) for %s: %s\n", name, err)
+		}
+		memKbytes, err := strconv.ParseUint(fields[column["MEM(k)"]], 10, 64)
+		if err != nil {
+			log.Errorf("parseXentop MEM(k) for %s: %s\n", name, err)
+		}
+		dm.UsedMemory = uint32(roundFromKbytesToMbytes(memKbytes))
+		maxmem := fields[column["MAXMEM(k)"]]
+		if maxmem == xentopNoLimit {
+			// Percent of the host memory
+			dm.UsedMemoryPercent, err = strconv.ParseFloat(
+				fields[column["MEM(%)"]], 64)
+			if err != nil {
+				log.Errorf("parseXentop MEM(%%) for %s: %s\n",
+					name, err)
+			}
+		} else {
+			maxKbytes, err := strconv.ParseUint(maxmem, 10, 64)
+			if err != nil {
+				log.Errorf("parseXentop MAXMEM(k) for %s: %s\n",
+					name, err)
+			}
+			dm.MaxMemory = uint32(roundFromKbytesToMbytes(maxKbytes))
+			dm.UsedMemoryPercent = percent(memKbytes, maxKbytes)
+		}
+		res[name] = dm
+	}
+	return res, nil
+}
+
+// xentopFields splits a line treating "no limit" as one field
+func xentopFields(line string) []string {
+	var fields []string
+	for _, f := range strings.Fields(line) {
+		if f == "limit" && len(fields) != 0 && fields[len(fields)-1] == "no" {
+			fields[len(fields)-1] = xentopNoLimit
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// +build !xenstat
+
+package domainmetrics
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseXentop(t *testing.T) {
+	f, err := os.Open("testdata/xentop.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	res, err := parseXentop(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// From the last iteration
+	expected := map[string]DomainMetric{
+		"Domain-0": {CPUTotal: 22436, UsedMemory: 1024,
+			UsedMemoryPercent: 12.5},
+		"ubuntu.1": {CPUTotal: 1201, UsedMemory: 512, MaxMemory: 513,
+			UsedMemoryPercent: 99.81},
+		"nginx.2": {CPUTotal: 35, UsedMemory: 256, MaxMemory: 257,
+			UsedMemoryPercent: 99.61},
+	}
+	assert.Equal(t, len(expected), len(res))
+	for name, dm := range expected {
+		assertDomainMetric(t, name, dm, res[name])
+	}
+
+	_, err = parseXentop(strings.NewReader("xentop: error\n"))
+	assert.NotNil(t, err)
+}
+
+func TestParseXlInfo(t *testing.T) {
+	f, err := os.Open("testdata/xlinfo.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	host, err := parseXlInfo(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(4), host.Ncpus)
+	assert.Equal(t, uint64(8191), host.TotalMemory)
+	assert.Equal(t, uint64(5120), host.FreeMemory)
+	assert.InDelta(t, 37.49, host.UsedPercent, 0.01)
+	assert.Equal(t, xenServicesName, host.ServicesName)
+
+	_, err = parseXlInfo(strings.NewReader("nr_cpus : 4\n"))
+	assert.NotNil(t, err)
+}
+
+func TestXentopFields(t *testing.T) {
+	testMatrix := map[string]struct {
+		line     string
+		expected []string
+	}{
+		"No limit": {
+			line:     "  Domain-0 -----r 10 0.0 1024 1.0 no limit n/a",
+			expected: []string{"Domain-0", "-----r", "10", "0.0", "1024", "1.0", "no limit", "n/a"},
+		},
+		"Limit": {
+			line:     "ubuntu.1 --b--- 10 0.0 1024 1.0 2048 2.0",
+			expected: []string{"ubuntu.1", "--b---", "10", "0.0", "1024", "1.0", "2048", "2.0"},
+		},
+		"Empty": {
+			line: "   ",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, xentopFields(test.line))
+	}
+}
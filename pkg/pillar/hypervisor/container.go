@@ -0,0 +1,366 @@
+// Copyright (c) 2018-2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Container backend using rkt with a stage1 which runs the pod as a
+// domain of the underlying VM backend
+
+package hypervisor
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/wrap"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	persistRktDataDir = "/persist/rkt"
+	rktUUIDFile       = persistRktDataDir + "/uuid_file"
+	stage1XenPath     = "/usr/sbin/stage1-xen.aci"
+	stage1KvmPath     = "/usr/sbin/stage1-kvm.aci"
+	// Where we save the stdout and stderr of pods in the foreground
+	rktLogDirname = "/var/log/rkt"
+	// The file is copied to .1 and truncated when it reaches this size
+	rktLogMaxSize = 1024 * 1024
+	// How long we wait for a backgrounded rkt run to report the pod
+	rktRunTimeout = 60 * time.Second
+)
+
+type containerHypervisor struct {
+	vm Hypervisor
+}
+
+func newContainer(vm Hypervisor) Hypervisor {
+	return containerHypervisor{vm: vm}
+}
+
+func (ctx containerHypervisor) Name() string {
+	return ContainerName
+}
+
+// With stage1-xen the xen cfg is used as a seed for the pod domain.
+// For other backends the file is only informational.
+func (ctx containerHypervisor) CreateDomConfig(config types.DomainConfig,
+	status types.DomainStatus, aa *types.AssignableAdapters,
+	file *os.File) error {
+
+	return ctx.vm.CreateDomConfig(config, status, aa, file)
+}
+
+func (ctx containerHypervisor) Create(status *types.DomainStatus,
+	cfgFilename string) (int, error) {
+
+	domainID, podUUID, err := rktRun(ctx.vm.Name(), status.DomainName,
+		status.ContainerImageID, cfgFilename)
+	if err != nil {
+		return 0, err
+	}
+	status.PodUUID = podUUID
+	return domainID, nil
+}
+
+// With stage1-xen the pod domain is created paused
+func (ctx containerHypervisor) Start(status types.DomainStatus) error {
+	if ctx.vm.Name() != XenName {
+		return nil
+	}
+	return ctx.vm.Start(status)
+}
+
+func (ctx containerHypervisor) Stop(status types.DomainStatus, force bool) error {
+	// rkt stop puts the container in exit state and kills the domain
+	// hence we do not invoke the VM backend
+	return rktStop(status.PodUUID, force)
+}
+
+func (ctx containerHypervisor) Destroy(status types.DomainStatus) error {
+	return rktRm(status.PodUUID)
+}
+
+func (ctx containerHypervisor) Info(status types.DomainStatus) (int, error) {
+	if ctx.vm.Name() == XenName {
+		return ctx.vm.Info(status)
+	}
+	return rktPid(status.PodUUID, status.DomainId)
+}
+
+func (ctx containerHypervisor) ConsoleInfo(status types.DomainStatus) (string, error) {
+	if ctx.vm.Name() == XenName {
+		return ctx.vm.ConsoleInfo(status)
+	}
+	return rktLogFile(status.DomainName), nil
+}
+
+func rktLogFile(domainName string) string {
+	return rktLogDirname + "/" + domainName + ".log"
+}
+
+// rotatingLog bounds the output of a pod like logrotate copytruncate
+// does for the guest consoles, but without waiting for the daily run.
+// The file is truncated in place since logmanager keeps it open.
+type rotatingLog struct {
+	sync.Mutex
+	filename string
+	maxSize  int64
+	file     *os.File
+	size     int64
+}
+
+// openRotatingLog starts with an empty file
+func openRotatingLog(filename string, maxSize int64) (*rotatingLog, error) {
+	file, err := os.OpenFile(filename,
+		os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &rotatingLog{filename: filename, maxSize: maxSize, file: file}, nil
+}
+
+func (l *rotatingLog) Write(b []byte) (int, error) {
+	l.Lock()
+	defer l.Unlock()
+	if l.size != 0 && l.size+int64(len(b)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			log.Errorf("rotatingLog %s: %s\n", l.filename, err)
+		}
+	}
+	n, err := l.file.Write(b)
+	l.size += int64(n)
+	return n, err
+}
+
+func (l *rotatingLog) rotate() error {
+	b, err := ioutil.ReadFile(l.filename)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(l.filename+".1", b, 0600); err != nil {
+		return err
+	}
+	if err := l.file.Truncate(0); err != nil {
+		return err
+	}
+	l.size = 0
+	return nil
+}
+
+func (l *rotatingLog) Close() error {
+	l.Lock()
+	defer l.Unlock()
+	return l.file.Close()
+}
+
+func (ctx containerHypervisor) PCIReserve(long string) error {
+	return ctx.vm.PCIReserve(long)
+}
+
+func (ctx containerHypervisor) PCIRelease(long string) error {
+	return ctx.vm.PCIRelease(long)
+}
+
+// Launch app/container thru rkt
+// returns domainID, podUUID and error
+func rktRun(vmName string, domainName string, ContainerImageID string,
+	cfgFilename string) (int, string, error) {
+
+	// STAGE1_XL_OPTS=-p STAGE1_SEED_XL_CFG=xenCfgFilename rkt --dir=<RKT_DATA_DIR> --insecure-options=image run <SHA> --stage1-path=/usr/sbin/stage1-xen.aci --uuid-file-save=uuid_file
+	log.Infof("rktRun %s - ContainerImageID %s\n", domainName, ContainerImageID)
+	stage1Path := stage1XenPath
+	if vmName != XenName {
+		stage1Path = stage1KvmPath
+	}
+	cmd := "rkt"
+	args := []string{
+		"--dir=" + persistRktDataDir,
+		"--insecure-options=image",
+		"run",
+		ContainerImageID,
+		"--stage1-path=" + stage1Path,
+		"--uuid-file-save=" + rktUUIDFile,
+	}
+	log.Infof("Calling command %s %v\n", cmd, args)
+	cmdLine := exec.Command(cmd, args...)
+	if vmName == XenName {
+		stage1XlOpts := "STAGE1_XL_OPTS=-p"
+		stage1XlCfg := "STAGE1_SEED_XL_CFG=" + cfgFilename
+		log.Infof("Also setting env vars %s %s\n", stage1XlOpts, stage1XlCfg)
+		cmdLine.Env = append(os.Environ(), stage1XlOpts, stage1XlCfg)
+		stdoutStderr, err := cmdLine.CombinedOutput()
+		if err != nil {
+			log.Errorln("rkt run failed ", err)
+			log.Errorln("rkt run output ", string(stdoutStderr))
+			return 0, "", fmt.Errorf("rkt run failed: %s\n",
+				string(stdoutStderr))
+		}
+	} else {
+		// Other stage1s run the pod in the foreground. We save its
+		// output for logmanager; each run starts with an empty file.
+		os.Remove(rktUUIDFile)
+		if err := os.MkdirAll(rktLogDirname, 0700); err != nil {
+			return 0, "", err
+		}
+		logf, err := openRotatingLog(rktLogFile(domainName),
+			rktLogMaxSize)
+		if err != nil {
+			return 0, "", err
+		}
+		cmdLine.Stdout = logf
+		cmdLine.Stderr = logf
+		if err := cmdLine.Start(); err != nil {
+			logf.Close()
+			log.Errorln("rkt run failed ", err)
+			return 0, "", fmt.Errorf("rkt run failed: %s\n", err)
+		}
+		go func() {
+			cmdLine.Wait()
+			logf.Close()
+		}()
+		if !waitForFile(rktUUIDFile, rktRunTimeout) {
+			return 0, "", fmt.Errorf("rkt run did not save %s\n",
+				rktUUIDFile)
+		}
+	}
+	log.Infof("rkt run done\n")
+
+	// Get Pod UUID
+	uuidData, err := ioutil.ReadFile(rktUUIDFile)
+	if err != nil {
+		log.Errorf("Open %s failed : %s\n", rktUUIDFile, err)
+		return 0, "", fmt.Errorf("open %s failed: %s\n", rktUUIDFile, err)
+	}
+	podUUID := strings.TrimSpace(string(uuidData))
+	log.Infof("podUUID = %s\n", podUUID)
+
+	if vmName != XenName {
+		domainID, err := rktPid(podUUID, 0)
+		return domainID, podUUID, err
+	}
+
+	// Obtain the domain id
+	cmd = "xl"
+	args = []string{
+		"domid",
+		domainName,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl domid failed ", err)
+		log.Errorln("xl domid output ", string(stdoutStderr))
+		return 0, "", fmt.Errorf("xl domid failed: %s\n",
+			string(stdoutStderr))
+	}
+	res := strings.TrimSpace(string(stdoutStderr))
+	domainID, err := strconv.Atoi(res)
+	if err != nil {
+		log.Errorf("Can't extract domainID from %s: %s\n", res, err)
+		return 0, "", fmt.Errorf("Can't extract domainID from %s: %s\n", res, err)
+	}
+	return domainID, podUUID, nil
+}
+
+// rktPid uses the pid of the pod as the domain ID for non-xen stage1s
+func rktPid(podUUID string, domainID int) (int, error) {
+	log.Debugf("rktPid %s %d\n", podUUID, domainID)
+	cmd := "rkt"
+	args := []string{
+		"--dir=" + persistRktDataDir,
+		"status",
+		podUUID,
+	}
+	// Avoid wrap since we are called periodically
+	stdoutStderr, err := exec.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Debugln("rkt status failed ", err)
+		log.Debugln("rkt status output ", string(stdoutStderr))
+		return domainID, fmt.Errorf("rkt status failed: %s\n",
+			string(stdoutStderr))
+	}
+	scanner := bufio.NewScanner(strings.NewReader(string(stdoutStderr)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "pid=") {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimPrefix(line, "pid="))
+		if err != nil {
+			return domainID, err
+		}
+		if pid <= 0 {
+			break
+		}
+		return pid, nil
+	}
+	return domainID, fmt.Errorf("rkt status: pod %s not running", podUUID)
+}
+
+func rktStop(PodUUID string, force bool) error {
+	log.Infof("rktStop %s %t\n", PodUUID, force)
+	cmd := "rkt"
+	var args []string
+	if force {
+		// rkt --dir=<RKT_DATA_DIR> stop PodUUID --force=true
+		args = []string{
+			"--dir=" + persistRktDataDir,
+			"stop",
+			PodUUID,
+			"--force=true",
+		}
+	} else {
+		// rkt --dir=<RKT_DATA_DIR> stop PodUUID
+		args = []string{
+			"--dir=" + persistRktDataDir,
+			"stop",
+			PodUUID,
+		}
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("rkt stop failed ", err)
+		log.Errorln("rkt stop output ", string(stdoutStderr))
+		return fmt.Errorf("rkt stop failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("rkt stop done\n")
+	return nil
+}
+
+func rktRm(PodUUID string) error {
+	log.Infof("rktRm %s\n", PodUUID)
+	// rkt --dir=<RKT_DATA_DIR> rm PodUUID
+	cmd := "rkt"
+	args := []string{
+		"--dir=" + persistRktDataDir,
+		"rm",
+		PodUUID,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("rkt Rm failed ", err)
+		log.Errorln("rkt Rm output ", string(stdoutStderr))
+		return fmt.Errorf("rkt Rm failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("rkt Rm done\n")
+	return nil
+}
+
+// waitForFile polls until filename exists or the timeout expires
+func waitForFile(filename string, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if _, err := os.Stat(filename); err == nil {
+			return true
+		}
+		time.Sleep(time.Second)
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingLog(t *testing.T) {
+	testMatrix := map[string]struct {
+		previous         string
+		writes           []string
+		expectedCurrent  string
+		expectedRotated  string
+		expectNoRotation bool
+	}{
+		"Starts empty": {
+			previous:         "old run\n",
+			writes:           []string{"a\n"},
+			expectedCurrent:  "a\n",
+			expectNoRotation: true,
+		},
+		"Fits": {
+			writes:           []string{"0123\n", "4567\n"},
+			expectedCurrent:  "0123\n4567\n",
+			expectNoRotation: true,
+		},
+		"Rotated": {
+			writes:          []string{"0123\n", "4567\n", "89\n"},
+			expectedCurrent: "89\n",
+			expectedRotated: "0123\n4567\n",
+		},
+		"Rotated twice": {
+			writes: []string{"0123\n", "4567\n", "89ab\n", "cdef\n",
+				"g\n"},
+			expectedCurrent: "g\n",
+			expectedRotated: "89ab\ncdef\n",
+		},
+		"Larger than the maximum": {
+			writes:          []string{"a\n", strings.Repeat("b", 20) + "\n"},
+			expectedCurrent: strings.Repeat("b", 20) + "\n",
+			expectedRotated: "a\n",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dir, err := ioutil.TempDir("", "rktlog")
+		if !assert.NoError(t, err) {
+			continue
+		}
+		filename := filepath.Join(dir, "app.log")
+		if test.previous != "" {
+			assert.NoError(t, ioutil.WriteFile(filename,
+				[]byte(test.previous), 0600))
+		}
+		l, err := openRotatingLog(filename, 10)
+		if !assert.NoError(t, err) {
+			os.RemoveAll(dir)
+			continue
+		}
+		// Like logmanager which keeps the file open
+		reader, err := os.Open(filename)
+		assert.NoError(t, err)
+		for _, w := range test.writes {
+			n, err := l.Write([]byte(w))
+			assert.NoError(t, err)
+			assert.Equal(t, len(w), n)
+		}
+		assert.NoError(t, l.Close())
+
+		b, err := ioutil.ReadFile(filename)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedCurrent, string(b))
+		fi, err := reader.Stat()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(test.expectedCurrent)), fi.Size())
+		reader.Close()
+
+		b, err = ioutil.ReadFile(filename + ".1")
+		if test.expectNoRotation {
+			assert.True(t, os.IsNotExist(err))
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedRotated, string(b))
+		}
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Abstraction of the hypervisor used by domainmgr to run the domUs.
+// The backend is selected once at boot; containers run on top of it
+// using rkt with a stage1 matching the backend.
+
+package hypervisor
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// Names of the supported backends; reported in DomainStatus.Hypervisor
+const (
+	XenName       = "xen"
+	KvmName       = "kvm"
+	ContainerName = "rkt"
+)
+
+// Hypervisor is the set of operations domainmgr needs to run a domU
+type Hypervisor interface {
+	// Name of the backend
+	Name() string
+
+	// CreateDomConfig produces the backend specific configuration file
+	// based on the DomainConfig and the DiskStatusList in the status
+	CreateDomConfig(config types.DomainConfig, status types.DomainStatus,
+		aa *types.AssignableAdapters, file *os.File) error
+
+	// Create the domain in a paused state and return the domain ID.
+	// Backends can record their own state in the status e.g., PodUUID
+	Create(status *types.DomainStatus, cfgFilename string) (int, error)
+
+	// Start (unpause) a domain created by Create
+	Start(status types.DomainStatus) error
+
+	// Stop asks the guest to shut down; force is a harder request
+	Stop(status types.DomainStatus, force bool) error
+
+	// Destroy the domain without involving the guest
+	Destroy(status types.DomainStatus) error
+
+	// Info returns the current domain ID which can change if the guest
+	// reboots; returns an error if the domain does not exist
+	Info(status types.DomainStatus) (int, error)
+
+	// ConsoleInfo returns the file where the guest console or the
+	// container output is logged
+	ConsoleInfo(status types.DomainStatus) (string, error)
+
+	// PCIReserve makes a PCI device available for assignment to domUs
+	PCIReserve(long string) error
+
+	// PCIRelease gives a PCI device back to dom0/the host
+	PCIRelease(long string) error
+}
+
+var knownHypervisors = map[string]func() Hypervisor{
+	XenName: newXen,
+	KvmName: newKvm,
+}
+
+// GetHypervisor returns the backend with the given name.
+// An empty name means auto-detect based on what the host provides.
+func GetHypervisor(name string) (Hypervisor, error) {
+	if name == "" {
+		name = BootTimeHypervisor()
+	}
+	create, ok := knownHypervisors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown hypervisor %s", name)
+	}
+	log.Infof("GetHypervisor using %s\n", name)
+	return create(), nil
+}
+
+// GetContainerHypervisor returns a backend for running containers on
+// top of the VM backend
+func GetContainerHypervisor(vm Hypervisor) Hypervisor {
+	return newContainer(vm)
+}
+
+// QemuDriveID returns the id of the qemu drive for the disk at index i of
+// the DiskStatusList of a domain run by the named backend, or "" if the
+// disk has no qemu drive. It is what query-blockstats reports.
+func QemuDriveID(name string, i int, ds types.DiskStatus) string {
+	switch name {
+	case XenName:
+		return xenDriveID(ds)
+	case KvmName:
+		return kvmDriveID(i)
+	default:
+		return ""
+	}
+}
+
+// BootTimeHypervisor determines which backend the host was booted with.
+// Xen is preferred when we are running in dom0.
+func BootTimeHypervisor() string {
+	if _, err := os.Stat("/proc/xen"); err == nil {
+		return XenName
+	}
+	if _, err := os.Stat("/dev/kvm"); err == nil {
+		return KvmName
+	}
+	log.Warnf("BootTimeHypervisor: neither xen nor kvm found; assuming %s\n",
+		XenName)
+	return XenName
+}
+
+type typeAndPCI struct {
+	pciLong string
+	ioType  types.IoType
+}
+
+func addNoDuplicatePCI(list []typeAndPCI, tap typeAndPCI) []typeAndPCI {
+
+	for _, t := range list {
+		if t.pciLong == tap.pciLong {
+			return list
+		}
+	}
+	return append(list, tap)
+}
+
+func addNoDuplicate(list []string, add string) []string {
+
+	for _, s := range list {
+		if s == add {
+			return list
+		}
+	}
+	return append(list, add)
+}
+
+// lookupPCIAssignments returns the PCI devices assigned to the domain
+// based on the reservations in the AssignableAdapters
+func lookupPCIAssignments(config types.DomainConfig, status types.DomainStatus,
+	aa *types.AssignableAdapters) []typeAndPCI {
+
+	var pciAssignments []typeAndPCI
+	for _, adapter := range config.IoAdapterList {
+		list := aa.LookupIoBundleGroup(adapter.Type, adapter.Name)
+		// We reserved it in handleCreate so nobody could have stolen it
+		if len(list) == 0 {
+			log.Fatalf("lookupPCIAssignments IoBundle disappeared %d %s for %s\n",
+				adapter.Type, adapter.Name, status.DomainName)
+		}
+		for _, ib := range list {
+			if ib == nil {
+				continue
+			}
+			if ib.UsedByUUID != config.UUIDandVersion.UUID {
+				log.Fatalf("lookupPCIAssignments IoBundle not ours %s: %d %s for %s\n",
+					ib.UsedByUUID, adapter.Type, adapter.Name,
+					status.DomainName)
+			}
+			if ib.PciLong != "" {
+				tap := typeAndPCI{pciLong: ib.PciLong, ioType: ib.Type}
+				pciAssignments = addNoDuplicatePCI(pciAssignments, tap)
+			}
+		}
+	}
+	return pciAssignments
+}
+
+// Go from kbytes to mbytes
+func kbyte2mbyte(kbyte int) int {
+	return (kbyte + 1023) / 1024
+}
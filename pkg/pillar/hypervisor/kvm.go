@@ -0,0 +1,396 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// KVM backend using qemu. The generated config file contains the qemu
+// arguments, one per line, and the running qemu is controlled over QMP.
+
+package hypervisor
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/eriknordmark/netlink"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/wrap"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	kvmStateDir       = "/var/run/hypervisor/kvm"
+	kvmConsoleDirname = "/var/log/kvm"
+	sysfsPciDevices   = "/sys/bus/pci/devices"
+	sysfsPciProbe     = "/sys/bus/pci/drivers_probe"
+	vfioDriver        = "vfio-pci"
+)
+
+type kvmHypervisor struct {
+}
+
+func newKvm() Hypervisor {
+	return kvmHypervisor{}
+}
+
+func (ctx kvmHypervisor) Name() string {
+	return KvmName
+}
+
+func kvmPidFile(domainName string) string {
+	return kvmStateDir + "/" + domainName + ".pid"
+}
+
+// KvmQmpSocket is where the qemu of the domain listens for QMP
+func KvmQmpSocket(domainName string) string {
+	return kvmStateDir + "/" + domainName + ".qmp"
+}
+
+// kvmDriveID is the qemu id of the disk at index i of the DiskStatusList
+func kvmDriveID(i int) string {
+	return fmt.Sprintf("drive%d", i)
+}
+
+// KvmGuestAgentSocket is the virtio-serial channel to the qemu guest
+// agent in the domain, if it runs one
+func KvmGuestAgentSocket(domainName string) string {
+	return kvmStateDir + "/" + domainName + ".qga"
+}
+
+func kvmConsoleFile(domainName string) string {
+	return kvmConsoleDirname + "/guest-" + domainName + ".log"
+}
+
+func qemuBinary() string {
+	switch runtime.GOARCH {
+	case "arm64":
+		return "qemu-system-aarch64"
+	default:
+		return "qemu-system-x86_64"
+	}
+}
+
+func (ctx kvmHypervisor) CreateDomConfig(config types.DomainConfig,
+	status types.DomainStatus, aa *types.AssignableAdapters,
+	file *os.File) error {
+
+	args, err := configToQemuArgs(config, status, aa)
+	if err != nil {
+		return err
+	}
+	file.WriteString("# This file is automatically generated by domainmgr\n")
+	file.WriteString("# One qemu argument per line\n")
+	for _, arg := range args {
+		file.WriteString(arg + "\n")
+	}
+	return nil
+}
+
+// Produce the qemu arguments based on the config and status
+func configToQemuArgs(config types.DomainConfig, status types.DomainStatus,
+	aa *types.AssignableAdapters) ([]string, error) {
+
+	domainName := status.DomainName
+	args := []string{
+		"-name", domainName,
+		"-uuid", config.UUIDandVersion.UUID.String(),
+		"-enable-kvm",
+		"-cpu", "host",
+		"-nodefaults",
+		"-no-user-config",
+		"-pidfile", kvmPidFile(domainName),
+		"-qmp", fmt.Sprintf("unix:%s,server,nowait",
+			KvmQmpSocket(domainName)),
+		"-serial", "file:" + kvmConsoleFile(domainName),
+		"-chardev", fmt.Sprintf("socket,id=qga0,path=%s,server,nowait",
+			KvmGuestAgentSocket(domainName)),
+		"-device", "virtio-serial",
+		"-device", "virtserialport,chardev=qga0,name=org.qemu.guest_agent.0",
+	}
+	console := "ttyS0"
+	if runtime.GOARCH == "arm64" {
+		args = append(args, "-machine", "virt,gic-version=host")
+		console = "ttyAMA0"
+	} else {
+		args = append(args, "-machine", "q35")
+	}
+
+	memory := fmt.Sprintf("%d", kbyte2mbyte(config.Memory))
+	if config.MaxMem != 0 && config.MaxMem > config.Memory {
+		memory = fmt.Sprintf("size=%dM,slots=1,maxmem=%dM",
+			kbyte2mbyte(config.Memory), kbyte2mbyte(config.MaxMem))
+	}
+	args = append(args, "-m", memory)
+	vCpus := config.VCpus
+	if vCpus == 0 {
+		vCpus = 1
+	}
+	maxCpus := config.MaxCpus
+	if maxCpus == 0 {
+		maxCpus = vCpus
+	}
+	args = append(args, "-smp", fmt.Sprintf("%d,maxcpus=%d", vCpus, maxCpus))
+	if config.CPUs != "" {
+		log.Warnf("configToQemuArgs(%s): ignoring CPU pinning %s\n",
+			domainName, config.CPUs)
+	}
+	if config.DeviceTree != "" || len(config.DtDev) != 0 ||
+		len(config.IRQs) != 0 || len(config.IOMem) != 0 {
+		return nil, fmt.Errorf("device tree, irq and iomem passthru not supported by %s",
+			KvmName)
+	}
+
+	switch config.VirtualizationMode {
+	case types.PV:
+		if config.Kernel != "" {
+			rootDev := config.RootDev
+			if rootDev == "" {
+				rootDev = "/dev/vda1"
+			}
+			args = append(args, "-kernel", config.Kernel)
+			if config.Ramdisk != "" {
+				args = append(args, "-initrd", config.Ramdisk)
+			}
+			args = append(args, "-append",
+				fmt.Sprintf("console=%s root=%s appuuid=%s %s",
+					console, rootDev, config.UUIDandVersion.UUID,
+					config.ExtraArgs))
+		} else if config.BootLoader != "" {
+			// The firmware will boot from the disk
+			log.Warnf("configToQemuArgs(%s): ignoring bootloader %s\n",
+				domainName, config.BootLoader)
+		}
+	case types.HVM:
+		// Always prefer CDROM vdisk over disk
+		args = append(args, "-boot", "order=dc")
+	}
+
+	if config.EnableVnc {
+		vnc := fmt.Sprintf("0.0.0.0:%d", config.VncDisplay)
+		if config.VncPasswd != "" {
+			// The password is set using QMP in Start
+			vnc += ",password"
+		}
+		args = append(args, "-vnc", vnc, "-vga", "std",
+			"-usb", "-device", "usb-tablet")
+	} else {
+		args = append(args, "-display", "none")
+	}
+
+	for i, ds := range status.DiskStatusList {
+		drive := fmt.Sprintf("file=%s,format=%s,id=%s",
+			ds.ActiveFileLocation, ds.Format, kvmDriveID(i))
+		if ds.Devtype == "cdrom" {
+			drive += ",media=cdrom,if=ide"
+		} else {
+			drive += ",if=virtio"
+		}
+		if ds.ReadOnly {
+			drive += ",readonly=on"
+		}
+		log.Debugf("Processing disk %d: %s\n", i, drive)
+		args = append(args, "-drive", drive)
+	}
+
+	for i, net := range config.VifList {
+		args = append(args,
+			"-netdev", fmt.Sprintf("tap,id=net%d,ifname=%s,script=no,downscript=no",
+				i, net.Vif),
+			"-device", fmt.Sprintf("virtio-net-pci,netdev=net%d,mac=%s",
+				i, net.Mac))
+	}
+
+	for _, pa := range lookupPCIAssignments(config, status, aa) {
+		args = append(args, "-device", "vfio-pci,host="+
+			types.PCILongToShort(pa.pciLong))
+	}
+	return args, nil
+}
+
+// Create starts qemu in a paused state. The pid is used as the domain ID.
+func (ctx kvmHypervisor) Create(status *types.DomainStatus,
+	cfgFilename string) (int, error) {
+
+	log.Infof("kvmCreate %s %s\n", status.DomainName, cfgFilename)
+	for _, dir := range []string{kvmStateDir, kvmConsoleDirname} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return 0, err
+		}
+	}
+	args, err := readQemuArgs(cfgFilename)
+	if err != nil {
+		return 0, err
+	}
+	args = append(args, "-S", "-daemonize")
+	cmd := qemuBinary()
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("qemu failed ", err)
+		log.Errorln("qemu output ", string(stdoutStderr))
+		return 0, fmt.Errorf("qemu failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("qemu done\n")
+	domainID, err := kvmPid(status.DomainName, 0)
+	if err != nil {
+		return 0, err
+	}
+	// The tap devices exist once qemu has daemonized
+	for _, net := range status.VifList {
+		if err := attachToBridge(net.Vif, net.Bridge); err != nil {
+			log.Errorf("kvmCreate(%s) %s\n", status.DomainName, err)
+			return domainID, err
+		}
+	}
+	return domainID, nil
+}
+
+func readQemuArgs(cfgFilename string) ([]string, error) {
+	f, err := os.Open(cfgFilename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var args []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		args = append(args, line)
+	}
+	return args, scanner.Err()
+}
+
+func attachToBridge(ifname string, bridgeName string) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return fmt.Errorf("attachToBridge can't find %s: %s",
+			ifname, err)
+	}
+	bridge, err := netlink.LinkByName(bridgeName)
+	if err != nil {
+		return fmt.Errorf("attachToBridge can't find bridge %s: %s",
+			bridgeName, err)
+	}
+	if err := netlink.LinkSetMasterByIndex(link, bridge.Attrs().Index); err != nil {
+		return fmt.Errorf("attachToBridge %s to %s failed: %s",
+			ifname, bridgeName, err)
+	}
+	return netlink.LinkSetUp(link)
+}
+
+func (ctx kvmHypervisor) Start(status types.DomainStatus) error {
+	socket := KvmQmpSocket(status.DomainName)
+	if status.EnableVnc && status.VncPasswd != "" {
+		err := qmpExec(socket, "change-vnc-password",
+			map[string]interface{}{"password": status.VncPasswd})
+		if err != nil {
+			log.Errorf("kvmStart(%s) set vnc password: %s\n",
+				status.DomainName, err)
+		}
+	}
+	return qmpExec(socket, "cont", nil)
+}
+
+// There is no PV shutdown hence force does the same ACPI power down
+func (ctx kvmHypervisor) Stop(status types.DomainStatus, force bool) error {
+	return qmpExec(KvmQmpSocket(status.DomainName), "system_powerdown", nil)
+}
+
+func (ctx kvmHypervisor) Destroy(status types.DomainStatus) error {
+	err := qmpExec(KvmQmpSocket(status.DomainName), "quit", nil)
+	if err == nil {
+		return nil
+	}
+	log.Warnf("kvmDestroy(%s) quit failed %s; killing\n",
+		status.DomainName, err)
+	pid, err := kvmPid(status.DomainName, status.DomainId)
+	if err != nil {
+		// Already gone
+		return nil
+	}
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
+
+func (ctx kvmHypervisor) Info(status types.DomainStatus) (int, error) {
+	return kvmPid(status.DomainName, status.DomainId)
+}
+
+func (ctx kvmHypervisor) ConsoleInfo(status types.DomainStatus) (string, error) {
+	return kvmConsoleFile(status.DomainName), nil
+}
+
+// kvmPid returns the pid of the qemu process if it is running
+func kvmPid(domainName string, domainID int) (int, error) {
+	pidFile := kvmPidFile(domainName)
+	contents, err := ioutil.ReadFile(pidFile)
+	if err != nil {
+		return domainID, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return domainID, fmt.Errorf("bad pid in %s: %s", pidFile, err)
+	}
+	if err := syscall.Kill(pid, 0); err != nil {
+		return domainID, fmt.Errorf("qemu %d for %s gone: %s",
+			pid, domainName, err)
+	}
+	if pid != domainID {
+		log.Debugf("kvmPid changed from %d to %d for %s\n",
+			domainID, pid, domainName)
+	}
+	return pid, nil
+}
+
+// PCIReserve binds the device to vfio-pci
+func (ctx kvmHypervisor) PCIReserve(long string) error {
+	log.Infof("PCIReserve %s\n", long)
+	return pciBindDriver(long, vfioDriver)
+}
+
+// PCIRelease lets the kernel pick the host driver again
+func (ctx kvmHypervisor) PCIRelease(long string) error {
+	log.Infof("PCIRelease %s\n", long)
+	return pciBindDriver(long, "")
+}
+
+func pciBindDriver(long string, driver string) error {
+	devPath := sysfsPciDevices + "/" + long
+	if _, err := os.Stat(devPath); err != nil {
+		return fmt.Errorf("pciBindDriver %s: %s", long, err)
+	}
+	override := driver
+	if override == "" {
+		override = "\n"
+	}
+	if err := ioutil.WriteFile(devPath+"/driver_override",
+		[]byte(override), 0644); err != nil {
+		errStr := fmt.Sprintf("pciBindDriver %s driver_override failed: %s",
+			long, err)
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	if _, err := os.Stat(devPath + "/driver"); err == nil {
+		if err := ioutil.WriteFile(devPath+"/driver/unbind",
+			[]byte(long), 0644); err != nil {
+			errStr := fmt.Sprintf("pciBindDriver %s unbind failed: %s",
+				long, err)
+			log.Errorln(errStr)
+			return errors.New(errStr)
+		}
+	}
+	if err := ioutil.WriteFile(sysfsPciProbe, []byte(long), 0644); err != nil {
+		errStr := fmt.Sprintf("pciBindDriver %s probe failed: %s",
+			long, err)
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigToQemuArgs(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("expected arguments are for amd64")
+	}
+	appUUID := uuid.FromStringOrNil("4a144db0-6b63-405a-b884-7760042023b1")
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: appUUID},
+		VmConfig: types.VmConfig{
+			Memory:             500 * 1024,
+			VCpus:              2,
+			VirtualizationMode: types.HVM,
+			EnableVnc:          true,
+			VncDisplay:         1,
+		},
+		VifList: []types.VifInfo{
+			{Bridge: "bn2", Vif: "nbu1x1", Mac: "00:16:3e:00:01:01"},
+		},
+	}
+	status := types.DomainStatus{
+		DomainName: "vyos-app.1",
+		DiskStatusList: []types.DiskStatus{
+			{ActiveFileLocation: "/persist/img/disk.qcow2",
+				Format: "qcow2"},
+			{ActiveFileLocation: "/persist/img/ro.raw",
+				Format: "raw", ReadOnly: true},
+		},
+	}
+	aa := types.AssignableAdapters{}
+	args, err := configToQemuArgs(config, status, &aa)
+	assert.Nil(t, err)
+	joined := strings.Join(args, " ")
+	expected := []string{
+		"-name vyos-app.1",
+		"-uuid 4a144db0-6b63-405a-b884-7760042023b1",
+		"-m 500",
+		"-smp 2,maxcpus=2",
+		"-boot order=dc",
+		"-vnc 0.0.0.0:1",
+		"-drive file=/persist/img/disk.qcow2,format=qcow2,id=drive0,if=virtio",
+		"-drive file=/persist/img/ro.raw,format=raw,id=drive1,if=virtio,readonly=on",
+		"-netdev tap,id=net0,ifname=nbu1x1,script=no,downscript=no",
+		"-device virtio-net-pci,netdev=net0,mac=00:16:3e:00:01:01",
+		"-chardev socket,id=qga0,path=/var/run/hypervisor/kvm/vyos-app.1.qga,server,nowait",
+		"-device virtserialport,chardev=qga0,name=org.qemu.guest_agent.0",
+	}
+	for _, e := range expected {
+		assert.Contains(t, joined, e)
+	}
+	assert.NotContains(t, joined, "-display none")
+
+	config.IRQs = []int{88}
+	_, err = configToQemuArgs(config, status, &aa)
+	assert.NotNil(t, err)
+}
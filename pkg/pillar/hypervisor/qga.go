@@ -0,0 +1,57 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Minimal qemu guest agent client. Unlike QMP there is no greeting nor
+// capabilities negotiation, but a previous client which timed out may have
+// left a response in the channel hence we use guest-sync with a fresh id.
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// GuestAgentPing returns nil if the guest agent answered within the timeout
+func GuestAgentPing(socket string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("unix", socket, timeout)
+	if err != nil {
+		return fmt.Errorf("guest agent connect %s failed: %s", socket, err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+	id := time.Now().UnixNano() & 0x7fffffff
+	return guestAgentSync(conn, conn, id)
+}
+
+// guestAgentSync sends guest-sync and skips responses until the one
+// with our id
+func guestAgentSync(r io.Reader, w io.Writer, id int64) error {
+	cmd := qmpCommand{Execute: "guest-sync",
+		Arguments: map[string]interface{}{"id": id}}
+	if err := json.NewEncoder(w).Encode(cmd); err != nil {
+		return fmt.Errorf("guest-sync send failed: %s", err)
+	}
+	dec := json.NewDecoder(r)
+	for {
+		var resp qmpResponse
+		if err := dec.Decode(&resp); err != nil {
+			return fmt.Errorf("guest-sync receive failed: %s", err)
+		}
+		if resp.Error != nil {
+			return fmt.Errorf("guest-sync failed: %s: %s",
+				resp.Error.Class, resp.Error.Desc)
+		}
+		var ret int64
+		if err := json.Unmarshal(resp.Return, &ret); err == nil && ret == id {
+			return nil
+		}
+		log.Debugf("guest-sync skipping stale response %s\n",
+			string(resp.Return))
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuestAgentSync(t *testing.T) {
+	testMatrix := map[string]struct {
+		responses  string
+		expectFail bool
+	}{
+		"Matching id": {
+			responses: `{"return": 42}`,
+		},
+		"Stale response first": {
+			responses: `{"return": 7}
+{"return": 42}`,
+		},
+		"Error": {
+			responses:  `{"error": {"class": "GenericError", "desc": "oops"}}`,
+			expectFail: true,
+		},
+		"No matching response": {
+			responses:  `{"return": 7}`,
+			expectFail: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var sent bytes.Buffer
+		err := guestAgentSync(strings.NewReader(test.responses), &sent, 42)
+		assert.Contains(t, sent.String(), `"guest-sync"`)
+		if test.expectFail {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
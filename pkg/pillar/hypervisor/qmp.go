@@ -0,0 +1,153 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Minimal QEMU Machine Protocol client
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const qmpTimeout = 10 * time.Second
+
+type qmpCommand struct {
+	Execute   string                 `json:"execute"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+}
+
+type qmpResponse struct {
+	Return json.RawMessage `json:"return"`
+	Event  string          `json:"event"`
+	Error  *struct {
+		Class string `json:"class"`
+		Desc  string `json:"desc"`
+	} `json:"error"`
+}
+
+// qmpExec connects to the socket, negotiates capabilities and runs
+// a single command
+func qmpExec(socket string, command string,
+	arguments map[string]interface{}) error {
+
+	log.Infof("qmpExec %s %s\n", socket, command)
+	_, err := qmpQuery(socket, command, arguments)
+	return err
+}
+
+// qmpQuery is qmpExec returning what the command returned
+func qmpQuery(socket string, command string,
+	arguments map[string]interface{}) (json.RawMessage, error) {
+
+	conn, err := net.DialTimeout("unix", socket, qmpTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("qmp connect %s failed: %s", socket, err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(qmpTimeout))
+
+	dec := json.NewDecoder(conn)
+	enc := json.NewEncoder(conn)
+	// Greeting
+	var greeting map[string]interface{}
+	if err := dec.Decode(&greeting); err != nil {
+		return nil, fmt.Errorf("qmp greeting failed: %s", err)
+	}
+	if _, err := qmpRun(dec, enc, qmpCommand{Execute: "qmp_capabilities"}); err != nil {
+		return nil, err
+	}
+	return qmpRun(dec, enc, qmpCommand{Execute: command,
+		Arguments: arguments})
+}
+
+func qmpRun(dec *json.Decoder, enc *json.Encoder,
+	cmd qmpCommand) (json.RawMessage, error) {
+
+	if err := enc.Encode(cmd); err != nil {
+		return nil, fmt.Errorf("qmp %s send failed: %s", cmd.Execute, err)
+	}
+	for {
+		var resp qmpResponse
+		if err := dec.Decode(&resp); err != nil {
+			return nil, fmt.Errorf("qmp %s receive failed: %s",
+				cmd.Execute, err)
+		}
+		if resp.Event != "" {
+			log.Debugf("qmp %s skipping event %s\n", cmd.Execute,
+				resp.Event)
+			continue
+		}
+		if resp.Error != nil {
+			return nil, fmt.Errorf("qmp %s failed: %s: %s", cmd.Execute,
+				resp.Error.Class, resp.Error.Desc)
+		}
+		return resp.Return, nil
+	}
+}
+
+// BlockStats are the I/O counters of one qemu drive since qemu started
+type BlockStats struct {
+	Device     string // Empty for drives added with -blockdev
+	NodeName   string
+	Qdev       string // Id or QOM path of the device using the drive
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+}
+
+// QmpBlockStats returns the counters of the drives of a running qemu,
+// including those qemu adds by default e.g., an empty floppy or cdrom
+func QmpBlockStats(socket string) ([]BlockStats, error) {
+	ret, err := qmpQuery(socket, "query-blockstats", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseBlockStats(ret)
+}
+
+// Matches is true if the drive has the id e.g., from QemuDriveID, as its
+// device name, node name or the id of the device using it
+func (st BlockStats) Matches(id string) bool {
+	if id == "" {
+		return false
+	}
+	return st.Device == id || st.NodeName == id || st.Qdev == id ||
+		strings.HasPrefix(st.Qdev, "/machine/peripheral/"+id+"/")
+}
+
+func parseBlockStats(ret json.RawMessage) ([]BlockStats, error) {
+	var devices []struct {
+		Device   string `json:"device"`
+		NodeName string `json:"node-name"`
+		Qdev     string `json:"qdev"`
+		Stats    struct {
+			ReadBytes  uint64 `json:"rd_bytes"`
+			WriteBytes uint64 `json:"wr_bytes"`
+			ReadOps    uint64 `json:"rd_operations"`
+			WriteOps   uint64 `json:"wr_operations"`
+		} `json:"stats"`
+	}
+	if err := json.Unmarshal(ret, &devices); err != nil {
+		return nil, fmt.Errorf("query-blockstats: %s", err)
+	}
+	var res []BlockStats
+	for _, d := range devices {
+		res = append(res, BlockStats{
+			Device:     d.Device,
+			NodeName:   d.NodeName,
+			Qdev:       d.Qdev,
+			ReadBytes:  d.Stats.ReadBytes,
+			WriteBytes: d.Stats.WriteBytes,
+			ReadOps:    d.Stats.ReadOps,
+			WriteOps:   d.Stats.WriteOps,
+		})
+	}
+	return res, nil
+}
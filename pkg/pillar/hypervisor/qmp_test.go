@@ -0,0 +1,153 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBlockStats(t *testing.T) {
+	testMatrix := map[string]struct {
+		ret        string
+		expected   []BlockStats
+		expectFail bool
+	}{
+		"Two drives": {
+			ret: `[{"device": "drive0", "stats": {"rd_bytes": 1024,
+				"wr_bytes": 2048, "rd_operations": 2, "wr_operations": 4,
+				"flush_operations": 1}},
+				{"device": "drive1", "stats": {"rd_bytes": 512,
+				"wr_bytes": 0, "rd_operations": 1, "wr_operations": 0}}]`,
+			expected: []BlockStats{
+				{Device: "drive0", ReadBytes: 1024, WriteBytes: 2048,
+					ReadOps: 2, WriteOps: 4},
+				{Device: "drive1", ReadBytes: 512, ReadOps: 1},
+			},
+		},
+		"Blockdev": {
+			ret: `[{"device": "", "node-name": "disk0",
+				"qdev": "/machine/peripheral/disk0/virtio-backend",
+				"stats": {"rd_bytes": 1, "wr_bytes": 2, "rd_operations": 3,
+				"wr_operations": 4}}]`,
+			expected: []BlockStats{
+				{NodeName: "disk0",
+					Qdev:      "/machine/peripheral/disk0/virtio-backend",
+					ReadBytes: 1, WriteBytes: 2, ReadOps: 3, WriteOps: 4},
+			},
+		},
+		"No drives": {
+			ret: `[]`,
+		},
+		"Not a list": {
+			ret:        `{}`,
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		res, err := parseBlockStats(json.RawMessage(test.ret))
+		if test.expectFail {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res)
+	}
+}
+
+func TestBlockStatsMatches(t *testing.T) {
+	testMatrix := map[string]struct {
+		stats    BlockStats
+		id       string
+		expected bool
+	}{
+		"Device": {
+			stats:    BlockStats{Device: "drive0"},
+			id:       "drive0",
+			expected: true,
+		},
+		"Other device": {
+			stats: BlockStats{Device: "drive1"},
+			id:    "drive0",
+		},
+		"Node name": {
+			stats:    BlockStats{NodeName: "drive0"},
+			id:       "drive0",
+			expected: true,
+		},
+		"Qdev id": {
+			stats:    BlockStats{Qdev: "drive0"},
+			id:       "drive0",
+			expected: true,
+		},
+		"Qdev path": {
+			stats:    BlockStats{Qdev: "/machine/peripheral/drive0/virtio-backend"},
+			id:       "drive0",
+			expected: true,
+		},
+		"Qdev path prefix": {
+			stats: BlockStats{Qdev: "/machine/peripheral/drive01/virtio-backend"},
+			id:    "drive0",
+		},
+		"No id": {
+			stats: BlockStats{},
+			id:    "",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected, test.stats.Matches(test.id))
+	}
+}
+
+func TestQemuDriveID(t *testing.T) {
+	testMatrix := map[string]struct {
+		hypervisor string
+		index      int
+		disk       types.DiskStatus
+		expected   string
+	}{
+		"KVM": {
+			hypervisor: KvmName,
+			index:      2,
+			disk:       types.DiskStatus{Vdev: "xvdc"},
+			expected:   "drive2",
+		},
+		"Xen first disk": {
+			hypervisor: XenName,
+			disk:       types.DiskStatus{Vdev: "xvda"},
+			expected:   "ide0-hd0",
+		},
+		"Xen cloud-init": {
+			hypervisor: XenName,
+			index:      1,
+			disk:       types.DiskStatus{Vdev: "hdc"},
+			expected:   "ide1-hd0",
+		},
+		"Xen cdrom": {
+			hypervisor: XenName,
+			index:      3,
+			disk:       types.DiskStatus{Vdev: "xvdd", Devtype: "cdrom"},
+			expected:   "ide1-cd1",
+		},
+		"Xen PV only": {
+			hypervisor: XenName,
+			index:      4,
+			disk:       types.DiskStatus{Vdev: "xvde"},
+		},
+		"Container": {
+			hypervisor: ContainerName,
+			disk:       types.DiskStatus{Vdev: "xvda"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.expected,
+			QemuDriveID(test.hypervisor, test.index, test.disk))
+	}
+}
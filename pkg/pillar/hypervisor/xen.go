@@ -0,0 +1,614 @@
+// Copyright (c) 2017-2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Xen backend using the xl command line tool
+
+package hypervisor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/wrap"
+	log "github.com/sirupsen/logrus"
+)
+
+// xenConsoleDirname is where xenconsoled logs the guest consoles
+const xenConsoleDirname = "/var/log/xen"
+
+// XenQmpSocket is where libxl has the qemu device model of the domain
+// listen for QMP. Only HVM domains and those with a qdisk backend have one.
+func XenQmpSocket(domainID int) string {
+	return fmt.Sprintf("/var/run/xen/qmp-libxl-%d", domainID)
+}
+
+// xenDriveID is the id qemu picks for the emulated IDE drive libxl adds
+// for the first four vdevs of HVM domains. The others only have a PV
+// backend, which has no drive id, hence we return "".
+func xenDriveID(ds types.DiskStatus) string {
+	vdev := strings.TrimPrefix(strings.TrimPrefix(ds.Vdev, "xvd"), "hd")
+	if len(vdev) != 1 || vdev[0] < 'a' || vdev[0] > 'd' {
+		return ""
+	}
+	index := int(vdev[0] - 'a')
+	media := "hd"
+	if ds.Devtype == "cdrom" {
+		media = "cd"
+	}
+	return fmt.Sprintf("ide%d-%s%d", index/2, media, index%2)
+}
+
+type xenHypervisor struct {
+}
+
+func newXen() Hypervisor {
+	return xenHypervisor{}
+}
+
+func (ctx xenHypervisor) Name() string {
+	return XenName
+}
+
+func (ctx xenHypervisor) CreateDomConfig(config types.DomainConfig,
+	status types.DomainStatus, aa *types.AssignableAdapters,
+	file *os.File) error {
+
+	return configToXencfg(config, status, aa, file)
+}
+
+func (ctx xenHypervisor) Create(status *types.DomainStatus,
+	cfgFilename string) (int, error) {
+
+	return xlCreate(status.DomainName, cfgFilename)
+}
+
+// Disable the vif offloads before letting the guest run
+func (ctx xenHypervisor) Start(status types.DomainStatus) error {
+
+	err := xlDisableVifOffload(status.DomainName, status.DomainId,
+		len(status.VifList))
+	if err != nil {
+		// XXX continuing even if we get a failure?
+		log.Errorf("xlDisableVifOffload for %s: %s\n",
+			status.DomainName, err)
+	}
+	if err := xlUnpause(status.DomainName, status.DomainId); err != nil {
+		return err
+	}
+	// XXX dumping status to log
+	xlStatus(status.DomainName, status.DomainId)
+	return nil
+}
+
+func (ctx xenHypervisor) Stop(status types.DomainStatus, force bool) error {
+	return xlShutdown(status.DomainName, status.DomainId, force)
+}
+
+func (ctx xenHypervisor) Destroy(status types.DomainStatus) error {
+	return xlDestroy(status.DomainName, status.DomainId)
+}
+
+func (ctx xenHypervisor) Info(status types.DomainStatus) (int, error) {
+	return xlDomid(status.DomainName, status.DomainId)
+}
+
+func (ctx xenHypervisor) ConsoleInfo(status types.DomainStatus) (string, error) {
+	return fmt.Sprintf("%s/guest-%s.log", xenConsoleDirname,
+		status.DomainName), nil
+}
+
+func (ctx xenHypervisor) PCIReserve(long string) error {
+	return pciAssignableAdd(long)
+}
+
+func (ctx xenHypervisor) PCIRelease(long string) error {
+	return pciAssignableRemove(long)
+}
+
+// Produce the xen cfg file based on the config and status created above
+// XXX or produce output to a string instead of file to make comparison
+// easier?
+func configToXencfg(config types.DomainConfig, status types.DomainStatus,
+	aa *types.AssignableAdapters, file *os.File) error {
+
+	xen_type := "pv"
+	rootDev := ""
+	extra := ""
+	bootLoader := ""
+	uuidStr := fmt.Sprintf("appuuid=%s ", config.UUIDandVersion.UUID)
+
+	switch config.VirtualizationMode {
+	case types.PV:
+		xen_type = "pv"
+		// Note that qcow2 images might have partitions hence xvda1 by default
+		rootDev = config.RootDev
+		if rootDev == "" {
+			rootDev = "/dev/xvda1"
+		}
+		extra = "console=hvc0 " + uuidStr + config.ExtraArgs
+		// XXX zedcloud should really set "pygrub"
+		bootLoader = config.BootLoader
+		if strings.HasSuffix(bootLoader, "pygrub") {
+			log.Warnf("Changing from %s to pygrub for %s\n",
+				bootLoader, config.Key())
+			bootLoader = "pygrub"
+		}
+	case types.HVM:
+		xen_type = "hvm"
+	}
+
+	file.WriteString("# This file is automatically generated by domainmgr\n")
+	file.WriteString(fmt.Sprintf("name = \"%s\"\n", status.DomainName))
+	file.WriteString(fmt.Sprintf("type = \"%s\"\n", xen_type))
+	file.WriteString(fmt.Sprintf("uuid = \"%s\"\n",
+		config.UUIDandVersion.UUID))
+
+	if config.Kernel != "" {
+		file.WriteString(fmt.Sprintf("kernel = \"%s\"\n",
+			config.Kernel))
+	}
+
+	if config.Ramdisk != "" {
+		file.WriteString(fmt.Sprintf("ramdisk = \"%s\"\n",
+			config.Ramdisk))
+	}
+
+	if bootLoader != "" {
+		file.WriteString(fmt.Sprintf("bootloader = \"%s\"\n",
+			bootLoader))
+	}
+	if config.EnableVnc {
+		file.WriteString(fmt.Sprintf("vnc = 1\n"))
+		file.WriteString(fmt.Sprintf("vnclisten = \"0.0.0.0\"\n"))
+		file.WriteString(fmt.Sprintf("usb=1\n"))
+		file.WriteString(fmt.Sprintf("usbdevice=[\"tablet\"]\n"))
+
+		if config.VncDisplay != 0 {
+			file.WriteString(fmt.Sprintf("vncdisplay = %d\n",
+				config.VncDisplay))
+		}
+		if config.VncPasswd != "" {
+			file.WriteString(fmt.Sprintf("vncpasswd = \"%s\"\n",
+				config.VncPasswd))
+		}
+	}
+
+	file.WriteString(fmt.Sprintf("memory = %d\n",
+		kbyte2mbyte(config.Memory)))
+	if config.MaxMem != 0 {
+		file.WriteString(fmt.Sprintf("maxmem = %d\n",
+			kbyte2mbyte(config.MaxMem)))
+	}
+	vCpus := config.VCpus
+	if vCpus == 0 {
+		vCpus = 1
+	}
+	file.WriteString(fmt.Sprintf("vcpus = %d\n", vCpus))
+	maxCpus := config.MaxCpus
+	if maxCpus == 0 {
+		maxCpus = vCpus
+	}
+	file.WriteString(fmt.Sprintf("maxcpus = %d\n", maxCpus))
+	if config.CPUs != "" {
+		file.WriteString(fmt.Sprintf("cpus = \"%s\"\n", config.CPUs))
+	}
+	if config.DeviceTree != "" {
+		file.WriteString(fmt.Sprintf("device_tree = \"%s\"\n",
+			config.DeviceTree))
+	}
+	dtString := ""
+	for _, dt := range config.DtDev {
+		if dtString != "" {
+			dtString += ","
+		}
+		dtString += fmt.Sprintf("\"%s\"", dt)
+	}
+	if dtString != "" {
+		file.WriteString(fmt.Sprintf("dtdev = [%s]\n", dtString))
+	}
+	// Note that qcow2 images might have partitions hence xvda1 by default
+	if rootDev != "" {
+		file.WriteString(fmt.Sprintf("root = \"%s\"\n", rootDev))
+	}
+	if extra != "" {
+		file.WriteString(fmt.Sprintf("extra = \"%s\"\n", extra))
+	}
+	// XXX Should one be able to disable the serial console? Would need
+	// knob in manifest
+
+	var serialAssignments []string
+	serialAssignments = append(serialAssignments, "pty")
+
+	// Always prefer CDROM vdisk over disk
+	file.WriteString(fmt.Sprintf("boot = \"%s\"\n", "dc"))
+
+	diskString := ""
+	for i, ds := range status.DiskStatusList {
+		access := "rw"
+		if ds.ReadOnly {
+			access = "ro"
+		}
+		oneDisk := fmt.Sprintf("'%s,%s,%s,%s'",
+			ds.ActiveFileLocation, ds.Format, ds.Vdev, access)
+		log.Debugf("Processing disk %d: %s\n", i, oneDisk)
+		if diskString == "" {
+			diskString = oneDisk
+		} else {
+			diskString = diskString + ", " + oneDisk
+		}
+	}
+	file.WriteString(fmt.Sprintf("disk = [%s]\n", diskString))
+
+	vifString := ""
+	for _, net := range config.VifList {
+		oneVif := fmt.Sprintf("'bridge=%s,vifname=%s,mac=%s,type=vif'",
+			net.Bridge, net.Vif, net.Mac)
+		if vifString == "" {
+			vifString = oneVif
+		} else {
+			vifString = vifString + ", " + oneVif
+		}
+	}
+	file.WriteString(fmt.Sprintf("vif = [%s]\n", vifString))
+
+	imString := ""
+	for _, im := range config.IOMem {
+		if imString != "" {
+			imString += ","
+		}
+		imString += fmt.Sprintf("\"%s\"", im)
+	}
+	if imString != "" {
+		file.WriteString(fmt.Sprintf("iomem = [%s]\n", imString))
+	}
+
+	// Gather all PCI assignments into a single line
+	// Also irqs, ioports, and serials
+	// irqs and ioports are used if we are pv; serials if hvm
+	var pciAssignments []typeAndPCI
+	var irqAssignments []string
+	var ioportsAssignments []string
+
+	for _, irq := range config.IRQs {
+		irqString := fmt.Sprintf("%d", irq)
+		irqAssignments = addNoDuplicate(irqAssignments, irqString)
+	}
+	for _, adapter := range config.IoAdapterList {
+		log.Debugf("configToXenCfg processing adapter %d %s\n",
+			adapter.Type, adapter.Name)
+		list := aa.LookupIoBundleGroup(adapter.Type, adapter.Name)
+		// We reserved it in handleCreate so nobody could have stolen it
+		if len(list) == 0 {
+			log.Fatalf("configToXencfg IoBundle disappeared %d %s for %s\n",
+				adapter.Type, adapter.Name, status.DomainName)
+		}
+		for _, ib := range list {
+			if ib == nil {
+				continue
+			}
+			if ib.UsedByUUID != config.UUIDandVersion.UUID {
+				log.Fatalf("configToXencfg IoBundle not ours %s: %d %s for %s\n",
+					ib.UsedByUUID, adapter.Type, adapter.Name,
+					status.DomainName)
+			}
+			if ib.PciLong != "" {
+				tap := typeAndPCI{pciLong: ib.PciLong, ioType: ib.Type}
+				pciAssignments = addNoDuplicatePCI(pciAssignments, tap)
+			}
+			if ib.Irq != "" && config.VirtualizationMode == types.PV {
+				log.Infof("Adding irq <%s>\n", ib.Irq)
+				irqAssignments = addNoDuplicate(irqAssignments,
+					ib.Irq)
+			}
+			if ib.Ioports != "" && config.VirtualizationMode == types.PV {
+				log.Infof("Adding ioport <%s>\n", ib.Ioports)
+				ioportsAssignments = addNoDuplicate(ioportsAssignments, ib.Ioports)
+			}
+			if ib.Serial != "" && config.VirtualizationMode == types.HVM {
+				log.Infof("Adding serial <%s>\n", ib.Serial)
+				serialAssignments = addNoDuplicate(serialAssignments, ib.Serial)
+			}
+		}
+	}
+	if len(pciAssignments) != 0 {
+		log.Debugf("PCI assignments %v\n", pciAssignments)
+		cfg := fmt.Sprintf("pci = [ ")
+		for i, pa := range pciAssignments {
+			if i != 0 {
+				cfg = cfg + ", "
+			}
+			short := types.PCILongToShort(pa.pciLong)
+			// USB controller are subject to legacy USB support from
+			// some BIOS. Use relaxed to get past that.
+			if pa.ioType == types.IoUSB {
+				cfg = cfg + fmt.Sprintf("'%s,rdm_policy=relaxed'",
+					short)
+			} else {
+				cfg = cfg + fmt.Sprintf("'%s'", short)
+			}
+		}
+		cfg = cfg + "]"
+		log.Debugf("Adding pci config <%s>\n", cfg)
+		file.WriteString(fmt.Sprintf("%s\n", cfg))
+	}
+	irqString := ""
+	for _, irq := range irqAssignments {
+		if irqString != "" {
+			irqString += ","
+		}
+		irqString += irq
+	}
+	if irqString != "" {
+		file.WriteString(fmt.Sprintf("irqs = [%s]\n", irqString))
+	}
+	ioportString := ""
+	for _, ioports := range ioportsAssignments {
+		if ioportString != "" {
+			ioportString += ","
+		}
+		ioportString += ioports
+	}
+	if ioportString != "" {
+		file.WriteString(fmt.Sprintf("ioports = [%s]\n", ioportString))
+	}
+	serialString := ""
+	for _, serial := range serialAssignments {
+		if serialString != "" {
+			serialString += ","
+		}
+		serialString += "'" + serial + "'"
+	}
+	if serialString != "" {
+		file.WriteString(fmt.Sprintf("serial = [%s]\n", serialString))
+	}
+	return nil
+}
+
+// Create in paused state; Need to call xlUnpause later
+func xlCreate(domainName string, xenCfgFilename string) (int, error) {
+	log.Infof("xlCreate %s %s\n", domainName, xenCfgFilename)
+	cmd := "xl"
+	args := []string{
+		"create",
+		xenCfgFilename,
+		"-p",
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl create failed ", err)
+		log.Errorln("xl create output ", string(stdoutStderr))
+		return 0, fmt.Errorf("xl create failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("xl create done\n")
+
+	args = []string{
+		"domid",
+		domainName,
+	}
+	stdoutStderr, err = wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl domid failed ", err)
+		log.Errorln("xl domid output ", string(stdoutStderr))
+		return 0, fmt.Errorf("xl domid failed: %s\n",
+			string(stdoutStderr))
+	}
+	res := strings.TrimSpace(string(stdoutStderr))
+	domainID, err := strconv.Atoi(res)
+	if err != nil {
+		log.Errorf("Can't extract domainID from %s: %s\n", res, err)
+		return 0, fmt.Errorf("Can't extract domainID from %s: %s\n", res, err)
+	}
+	return domainID, nil
+}
+
+func xlStatus(domainName string, domainID int) error {
+	log.Infof("xlStatus %s %d\n", domainName, domainID)
+	// XXX xl list -l domainName returns json. XXX but state not included!
+	// Note that state is not very useful anyhow
+	cmd := "xl"
+	args := []string{
+		"list",
+		"-l",
+		domainName,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl list failed ", err)
+		log.Errorln("xl list output ", string(stdoutStderr))
+		return fmt.Errorf("xl list failed: %s\n",
+			string(stdoutStderr))
+	}
+	// XXX parse json to look at state? Not currently included
+	// XXX note that there is a warning at the top of the combined
+	// output. If we want to parse the json we need to get Output()
+	log.Infof("xl list done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+// If we have a domain reboot issue the domainID
+// can change.
+func xlDomid(domainName string, domainID int) (int, error) {
+	log.Debugf("xlDomid %s %d\n", domainName, domainID)
+	cmd := "xl"
+	args := []string{
+		"domid",
+		domainName,
+	}
+	// Avoid wrap since we are called periodically
+	stdoutStderr, err := exec.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Debugln("xl domid failed ", err)
+		log.Debugln("xl domid output ", string(stdoutStderr))
+		return domainID, fmt.Errorf("xl domid failed: %s\n",
+			string(stdoutStderr))
+	}
+	res := strings.TrimSpace(string(stdoutStderr))
+	domainID2, err := strconv.Atoi(res)
+	if err != nil {
+		log.Errorf("xl domid not integer %s: failed %s\n", res, err)
+		return domainID, err
+	}
+	if domainID2 != domainID {
+		log.Warningf("domainid changed from %d to %d for %s\n",
+			domainID, domainID2, domainName)
+	}
+	return domainID2, err
+}
+
+// Perform xenstore write to disable all of these for all VIFs
+// feature-sg, feature-gso-tcpv4, feature-gso-tcpv6, feature-ipv6-csum-offload
+func xlDisableVifOffload(domainName string, domainID int, vifCount int) error {
+	log.Infof("xlDisableVifOffload %s %d %d\n",
+		domainName, domainID, vifCount)
+	pref := "/local/domain"
+	for i := 0; i < vifCount; i += 1 {
+		varNames := []string{
+			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-sg",
+				pref, domainID, i),
+			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-gso-tcpv4",
+				pref, domainID, i),
+			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-gso-tcpv6",
+				pref, domainID, i),
+			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-ipv4-csum-offload",
+				pref, domainID, i),
+			fmt.Sprintf("%s/0/backend/vif/%d/%d/feature-ipv6-csum-offload",
+				pref, domainID, i),
+			fmt.Sprintf("%s/%d/device/vif/%d/feature-sg",
+				pref, domainID, i),
+			fmt.Sprintf("%s/%d/device/vif/%d/feature-gso-tcpv4",
+				pref, domainID, i),
+			fmt.Sprintf("%s/%d/device/vif/%d/feature-gso-tcpv6",
+				pref, domainID, i),
+			fmt.Sprintf("%s/%d/device/vif/%d/feature-ipv4-csum-offload",
+				pref, domainID, i),
+			fmt.Sprintf("%s/%d/device/vif/%d/feature-ipv6-csum-offload",
+				pref, domainID, i),
+		}
+		for _, varName := range varNames {
+			cmd := "xenstore"
+			args := []string{
+				"write",
+				varName,
+				"0",
+			}
+			stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+			if err != nil {
+				log.Errorln("xenstore write failed ", err)
+				log.Errorln("xenstore write output ", string(stdoutStderr))
+				return fmt.Errorf("xenstore write failed: %s\n",
+					string(stdoutStderr))
+			}
+			log.Debugf("xenstore write done. Result %s\n",
+				string(stdoutStderr))
+		}
+	}
+
+	log.Infof("xlDisableVifOffload done.\n")
+	return nil
+}
+
+func xlUnpause(domainName string, domainID int) error {
+	log.Infof("xlUnpause %s %d\n", domainName, domainID)
+	cmd := "xl"
+	args := []string{
+		"unpause",
+		domainName,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl unpause failed ", err)
+		log.Errorln("xl unpause output ", string(stdoutStderr))
+		return fmt.Errorf("xl unpause failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("xlUnpause done. Result %s\n", string(stdoutStderr))
+	return nil
+}
+
+func xlShutdown(domainName string, domainID int, force bool) error {
+	log.Infof("xlShutdown %s %d\n", domainName, domainID)
+	cmd := "xl"
+	var args []string
+	if force {
+		args = []string{
+			"shutdown",
+			"-F",
+			domainName,
+		}
+	} else {
+		args = []string{
+			"shutdown",
+			domainName,
+		}
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl shutdown failed ", err)
+		log.Errorln("xl shutdown output ", string(stdoutStderr))
+		return fmt.Errorf("xl shutdown failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("xl shutdown done\n")
+	return nil
+}
+
+func xlDestroy(domainName string, domainID int) error {
+	log.Infof("xlDestroy %s %d\n", domainName, domainID)
+	cmd := "xl"
+	args := []string{
+		"destroy",
+		domainName,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorln("xl destroy failed ", err)
+		log.Errorln("xl destroy output ", string(stdoutStderr))
+		return fmt.Errorf("xl destroy failed: %s\n",
+			string(stdoutStderr))
+	}
+	log.Infof("xl destroy done\n")
+	return nil
+}
+
+func pciAssignableAdd(long string) error {
+	log.Infof("pciAssignableAdd %s\n", long)
+	cmd := "xl"
+	args := []string{
+		"pci-assignable-add",
+		long,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xl pci-assignable-add failed: %s\n",
+			string(stdoutStderr))
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	log.Infof("xl pci-assignable-add done\n")
+	return nil
+}
+
+func pciAssignableRemove(long string) error {
+	log.Infof("pciAssignableRemove %s\n", long)
+	cmd := "xl"
+	args := []string{
+		"pci-assignable-rem",
+		"-r",
+		long,
+	}
+	stdoutStderr, err := wrap.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("xl pci-assignable-rem failed: %s\n",
+			string(stdoutStderr))
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	log.Infof("xl pci-assignable-rem done\n")
+	return nil
+}
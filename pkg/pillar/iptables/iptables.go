@@ -158,7 +158,12 @@ func getIpRuleCounters(counters []AclCounters, match *AclCounters) *AclCounters
 		if c.IIf != match.IIf || c.OIf != match.OIf {
 			continue
 		}
-		if c.Piif != match.Piif || c.Poif != match.Poif {
+		if c.Piif != match.Piif {
+			continue
+		}
+		// Only a Transparent network instance matches on the output
+		// vif; otherwise the rules are for the whole bridge
+		if c.Poif != "" && c.Poif != match.Poif {
 			continue
 		}
 		log.Debugf("getIpRuleCounters: matched counters %+v\n",
@@ -180,6 +185,7 @@ func GetIPRuleACLDrop(counters []AclCounters, bridgeName string, vifName string,
 	var iif string
 	var piif string
 	var oif string
+	var poif string
 	if input {
 		iif = bridgeName
 		if vifName != "" {
@@ -187,8 +193,11 @@ func GetIPRuleACLDrop(counters []AclCounters, bridgeName string, vifName string,
 		}
 	} else {
 		oif = bridgeName
+		if vifName != "" {
+			poif = vifName + "+"
+		}
 	}
-	match := AclCounters{IIf: iif, Piif: piif, OIf: oif, IpVer: ipVer,
+	match := AclCounters{IIf: iif, Piif: piif, OIf: oif, Poif: poif, IpVer: ipVer,
 		Drop: true, Limit: false}
 	c := getIpRuleCounters(counters, &match)
 	if c == nil {
@@ -204,6 +213,7 @@ func GetIPRuleACLLog(counters []AclCounters, bridgeName string, vifName string,
 	var iif string
 	var piif string
 	var oif string
+	var poif string
 	if input {
 		iif = bridgeName
 		if vifName != "" {
@@ -211,8 +221,11 @@ func GetIPRuleACLLog(counters []AclCounters, bridgeName string, vifName string,
 		}
 	} else {
 		oif = bridgeName
+		if vifName != "" {
+			poif = vifName + "+"
+		}
 	}
-	match := AclCounters{IIf: iif, Piif: piif, OIf: oif, IpVer: ipVer,
+	match := AclCounters{IIf: iif, Piif: piif, OIf: oif, Poif: poif, IpVer: ipVer,
 		Drop: false, Limit: false, Log: true}
 	c := getIpRuleCounters(counters, &match)
 	if c == nil {
@@ -231,6 +244,7 @@ func GetIPRuleACLRateLimitDrop(counters []AclCounters, bridgeName string,
 	var iif string
 	var piif string
 	var oif string
+	var poif string
 	if input {
 		iif = bridgeName
 		if vifName != "" {
@@ -238,9 +252,12 @@ func GetIPRuleACLRateLimitDrop(counters []AclCounters, bridgeName string,
 		}
 	} else {
 		oif = bridgeName
+		if vifName != "" {
+			poif = vifName + "+"
+		}
 	}
 	// for RateLimit Drops, the Drop is false
-	match := AclCounters{IIf: iif, Piif: piif, OIf: oif, IpVer: ipVer,
+	match := AclCounters{IIf: iif, Piif: piif, OIf: oif, Poif: poif, IpVer: ipVer,
 		Drop: false, Limit: true}
 	c := getIpRuleCounters(counters, &match)
 	if c == nil {
@@ -0,0 +1,149 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// nftables support code. A script is loaded with nft -f which passes all
+// of its commands to the kernel as one netlink transaction; either all of
+// them are applied or none are. There is no netlink nftables library in
+// the tree hence we run nft, and read the counters from its JSON output.
+
+package iptables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/wrap"
+	log "github.com/sirupsen/logrus"
+)
+
+// NftTable is the name of the tables in the bridge and inet families
+// which hold the application ACL chains, counters and sets
+const NftTable = "eve-acl"
+
+// Kinds of the named counters for the ACL rules; these correspond to the
+// Drop, Log and Limit fields in AclCounters
+const (
+	NftCounterDrop  = "drop"
+	NftCounterLog   = "log"
+	NftCounterLimit = "limit"
+)
+
+// NftApply loads the script as a single transaction
+func NftApply(script string) error {
+	cmd := wrap.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("nft -f failed %s output %s script %s",
+			err, out, script)
+		log.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// NftCounterName encodes what the counter counts in its name so that
+// FetchNftCounters can report it without any other state
+func NftCounterName(kind string, ipVer int, bridgeName string,
+	vifName string) string {
+
+	return fmt.Sprintf("%s.%d.%s.%s", kind, ipVer, bridgeName, vifName)
+}
+
+// FetchNftCounters returns the named counters in the bridge family table
+// as input counters of the vif and those in the inet family table as
+// output counters of the bridge, the same as the iptables rules they
+// replace.
+func FetchNftCounters() []AclCounters {
+	out, err := wrap.Command("nft", "-j", "list", "counters").Output()
+	if err != nil {
+		log.Errorf("FetchNftCounters: nft list failed %s\n", err)
+		return nil
+	}
+	counters, err := parseNftCounters(out)
+	if err != nil {
+		log.Errorf("FetchNftCounters: %s\n", err)
+		return nil
+	}
+	return counters
+}
+
+// nftJSON is the part of the nft -j output we use. The list has one
+// object per table, chain, counter etc. keyed by its kind.
+type nftJSON struct {
+	Nftables []struct {
+		Counter *struct {
+			Family  string `json:"family"`
+			Table   string `json:"table"`
+			Name    string `json:"name"`
+			Packets uint64 `json:"packets"`
+			Bytes   uint64 `json:"bytes"`
+		} `json:"counter"`
+	} `json:"nftables"`
+}
+
+// Parse the output of nft -j list counters which looks like
+//
+//	{"nftables": [{"metainfo": {"json_schema_version": 1}},
+//	  {"counter": {"family": "bridge", "name": "drop.4.bn1.nbu1x1",
+//	   "table": "eve-acl", "handle": 3, "packets": 12, "bytes": 1008}}]}
+func parseNftCounters(out []byte) ([]AclCounters, error) {
+	var res nftJSON
+	if err := json.Unmarshal(out, &res); err != nil {
+		return nil, fmt.Errorf("bad nft output: %s", err)
+	}
+	var counters []AclCounters
+	for _, obj := range res.Nftables {
+		c := obj.Counter
+		if c == nil || c.Table != NftTable {
+			continue
+		}
+		ac := parseNftCounterName(c.Name, c.Family)
+		if ac == nil {
+			continue
+		}
+		ac.Pkts = c.Packets
+		ac.Bytes = c.Bytes
+		counters = append(counters, *ac)
+	}
+	return counters, nil
+}
+
+func parseNftCounterName(name string, family string) *AclCounters {
+	items := strings.Split(name, ".")
+	if len(items) != 4 {
+		log.Warnf("Unexpected nft counter name %s\n", name)
+		return nil
+	}
+	ipVer, err := strconv.Atoi(items[1])
+	if err != nil {
+		log.Warnf("Unexpected nft counter name %s\n", name)
+		return nil
+	}
+	ac := AclCounters{Table: family, IpVer: ipVer}
+	switch items[0] {
+	case NftCounterDrop:
+		ac.Drop = true
+	case NftCounterLog:
+		ac.Log = true
+	case NftCounterLimit:
+		ac.Limit = true
+	default:
+		log.Warnf("Unexpected nft counter name %s\n", name)
+		return nil
+	}
+	switch family {
+	case "bridge":
+		// acl.go matches on the vifname with a '+' appended
+		ac.IIf = items[2]
+		ac.Piif = items[3] + "+"
+	case "inet":
+		ac.OIf = items[2]
+	default:
+		return nil
+	}
+	return &ac
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package iptables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Recorded from nft -j list counters with the names from NftCounterName
+const nftCountersJSON = `{"nftables": [{"metainfo": {"version": "0.9.2", "release_name": "Scram", "json_schema_version": 1}}, {"counter": {"family": "bridge", "name": "drop.4.bn1.nbu1x1", "table": "eve-acl", "handle": 5, "packets": 12, "bytes": 1008}}, {"counter": {"family": "bridge", "name": "log.6.bn1.nbu1x1", "table": "eve-acl", "handle": 6, "packets": 3, "bytes": 240}}, {"counter": {"family": "inet", "name": "limit.4.bn1.nbu1x1", "table": "eve-acl", "handle": 7, "packets": 100, "bytes": 64000}}, {"counter": {"family": "inet", "name": "drop.4.bn1.nbu1x1", "table": "other", "handle": 2, "packets": 1, "bytes": 1}}, {"counter": {"family": "inet", "name": "probe", "table": "eve-acl", "handle": 8, "packets": 0, "bytes": 0}}]}`
+
+func TestParseNftCounters(t *testing.T) {
+	testMatrix := map[string]struct {
+		out        string
+		expected   []AclCounters
+		expectFail bool
+	}{
+		"Counters": {
+			out: nftCountersJSON,
+			expected: []AclCounters{
+				{Table: "bridge", IpVer: 4, IIf: "bn1",
+					Piif: "nbu1x1+", Drop: true, Pkts: 12,
+					Bytes: 1008},
+				{Table: "bridge", IpVer: 6, IIf: "bn1",
+					Piif: "nbu1x1+", Log: true, Pkts: 3,
+					Bytes: 240},
+				{Table: "inet", IpVer: 4, OIf: "bn1",
+					Limit: true, Pkts: 100, Bytes: 64000},
+			},
+		},
+		"No counters": {
+			out: `{"nftables": [{"metainfo": {"json_schema_version": 1}}]}`,
+		},
+		"Not JSON": {
+			out:        "table bridge eve-acl {\n}\n",
+			expectFail: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		counters, err := parseNftCounters([]byte(test.out))
+		if test.expectFail {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, counters)
+	}
+}
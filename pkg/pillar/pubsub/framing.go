@@ -0,0 +1,232 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Binary framing for the pubsub socket protocol.
+// A subscriber which supports it sends
+//	"request" topic version compression
+// and a publisher which supports it replies with
+//	"hello" topic version compression
+// after which all messages are binary frames. Older subscribers send
+// the two word request and get the text protocol. Older publishers close
+// the connection on the longer request and the subscriber falls back
+// to the text protocol.
+//
+// Each frame starts with a fixed header
+//	version(1) op(1) flags(1) reserved(1) seq(4) keyLen(4) valLen(4)
+// followed by the raw key and the json value, which is zlib compressed
+// if the compressed flag is set. Frames larger than a packet are sent
+// as multiple packets and reassembled based on the lengths in the header.
+// The subscriber acknowledges the seq of the frames it has delivered and
+// the publisher keeps at most ackWindow frames unacknowledged.
+
+package pubsub
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	protocolVersion   = "2"
+	compressionZlib   = "zlib"
+	compressionNone   = "none"
+	frameVersion      = 2
+	frameHeaderLen    = 16
+	maxPacketSize     = 65536 // Read buffer size
+	maxFragmentLen    = 60000 // Largest packet we write
+	maxFrameLen       = 64 * 1024 * 1024
+	compressThreshold = 512 // Smaller values are sent uncompressed
+	ackWindow         = 32  // Max unacknowledged frames
+	ackEvery          = 8   // Subscriber acknowledges every N frames
+)
+
+type frameOp uint8
+
+const (
+	frameUpdate frameOp = iota + 1
+	frameDelete
+	frameComplete
+	frameRestarted
+	frameAck
+)
+
+const frameFlagCompressed = 0x1
+
+type frame struct {
+	op    frameOp
+	flags uint8
+	seq   uint32
+	key   []byte
+	val   []byte
+}
+
+// writeFrame writes the frame as one or more packets
+func writeFrame(w io.Writer, f frame) error {
+	b := make([]byte, frameHeaderLen+len(f.key)+len(f.val))
+	b[0] = frameVersion
+	b[1] = byte(f.op)
+	b[2] = f.flags
+	binary.BigEndian.PutUint32(b[4:8], f.seq)
+	binary.BigEndian.PutUint32(b[8:12], uint32(len(f.key)))
+	binary.BigEndian.PutUint32(b[12:16], uint32(len(f.val)))
+	copy(b[frameHeaderLen:], f.key)
+	copy(b[frameHeaderLen+len(f.key):], f.val)
+	for len(b) != 0 {
+		n := len(b)
+		if n > maxFragmentLen {
+			n = maxFragmentLen
+		}
+		if _, err := w.Write(b[:n]); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// readFrame reads packets until it has a complete frame. The buf is used
+// for each read and must be at least maxPacketSize
+func readFrame(r io.Reader, buf []byte) (frame, error) {
+	var f frame
+	res, err := r.Read(buf)
+	if err != nil {
+		return f, err
+	}
+	if res < frameHeaderLen {
+		return f, fmt.Errorf("readFrame: short header %d bytes", res)
+	}
+	if buf[0] != frameVersion {
+		return f, fmt.Errorf("readFrame: unsupported version %d", buf[0])
+	}
+	f.op = frameOp(buf[1])
+	f.flags = buf[2]
+	f.seq = binary.BigEndian.Uint32(buf[4:8])
+	keyLen := int(binary.BigEndian.Uint32(buf[8:12]))
+	valLen := int(binary.BigEndian.Uint32(buf[12:16]))
+	total := frameHeaderLen + keyLen + valLen
+	if keyLen > maxFrameLen || valLen > maxFrameLen {
+		return f, fmt.Errorf("readFrame: frame too large %d", total)
+	}
+	data := make([]byte, 0, total)
+	data = append(data, buf[:res]...)
+	for len(data) < total {
+		res, err := r.Read(buf)
+		if err != nil {
+			return f, err
+		}
+		data = append(data, buf[:res]...)
+	}
+	if len(data) != total {
+		return f, fmt.Errorf("readFrame: got %d bytes expected %d",
+			len(data), total)
+	}
+	f.key = data[frameHeaderLen : frameHeaderLen+keyLen]
+	f.val = data[frameHeaderLen+keyLen:]
+	return f, nil
+}
+
+// compressValue returns the value to send and the flags
+func compressValue(val []byte, compress bool) ([]byte, uint8) {
+	if !compress || len(val) < compressThreshold {
+		return val, 0
+	}
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(val); err != nil {
+		return val, 0
+	}
+	if err := w.Close(); err != nil {
+		return val, 0
+	}
+	if buf.Len() >= len(val) {
+		return val, 0
+	}
+	return buf.Bytes(), frameFlagCompressed
+}
+
+func decompressValue(f frame) ([]byte, error) {
+	if f.flags&frameFlagCompressed == 0 {
+		return f.val, nil
+	}
+	r, err := zlib.NewReader(bytes.NewReader(f.val))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	val, err := ioutil.ReadAll(io.LimitReader(r, maxFrameLen+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(val) > maxFrameLen {
+		return nil, errors.New("decompressValue: value too large")
+	}
+	return val, nil
+}
+
+// pubConn is the publisher side of a connection to one subscriber
+type pubConn struct {
+	sock     net.Conn
+	binary   bool
+	compress bool
+
+	// Flow control for the binary protocol
+	seq     uint32 // Last seq sent
+	ackLock sync.Mutex
+	acked   uint32        // Last seq acknowledged by the subscriber
+	ackCh   chan struct{} // Signalled when acked advances
+	ackDone chan struct{} // Closed when the subscriber goes away
+}
+
+// send waits until there is room in the window and sends the frame
+func (c *pubConn) send(f frame) error {
+	for {
+		c.ackLock.Lock()
+		inflight := c.seq - c.acked
+		c.ackLock.Unlock()
+		if inflight < ackWindow {
+			break
+		}
+		select {
+		case <-c.ackCh:
+		case <-c.ackDone:
+			return errors.New("send: subscriber closed connection")
+		}
+	}
+	c.seq++
+	f.seq = c.seq
+	return writeFrame(c.sock, f)
+}
+
+// go routine reading acknowledgements from the subscriber
+func (c *pubConn) readAcks(name string, instance int) {
+	defer close(c.ackDone)
+	buf := make([]byte, maxPacketSize)
+	for {
+		f, err := readFrame(c.sock, buf)
+		if err != nil {
+			log.Infof("readAcks(%s/%d) done: %s\n", name, instance, err)
+			return
+		}
+		if f.op != frameAck {
+			log.Errorf("readAcks(%s/%d) unexpected op %d\n",
+				name, instance, f.op)
+			continue
+		}
+		c.ackLock.Lock()
+		c.acked = f.seq
+		c.ackLock.Unlock()
+		select {
+		case c.ackCh <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	large := "{\"a\":\"" + strings.Repeat("x", 3*maxFragmentLen) + "\"}"
+	testMatrix := map[string]struct {
+		op       frameOp
+		key      string
+		val      string
+		compress bool
+	}{
+		"Complete": {
+			op: frameComplete,
+		},
+		"Delete with space in key": {
+			op:  frameDelete,
+			key: "key with space",
+		},
+		"Small update not compressed": {
+			op:       frameUpdate,
+			key:      "key_0",
+			val:      "{\"a\":1}",
+			compress: true,
+		},
+		"Large update": {
+			op:  frameUpdate,
+			key: "key_1",
+			val: large,
+		},
+		"Large update compressed": {
+			op:       frameUpdate,
+			key:      "key_2",
+			val:      large,
+			compress: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		w, r := net.Pipe()
+		val, flags := compressValue([]byte(test.val), test.compress)
+		go func() {
+			writeFrame(w, frame{op: test.op, flags: flags, seq: 7,
+				key: []byte(test.key), val: val})
+			w.Close()
+		}()
+		buf := make([]byte, maxPacketSize)
+		f, err := readFrame(r, buf)
+		assert.Nil(t, err)
+		assert.Equal(t, test.op, f.op)
+		assert.Equal(t, uint32(7), f.seq)
+		assert.Equal(t, test.key, string(f.key))
+		got, err := decompressValue(f)
+		assert.Nil(t, err)
+		assert.Equal(t, test.val, string(got))
+		if test.compress && len(test.val) > compressThreshold {
+			assert.True(t, len(f.val) < len(test.val))
+		}
+		r.Close()
+	}
+}
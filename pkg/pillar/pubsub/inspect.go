@@ -0,0 +1,219 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Introspection of publications for debugging.
+// A client sends
+//	"inspect" topic
+// on the publication socket and the publisher replies with
+//	"info" topic base64-json-of-PublicationInfo
+// and closes the connection.
+
+package pubsub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const inspectTimeout = 5 * time.Second
+
+// PublicationInfo is what the publisher reports about a publication
+type PublicationInfo struct {
+	Name         string
+	KeyCount     int
+	Subscribers  int  // Connected subscribers
+	Synchronized int  // Subscribers which have been sent all the keys
+	Restarted    bool // Publisher has signaled restarted
+	LastUpdate   time.Time
+	FromDir      bool // Publisher did not answer; based on the files
+}
+
+// Statistics maintained by the publisher
+type pubStats struct {
+	lock         sync.Mutex
+	subscribers  int
+	synchronized int
+	lastUpdate   time.Time
+}
+
+func (pub *Publication) noteUpdate() {
+	pub.stats.lock.Lock()
+	pub.stats.lastUpdate = time.Now()
+	pub.stats.lock.Unlock()
+}
+
+func (pub *Publication) noteSubscriber(delta int) {
+	pub.stats.lock.Lock()
+	pub.stats.subscribers += delta
+	pub.stats.lock.Unlock()
+}
+
+func (pub *Publication) noteSynchronized(delta int) {
+	pub.stats.lock.Lock()
+	pub.stats.synchronized += delta
+	pub.stats.lock.Unlock()
+}
+
+// isRestarted is used by the goroutines serving the subscribers
+func (pub *Publication) isRestarted() bool {
+	pub.stats.lock.Lock()
+	defer pub.stats.lock.Unlock()
+	return pub.km.restarted
+}
+
+func (pub *Publication) info() PublicationInfo {
+	pub.stats.lock.Lock()
+	defer pub.stats.lock.Unlock()
+	return PublicationInfo{
+		Name:         pub.nameString(),
+		KeyCount:     len(pub.GetAll()),
+		Subscribers:  pub.stats.subscribers,
+		Synchronized: pub.stats.synchronized,
+		Restarted:    pub.km.restarted,
+		LastUpdate:   pub.stats.lastUpdate,
+	}
+}
+
+func (pub *Publication) sendInfo(s net.Conn) error {
+	b, err := json.Marshal(pub.info())
+	if err != nil {
+		log.Fatal("json Marshal in sendInfo", err)
+	}
+	sendVal := base64.StdEncoding.EncodeToString(b)
+	_, err = s.Write([]byte(fmt.Sprintf("info %s %s", pub.topic, sendVal)))
+	return err
+}
+
+// ListPublications returns the names (agent/topic or agent/scope/topic)
+// of the publications based on the sockets and the json files under
+// /var/run
+func ListPublications() ([]string, error) {
+	found := make(map[string]bool)
+	root := "/var/run"
+	walker := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Files come and go
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil || !strings.Contains(rel, "/") {
+			return nil
+		}
+		if info.Mode()&os.ModeSocket != 0 &&
+			strings.HasSuffix(rel, ".sock") {
+			found[strings.TrimSuffix(rel, ".sock")] = true
+		} else if !info.IsDir() && strings.HasSuffix(rel, ".json") {
+			found[filepath.Dir(rel)] = true
+		}
+		return nil
+	}
+	if err := filepath.Walk(root, walker); err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range found {
+		// A json file in the agent directory is not a publication
+		if strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// InspectPublication asks the publisher about the publication.
+// If the publisher does not answer the information is based on the
+// files in PubDirName
+func InspectPublication(name string) (PublicationInfo, error) {
+	info, err := inspectSock(name)
+	if err == nil {
+		return info, nil
+	}
+	log.Debugf("InspectPublication(%s): %s; using files\n", name, err)
+	return inspectDir(name)
+}
+
+func inspectSock(name string) (PublicationInfo, error) {
+	var info PublicationInfo
+	topic := filepath.Base(name)
+	s, err := net.DialTimeout("unixpacket", SockName(name), inspectTimeout)
+	if err != nil {
+		return info, err
+	}
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(inspectTimeout))
+	if _, err := s.Write([]byte(fmt.Sprintf("inspect %s", topic))); err != nil {
+		return info, err
+	}
+	buf := make([]byte, maxPacketSize)
+	res, err := s.Read(buf)
+	if err != nil {
+		return info, err
+	}
+	reply := strings.Split(string(buf[0:res]), " ")
+	if len(reply) != 3 || reply[0] != "info" || reply[1] != topic {
+		errStr := fmt.Sprintf("inspectSock(%s): unexpected reply %s",
+			name, reply[0])
+		return info, errors.New(errStr)
+	}
+	b, err := base64.StdEncoding.DecodeString(reply[2])
+	if err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal(b, &info); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+func inspectDir(name string) (PublicationInfo, error) {
+	info := PublicationInfo{Name: name, FromDir: true}
+	files, err := ioutil.ReadDir(PubDirName(name))
+	if err != nil {
+		return info, err
+	}
+	for _, file := range files {
+		if file.Name() == "restarted" {
+			info.Restarted = true
+			continue
+		}
+		if !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		info.KeyCount++
+		if file.ModTime().After(info.LastUpdate) {
+			info.LastUpdate = file.ModTime()
+		}
+	}
+	return info, nil
+}
+
+// SubscribeByName subscribes to a publication based on its name
+// (agent/topic or agent/scope/topic) without knowing the type.
+// The items are the unmarshalled json i.e., map[string]interface{}.
+func SubscribeByName(name string, ctx interface{}) (*Subscription, error) {
+	parts := strings.Split(name, "/")
+	switch len(parts) {
+	case 2:
+		return subscribeTopic(parts[0], "", parts[1], nil, false, ctx,
+			false)
+	case 3:
+		return subscribeTopic(parts[0], parts[1], parts[2], nil, false,
+			ctx, false)
+	default:
+		errStr := fmt.Sprintf("SubscribeByName: bad name %s", name)
+		return nil, errors.New(errStr)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// In-process transport. When the publisher is in the same process as the
+// subscriber the changes are passed to the subscriber without going
+// through the socket. zedbox runs each agent in a process of its own
+// hence this only applies to agents sharing a process, such as tests and
+// tools. The socket is still maintained for out-of-process subscribers,
+// and the json files are always written as the checkpoint from which a
+// restarted agent recovers its state.
+
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Publications in this process indexed by nameString
+type localPublications struct {
+	lock sync.Mutex
+	pubs map[string]*Publication
+}
+
+var localPubs = localPublications{pubs: make(map[string]*Publication)}
+
+// Set to false to always use the sockets
+var useLocalTransport = true
+
+func localPublicationAdd(pub *Publication) {
+	localPubs.lock.Lock()
+	localPubs.pubs[pub.nameString()] = pub
+	localPubs.lock.Unlock()
+}
+
+func lookupLocalPublication(name string) *Publication {
+	if !useLocalTransport {
+		return nil
+	}
+	localPubs.lock.Lock()
+	defer localPubs.lock.Unlock()
+	return localPubs.pubs[name]
+}
+
+// watchLocal feeds the changes from the publication in this process to
+// the subscriber. Same as serveConnection plus connectAndRead but without
+// the socket. Returns when the subscription is closed.
+func (sub *Subscription) watchLocal(pub *Publication) {
+	name := sub.nameString()
+	log.Infof("watchLocal(%s)\n", name)
+	pub.noteSubscriber(1)
+	defer pub.noteSubscriber(-1)
+
+	// Insert our notification channel before we get the initial
+	// snapshot to avoid missing any updates/deletes.
+	updater := make(chan notify, 1)
+	updatersAdd(updater, name, -1)
+	defer updatersRemove(updater)
+
+	sendToPeer := make(localCollection)
+	sentRestarted := false
+	keys := pub.determineDiffs(sendToPeer)
+	if !sub.deliverLocal(keys, sendToPeer) || !sub.send("C done") {
+		log.Infof("watchLocal(%s) closed\n", name)
+		return
+	}
+	pub.noteSynchronized(1)
+	defer pub.noteSynchronized(-1)
+	for {
+		if pub.isRestarted() && !sentRestarted {
+			if !sub.send("R done") {
+				break
+			}
+			sentRestarted = true
+		}
+		select {
+		case <-updater:
+		case <-sub.done:
+			log.Infof("watchLocal(%s) closed\n", name)
+			return
+		}
+		keys := pub.determineDiffs(sendToPeer)
+		if !sub.deliverLocal(keys, sendToPeer) {
+			break
+		}
+	}
+	log.Infof("watchLocal(%s) closed\n", name)
+}
+
+// deliverLocal uses the same change format as the binary protocol.
+// Returns false if the subscription was closed.
+func (sub *Subscription) deliverLocal(keys []string,
+	sendToPeer localCollection) bool {
+
+	for _, key := range keys {
+		var change string
+		val, ok := sendToPeer[key]
+		if ok {
+			b, err := json.Marshal(val)
+			if err != nil {
+				log.Fatal("json Marshal in deliverLocal", err)
+			}
+			change = fmt.Sprintf("U %d %s%s", len(key), key, b)
+		} else {
+			change = "X " + key
+		}
+		if !sub.send(change) {
+			return false
+		}
+	}
+	return true
+}
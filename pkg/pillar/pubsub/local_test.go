@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type LocalItem struct {
+	Value int
+}
+
+// newLocalPublication does not create the socket nor add it to localPubs
+func newLocalPublication(t *testing.T, agentName string) *Publication {
+	dirName, err := ioutil.TempDir("", "pubsub")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	pub := new(Publication)
+	pub.topicType = LocalItem{}
+	pub.agentName = agentName
+	pub.topic = TypeToName(LocalItem{})
+	pub.km = keyMap{key: NewLockedStringMap()}
+	pub.dirName = dirName
+	return pub
+}
+
+func receiveChange(t *testing.T, sub *Subscription) string {
+	select {
+	case change := <-sub.C:
+		sub.ProcessChange(change)
+		return change
+	case <-time.After(5 * time.Second):
+		t.Fatalf("No change from %s", sub.nameString())
+		return ""
+	}
+}
+
+// changeKey returns the operation and the key of the change
+func changeKey(change string) (string, string) {
+	switch {
+	case strings.HasPrefix(change, "X "):
+		return "X", change[2:]
+	case strings.HasPrefix(change, "U "):
+		reply := strings.SplitN(change, " ", 3)
+		return "U", strings.SplitN(reply[2], "{", 2)[0]
+	default:
+		return change, ""
+	}
+}
+
+func countUpdaters(name string) int {
+	updaterList.lock.Lock()
+	defer updaterList.lock.Unlock()
+	count := 0
+	for _, nn := range updaterList.servers {
+		if nn.name == name {
+			count++
+		}
+	}
+	return count
+}
+
+func TestWatchLocal(t *testing.T) {
+	pub := newLocalPublication(t, "localtest")
+	defer os.RemoveAll(pub.dirName)
+	pub.Publish("a", LocalItem{Value: 1})
+	pub.Publish("b", LocalItem{Value: 2})
+
+	sub, err := subscribeTopic("localtest", "", pub.topic, LocalItem{},
+		false, nil, false)
+	if err != nil {
+		t.Fatalf("subscribeTopic failed: %s", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		sub.watchLocal(pub)
+		close(done)
+	}()
+
+	// The initial keys in any order and then complete
+	initial := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		op, key := changeKey(receiveChange(t, sub))
+		assert.Equal(t, "U", op)
+		initial[key] = true
+	}
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, initial)
+	assert.Equal(t, "C done", receiveChange(t, sub))
+	assert.True(t, sub.Synchronized())
+	assert.Equal(t, 1, pub.info().Subscribers)
+	assert.Equal(t, 1, pub.info().Synchronized)
+
+	// Each change is delivered in order
+	testMatrix := []struct {
+		name       string
+		change     func()
+		expectedOp string
+		expectKey  string
+	}{
+		{
+			name:       "Modify",
+			change:     func() { pub.Publish("a", LocalItem{Value: 10}) },
+			expectedOp: "U",
+			expectKey:  "a",
+		},
+		{
+			name:       "Add",
+			change:     func() { pub.Publish("c", LocalItem{Value: 3}) },
+			expectedOp: "U",
+			expectKey:  "c",
+		},
+		{
+			name:       "Delete",
+			change:     func() { pub.Unpublish("b") },
+			expectedOp: "X",
+			expectKey:  "b",
+		},
+	}
+	for _, test := range testMatrix {
+		t.Logf("Running test case %s", test.name)
+		test.change()
+		op, key := changeKey(receiveChange(t, sub))
+		assert.Equal(t, test.expectedOp, op)
+		assert.Equal(t, test.expectKey, key)
+	}
+	items := sub.GetAll()
+	assert.Equal(t, 2, len(items))
+	a, err := sub.Get("a")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"Value": float64(10)}, a)
+
+	// Restarted after the changes which preceded it
+	pub.Publish("d", LocalItem{Value: 4})
+	pub.SignalRestarted()
+	op, key := changeKey(receiveChange(t, sub))
+	assert.Equal(t, "U", op)
+	assert.Equal(t, "d", key)
+	assert.Equal(t, "R done", receiveChange(t, sub))
+	assert.True(t, sub.Restarted())
+
+	// Close removes the updater
+	assert.Equal(t, 1, countUpdaters(pub.nameString()))
+	sub.Close()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("watchLocal did not return")
+	}
+	assert.Equal(t, 0, countUpdaters(pub.nameString()))
+	assert.Equal(t, 0, pub.info().Subscribers)
+	assert.Equal(t, 0, pub.info().Synchronized)
+	// Publishing after the close does not block
+	pub.Publish("e", LocalItem{Value: 5})
+}
+
+func TestCheckpoint(t *testing.T) {
+	pub := newLocalPublication(t, "checkpointtest")
+	defer os.RemoveAll(pub.dirName)
+	fileName := func(key string) string {
+		return filepath.Join(pub.dirName, key+".json")
+	}
+
+	// Written without any subscribers
+	pub.Publish("a", LocalItem{Value: 1})
+	pub.Publish("b", LocalItem{Value: 2})
+	b, err := ioutil.ReadFile(fileName("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Value":1}`, string(b))
+	assert.Nil(t, pub.Unpublish("b"))
+	_, err = os.Stat(fileName("b"))
+	assert.True(t, os.IsNotExist(err))
+	pub.Publish("a", LocalItem{Value: 10})
+
+	// A restarted publisher recovers its state from the files
+	restarted := newLocalPublication(t, "checkpointtest")
+	os.RemoveAll(restarted.dirName)
+	restarted.dirName = pub.dirName
+	restarted.populate()
+	assert.Equal(t, map[string]interface{}{
+		"a": map[string]interface{}{"Value": float64(10)},
+	}, restarted.GetAll())
+}
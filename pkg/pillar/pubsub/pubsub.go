@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
@@ -33,6 +34,10 @@ import (
 // "restarted" if/when pub.km.restarted is set.
 // Ongoing we send "update" and "delete" messages.
 // They keys and values are base64-encoded since they might contain spaces.
+// A binary protocol is negotiated in the request and hello; see framing.go
+// Subscribers in the same process as the publisher bypass the socket;
+// see local.go
+// An "inspect" request returns statistics; see inspect.go
 // We include typeName after command word for sanity checks.
 // Hence the message format is
 //	"request" topic
@@ -88,7 +93,7 @@ func updatersAdd(updater chan notify, name string, instance int) {
 
 func updatersRemove(updater chan notify) {
 	updaterList.lock.Lock()
-	servers := make([]notifyName, len(updaterList.servers))
+	servers := make([]notifyName, 0, len(updaterList.servers))
 	found := false
 	for _, old := range updaterList.servers {
 		if old.ch == updater {
@@ -149,6 +154,7 @@ type Publication struct {
 	publishToDir bool // Handle special case of file only info
 	dirName      string
 	persistent   bool
+	stats        pubStats // For inspect
 }
 
 func Publish(agentName string, topicType interface{}) (*Publication, error) {
@@ -232,6 +238,7 @@ func publishImpl(agentName string, agentScope string,
 		pub.listener = s
 		go pub.publisher()
 	}
+	localPublicationAdd(pub)
 	return pub, nil
 }
 
@@ -323,17 +330,45 @@ func (pub *Publication) serveConnection(s net.Conn, instance int) {
 
 	request := strings.Split(string(buf[0:res]), " ")
 	log.Infof("serveConnection read %d: %v\n", len(request), request)
-	if len(request) != 2 || request[0] != "request" || request[1] != pub.topic {
+	if len(request) == 2 && request[0] == "inspect" &&
+		request[1] == pub.topic {
+		if err := pub.sendInfo(s); err != nil {
+			log.Errorf("serveConnection(%s/%d) sendInfo failed %s\n",
+				name, instance, err)
+		}
+		return
+	}
+	if (len(request) != 2 && len(request) != 4) ||
+		request[0] != "request" || request[1] != pub.topic {
 		log.Errorf("Invalid request message: %v\n", request)
 		return
 	}
-
-	_, err = s.Write([]byte(fmt.Sprintf("hello %s", pub.topic)))
+	pub.noteSubscriber(1)
+	defer pub.noteSubscriber(-1)
+	conn := &pubConn{sock: s}
+	hello := fmt.Sprintf("hello %s", pub.topic)
+	// Newer subscribers ask for the binary protocol
+	if len(request) == 4 && request[2] == protocolVersion {
+		conn.binary = true
+		conn.compress = (request[3] == compressionZlib)
+		compression := compressionNone
+		if conn.compress {
+			compression = compressionZlib
+		}
+		hello = fmt.Sprintf("hello %s %s %s", pub.topic,
+			protocolVersion, compression)
+	}
+	_, err = s.Write([]byte(hello))
 	if err != nil {
 		log.Errorf("serveConnection(%s/%d) failed %s\n",
 			name, instance, err)
 		return
 	}
+	if conn.binary {
+		conn.ackCh = make(chan struct{}, 1)
+		conn.ackDone = make(chan struct{})
+		go conn.readAcks(name, instance)
+	}
 	// Insert our notification channel before we get the initial
 	// snapshot to avoid missing any updates/deletes.
 	updater := make(chan notify, 1)
@@ -345,20 +380,22 @@ func (pub *Publication) serveConnection(s net.Conn, instance int) {
 	keys := pub.determineDiffs(sendToPeer)
 
 	// Send the keys we just determined; all since this is the initial
-	err = pub.serialize(s, keys, sendToPeer)
+	err = pub.serialize(conn, keys, sendToPeer)
 	if err != nil {
 		log.Errorf("serveConnection(%s/%d) serialize failed %s\n",
 			name, instance, err)
 		return
 	}
-	err = pub.sendComplete(s)
+	err = pub.sendComplete(conn)
 	if err != nil {
 		log.Errorf("serveConnection(%s/%d) sendComplete failed %s\n",
 			name, instance, err)
 		return
 	}
-	if pub.km.restarted && !sentRestarted {
-		err = pub.sendRestarted(s)
+	pub.noteSynchronized(1)
+	defer pub.noteSynchronized(-1)
+	if pub.isRestarted() && !sentRestarted {
+		err = pub.sendRestarted(conn)
 		if err != nil {
 			log.Errorf("serveConnection(%s/%d) sendRestarted failed %s\n",
 				name, instance, err)
@@ -381,15 +418,15 @@ func (pub *Publication) serveConnection(s net.Conn, instance int) {
 		keys := pub.determineDiffs(sendToPeer)
 
 		// Send the updates and deletes for those keys
-		err = pub.serialize(s, keys, sendToPeer)
+		err = pub.serialize(conn, keys, sendToPeer)
 		if err != nil {
 			log.Errorf("serveConnection(%s/%d) serialize failed %s\n",
 				name, instance, err)
 			return
 		}
 
-		if pub.km.restarted && !sentRestarted {
-			err = pub.sendRestarted(s)
+		if pub.isRestarted() && !sentRestarted {
+			err = pub.sendRestarted(conn)
 			if err != nil {
 				log.Errorf("serveConnection(%s/%d) sendRestarted failed %s\n",
 					name, instance, err)
@@ -528,6 +565,7 @@ func (pub *Publication) Publish(key string, item interface{}) error {
 		log.Debugf("Publish(%s/%s) adding %+v\n", name, key, newItem)
 	}
 	pub.km.key.Store(key, newItem)
+	pub.noteUpdate()
 
 	if log.GetLevel() == log.DebugLevel {
 		pub.dump("after Publish")
@@ -542,11 +580,7 @@ func (pub *Publication) Publish(key string, item interface{}) error {
 	if err != nil {
 		log.Fatal("json Marshal in Publish", err)
 	}
-	err = WriteRename(fileName, b)
-	if err != nil {
-		return err
-	}
-	return nil
+	return WriteRename(fileName, b)
 }
 
 func WriteRename(fileName string, b []byte) error {
@@ -602,6 +636,7 @@ func (pub *Publication) Unpublish(key string) error {
 		return errors.New(errStr)
 	}
 	pub.km.key.Delete(key)
+	pub.noteUpdate()
 	if log.GetLevel() == log.DebugLevel {
 		pub.dump("after Unpublish")
 	}
@@ -633,12 +668,15 @@ func (pub *Publication) restartImpl(restarted bool) error {
 	name := pub.nameString()
 	log.Debugf("pub.restartImpl(%s, %v)\n", name, restarted)
 
-	if restarted == pub.km.restarted {
+	pub.stats.lock.Lock()
+	unchanged := (restarted == pub.km.restarted)
+	pub.km.restarted = restarted
+	pub.stats.lock.Unlock()
+	if unchanged {
 		log.Debugf("pub.restartImpl(%s, %v) value unchanged\n",
 			name, restarted)
 		return nil
 	}
-	pub.km.restarted = restarted
 	if restarted {
 		// XXX lock on restarted to make sure it gets noticed?
 		// Implicit in updaters lock??
@@ -664,7 +702,7 @@ func (pub *Publication) restartImpl(restarted bool) error {
 	return nil
 }
 
-func (pub *Publication) serialize(sock net.Conn, keys []string,
+func (pub *Publication) serialize(conn *pubConn, keys []string,
 	sendToPeer localCollection) error {
 
 	name := pub.nameString()
@@ -673,14 +711,14 @@ func (pub *Publication) serialize(sock net.Conn, keys []string,
 	for _, key := range keys {
 		val, ok := sendToPeer[key]
 		if ok {
-			err := pub.sendUpdate(sock, key, val)
+			err := pub.sendUpdate(conn, key, val)
 			if err != nil {
 				log.Errorf("serialize(%s) sendUpdate failed %s\n",
 					name, err)
 				return err
 			}
 		} else {
-			err := pub.sendDelete(sock, key)
+			err := pub.sendDelete(conn, key)
 			if err != nil {
 				log.Errorf("serialize(%s) sendDelete failed %s\n",
 					name, err)
@@ -691,7 +729,7 @@ func (pub *Publication) serialize(sock net.Conn, keys []string,
 	return nil
 }
 
-func (pub *Publication) sendUpdate(sock net.Conn, key string,
+func (pub *Publication) sendUpdate(conn *pubConn, key string,
 	val interface{}) error {
 
 	log.Debugf("sendUpdate(%s): key %s\n", pub.nameString(), key)
@@ -699,35 +737,49 @@ func (pub *Publication) sendUpdate(sock net.Conn, key string,
 	if err != nil {
 		log.Fatal("json Marshal in sendUpdate", err)
 	}
+	if conn.binary {
+		val, flags := compressValue(b, conn.compress)
+		return conn.send(frame{op: frameUpdate, flags: flags,
+			key: []byte(key), val: val})
+	}
 	// base64-encode to avoid having spaces in the key and val
 	sendKey := base64.StdEncoding.EncodeToString([]byte(key))
 	sendVal := base64.StdEncoding.EncodeToString(b)
-	_, err = sock.Write([]byte(fmt.Sprintf("update %s %s %s",
+	_, err = conn.sock.Write([]byte(fmt.Sprintf("update %s %s %s",
 		pub.topic, sendKey, sendVal)))
 	return err
 }
 
-func (pub *Publication) sendDelete(sock net.Conn, key string) error {
+func (pub *Publication) sendDelete(conn *pubConn, key string) error {
 
 	log.Debugf("sendDelete(%s): key %s\n", pub.nameString(), key)
+	if conn.binary {
+		return conn.send(frame{op: frameDelete, key: []byte(key)})
+	}
 	// base64-encode to avoid having spaces in the key
 	sendKey := base64.StdEncoding.EncodeToString([]byte(key))
-	_, err := sock.Write([]byte(fmt.Sprintf("delete %s %s",
+	_, err := conn.sock.Write([]byte(fmt.Sprintf("delete %s %s",
 		pub.topic, sendKey)))
 	return err
 }
 
-func (pub *Publication) sendRestarted(sock net.Conn) error {
+func (pub *Publication) sendRestarted(conn *pubConn) error {
 
 	log.Debugf("sendRestarted(%s)\n", pub.nameString())
-	_, err := sock.Write([]byte(fmt.Sprintf("restarted %s", pub.topic)))
+	if conn.binary {
+		return conn.send(frame{op: frameRestarted})
+	}
+	_, err := conn.sock.Write([]byte(fmt.Sprintf("restarted %s", pub.topic)))
 	return err
 }
 
-func (pub *Publication) sendComplete(sock net.Conn) error {
+func (pub *Publication) sendComplete(conn *pubConn) error {
 
 	log.Debugf("sendComplete(%s)\n", pub.nameString())
-	_, err := sock.Write([]byte(fmt.Sprintf("complete %s", pub.topic)))
+	if conn.binary {
+		return conn.send(frame{op: frameComplete})
+	}
+	_, err := conn.sock.Write([]byte(fmt.Sprintf("complete %s", pub.topic)))
 	return err
 }
 
@@ -802,6 +854,8 @@ type Subscription struct {
 
 	// Private fields
 	sendChan   chan<- string
+	done       chan struct{} // Closed by Close
+	closeOnce  *sync.Once
 	topicType  interface{}
 	agentName  string
 	agentScope string
@@ -810,6 +864,13 @@ type Subscription struct {
 	userCtx    interface{}
 	sock       net.Conn // For socket subscriptions
 
+	// Binary protocol state for socket subscriptions
+	textOnly bool // Publisher does not support binary
+	gotHello bool
+	binary   bool
+	lastSeq  uint32
+	unacked  int
+
 	synchronized     bool
 	subscribeFromDir bool // Handle special case of file only info
 	dirName          string
@@ -856,11 +917,20 @@ func SubscribePersistent(agentName string, topicType interface{}, activate bool,
 func subscribeImpl(agentName string, agentScope string, topicType interface{},
 	activate bool, ctx interface{}, persistent bool) (*Subscription, error) {
 
-	topic := TypeToName(topicType)
+	return subscribeTopic(agentName, agentScope, TypeToName(topicType),
+		topicType, activate, ctx, persistent)
+}
+
+func subscribeTopic(agentName string, agentScope string, topic string,
+	topicType interface{}, activate bool, ctx interface{},
+	persistent bool) (*Subscription, error) {
+
 	changes := make(chan string)
 	sub := new(Subscription)
 	sub.C = changes
 	sub.sendChan = changes
+	sub.done = make(chan struct{})
+	sub.closeOnce = new(sync.Once)
 	sub.topicType = topicType
 	sub.agentName = agentName
 	sub.agentScope = agentScope
@@ -923,39 +993,102 @@ func (sub *Subscription) Activate() error {
 	}
 }
 
+// Close stops sending changes on C unless the subscription reads from a
+// directory. The collection is kept hence Get and GetAll can still be used.
+func (sub *Subscription) Close() {
+	log.Infof("Close(%s)\n", sub.nameString())
+	sub.closeOnce.Do(func() { close(sub.done) })
+}
+
+// send returns false if the subscription was closed
+func (sub *Subscription) send(change string) bool {
+	select {
+	case sub.sendChan <- change:
+		return true
+	case <-sub.done:
+		return false
+	}
+}
+
 func (sub *Subscription) watchSock() {
 
 	for {
+		if sub.sock == nil {
+			pub := lookupLocalPublication(sub.nameString())
+			if pub != nil {
+				// Only returns when closed
+				sub.watchLocal(pub)
+				return
+			}
+		}
 		msg, key, val := sub.connectAndRead()
+		var change string
 		switch msg {
-		case "hello":
+		case "hello", "local":
 			// Do nothing
 		case "complete":
 			// XXX to handle restart we need to handle "complete"
 			// by doing a sweep across the KeyMap to handleDelete
 			// what we didn't see before the "complete"
-			sub.sendChan <- "C done"
+			change = "C done"
 
 		case "restarted":
-			sub.sendChan <- "R done"
+			change = "R done"
 
 		case "delete":
-			sub.sendChan <- "D " + key
+			if sub.binary {
+				change = "X " + key
+			} else {
+				change = "D " + key
+			}
 
 		case "update":
 			// XXX is size of val any issue? pointer?
-			sub.sendChan <- "M " + key + " " + val
+			if sub.binary {
+				change = fmt.Sprintf("U %d %s%s",
+					len(key), key, val)
+			} else {
+				change = "M " + key + " " + val
+			}
+		}
+		if change != "" && !sub.send(change) {
+			log.Infof("watchSock(%s) closed\n", sub.nameString())
+			return
+		}
+		if sub.binary && msg != "hello" && msg != "local" {
+			sub.ackFrame(msg == "complete")
 		}
 	}
 }
 
+// ackFrame tells the publisher we have delivered the frames up to lastSeq.
+// Done every ackEvery frames unless forced.
+func (sub *Subscription) ackFrame(force bool) {
+	sub.unacked++
+	if sub.sock == nil || (sub.unacked < ackEvery && !force) {
+		return
+	}
+	err := writeFrame(sub.sock, frame{op: frameAck, seq: sub.lastSeq})
+	if err != nil {
+		errStr := fmt.Sprintf("ackFrame(%s): sock write failed %s",
+			sub.nameString(), err)
+		log.Errorln(errStr)
+		sub.sock.Close()
+		sub.sock = nil
+		return
+	}
+	sub.unacked = 0
+}
+
 // Returns msg, key, val
-// key and val are base64-encoded
+// Returns "local" if the publication appeared in this process.
+// For the text protocol key and val are base64-encoded. For the binary
+// protocol they are the key and the json value.
 func (sub *Subscription) connectAndRead() (string, string, string) {
 
 	name := sub.nameString()
 	sockName := SockName(name)
-	buf := make([]byte, 65536)
+	buf := make([]byte, maxPacketSize)
 
 	// Waiting for publisher to appear; retry on error
 	for {
@@ -966,10 +1099,21 @@ func (sub *Subscription) connectAndRead() (string, string, string) {
 					name, err)
 				log.Warnln(errStr)
 				time.Sleep(10 * time.Second)
+				if lookupLocalPublication(name) != nil {
+					return "local", "", ""
+				}
 				continue
 			}
 			sub.sock = s
+			sub.gotHello = false
+			sub.binary = false
+			sub.lastSeq = 0
+			sub.unacked = 0
 			req := fmt.Sprintf("request %s", sub.topic)
+			if !sub.textOnly {
+				req = fmt.Sprintf("request %s %s %s", sub.topic,
+					protocolVersion, compressionZlib)
+			}
 			_, err = s.Write([]byte(req))
 			if err != nil {
 				errStr := fmt.Sprintf("connectAndRead(%s): sock write failed %s",
@@ -981,6 +1125,22 @@ func (sub *Subscription) connectAndRead() (string, string, string) {
 			}
 		}
 
+		if sub.binary {
+			msg, key, val, err := sub.readFrame(buf)
+			if err != nil {
+				errStr := fmt.Sprintf("connectAndRead(%s): frame read failed %s",
+					name, err)
+				log.Errorln(errStr)
+				sub.sock.Close()
+				sub.sock = nil
+				continue
+			}
+			if msg == "" {
+				continue
+			}
+			return msg, key, val
+		}
+
 		res, err := sub.sock.Read(buf)
 		if err != nil {
 			errStr := fmt.Sprintf("connectAndRead(%s): sock read failed %s",
@@ -988,14 +1148,14 @@ func (sub *Subscription) connectAndRead() (string, string, string) {
 			log.Errorln(errStr)
 			sub.sock.Close()
 			sub.sock = nil
+			if err == io.EOF && !sub.gotHello && !sub.textOnly {
+				log.Warnf("connectAndRead(%s): publisher does not support protocol %s; using text\n",
+					name, protocolVersion)
+				sub.textOnly = true
+			}
 			continue
 		}
 
-		if res == len(buf) {
-			// Likely truncated
-			log.Fatalf("connectAndRead(%s) request likely truncated\n",
-				name)
-		}
 		reply := strings.Split(string(buf[0:res]), " ")
 		count := len(reply)
 		if count < 2 {
@@ -1017,7 +1177,18 @@ func (sub *Subscription) connectAndRead() (string, string, string) {
 		// XXX are there error cases where we should Close and
 		// continue aka reconnect?
 		switch msg {
-		case "hello", "restarted", "complete":
+		case "hello":
+			log.Debugf("connectAndRead(%s) Got message %s type %s\n",
+				name, msg, t)
+			sub.gotHello = true
+			if count >= 4 && reply[2] == protocolVersion {
+				log.Infof("connectAndRead(%s) using protocol %s compression %s\n",
+					name, reply[2], reply[3])
+				sub.binary = true
+			}
+			return msg, "", ""
+
+		case "restarted", "complete":
 			log.Debugf("connectAndRead(%s) Got message %s type %s\n",
 				name, msg, t)
 			return msg, "", ""
@@ -1088,6 +1259,44 @@ func (sub *Subscription) connectAndRead() (string, string, string) {
 	}
 }
 
+// readFrame reads one binary frame and returns it in the form of
+// connectAndRead. Returns an empty msg for frames to skip
+func (sub *Subscription) readFrame(buf []byte) (string, string, string, error) {
+
+	name := sub.nameString()
+	f, err := readFrame(sub.sock, buf)
+	if err != nil {
+		return "", "", "", err
+	}
+	sub.lastSeq = f.seq
+	switch f.op {
+	case frameComplete:
+		return "complete", "", "", nil
+	case frameRestarted:
+		return "restarted", "", "", nil
+	case frameDelete:
+		log.Debugf("connectAndRead(%s): delete key %s\n",
+			name, string(f.key))
+		return "delete", string(f.key), "", nil
+	case frameUpdate:
+		val, err := decompressValue(f)
+		if err != nil {
+			errStr := fmt.Sprintf("connectAndRead(%s): decompress failed %s",
+				name, err)
+			log.Errorln(errStr)
+			return "", "", "", nil
+		}
+		log.Debugf("connectAndRead(%s): update key %s val %s\n",
+			name, string(f.key), string(val))
+		return "update", string(f.key), string(val), nil
+	default:
+		errStr := fmt.Sprintf("connectAndRead(%s): unknown op %d",
+			name, f.op)
+		log.Errorln(errStr)
+		return "", "", "", nil
+	}
+}
+
 // We handle both subscribeFromDir and subscribeFromSock
 // Note that change filename includes .json for subscribeFromDir. That
 // is removed by HandleStatusEvent.
@@ -1146,6 +1355,32 @@ func (sub *Subscription) ProcessChange(change string) {
 				return
 			}
 			handleModify(sub, string(key), output)
+
+		// Binary protocol; key and val are not encoded
+		case "X":
+			handleDelete(sub, change[2:])
+
+		case "U":
+			// "U" keylen key+json
+			var keyLen int
+			_, err := fmt.Sscanf(reply[1], "%d", &keyLen)
+			start := len("U ") + len(reply[1]) + 1
+			if err != nil || start+keyLen > len(change) {
+				errStr := fmt.Sprintf("ProcessChange(%s): bad update %s",
+					name, reply[1])
+				log.Errorln(errStr)
+				return
+			}
+			key := change[start : start+keyLen]
+			val := change[start+keyLen:]
+			var output interface{}
+			if err := json.Unmarshal([]byte(val), &output); err != nil {
+				errStr := fmt.Sprintf("ProcessChange(%s): json failed %s",
+					name, err)
+				log.Errorln(errStr)
+				return
+			}
+			handleModify(sub, key, output)
 		}
 	} else {
 		// Enforced in Subscribe()
@@ -0,0 +1,247 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// +build ignore
+
+// Generates topics_generated.go with the typed wrappers for each topic.
+// Run using "go generate" in this directory.
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"text/template"
+)
+
+const outputFile = "topics_generated.go"
+
+// The types in the types package which are used as pubsub topics
+var topics = []string{
+	"AppInstanceConfig",
+	"AppInstanceStatus",
+	"AppNetworkConfig",
+	"AppNetworkStatus",
+	"AssignableAdapters",
+	"BaseOsConfig",
+	"BaseOsStatus",
+	"CertObjConfig",
+	"CertObjStatus",
+	"DatastoreConfig",
+	"DeviceNetworkConfig",
+	"DeviceNetworkStatus",
+	"DevicePortConfig",
+	"DevicePortConfigList",
+	"DomainConfig",
+	"DomainStatus",
+	"DownloaderConfig",
+	"DownloaderStatus",
+	"EIDConfig",
+	"EIDStatus",
+	"GlobalConfig",
+	"GlobalDownloadConfig",
+	"GlobalDownloadStatus",
+	"IPFlow",
+	"ImageStatus",
+	"LedBlinkCounter",
+	"LispDataplaneConfig",
+	"LispInfoStatus",
+	"LispMetrics",
+	"NetworkInstanceConfig",
+	"NetworkInstanceMetrics",
+	"NetworkInstanceStatus",
+	"NetworkMetrics",
+	"NetworkXObjectConfig",
+	"UuidToNum",
+	"VerifyImageConfig",
+	"VerifyImageStatus",
+	"ZbootConfig",
+	"ZbootStatus",
+}
+
+var header = `// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by generate.go; DO NOT EDIT.
+
+package typedpubsub
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+`
+
+var topicTemplate = template.Must(template.New("topic").Parse(`
+// {{.}}Publication is a pubsub.Publication of types.{{.}}
+type {{.}}Publication struct {
+	*pubsub.Publication
+}
+
+// Publish{{.}} creates a publication of types.{{.}}
+func Publish{{.}}(agentName string) (*{{.}}Publication, error) {
+	pub, err := pubsub.Publish(agentName, types.{{.}}{})
+	if err != nil {
+		return nil, err
+	}
+	return &{{.}}Publication{Publication: pub}, nil
+}
+
+// Publish{{.}}Scope creates a scoped publication of types.{{.}}
+func Publish{{.}}Scope(agentName string, agentScope string) (*{{.}}Publication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.{{.}}{})
+	if err != nil {
+		return nil, err
+	}
+	return &{{.}}Publication{Publication: pub}, nil
+}
+
+// Publish{{.}}Persistent creates a persistent publication of types.{{.}}
+func Publish{{.}}Persistent(agentName string) (*{{.}}Publication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.{{.}}{})
+	if err != nil {
+		return nil, err
+	}
+	return &{{.}}Publication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *{{.}}Publication) Publish(key string, item types.{{.}}) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *{{.}}Publication) Get(key string) (types.{{.}}, error) {
+	var item types.{{.}}
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *{{.}}Publication) GetAll() map[string]types.{{.}} {
+	result := make(map[string]types.{{.}})
+	for key, m := range pub.Publication.GetAll() {
+		var item types.{{.}}
+		if err := decode(m, &item); err != nil {
+			log.Errorf("{{.}}Publication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// {{.}}Handler is called with the item for create, modify and delete
+type {{.}}Handler func(ctx interface{}, key string, item types.{{.}})
+
+// {{.}}Subscription is a pubsub.Subscription of types.{{.}}
+type {{.}}Subscription struct {
+	*pubsub.Subscription
+}
+
+// Subscribe{{.}} subscribes to types.{{.}} from agentName
+func Subscribe{{.}}(agentName string, activate bool, ctx interface{}) (*{{.}}Subscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.{{.}}{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.}}Subscription{Subscription: sub}, nil
+}
+
+// Subscribe{{.}}Scope subscribes to types.{{.}} from agentName/agentScope
+func Subscribe{{.}}Scope(agentName string, agentScope string, activate bool, ctx interface{}) (*{{.}}Subscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.{{.}}{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.}}Subscription{Subscription: sub}, nil
+}
+
+// Subscribe{{.}}Persistent subscribes to the persistent types.{{.}} from agentName
+func Subscribe{{.}}Persistent(agentName string, activate bool, ctx interface{}) (*{{.}}Subscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.{{.}}{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.}}Subscription{Subscription: sub}, nil
+}
+
+func wrap{{.}}Handler(op string, fn {{.}}Handler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.{{.}}
+		if err := decode(m, &item); err != nil {
+			log.Errorf("{{.}}Subscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *{{.}}Subscription) SetCreateHandler(fn {{.}}Handler) {
+	sub.CreateHandler = wrap{{.}}Handler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *{{.}}Subscription) SetModifyHandler(fn {{.}}Handler) {
+	sub.ModifyHandler = wrap{{.}}Handler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *{{.}}Subscription) SetDeleteHandler(fn {{.}}Handler) {
+	sub.DeleteHandler = wrap{{.}}Handler("delete", fn)
+}
+
+// Get the item for key
+func (sub *{{.}}Subscription) Get(key string) (types.{{.}}, error) {
+	var item types.{{.}}
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *{{.}}Subscription) GetAll() map[string]types.{{.}} {
+	result := make(map[string]types.{{.}})
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.{{.}}
+		if err := decode(m, &item); err != nil {
+			log.Errorf("{{.}}Subscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+`))
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, topic := range topics {
+		if err := topicTemplate.Execute(&buf, topic); err != nil {
+			log.Fatal(err)
+		}
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(outputFile, out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,5940 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by generate.go; DO NOT EDIT.
+
+package typedpubsub
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// AppInstanceConfigPublication is a pubsub.Publication of types.AppInstanceConfig
+type AppInstanceConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishAppInstanceConfig creates a publication of types.AppInstanceConfig
+func PublishAppInstanceConfig(agentName string) (*AppInstanceConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.AppInstanceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceConfigPublication{Publication: pub}, nil
+}
+
+// PublishAppInstanceConfigScope creates a scoped publication of types.AppInstanceConfig
+func PublishAppInstanceConfigScope(agentName string, agentScope string) (*AppInstanceConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.AppInstanceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceConfigPublication{Publication: pub}, nil
+}
+
+// PublishAppInstanceConfigPersistent creates a persistent publication of types.AppInstanceConfig
+func PublishAppInstanceConfigPersistent(agentName string) (*AppInstanceConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.AppInstanceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *AppInstanceConfigPublication) Publish(key string, item types.AppInstanceConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *AppInstanceConfigPublication) Get(key string) (types.AppInstanceConfig, error) {
+	var item types.AppInstanceConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *AppInstanceConfigPublication) GetAll() map[string]types.AppInstanceConfig {
+	result := make(map[string]types.AppInstanceConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.AppInstanceConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppInstanceConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppInstanceConfigHandler is called with the item for create, modify and delete
+type AppInstanceConfigHandler func(ctx interface{}, key string, item types.AppInstanceConfig)
+
+// AppInstanceConfigSubscription is a pubsub.Subscription of types.AppInstanceConfig
+type AppInstanceConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeAppInstanceConfig subscribes to types.AppInstanceConfig from agentName
+func SubscribeAppInstanceConfig(agentName string, activate bool, ctx interface{}) (*AppInstanceConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.AppInstanceConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppInstanceConfigScope subscribes to types.AppInstanceConfig from agentName/agentScope
+func SubscribeAppInstanceConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*AppInstanceConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.AppInstanceConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppInstanceConfigPersistent subscribes to the persistent types.AppInstanceConfig from agentName
+func SubscribeAppInstanceConfigPersistent(agentName string, activate bool, ctx interface{}) (*AppInstanceConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.AppInstanceConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapAppInstanceConfigHandler(op string, fn AppInstanceConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.AppInstanceConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppInstanceConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *AppInstanceConfigSubscription) SetCreateHandler(fn AppInstanceConfigHandler) {
+	sub.CreateHandler = wrapAppInstanceConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *AppInstanceConfigSubscription) SetModifyHandler(fn AppInstanceConfigHandler) {
+	sub.ModifyHandler = wrapAppInstanceConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *AppInstanceConfigSubscription) SetDeleteHandler(fn AppInstanceConfigHandler) {
+	sub.DeleteHandler = wrapAppInstanceConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *AppInstanceConfigSubscription) Get(key string) (types.AppInstanceConfig, error) {
+	var item types.AppInstanceConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *AppInstanceConfigSubscription) GetAll() map[string]types.AppInstanceConfig {
+	result := make(map[string]types.AppInstanceConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.AppInstanceConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppInstanceConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppInstanceStatusPublication is a pubsub.Publication of types.AppInstanceStatus
+type AppInstanceStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishAppInstanceStatus creates a publication of types.AppInstanceStatus
+func PublishAppInstanceStatus(agentName string) (*AppInstanceStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.AppInstanceStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceStatusPublication{Publication: pub}, nil
+}
+
+// PublishAppInstanceStatusScope creates a scoped publication of types.AppInstanceStatus
+func PublishAppInstanceStatusScope(agentName string, agentScope string) (*AppInstanceStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.AppInstanceStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceStatusPublication{Publication: pub}, nil
+}
+
+// PublishAppInstanceStatusPersistent creates a persistent publication of types.AppInstanceStatus
+func PublishAppInstanceStatusPersistent(agentName string) (*AppInstanceStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.AppInstanceStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *AppInstanceStatusPublication) Publish(key string, item types.AppInstanceStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *AppInstanceStatusPublication) Get(key string) (types.AppInstanceStatus, error) {
+	var item types.AppInstanceStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *AppInstanceStatusPublication) GetAll() map[string]types.AppInstanceStatus {
+	result := make(map[string]types.AppInstanceStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.AppInstanceStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppInstanceStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppInstanceStatusHandler is called with the item for create, modify and delete
+type AppInstanceStatusHandler func(ctx interface{}, key string, item types.AppInstanceStatus)
+
+// AppInstanceStatusSubscription is a pubsub.Subscription of types.AppInstanceStatus
+type AppInstanceStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeAppInstanceStatus subscribes to types.AppInstanceStatus from agentName
+func SubscribeAppInstanceStatus(agentName string, activate bool, ctx interface{}) (*AppInstanceStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.AppInstanceStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppInstanceStatusScope subscribes to types.AppInstanceStatus from agentName/agentScope
+func SubscribeAppInstanceStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*AppInstanceStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.AppInstanceStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppInstanceStatusPersistent subscribes to the persistent types.AppInstanceStatus from agentName
+func SubscribeAppInstanceStatusPersistent(agentName string, activate bool, ctx interface{}) (*AppInstanceStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.AppInstanceStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppInstanceStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapAppInstanceStatusHandler(op string, fn AppInstanceStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.AppInstanceStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppInstanceStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *AppInstanceStatusSubscription) SetCreateHandler(fn AppInstanceStatusHandler) {
+	sub.CreateHandler = wrapAppInstanceStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *AppInstanceStatusSubscription) SetModifyHandler(fn AppInstanceStatusHandler) {
+	sub.ModifyHandler = wrapAppInstanceStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *AppInstanceStatusSubscription) SetDeleteHandler(fn AppInstanceStatusHandler) {
+	sub.DeleteHandler = wrapAppInstanceStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *AppInstanceStatusSubscription) Get(key string) (types.AppInstanceStatus, error) {
+	var item types.AppInstanceStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *AppInstanceStatusSubscription) GetAll() map[string]types.AppInstanceStatus {
+	result := make(map[string]types.AppInstanceStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.AppInstanceStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppInstanceStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppNetworkConfigPublication is a pubsub.Publication of types.AppNetworkConfig
+type AppNetworkConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishAppNetworkConfig creates a publication of types.AppNetworkConfig
+func PublishAppNetworkConfig(agentName string) (*AppNetworkConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.AppNetworkConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkConfigPublication{Publication: pub}, nil
+}
+
+// PublishAppNetworkConfigScope creates a scoped publication of types.AppNetworkConfig
+func PublishAppNetworkConfigScope(agentName string, agentScope string) (*AppNetworkConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.AppNetworkConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkConfigPublication{Publication: pub}, nil
+}
+
+// PublishAppNetworkConfigPersistent creates a persistent publication of types.AppNetworkConfig
+func PublishAppNetworkConfigPersistent(agentName string) (*AppNetworkConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.AppNetworkConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *AppNetworkConfigPublication) Publish(key string, item types.AppNetworkConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *AppNetworkConfigPublication) Get(key string) (types.AppNetworkConfig, error) {
+	var item types.AppNetworkConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *AppNetworkConfigPublication) GetAll() map[string]types.AppNetworkConfig {
+	result := make(map[string]types.AppNetworkConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.AppNetworkConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppNetworkConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppNetworkConfigHandler is called with the item for create, modify and delete
+type AppNetworkConfigHandler func(ctx interface{}, key string, item types.AppNetworkConfig)
+
+// AppNetworkConfigSubscription is a pubsub.Subscription of types.AppNetworkConfig
+type AppNetworkConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeAppNetworkConfig subscribes to types.AppNetworkConfig from agentName
+func SubscribeAppNetworkConfig(agentName string, activate bool, ctx interface{}) (*AppNetworkConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.AppNetworkConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppNetworkConfigScope subscribes to types.AppNetworkConfig from agentName/agentScope
+func SubscribeAppNetworkConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*AppNetworkConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.AppNetworkConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppNetworkConfigPersistent subscribes to the persistent types.AppNetworkConfig from agentName
+func SubscribeAppNetworkConfigPersistent(agentName string, activate bool, ctx interface{}) (*AppNetworkConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.AppNetworkConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapAppNetworkConfigHandler(op string, fn AppNetworkConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.AppNetworkConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppNetworkConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *AppNetworkConfigSubscription) SetCreateHandler(fn AppNetworkConfigHandler) {
+	sub.CreateHandler = wrapAppNetworkConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *AppNetworkConfigSubscription) SetModifyHandler(fn AppNetworkConfigHandler) {
+	sub.ModifyHandler = wrapAppNetworkConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *AppNetworkConfigSubscription) SetDeleteHandler(fn AppNetworkConfigHandler) {
+	sub.DeleteHandler = wrapAppNetworkConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *AppNetworkConfigSubscription) Get(key string) (types.AppNetworkConfig, error) {
+	var item types.AppNetworkConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *AppNetworkConfigSubscription) GetAll() map[string]types.AppNetworkConfig {
+	result := make(map[string]types.AppNetworkConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.AppNetworkConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppNetworkConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppNetworkStatusPublication is a pubsub.Publication of types.AppNetworkStatus
+type AppNetworkStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishAppNetworkStatus creates a publication of types.AppNetworkStatus
+func PublishAppNetworkStatus(agentName string) (*AppNetworkStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.AppNetworkStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkStatusPublication{Publication: pub}, nil
+}
+
+// PublishAppNetworkStatusScope creates a scoped publication of types.AppNetworkStatus
+func PublishAppNetworkStatusScope(agentName string, agentScope string) (*AppNetworkStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.AppNetworkStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkStatusPublication{Publication: pub}, nil
+}
+
+// PublishAppNetworkStatusPersistent creates a persistent publication of types.AppNetworkStatus
+func PublishAppNetworkStatusPersistent(agentName string) (*AppNetworkStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.AppNetworkStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *AppNetworkStatusPublication) Publish(key string, item types.AppNetworkStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *AppNetworkStatusPublication) Get(key string) (types.AppNetworkStatus, error) {
+	var item types.AppNetworkStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *AppNetworkStatusPublication) GetAll() map[string]types.AppNetworkStatus {
+	result := make(map[string]types.AppNetworkStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.AppNetworkStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppNetworkStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AppNetworkStatusHandler is called with the item for create, modify and delete
+type AppNetworkStatusHandler func(ctx interface{}, key string, item types.AppNetworkStatus)
+
+// AppNetworkStatusSubscription is a pubsub.Subscription of types.AppNetworkStatus
+type AppNetworkStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeAppNetworkStatus subscribes to types.AppNetworkStatus from agentName
+func SubscribeAppNetworkStatus(agentName string, activate bool, ctx interface{}) (*AppNetworkStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.AppNetworkStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppNetworkStatusScope subscribes to types.AppNetworkStatus from agentName/agentScope
+func SubscribeAppNetworkStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*AppNetworkStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.AppNetworkStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAppNetworkStatusPersistent subscribes to the persistent types.AppNetworkStatus from agentName
+func SubscribeAppNetworkStatusPersistent(agentName string, activate bool, ctx interface{}) (*AppNetworkStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.AppNetworkStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AppNetworkStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapAppNetworkStatusHandler(op string, fn AppNetworkStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.AppNetworkStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppNetworkStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *AppNetworkStatusSubscription) SetCreateHandler(fn AppNetworkStatusHandler) {
+	sub.CreateHandler = wrapAppNetworkStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *AppNetworkStatusSubscription) SetModifyHandler(fn AppNetworkStatusHandler) {
+	sub.ModifyHandler = wrapAppNetworkStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *AppNetworkStatusSubscription) SetDeleteHandler(fn AppNetworkStatusHandler) {
+	sub.DeleteHandler = wrapAppNetworkStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *AppNetworkStatusSubscription) Get(key string) (types.AppNetworkStatus, error) {
+	var item types.AppNetworkStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *AppNetworkStatusSubscription) GetAll() map[string]types.AppNetworkStatus {
+	result := make(map[string]types.AppNetworkStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.AppNetworkStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AppNetworkStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AssignableAdaptersPublication is a pubsub.Publication of types.AssignableAdapters
+type AssignableAdaptersPublication struct {
+	*pubsub.Publication
+}
+
+// PublishAssignableAdapters creates a publication of types.AssignableAdapters
+func PublishAssignableAdapters(agentName string) (*AssignableAdaptersPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.AssignableAdapters{})
+	if err != nil {
+		return nil, err
+	}
+	return &AssignableAdaptersPublication{Publication: pub}, nil
+}
+
+// PublishAssignableAdaptersScope creates a scoped publication of types.AssignableAdapters
+func PublishAssignableAdaptersScope(agentName string, agentScope string) (*AssignableAdaptersPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.AssignableAdapters{})
+	if err != nil {
+		return nil, err
+	}
+	return &AssignableAdaptersPublication{Publication: pub}, nil
+}
+
+// PublishAssignableAdaptersPersistent creates a persistent publication of types.AssignableAdapters
+func PublishAssignableAdaptersPersistent(agentName string) (*AssignableAdaptersPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.AssignableAdapters{})
+	if err != nil {
+		return nil, err
+	}
+	return &AssignableAdaptersPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *AssignableAdaptersPublication) Publish(key string, item types.AssignableAdapters) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *AssignableAdaptersPublication) Get(key string) (types.AssignableAdapters, error) {
+	var item types.AssignableAdapters
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *AssignableAdaptersPublication) GetAll() map[string]types.AssignableAdapters {
+	result := make(map[string]types.AssignableAdapters)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.AssignableAdapters
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AssignableAdaptersPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// AssignableAdaptersHandler is called with the item for create, modify and delete
+type AssignableAdaptersHandler func(ctx interface{}, key string, item types.AssignableAdapters)
+
+// AssignableAdaptersSubscription is a pubsub.Subscription of types.AssignableAdapters
+type AssignableAdaptersSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeAssignableAdapters subscribes to types.AssignableAdapters from agentName
+func SubscribeAssignableAdapters(agentName string, activate bool, ctx interface{}) (*AssignableAdaptersSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.AssignableAdapters{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AssignableAdaptersSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAssignableAdaptersScope subscribes to types.AssignableAdapters from agentName/agentScope
+func SubscribeAssignableAdaptersScope(agentName string, agentScope string, activate bool, ctx interface{}) (*AssignableAdaptersSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.AssignableAdapters{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AssignableAdaptersSubscription{Subscription: sub}, nil
+}
+
+// SubscribeAssignableAdaptersPersistent subscribes to the persistent types.AssignableAdapters from agentName
+func SubscribeAssignableAdaptersPersistent(agentName string, activate bool, ctx interface{}) (*AssignableAdaptersSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.AssignableAdapters{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AssignableAdaptersSubscription{Subscription: sub}, nil
+}
+
+func wrapAssignableAdaptersHandler(op string, fn AssignableAdaptersHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.AssignableAdapters
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AssignableAdaptersSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *AssignableAdaptersSubscription) SetCreateHandler(fn AssignableAdaptersHandler) {
+	sub.CreateHandler = wrapAssignableAdaptersHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *AssignableAdaptersSubscription) SetModifyHandler(fn AssignableAdaptersHandler) {
+	sub.ModifyHandler = wrapAssignableAdaptersHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *AssignableAdaptersSubscription) SetDeleteHandler(fn AssignableAdaptersHandler) {
+	sub.DeleteHandler = wrapAssignableAdaptersHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *AssignableAdaptersSubscription) Get(key string) (types.AssignableAdapters, error) {
+	var item types.AssignableAdapters
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *AssignableAdaptersSubscription) GetAll() map[string]types.AssignableAdapters {
+	result := make(map[string]types.AssignableAdapters)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.AssignableAdapters
+		if err := decode(m, &item); err != nil {
+			log.Errorf("AssignableAdaptersSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// BaseOsConfigPublication is a pubsub.Publication of types.BaseOsConfig
+type BaseOsConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishBaseOsConfig creates a publication of types.BaseOsConfig
+func PublishBaseOsConfig(agentName string) (*BaseOsConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.BaseOsConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsConfigPublication{Publication: pub}, nil
+}
+
+// PublishBaseOsConfigScope creates a scoped publication of types.BaseOsConfig
+func PublishBaseOsConfigScope(agentName string, agentScope string) (*BaseOsConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.BaseOsConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsConfigPublication{Publication: pub}, nil
+}
+
+// PublishBaseOsConfigPersistent creates a persistent publication of types.BaseOsConfig
+func PublishBaseOsConfigPersistent(agentName string) (*BaseOsConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.BaseOsConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *BaseOsConfigPublication) Publish(key string, item types.BaseOsConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *BaseOsConfigPublication) Get(key string) (types.BaseOsConfig, error) {
+	var item types.BaseOsConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *BaseOsConfigPublication) GetAll() map[string]types.BaseOsConfig {
+	result := make(map[string]types.BaseOsConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.BaseOsConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("BaseOsConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// BaseOsConfigHandler is called with the item for create, modify and delete
+type BaseOsConfigHandler func(ctx interface{}, key string, item types.BaseOsConfig)
+
+// BaseOsConfigSubscription is a pubsub.Subscription of types.BaseOsConfig
+type BaseOsConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeBaseOsConfig subscribes to types.BaseOsConfig from agentName
+func SubscribeBaseOsConfig(agentName string, activate bool, ctx interface{}) (*BaseOsConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.BaseOsConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeBaseOsConfigScope subscribes to types.BaseOsConfig from agentName/agentScope
+func SubscribeBaseOsConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*BaseOsConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.BaseOsConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeBaseOsConfigPersistent subscribes to the persistent types.BaseOsConfig from agentName
+func SubscribeBaseOsConfigPersistent(agentName string, activate bool, ctx interface{}) (*BaseOsConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.BaseOsConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapBaseOsConfigHandler(op string, fn BaseOsConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.BaseOsConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("BaseOsConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *BaseOsConfigSubscription) SetCreateHandler(fn BaseOsConfigHandler) {
+	sub.CreateHandler = wrapBaseOsConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *BaseOsConfigSubscription) SetModifyHandler(fn BaseOsConfigHandler) {
+	sub.ModifyHandler = wrapBaseOsConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *BaseOsConfigSubscription) SetDeleteHandler(fn BaseOsConfigHandler) {
+	sub.DeleteHandler = wrapBaseOsConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *BaseOsConfigSubscription) Get(key string) (types.BaseOsConfig, error) {
+	var item types.BaseOsConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *BaseOsConfigSubscription) GetAll() map[string]types.BaseOsConfig {
+	result := make(map[string]types.BaseOsConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.BaseOsConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("BaseOsConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// BaseOsStatusPublication is a pubsub.Publication of types.BaseOsStatus
+type BaseOsStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishBaseOsStatus creates a publication of types.BaseOsStatus
+func PublishBaseOsStatus(agentName string) (*BaseOsStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.BaseOsStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsStatusPublication{Publication: pub}, nil
+}
+
+// PublishBaseOsStatusScope creates a scoped publication of types.BaseOsStatus
+func PublishBaseOsStatusScope(agentName string, agentScope string) (*BaseOsStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.BaseOsStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsStatusPublication{Publication: pub}, nil
+}
+
+// PublishBaseOsStatusPersistent creates a persistent publication of types.BaseOsStatus
+func PublishBaseOsStatusPersistent(agentName string) (*BaseOsStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.BaseOsStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *BaseOsStatusPublication) Publish(key string, item types.BaseOsStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *BaseOsStatusPublication) Get(key string) (types.BaseOsStatus, error) {
+	var item types.BaseOsStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *BaseOsStatusPublication) GetAll() map[string]types.BaseOsStatus {
+	result := make(map[string]types.BaseOsStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.BaseOsStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("BaseOsStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// BaseOsStatusHandler is called with the item for create, modify and delete
+type BaseOsStatusHandler func(ctx interface{}, key string, item types.BaseOsStatus)
+
+// BaseOsStatusSubscription is a pubsub.Subscription of types.BaseOsStatus
+type BaseOsStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeBaseOsStatus subscribes to types.BaseOsStatus from agentName
+func SubscribeBaseOsStatus(agentName string, activate bool, ctx interface{}) (*BaseOsStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.BaseOsStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeBaseOsStatusScope subscribes to types.BaseOsStatus from agentName/agentScope
+func SubscribeBaseOsStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*BaseOsStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.BaseOsStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeBaseOsStatusPersistent subscribes to the persistent types.BaseOsStatus from agentName
+func SubscribeBaseOsStatusPersistent(agentName string, activate bool, ctx interface{}) (*BaseOsStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.BaseOsStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseOsStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapBaseOsStatusHandler(op string, fn BaseOsStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.BaseOsStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("BaseOsStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *BaseOsStatusSubscription) SetCreateHandler(fn BaseOsStatusHandler) {
+	sub.CreateHandler = wrapBaseOsStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *BaseOsStatusSubscription) SetModifyHandler(fn BaseOsStatusHandler) {
+	sub.ModifyHandler = wrapBaseOsStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *BaseOsStatusSubscription) SetDeleteHandler(fn BaseOsStatusHandler) {
+	sub.DeleteHandler = wrapBaseOsStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *BaseOsStatusSubscription) Get(key string) (types.BaseOsStatus, error) {
+	var item types.BaseOsStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *BaseOsStatusSubscription) GetAll() map[string]types.BaseOsStatus {
+	result := make(map[string]types.BaseOsStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.BaseOsStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("BaseOsStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// CertObjConfigPublication is a pubsub.Publication of types.CertObjConfig
+type CertObjConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishCertObjConfig creates a publication of types.CertObjConfig
+func PublishCertObjConfig(agentName string) (*CertObjConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.CertObjConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjConfigPublication{Publication: pub}, nil
+}
+
+// PublishCertObjConfigScope creates a scoped publication of types.CertObjConfig
+func PublishCertObjConfigScope(agentName string, agentScope string) (*CertObjConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.CertObjConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjConfigPublication{Publication: pub}, nil
+}
+
+// PublishCertObjConfigPersistent creates a persistent publication of types.CertObjConfig
+func PublishCertObjConfigPersistent(agentName string) (*CertObjConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.CertObjConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *CertObjConfigPublication) Publish(key string, item types.CertObjConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *CertObjConfigPublication) Get(key string) (types.CertObjConfig, error) {
+	var item types.CertObjConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *CertObjConfigPublication) GetAll() map[string]types.CertObjConfig {
+	result := make(map[string]types.CertObjConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.CertObjConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("CertObjConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// CertObjConfigHandler is called with the item for create, modify and delete
+type CertObjConfigHandler func(ctx interface{}, key string, item types.CertObjConfig)
+
+// CertObjConfigSubscription is a pubsub.Subscription of types.CertObjConfig
+type CertObjConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeCertObjConfig subscribes to types.CertObjConfig from agentName
+func SubscribeCertObjConfig(agentName string, activate bool, ctx interface{}) (*CertObjConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.CertObjConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeCertObjConfigScope subscribes to types.CertObjConfig from agentName/agentScope
+func SubscribeCertObjConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*CertObjConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.CertObjConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeCertObjConfigPersistent subscribes to the persistent types.CertObjConfig from agentName
+func SubscribeCertObjConfigPersistent(agentName string, activate bool, ctx interface{}) (*CertObjConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.CertObjConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapCertObjConfigHandler(op string, fn CertObjConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.CertObjConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("CertObjConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *CertObjConfigSubscription) SetCreateHandler(fn CertObjConfigHandler) {
+	sub.CreateHandler = wrapCertObjConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *CertObjConfigSubscription) SetModifyHandler(fn CertObjConfigHandler) {
+	sub.ModifyHandler = wrapCertObjConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *CertObjConfigSubscription) SetDeleteHandler(fn CertObjConfigHandler) {
+	sub.DeleteHandler = wrapCertObjConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *CertObjConfigSubscription) Get(key string) (types.CertObjConfig, error) {
+	var item types.CertObjConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *CertObjConfigSubscription) GetAll() map[string]types.CertObjConfig {
+	result := make(map[string]types.CertObjConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.CertObjConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("CertObjConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// CertObjStatusPublication is a pubsub.Publication of types.CertObjStatus
+type CertObjStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishCertObjStatus creates a publication of types.CertObjStatus
+func PublishCertObjStatus(agentName string) (*CertObjStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.CertObjStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjStatusPublication{Publication: pub}, nil
+}
+
+// PublishCertObjStatusScope creates a scoped publication of types.CertObjStatus
+func PublishCertObjStatusScope(agentName string, agentScope string) (*CertObjStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.CertObjStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjStatusPublication{Publication: pub}, nil
+}
+
+// PublishCertObjStatusPersistent creates a persistent publication of types.CertObjStatus
+func PublishCertObjStatusPersistent(agentName string) (*CertObjStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.CertObjStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *CertObjStatusPublication) Publish(key string, item types.CertObjStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *CertObjStatusPublication) Get(key string) (types.CertObjStatus, error) {
+	var item types.CertObjStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *CertObjStatusPublication) GetAll() map[string]types.CertObjStatus {
+	result := make(map[string]types.CertObjStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.CertObjStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("CertObjStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// CertObjStatusHandler is called with the item for create, modify and delete
+type CertObjStatusHandler func(ctx interface{}, key string, item types.CertObjStatus)
+
+// CertObjStatusSubscription is a pubsub.Subscription of types.CertObjStatus
+type CertObjStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeCertObjStatus subscribes to types.CertObjStatus from agentName
+func SubscribeCertObjStatus(agentName string, activate bool, ctx interface{}) (*CertObjStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.CertObjStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeCertObjStatusScope subscribes to types.CertObjStatus from agentName/agentScope
+func SubscribeCertObjStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*CertObjStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.CertObjStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeCertObjStatusPersistent subscribes to the persistent types.CertObjStatus from agentName
+func SubscribeCertObjStatusPersistent(agentName string, activate bool, ctx interface{}) (*CertObjStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.CertObjStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CertObjStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapCertObjStatusHandler(op string, fn CertObjStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.CertObjStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("CertObjStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *CertObjStatusSubscription) SetCreateHandler(fn CertObjStatusHandler) {
+	sub.CreateHandler = wrapCertObjStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *CertObjStatusSubscription) SetModifyHandler(fn CertObjStatusHandler) {
+	sub.ModifyHandler = wrapCertObjStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *CertObjStatusSubscription) SetDeleteHandler(fn CertObjStatusHandler) {
+	sub.DeleteHandler = wrapCertObjStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *CertObjStatusSubscription) Get(key string) (types.CertObjStatus, error) {
+	var item types.CertObjStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *CertObjStatusSubscription) GetAll() map[string]types.CertObjStatus {
+	result := make(map[string]types.CertObjStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.CertObjStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("CertObjStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DatastoreConfigPublication is a pubsub.Publication of types.DatastoreConfig
+type DatastoreConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDatastoreConfig creates a publication of types.DatastoreConfig
+func PublishDatastoreConfig(agentName string) (*DatastoreConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DatastoreConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DatastoreConfigPublication{Publication: pub}, nil
+}
+
+// PublishDatastoreConfigScope creates a scoped publication of types.DatastoreConfig
+func PublishDatastoreConfigScope(agentName string, agentScope string) (*DatastoreConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DatastoreConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DatastoreConfigPublication{Publication: pub}, nil
+}
+
+// PublishDatastoreConfigPersistent creates a persistent publication of types.DatastoreConfig
+func PublishDatastoreConfigPersistent(agentName string) (*DatastoreConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DatastoreConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DatastoreConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DatastoreConfigPublication) Publish(key string, item types.DatastoreConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DatastoreConfigPublication) Get(key string) (types.DatastoreConfig, error) {
+	var item types.DatastoreConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DatastoreConfigPublication) GetAll() map[string]types.DatastoreConfig {
+	result := make(map[string]types.DatastoreConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DatastoreConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DatastoreConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DatastoreConfigHandler is called with the item for create, modify and delete
+type DatastoreConfigHandler func(ctx interface{}, key string, item types.DatastoreConfig)
+
+// DatastoreConfigSubscription is a pubsub.Subscription of types.DatastoreConfig
+type DatastoreConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDatastoreConfig subscribes to types.DatastoreConfig from agentName
+func SubscribeDatastoreConfig(agentName string, activate bool, ctx interface{}) (*DatastoreConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DatastoreConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DatastoreConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDatastoreConfigScope subscribes to types.DatastoreConfig from agentName/agentScope
+func SubscribeDatastoreConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DatastoreConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DatastoreConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DatastoreConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDatastoreConfigPersistent subscribes to the persistent types.DatastoreConfig from agentName
+func SubscribeDatastoreConfigPersistent(agentName string, activate bool, ctx interface{}) (*DatastoreConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DatastoreConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DatastoreConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapDatastoreConfigHandler(op string, fn DatastoreConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DatastoreConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DatastoreConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DatastoreConfigSubscription) SetCreateHandler(fn DatastoreConfigHandler) {
+	sub.CreateHandler = wrapDatastoreConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DatastoreConfigSubscription) SetModifyHandler(fn DatastoreConfigHandler) {
+	sub.ModifyHandler = wrapDatastoreConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DatastoreConfigSubscription) SetDeleteHandler(fn DatastoreConfigHandler) {
+	sub.DeleteHandler = wrapDatastoreConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DatastoreConfigSubscription) Get(key string) (types.DatastoreConfig, error) {
+	var item types.DatastoreConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DatastoreConfigSubscription) GetAll() map[string]types.DatastoreConfig {
+	result := make(map[string]types.DatastoreConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DatastoreConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DatastoreConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DeviceNetworkConfigPublication is a pubsub.Publication of types.DeviceNetworkConfig
+type DeviceNetworkConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDeviceNetworkConfig creates a publication of types.DeviceNetworkConfig
+func PublishDeviceNetworkConfig(agentName string) (*DeviceNetworkConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DeviceNetworkConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkConfigPublication{Publication: pub}, nil
+}
+
+// PublishDeviceNetworkConfigScope creates a scoped publication of types.DeviceNetworkConfig
+func PublishDeviceNetworkConfigScope(agentName string, agentScope string) (*DeviceNetworkConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DeviceNetworkConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkConfigPublication{Publication: pub}, nil
+}
+
+// PublishDeviceNetworkConfigPersistent creates a persistent publication of types.DeviceNetworkConfig
+func PublishDeviceNetworkConfigPersistent(agentName string) (*DeviceNetworkConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DeviceNetworkConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DeviceNetworkConfigPublication) Publish(key string, item types.DeviceNetworkConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DeviceNetworkConfigPublication) Get(key string) (types.DeviceNetworkConfig, error) {
+	var item types.DeviceNetworkConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DeviceNetworkConfigPublication) GetAll() map[string]types.DeviceNetworkConfig {
+	result := make(map[string]types.DeviceNetworkConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DeviceNetworkConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DeviceNetworkConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DeviceNetworkConfigHandler is called with the item for create, modify and delete
+type DeviceNetworkConfigHandler func(ctx interface{}, key string, item types.DeviceNetworkConfig)
+
+// DeviceNetworkConfigSubscription is a pubsub.Subscription of types.DeviceNetworkConfig
+type DeviceNetworkConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDeviceNetworkConfig subscribes to types.DeviceNetworkConfig from agentName
+func SubscribeDeviceNetworkConfig(agentName string, activate bool, ctx interface{}) (*DeviceNetworkConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DeviceNetworkConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDeviceNetworkConfigScope subscribes to types.DeviceNetworkConfig from agentName/agentScope
+func SubscribeDeviceNetworkConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DeviceNetworkConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DeviceNetworkConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDeviceNetworkConfigPersistent subscribes to the persistent types.DeviceNetworkConfig from agentName
+func SubscribeDeviceNetworkConfigPersistent(agentName string, activate bool, ctx interface{}) (*DeviceNetworkConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DeviceNetworkConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapDeviceNetworkConfigHandler(op string, fn DeviceNetworkConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DeviceNetworkConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DeviceNetworkConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DeviceNetworkConfigSubscription) SetCreateHandler(fn DeviceNetworkConfigHandler) {
+	sub.CreateHandler = wrapDeviceNetworkConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DeviceNetworkConfigSubscription) SetModifyHandler(fn DeviceNetworkConfigHandler) {
+	sub.ModifyHandler = wrapDeviceNetworkConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DeviceNetworkConfigSubscription) SetDeleteHandler(fn DeviceNetworkConfigHandler) {
+	sub.DeleteHandler = wrapDeviceNetworkConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DeviceNetworkConfigSubscription) Get(key string) (types.DeviceNetworkConfig, error) {
+	var item types.DeviceNetworkConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DeviceNetworkConfigSubscription) GetAll() map[string]types.DeviceNetworkConfig {
+	result := make(map[string]types.DeviceNetworkConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DeviceNetworkConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DeviceNetworkConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DeviceNetworkStatusPublication is a pubsub.Publication of types.DeviceNetworkStatus
+type DeviceNetworkStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDeviceNetworkStatus creates a publication of types.DeviceNetworkStatus
+func PublishDeviceNetworkStatus(agentName string) (*DeviceNetworkStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DeviceNetworkStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkStatusPublication{Publication: pub}, nil
+}
+
+// PublishDeviceNetworkStatusScope creates a scoped publication of types.DeviceNetworkStatus
+func PublishDeviceNetworkStatusScope(agentName string, agentScope string) (*DeviceNetworkStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DeviceNetworkStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkStatusPublication{Publication: pub}, nil
+}
+
+// PublishDeviceNetworkStatusPersistent creates a persistent publication of types.DeviceNetworkStatus
+func PublishDeviceNetworkStatusPersistent(agentName string) (*DeviceNetworkStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DeviceNetworkStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DeviceNetworkStatusPublication) Publish(key string, item types.DeviceNetworkStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DeviceNetworkStatusPublication) Get(key string) (types.DeviceNetworkStatus, error) {
+	var item types.DeviceNetworkStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DeviceNetworkStatusPublication) GetAll() map[string]types.DeviceNetworkStatus {
+	result := make(map[string]types.DeviceNetworkStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DeviceNetworkStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DeviceNetworkStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DeviceNetworkStatusHandler is called with the item for create, modify and delete
+type DeviceNetworkStatusHandler func(ctx interface{}, key string, item types.DeviceNetworkStatus)
+
+// DeviceNetworkStatusSubscription is a pubsub.Subscription of types.DeviceNetworkStatus
+type DeviceNetworkStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDeviceNetworkStatus subscribes to types.DeviceNetworkStatus from agentName
+func SubscribeDeviceNetworkStatus(agentName string, activate bool, ctx interface{}) (*DeviceNetworkStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DeviceNetworkStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDeviceNetworkStatusScope subscribes to types.DeviceNetworkStatus from agentName/agentScope
+func SubscribeDeviceNetworkStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DeviceNetworkStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DeviceNetworkStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDeviceNetworkStatusPersistent subscribes to the persistent types.DeviceNetworkStatus from agentName
+func SubscribeDeviceNetworkStatusPersistent(agentName string, activate bool, ctx interface{}) (*DeviceNetworkStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DeviceNetworkStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceNetworkStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapDeviceNetworkStatusHandler(op string, fn DeviceNetworkStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DeviceNetworkStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DeviceNetworkStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DeviceNetworkStatusSubscription) SetCreateHandler(fn DeviceNetworkStatusHandler) {
+	sub.CreateHandler = wrapDeviceNetworkStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DeviceNetworkStatusSubscription) SetModifyHandler(fn DeviceNetworkStatusHandler) {
+	sub.ModifyHandler = wrapDeviceNetworkStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DeviceNetworkStatusSubscription) SetDeleteHandler(fn DeviceNetworkStatusHandler) {
+	sub.DeleteHandler = wrapDeviceNetworkStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DeviceNetworkStatusSubscription) Get(key string) (types.DeviceNetworkStatus, error) {
+	var item types.DeviceNetworkStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DeviceNetworkStatusSubscription) GetAll() map[string]types.DeviceNetworkStatus {
+	result := make(map[string]types.DeviceNetworkStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DeviceNetworkStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DeviceNetworkStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DevicePortConfigPublication is a pubsub.Publication of types.DevicePortConfig
+type DevicePortConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDevicePortConfig creates a publication of types.DevicePortConfig
+func PublishDevicePortConfig(agentName string) (*DevicePortConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DevicePortConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigPublication{Publication: pub}, nil
+}
+
+// PublishDevicePortConfigScope creates a scoped publication of types.DevicePortConfig
+func PublishDevicePortConfigScope(agentName string, agentScope string) (*DevicePortConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DevicePortConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigPublication{Publication: pub}, nil
+}
+
+// PublishDevicePortConfigPersistent creates a persistent publication of types.DevicePortConfig
+func PublishDevicePortConfigPersistent(agentName string) (*DevicePortConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DevicePortConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DevicePortConfigPublication) Publish(key string, item types.DevicePortConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DevicePortConfigPublication) Get(key string) (types.DevicePortConfig, error) {
+	var item types.DevicePortConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DevicePortConfigPublication) GetAll() map[string]types.DevicePortConfig {
+	result := make(map[string]types.DevicePortConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DevicePortConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DevicePortConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DevicePortConfigHandler is called with the item for create, modify and delete
+type DevicePortConfigHandler func(ctx interface{}, key string, item types.DevicePortConfig)
+
+// DevicePortConfigSubscription is a pubsub.Subscription of types.DevicePortConfig
+type DevicePortConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDevicePortConfig subscribes to types.DevicePortConfig from agentName
+func SubscribeDevicePortConfig(agentName string, activate bool, ctx interface{}) (*DevicePortConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DevicePortConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDevicePortConfigScope subscribes to types.DevicePortConfig from agentName/agentScope
+func SubscribeDevicePortConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DevicePortConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DevicePortConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDevicePortConfigPersistent subscribes to the persistent types.DevicePortConfig from agentName
+func SubscribeDevicePortConfigPersistent(agentName string, activate bool, ctx interface{}) (*DevicePortConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DevicePortConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapDevicePortConfigHandler(op string, fn DevicePortConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DevicePortConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DevicePortConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DevicePortConfigSubscription) SetCreateHandler(fn DevicePortConfigHandler) {
+	sub.CreateHandler = wrapDevicePortConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DevicePortConfigSubscription) SetModifyHandler(fn DevicePortConfigHandler) {
+	sub.ModifyHandler = wrapDevicePortConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DevicePortConfigSubscription) SetDeleteHandler(fn DevicePortConfigHandler) {
+	sub.DeleteHandler = wrapDevicePortConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DevicePortConfigSubscription) Get(key string) (types.DevicePortConfig, error) {
+	var item types.DevicePortConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DevicePortConfigSubscription) GetAll() map[string]types.DevicePortConfig {
+	result := make(map[string]types.DevicePortConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DevicePortConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DevicePortConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DevicePortConfigListPublication is a pubsub.Publication of types.DevicePortConfigList
+type DevicePortConfigListPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDevicePortConfigList creates a publication of types.DevicePortConfigList
+func PublishDevicePortConfigList(agentName string) (*DevicePortConfigListPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DevicePortConfigList{})
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigListPublication{Publication: pub}, nil
+}
+
+// PublishDevicePortConfigListScope creates a scoped publication of types.DevicePortConfigList
+func PublishDevicePortConfigListScope(agentName string, agentScope string) (*DevicePortConfigListPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DevicePortConfigList{})
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigListPublication{Publication: pub}, nil
+}
+
+// PublishDevicePortConfigListPersistent creates a persistent publication of types.DevicePortConfigList
+func PublishDevicePortConfigListPersistent(agentName string) (*DevicePortConfigListPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DevicePortConfigList{})
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigListPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DevicePortConfigListPublication) Publish(key string, item types.DevicePortConfigList) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DevicePortConfigListPublication) Get(key string) (types.DevicePortConfigList, error) {
+	var item types.DevicePortConfigList
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DevicePortConfigListPublication) GetAll() map[string]types.DevicePortConfigList {
+	result := make(map[string]types.DevicePortConfigList)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DevicePortConfigList
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DevicePortConfigListPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DevicePortConfigListHandler is called with the item for create, modify and delete
+type DevicePortConfigListHandler func(ctx interface{}, key string, item types.DevicePortConfigList)
+
+// DevicePortConfigListSubscription is a pubsub.Subscription of types.DevicePortConfigList
+type DevicePortConfigListSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDevicePortConfigList subscribes to types.DevicePortConfigList from agentName
+func SubscribeDevicePortConfigList(agentName string, activate bool, ctx interface{}) (*DevicePortConfigListSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DevicePortConfigList{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigListSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDevicePortConfigListScope subscribes to types.DevicePortConfigList from agentName/agentScope
+func SubscribeDevicePortConfigListScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DevicePortConfigListSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DevicePortConfigList{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigListSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDevicePortConfigListPersistent subscribes to the persistent types.DevicePortConfigList from agentName
+func SubscribeDevicePortConfigListPersistent(agentName string, activate bool, ctx interface{}) (*DevicePortConfigListSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DevicePortConfigList{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DevicePortConfigListSubscription{Subscription: sub}, nil
+}
+
+func wrapDevicePortConfigListHandler(op string, fn DevicePortConfigListHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DevicePortConfigList
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DevicePortConfigListSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DevicePortConfigListSubscription) SetCreateHandler(fn DevicePortConfigListHandler) {
+	sub.CreateHandler = wrapDevicePortConfigListHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DevicePortConfigListSubscription) SetModifyHandler(fn DevicePortConfigListHandler) {
+	sub.ModifyHandler = wrapDevicePortConfigListHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DevicePortConfigListSubscription) SetDeleteHandler(fn DevicePortConfigListHandler) {
+	sub.DeleteHandler = wrapDevicePortConfigListHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DevicePortConfigListSubscription) Get(key string) (types.DevicePortConfigList, error) {
+	var item types.DevicePortConfigList
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DevicePortConfigListSubscription) GetAll() map[string]types.DevicePortConfigList {
+	result := make(map[string]types.DevicePortConfigList)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DevicePortConfigList
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DevicePortConfigListSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DomainConfigPublication is a pubsub.Publication of types.DomainConfig
+type DomainConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDomainConfig creates a publication of types.DomainConfig
+func PublishDomainConfig(agentName string) (*DomainConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DomainConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DomainConfigPublication{Publication: pub}, nil
+}
+
+// PublishDomainConfigScope creates a scoped publication of types.DomainConfig
+func PublishDomainConfigScope(agentName string, agentScope string) (*DomainConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DomainConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DomainConfigPublication{Publication: pub}, nil
+}
+
+// PublishDomainConfigPersistent creates a persistent publication of types.DomainConfig
+func PublishDomainConfigPersistent(agentName string) (*DomainConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DomainConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DomainConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DomainConfigPublication) Publish(key string, item types.DomainConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DomainConfigPublication) Get(key string) (types.DomainConfig, error) {
+	var item types.DomainConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DomainConfigPublication) GetAll() map[string]types.DomainConfig {
+	result := make(map[string]types.DomainConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DomainConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DomainConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DomainConfigHandler is called with the item for create, modify and delete
+type DomainConfigHandler func(ctx interface{}, key string, item types.DomainConfig)
+
+// DomainConfigSubscription is a pubsub.Subscription of types.DomainConfig
+type DomainConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDomainConfig subscribes to types.DomainConfig from agentName
+func SubscribeDomainConfig(agentName string, activate bool, ctx interface{}) (*DomainConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DomainConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDomainConfigScope subscribes to types.DomainConfig from agentName/agentScope
+func SubscribeDomainConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DomainConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DomainConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDomainConfigPersistent subscribes to the persistent types.DomainConfig from agentName
+func SubscribeDomainConfigPersistent(agentName string, activate bool, ctx interface{}) (*DomainConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DomainConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapDomainConfigHandler(op string, fn DomainConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DomainConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DomainConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DomainConfigSubscription) SetCreateHandler(fn DomainConfigHandler) {
+	sub.CreateHandler = wrapDomainConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DomainConfigSubscription) SetModifyHandler(fn DomainConfigHandler) {
+	sub.ModifyHandler = wrapDomainConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DomainConfigSubscription) SetDeleteHandler(fn DomainConfigHandler) {
+	sub.DeleteHandler = wrapDomainConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DomainConfigSubscription) Get(key string) (types.DomainConfig, error) {
+	var item types.DomainConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DomainConfigSubscription) GetAll() map[string]types.DomainConfig {
+	result := make(map[string]types.DomainConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DomainConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DomainConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DomainStatusPublication is a pubsub.Publication of types.DomainStatus
+type DomainStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDomainStatus creates a publication of types.DomainStatus
+func PublishDomainStatus(agentName string) (*DomainStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DomainStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DomainStatusPublication{Publication: pub}, nil
+}
+
+// PublishDomainStatusScope creates a scoped publication of types.DomainStatus
+func PublishDomainStatusScope(agentName string, agentScope string) (*DomainStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DomainStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DomainStatusPublication{Publication: pub}, nil
+}
+
+// PublishDomainStatusPersistent creates a persistent publication of types.DomainStatus
+func PublishDomainStatusPersistent(agentName string) (*DomainStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DomainStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DomainStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DomainStatusPublication) Publish(key string, item types.DomainStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DomainStatusPublication) Get(key string) (types.DomainStatus, error) {
+	var item types.DomainStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DomainStatusPublication) GetAll() map[string]types.DomainStatus {
+	result := make(map[string]types.DomainStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DomainStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DomainStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DomainStatusHandler is called with the item for create, modify and delete
+type DomainStatusHandler func(ctx interface{}, key string, item types.DomainStatus)
+
+// DomainStatusSubscription is a pubsub.Subscription of types.DomainStatus
+type DomainStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDomainStatus subscribes to types.DomainStatus from agentName
+func SubscribeDomainStatus(agentName string, activate bool, ctx interface{}) (*DomainStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DomainStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDomainStatusScope subscribes to types.DomainStatus from agentName/agentScope
+func SubscribeDomainStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DomainStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DomainStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDomainStatusPersistent subscribes to the persistent types.DomainStatus from agentName
+func SubscribeDomainStatusPersistent(agentName string, activate bool, ctx interface{}) (*DomainStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DomainStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapDomainStatusHandler(op string, fn DomainStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DomainStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DomainStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DomainStatusSubscription) SetCreateHandler(fn DomainStatusHandler) {
+	sub.CreateHandler = wrapDomainStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DomainStatusSubscription) SetModifyHandler(fn DomainStatusHandler) {
+	sub.ModifyHandler = wrapDomainStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DomainStatusSubscription) SetDeleteHandler(fn DomainStatusHandler) {
+	sub.DeleteHandler = wrapDomainStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DomainStatusSubscription) Get(key string) (types.DomainStatus, error) {
+	var item types.DomainStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DomainStatusSubscription) GetAll() map[string]types.DomainStatus {
+	result := make(map[string]types.DomainStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DomainStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DomainStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DownloaderConfigPublication is a pubsub.Publication of types.DownloaderConfig
+type DownloaderConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDownloaderConfig creates a publication of types.DownloaderConfig
+func PublishDownloaderConfig(agentName string) (*DownloaderConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DownloaderConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderConfigPublication{Publication: pub}, nil
+}
+
+// PublishDownloaderConfigScope creates a scoped publication of types.DownloaderConfig
+func PublishDownloaderConfigScope(agentName string, agentScope string) (*DownloaderConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DownloaderConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderConfigPublication{Publication: pub}, nil
+}
+
+// PublishDownloaderConfigPersistent creates a persistent publication of types.DownloaderConfig
+func PublishDownloaderConfigPersistent(agentName string) (*DownloaderConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DownloaderConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DownloaderConfigPublication) Publish(key string, item types.DownloaderConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DownloaderConfigPublication) Get(key string) (types.DownloaderConfig, error) {
+	var item types.DownloaderConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DownloaderConfigPublication) GetAll() map[string]types.DownloaderConfig {
+	result := make(map[string]types.DownloaderConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DownloaderConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DownloaderConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DownloaderConfigHandler is called with the item for create, modify and delete
+type DownloaderConfigHandler func(ctx interface{}, key string, item types.DownloaderConfig)
+
+// DownloaderConfigSubscription is a pubsub.Subscription of types.DownloaderConfig
+type DownloaderConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDownloaderConfig subscribes to types.DownloaderConfig from agentName
+func SubscribeDownloaderConfig(agentName string, activate bool, ctx interface{}) (*DownloaderConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DownloaderConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDownloaderConfigScope subscribes to types.DownloaderConfig from agentName/agentScope
+func SubscribeDownloaderConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DownloaderConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DownloaderConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDownloaderConfigPersistent subscribes to the persistent types.DownloaderConfig from agentName
+func SubscribeDownloaderConfigPersistent(agentName string, activate bool, ctx interface{}) (*DownloaderConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DownloaderConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapDownloaderConfigHandler(op string, fn DownloaderConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DownloaderConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DownloaderConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DownloaderConfigSubscription) SetCreateHandler(fn DownloaderConfigHandler) {
+	sub.CreateHandler = wrapDownloaderConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DownloaderConfigSubscription) SetModifyHandler(fn DownloaderConfigHandler) {
+	sub.ModifyHandler = wrapDownloaderConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DownloaderConfigSubscription) SetDeleteHandler(fn DownloaderConfigHandler) {
+	sub.DeleteHandler = wrapDownloaderConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DownloaderConfigSubscription) Get(key string) (types.DownloaderConfig, error) {
+	var item types.DownloaderConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DownloaderConfigSubscription) GetAll() map[string]types.DownloaderConfig {
+	result := make(map[string]types.DownloaderConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DownloaderConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DownloaderConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DownloaderStatusPublication is a pubsub.Publication of types.DownloaderStatus
+type DownloaderStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishDownloaderStatus creates a publication of types.DownloaderStatus
+func PublishDownloaderStatus(agentName string) (*DownloaderStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.DownloaderStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderStatusPublication{Publication: pub}, nil
+}
+
+// PublishDownloaderStatusScope creates a scoped publication of types.DownloaderStatus
+func PublishDownloaderStatusScope(agentName string, agentScope string) (*DownloaderStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.DownloaderStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderStatusPublication{Publication: pub}, nil
+}
+
+// PublishDownloaderStatusPersistent creates a persistent publication of types.DownloaderStatus
+func PublishDownloaderStatusPersistent(agentName string) (*DownloaderStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.DownloaderStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *DownloaderStatusPublication) Publish(key string, item types.DownloaderStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *DownloaderStatusPublication) Get(key string) (types.DownloaderStatus, error) {
+	var item types.DownloaderStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *DownloaderStatusPublication) GetAll() map[string]types.DownloaderStatus {
+	result := make(map[string]types.DownloaderStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.DownloaderStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DownloaderStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// DownloaderStatusHandler is called with the item for create, modify and delete
+type DownloaderStatusHandler func(ctx interface{}, key string, item types.DownloaderStatus)
+
+// DownloaderStatusSubscription is a pubsub.Subscription of types.DownloaderStatus
+type DownloaderStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeDownloaderStatus subscribes to types.DownloaderStatus from agentName
+func SubscribeDownloaderStatus(agentName string, activate bool, ctx interface{}) (*DownloaderStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.DownloaderStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDownloaderStatusScope subscribes to types.DownloaderStatus from agentName/agentScope
+func SubscribeDownloaderStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*DownloaderStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.DownloaderStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeDownloaderStatusPersistent subscribes to the persistent types.DownloaderStatus from agentName
+func SubscribeDownloaderStatusPersistent(agentName string, activate bool, ctx interface{}) (*DownloaderStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.DownloaderStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DownloaderStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapDownloaderStatusHandler(op string, fn DownloaderStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.DownloaderStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DownloaderStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *DownloaderStatusSubscription) SetCreateHandler(fn DownloaderStatusHandler) {
+	sub.CreateHandler = wrapDownloaderStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *DownloaderStatusSubscription) SetModifyHandler(fn DownloaderStatusHandler) {
+	sub.ModifyHandler = wrapDownloaderStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *DownloaderStatusSubscription) SetDeleteHandler(fn DownloaderStatusHandler) {
+	sub.DeleteHandler = wrapDownloaderStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *DownloaderStatusSubscription) Get(key string) (types.DownloaderStatus, error) {
+	var item types.DownloaderStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *DownloaderStatusSubscription) GetAll() map[string]types.DownloaderStatus {
+	result := make(map[string]types.DownloaderStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.DownloaderStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("DownloaderStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// EIDConfigPublication is a pubsub.Publication of types.EIDConfig
+type EIDConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishEIDConfig creates a publication of types.EIDConfig
+func PublishEIDConfig(agentName string) (*EIDConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.EIDConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &EIDConfigPublication{Publication: pub}, nil
+}
+
+// PublishEIDConfigScope creates a scoped publication of types.EIDConfig
+func PublishEIDConfigScope(agentName string, agentScope string) (*EIDConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.EIDConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &EIDConfigPublication{Publication: pub}, nil
+}
+
+// PublishEIDConfigPersistent creates a persistent publication of types.EIDConfig
+func PublishEIDConfigPersistent(agentName string) (*EIDConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.EIDConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &EIDConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *EIDConfigPublication) Publish(key string, item types.EIDConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *EIDConfigPublication) Get(key string) (types.EIDConfig, error) {
+	var item types.EIDConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *EIDConfigPublication) GetAll() map[string]types.EIDConfig {
+	result := make(map[string]types.EIDConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.EIDConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("EIDConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// EIDConfigHandler is called with the item for create, modify and delete
+type EIDConfigHandler func(ctx interface{}, key string, item types.EIDConfig)
+
+// EIDConfigSubscription is a pubsub.Subscription of types.EIDConfig
+type EIDConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeEIDConfig subscribes to types.EIDConfig from agentName
+func SubscribeEIDConfig(agentName string, activate bool, ctx interface{}) (*EIDConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.EIDConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EIDConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeEIDConfigScope subscribes to types.EIDConfig from agentName/agentScope
+func SubscribeEIDConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*EIDConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.EIDConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EIDConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeEIDConfigPersistent subscribes to the persistent types.EIDConfig from agentName
+func SubscribeEIDConfigPersistent(agentName string, activate bool, ctx interface{}) (*EIDConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.EIDConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EIDConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapEIDConfigHandler(op string, fn EIDConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.EIDConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("EIDConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *EIDConfigSubscription) SetCreateHandler(fn EIDConfigHandler) {
+	sub.CreateHandler = wrapEIDConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *EIDConfigSubscription) SetModifyHandler(fn EIDConfigHandler) {
+	sub.ModifyHandler = wrapEIDConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *EIDConfigSubscription) SetDeleteHandler(fn EIDConfigHandler) {
+	sub.DeleteHandler = wrapEIDConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *EIDConfigSubscription) Get(key string) (types.EIDConfig, error) {
+	var item types.EIDConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *EIDConfigSubscription) GetAll() map[string]types.EIDConfig {
+	result := make(map[string]types.EIDConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.EIDConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("EIDConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// EIDStatusPublication is a pubsub.Publication of types.EIDStatus
+type EIDStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishEIDStatus creates a publication of types.EIDStatus
+func PublishEIDStatus(agentName string) (*EIDStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.EIDStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &EIDStatusPublication{Publication: pub}, nil
+}
+
+// PublishEIDStatusScope creates a scoped publication of types.EIDStatus
+func PublishEIDStatusScope(agentName string, agentScope string) (*EIDStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.EIDStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &EIDStatusPublication{Publication: pub}, nil
+}
+
+// PublishEIDStatusPersistent creates a persistent publication of types.EIDStatus
+func PublishEIDStatusPersistent(agentName string) (*EIDStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.EIDStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &EIDStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *EIDStatusPublication) Publish(key string, item types.EIDStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *EIDStatusPublication) Get(key string) (types.EIDStatus, error) {
+	var item types.EIDStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *EIDStatusPublication) GetAll() map[string]types.EIDStatus {
+	result := make(map[string]types.EIDStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.EIDStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("EIDStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// EIDStatusHandler is called with the item for create, modify and delete
+type EIDStatusHandler func(ctx interface{}, key string, item types.EIDStatus)
+
+// EIDStatusSubscription is a pubsub.Subscription of types.EIDStatus
+type EIDStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeEIDStatus subscribes to types.EIDStatus from agentName
+func SubscribeEIDStatus(agentName string, activate bool, ctx interface{}) (*EIDStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.EIDStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EIDStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeEIDStatusScope subscribes to types.EIDStatus from agentName/agentScope
+func SubscribeEIDStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*EIDStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.EIDStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EIDStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeEIDStatusPersistent subscribes to the persistent types.EIDStatus from agentName
+func SubscribeEIDStatusPersistent(agentName string, activate bool, ctx interface{}) (*EIDStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.EIDStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EIDStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapEIDStatusHandler(op string, fn EIDStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.EIDStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("EIDStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *EIDStatusSubscription) SetCreateHandler(fn EIDStatusHandler) {
+	sub.CreateHandler = wrapEIDStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *EIDStatusSubscription) SetModifyHandler(fn EIDStatusHandler) {
+	sub.ModifyHandler = wrapEIDStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *EIDStatusSubscription) SetDeleteHandler(fn EIDStatusHandler) {
+	sub.DeleteHandler = wrapEIDStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *EIDStatusSubscription) Get(key string) (types.EIDStatus, error) {
+	var item types.EIDStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *EIDStatusSubscription) GetAll() map[string]types.EIDStatus {
+	result := make(map[string]types.EIDStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.EIDStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("EIDStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// GlobalConfigPublication is a pubsub.Publication of types.GlobalConfig
+type GlobalConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishGlobalConfig creates a publication of types.GlobalConfig
+func PublishGlobalConfig(agentName string) (*GlobalConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.GlobalConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalConfigPublication{Publication: pub}, nil
+}
+
+// PublishGlobalConfigScope creates a scoped publication of types.GlobalConfig
+func PublishGlobalConfigScope(agentName string, agentScope string) (*GlobalConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.GlobalConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalConfigPublication{Publication: pub}, nil
+}
+
+// PublishGlobalConfigPersistent creates a persistent publication of types.GlobalConfig
+func PublishGlobalConfigPersistent(agentName string) (*GlobalConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.GlobalConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *GlobalConfigPublication) Publish(key string, item types.GlobalConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *GlobalConfigPublication) Get(key string) (types.GlobalConfig, error) {
+	var item types.GlobalConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *GlobalConfigPublication) GetAll() map[string]types.GlobalConfig {
+	result := make(map[string]types.GlobalConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.GlobalConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// GlobalConfigHandler is called with the item for create, modify and delete
+type GlobalConfigHandler func(ctx interface{}, key string, item types.GlobalConfig)
+
+// GlobalConfigSubscription is a pubsub.Subscription of types.GlobalConfig
+type GlobalConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeGlobalConfig subscribes to types.GlobalConfig from agentName
+func SubscribeGlobalConfig(agentName string, activate bool, ctx interface{}) (*GlobalConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.GlobalConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeGlobalConfigScope subscribes to types.GlobalConfig from agentName/agentScope
+func SubscribeGlobalConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*GlobalConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.GlobalConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeGlobalConfigPersistent subscribes to the persistent types.GlobalConfig from agentName
+func SubscribeGlobalConfigPersistent(agentName string, activate bool, ctx interface{}) (*GlobalConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.GlobalConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapGlobalConfigHandler(op string, fn GlobalConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.GlobalConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *GlobalConfigSubscription) SetCreateHandler(fn GlobalConfigHandler) {
+	sub.CreateHandler = wrapGlobalConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *GlobalConfigSubscription) SetModifyHandler(fn GlobalConfigHandler) {
+	sub.ModifyHandler = wrapGlobalConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *GlobalConfigSubscription) SetDeleteHandler(fn GlobalConfigHandler) {
+	sub.DeleteHandler = wrapGlobalConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *GlobalConfigSubscription) Get(key string) (types.GlobalConfig, error) {
+	var item types.GlobalConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *GlobalConfigSubscription) GetAll() map[string]types.GlobalConfig {
+	result := make(map[string]types.GlobalConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.GlobalConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// GlobalDownloadConfigPublication is a pubsub.Publication of types.GlobalDownloadConfig
+type GlobalDownloadConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishGlobalDownloadConfig creates a publication of types.GlobalDownloadConfig
+func PublishGlobalDownloadConfig(agentName string) (*GlobalDownloadConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.GlobalDownloadConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadConfigPublication{Publication: pub}, nil
+}
+
+// PublishGlobalDownloadConfigScope creates a scoped publication of types.GlobalDownloadConfig
+func PublishGlobalDownloadConfigScope(agentName string, agentScope string) (*GlobalDownloadConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.GlobalDownloadConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadConfigPublication{Publication: pub}, nil
+}
+
+// PublishGlobalDownloadConfigPersistent creates a persistent publication of types.GlobalDownloadConfig
+func PublishGlobalDownloadConfigPersistent(agentName string) (*GlobalDownloadConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.GlobalDownloadConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *GlobalDownloadConfigPublication) Publish(key string, item types.GlobalDownloadConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *GlobalDownloadConfigPublication) Get(key string) (types.GlobalDownloadConfig, error) {
+	var item types.GlobalDownloadConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *GlobalDownloadConfigPublication) GetAll() map[string]types.GlobalDownloadConfig {
+	result := make(map[string]types.GlobalDownloadConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.GlobalDownloadConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalDownloadConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// GlobalDownloadConfigHandler is called with the item for create, modify and delete
+type GlobalDownloadConfigHandler func(ctx interface{}, key string, item types.GlobalDownloadConfig)
+
+// GlobalDownloadConfigSubscription is a pubsub.Subscription of types.GlobalDownloadConfig
+type GlobalDownloadConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeGlobalDownloadConfig subscribes to types.GlobalDownloadConfig from agentName
+func SubscribeGlobalDownloadConfig(agentName string, activate bool, ctx interface{}) (*GlobalDownloadConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.GlobalDownloadConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeGlobalDownloadConfigScope subscribes to types.GlobalDownloadConfig from agentName/agentScope
+func SubscribeGlobalDownloadConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*GlobalDownloadConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.GlobalDownloadConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeGlobalDownloadConfigPersistent subscribes to the persistent types.GlobalDownloadConfig from agentName
+func SubscribeGlobalDownloadConfigPersistent(agentName string, activate bool, ctx interface{}) (*GlobalDownloadConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.GlobalDownloadConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapGlobalDownloadConfigHandler(op string, fn GlobalDownloadConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.GlobalDownloadConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalDownloadConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *GlobalDownloadConfigSubscription) SetCreateHandler(fn GlobalDownloadConfigHandler) {
+	sub.CreateHandler = wrapGlobalDownloadConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *GlobalDownloadConfigSubscription) SetModifyHandler(fn GlobalDownloadConfigHandler) {
+	sub.ModifyHandler = wrapGlobalDownloadConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *GlobalDownloadConfigSubscription) SetDeleteHandler(fn GlobalDownloadConfigHandler) {
+	sub.DeleteHandler = wrapGlobalDownloadConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *GlobalDownloadConfigSubscription) Get(key string) (types.GlobalDownloadConfig, error) {
+	var item types.GlobalDownloadConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *GlobalDownloadConfigSubscription) GetAll() map[string]types.GlobalDownloadConfig {
+	result := make(map[string]types.GlobalDownloadConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.GlobalDownloadConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalDownloadConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// GlobalDownloadStatusPublication is a pubsub.Publication of types.GlobalDownloadStatus
+type GlobalDownloadStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishGlobalDownloadStatus creates a publication of types.GlobalDownloadStatus
+func PublishGlobalDownloadStatus(agentName string) (*GlobalDownloadStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.GlobalDownloadStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadStatusPublication{Publication: pub}, nil
+}
+
+// PublishGlobalDownloadStatusScope creates a scoped publication of types.GlobalDownloadStatus
+func PublishGlobalDownloadStatusScope(agentName string, agentScope string) (*GlobalDownloadStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.GlobalDownloadStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadStatusPublication{Publication: pub}, nil
+}
+
+// PublishGlobalDownloadStatusPersistent creates a persistent publication of types.GlobalDownloadStatus
+func PublishGlobalDownloadStatusPersistent(agentName string) (*GlobalDownloadStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.GlobalDownloadStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *GlobalDownloadStatusPublication) Publish(key string, item types.GlobalDownloadStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *GlobalDownloadStatusPublication) Get(key string) (types.GlobalDownloadStatus, error) {
+	var item types.GlobalDownloadStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *GlobalDownloadStatusPublication) GetAll() map[string]types.GlobalDownloadStatus {
+	result := make(map[string]types.GlobalDownloadStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.GlobalDownloadStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalDownloadStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// GlobalDownloadStatusHandler is called with the item for create, modify and delete
+type GlobalDownloadStatusHandler func(ctx interface{}, key string, item types.GlobalDownloadStatus)
+
+// GlobalDownloadStatusSubscription is a pubsub.Subscription of types.GlobalDownloadStatus
+type GlobalDownloadStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeGlobalDownloadStatus subscribes to types.GlobalDownloadStatus from agentName
+func SubscribeGlobalDownloadStatus(agentName string, activate bool, ctx interface{}) (*GlobalDownloadStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.GlobalDownloadStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeGlobalDownloadStatusScope subscribes to types.GlobalDownloadStatus from agentName/agentScope
+func SubscribeGlobalDownloadStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*GlobalDownloadStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.GlobalDownloadStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeGlobalDownloadStatusPersistent subscribes to the persistent types.GlobalDownloadStatus from agentName
+func SubscribeGlobalDownloadStatusPersistent(agentName string, activate bool, ctx interface{}) (*GlobalDownloadStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.GlobalDownloadStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalDownloadStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapGlobalDownloadStatusHandler(op string, fn GlobalDownloadStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.GlobalDownloadStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalDownloadStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *GlobalDownloadStatusSubscription) SetCreateHandler(fn GlobalDownloadStatusHandler) {
+	sub.CreateHandler = wrapGlobalDownloadStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *GlobalDownloadStatusSubscription) SetModifyHandler(fn GlobalDownloadStatusHandler) {
+	sub.ModifyHandler = wrapGlobalDownloadStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *GlobalDownloadStatusSubscription) SetDeleteHandler(fn GlobalDownloadStatusHandler) {
+	sub.DeleteHandler = wrapGlobalDownloadStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *GlobalDownloadStatusSubscription) Get(key string) (types.GlobalDownloadStatus, error) {
+	var item types.GlobalDownloadStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *GlobalDownloadStatusSubscription) GetAll() map[string]types.GlobalDownloadStatus {
+	result := make(map[string]types.GlobalDownloadStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.GlobalDownloadStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("GlobalDownloadStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// IPFlowPublication is a pubsub.Publication of types.IPFlow
+type IPFlowPublication struct {
+	*pubsub.Publication
+}
+
+// PublishIPFlow creates a publication of types.IPFlow
+func PublishIPFlow(agentName string) (*IPFlowPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.IPFlow{})
+	if err != nil {
+		return nil, err
+	}
+	return &IPFlowPublication{Publication: pub}, nil
+}
+
+// PublishIPFlowScope creates a scoped publication of types.IPFlow
+func PublishIPFlowScope(agentName string, agentScope string) (*IPFlowPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.IPFlow{})
+	if err != nil {
+		return nil, err
+	}
+	return &IPFlowPublication{Publication: pub}, nil
+}
+
+// PublishIPFlowPersistent creates a persistent publication of types.IPFlow
+func PublishIPFlowPersistent(agentName string) (*IPFlowPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.IPFlow{})
+	if err != nil {
+		return nil, err
+	}
+	return &IPFlowPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *IPFlowPublication) Publish(key string, item types.IPFlow) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *IPFlowPublication) Get(key string) (types.IPFlow, error) {
+	var item types.IPFlow
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *IPFlowPublication) GetAll() map[string]types.IPFlow {
+	result := make(map[string]types.IPFlow)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.IPFlow
+		if err := decode(m, &item); err != nil {
+			log.Errorf("IPFlowPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// IPFlowHandler is called with the item for create, modify and delete
+type IPFlowHandler func(ctx interface{}, key string, item types.IPFlow)
+
+// IPFlowSubscription is a pubsub.Subscription of types.IPFlow
+type IPFlowSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeIPFlow subscribes to types.IPFlow from agentName
+func SubscribeIPFlow(agentName string, activate bool, ctx interface{}) (*IPFlowSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.IPFlow{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &IPFlowSubscription{Subscription: sub}, nil
+}
+
+// SubscribeIPFlowScope subscribes to types.IPFlow from agentName/agentScope
+func SubscribeIPFlowScope(agentName string, agentScope string, activate bool, ctx interface{}) (*IPFlowSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.IPFlow{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &IPFlowSubscription{Subscription: sub}, nil
+}
+
+// SubscribeIPFlowPersistent subscribes to the persistent types.IPFlow from agentName
+func SubscribeIPFlowPersistent(agentName string, activate bool, ctx interface{}) (*IPFlowSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.IPFlow{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &IPFlowSubscription{Subscription: sub}, nil
+}
+
+func wrapIPFlowHandler(op string, fn IPFlowHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.IPFlow
+		if err := decode(m, &item); err != nil {
+			log.Errorf("IPFlowSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *IPFlowSubscription) SetCreateHandler(fn IPFlowHandler) {
+	sub.CreateHandler = wrapIPFlowHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *IPFlowSubscription) SetModifyHandler(fn IPFlowHandler) {
+	sub.ModifyHandler = wrapIPFlowHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *IPFlowSubscription) SetDeleteHandler(fn IPFlowHandler) {
+	sub.DeleteHandler = wrapIPFlowHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *IPFlowSubscription) Get(key string) (types.IPFlow, error) {
+	var item types.IPFlow
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *IPFlowSubscription) GetAll() map[string]types.IPFlow {
+	result := make(map[string]types.IPFlow)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.IPFlow
+		if err := decode(m, &item); err != nil {
+			log.Errorf("IPFlowSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// ImageStatusPublication is a pubsub.Publication of types.ImageStatus
+type ImageStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishImageStatus creates a publication of types.ImageStatus
+func PublishImageStatus(agentName string) (*ImageStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.ImageStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &ImageStatusPublication{Publication: pub}, nil
+}
+
+// PublishImageStatusScope creates a scoped publication of types.ImageStatus
+func PublishImageStatusScope(agentName string, agentScope string) (*ImageStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.ImageStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &ImageStatusPublication{Publication: pub}, nil
+}
+
+// PublishImageStatusPersistent creates a persistent publication of types.ImageStatus
+func PublishImageStatusPersistent(agentName string) (*ImageStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.ImageStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &ImageStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *ImageStatusPublication) Publish(key string, item types.ImageStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *ImageStatusPublication) Get(key string) (types.ImageStatus, error) {
+	var item types.ImageStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *ImageStatusPublication) GetAll() map[string]types.ImageStatus {
+	result := make(map[string]types.ImageStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.ImageStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ImageStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// ImageStatusHandler is called with the item for create, modify and delete
+type ImageStatusHandler func(ctx interface{}, key string, item types.ImageStatus)
+
+// ImageStatusSubscription is a pubsub.Subscription of types.ImageStatus
+type ImageStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeImageStatus subscribes to types.ImageStatus from agentName
+func SubscribeImageStatus(agentName string, activate bool, ctx interface{}) (*ImageStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.ImageStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeImageStatusScope subscribes to types.ImageStatus from agentName/agentScope
+func SubscribeImageStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*ImageStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.ImageStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeImageStatusPersistent subscribes to the persistent types.ImageStatus from agentName
+func SubscribeImageStatusPersistent(agentName string, activate bool, ctx interface{}) (*ImageStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.ImageStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapImageStatusHandler(op string, fn ImageStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.ImageStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ImageStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *ImageStatusSubscription) SetCreateHandler(fn ImageStatusHandler) {
+	sub.CreateHandler = wrapImageStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *ImageStatusSubscription) SetModifyHandler(fn ImageStatusHandler) {
+	sub.ModifyHandler = wrapImageStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *ImageStatusSubscription) SetDeleteHandler(fn ImageStatusHandler) {
+	sub.DeleteHandler = wrapImageStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *ImageStatusSubscription) Get(key string) (types.ImageStatus, error) {
+	var item types.ImageStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *ImageStatusSubscription) GetAll() map[string]types.ImageStatus {
+	result := make(map[string]types.ImageStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.ImageStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ImageStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LedBlinkCounterPublication is a pubsub.Publication of types.LedBlinkCounter
+type LedBlinkCounterPublication struct {
+	*pubsub.Publication
+}
+
+// PublishLedBlinkCounter creates a publication of types.LedBlinkCounter
+func PublishLedBlinkCounter(agentName string) (*LedBlinkCounterPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.LedBlinkCounter{})
+	if err != nil {
+		return nil, err
+	}
+	return &LedBlinkCounterPublication{Publication: pub}, nil
+}
+
+// PublishLedBlinkCounterScope creates a scoped publication of types.LedBlinkCounter
+func PublishLedBlinkCounterScope(agentName string, agentScope string) (*LedBlinkCounterPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.LedBlinkCounter{})
+	if err != nil {
+		return nil, err
+	}
+	return &LedBlinkCounterPublication{Publication: pub}, nil
+}
+
+// PublishLedBlinkCounterPersistent creates a persistent publication of types.LedBlinkCounter
+func PublishLedBlinkCounterPersistent(agentName string) (*LedBlinkCounterPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.LedBlinkCounter{})
+	if err != nil {
+		return nil, err
+	}
+	return &LedBlinkCounterPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *LedBlinkCounterPublication) Publish(key string, item types.LedBlinkCounter) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *LedBlinkCounterPublication) Get(key string) (types.LedBlinkCounter, error) {
+	var item types.LedBlinkCounter
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *LedBlinkCounterPublication) GetAll() map[string]types.LedBlinkCounter {
+	result := make(map[string]types.LedBlinkCounter)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.LedBlinkCounter
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LedBlinkCounterPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LedBlinkCounterHandler is called with the item for create, modify and delete
+type LedBlinkCounterHandler func(ctx interface{}, key string, item types.LedBlinkCounter)
+
+// LedBlinkCounterSubscription is a pubsub.Subscription of types.LedBlinkCounter
+type LedBlinkCounterSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeLedBlinkCounter subscribes to types.LedBlinkCounter from agentName
+func SubscribeLedBlinkCounter(agentName string, activate bool, ctx interface{}) (*LedBlinkCounterSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.LedBlinkCounter{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LedBlinkCounterSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLedBlinkCounterScope subscribes to types.LedBlinkCounter from agentName/agentScope
+func SubscribeLedBlinkCounterScope(agentName string, agentScope string, activate bool, ctx interface{}) (*LedBlinkCounterSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.LedBlinkCounter{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LedBlinkCounterSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLedBlinkCounterPersistent subscribes to the persistent types.LedBlinkCounter from agentName
+func SubscribeLedBlinkCounterPersistent(agentName string, activate bool, ctx interface{}) (*LedBlinkCounterSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.LedBlinkCounter{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LedBlinkCounterSubscription{Subscription: sub}, nil
+}
+
+func wrapLedBlinkCounterHandler(op string, fn LedBlinkCounterHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.LedBlinkCounter
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LedBlinkCounterSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *LedBlinkCounterSubscription) SetCreateHandler(fn LedBlinkCounterHandler) {
+	sub.CreateHandler = wrapLedBlinkCounterHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *LedBlinkCounterSubscription) SetModifyHandler(fn LedBlinkCounterHandler) {
+	sub.ModifyHandler = wrapLedBlinkCounterHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *LedBlinkCounterSubscription) SetDeleteHandler(fn LedBlinkCounterHandler) {
+	sub.DeleteHandler = wrapLedBlinkCounterHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *LedBlinkCounterSubscription) Get(key string) (types.LedBlinkCounter, error) {
+	var item types.LedBlinkCounter
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *LedBlinkCounterSubscription) GetAll() map[string]types.LedBlinkCounter {
+	result := make(map[string]types.LedBlinkCounter)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.LedBlinkCounter
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LedBlinkCounterSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LispDataplaneConfigPublication is a pubsub.Publication of types.LispDataplaneConfig
+type LispDataplaneConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishLispDataplaneConfig creates a publication of types.LispDataplaneConfig
+func PublishLispDataplaneConfig(agentName string) (*LispDataplaneConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.LispDataplaneConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispDataplaneConfigPublication{Publication: pub}, nil
+}
+
+// PublishLispDataplaneConfigScope creates a scoped publication of types.LispDataplaneConfig
+func PublishLispDataplaneConfigScope(agentName string, agentScope string) (*LispDataplaneConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.LispDataplaneConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispDataplaneConfigPublication{Publication: pub}, nil
+}
+
+// PublishLispDataplaneConfigPersistent creates a persistent publication of types.LispDataplaneConfig
+func PublishLispDataplaneConfigPersistent(agentName string) (*LispDataplaneConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.LispDataplaneConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispDataplaneConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *LispDataplaneConfigPublication) Publish(key string, item types.LispDataplaneConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *LispDataplaneConfigPublication) Get(key string) (types.LispDataplaneConfig, error) {
+	var item types.LispDataplaneConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *LispDataplaneConfigPublication) GetAll() map[string]types.LispDataplaneConfig {
+	result := make(map[string]types.LispDataplaneConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.LispDataplaneConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispDataplaneConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LispDataplaneConfigHandler is called with the item for create, modify and delete
+type LispDataplaneConfigHandler func(ctx interface{}, key string, item types.LispDataplaneConfig)
+
+// LispDataplaneConfigSubscription is a pubsub.Subscription of types.LispDataplaneConfig
+type LispDataplaneConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeLispDataplaneConfig subscribes to types.LispDataplaneConfig from agentName
+func SubscribeLispDataplaneConfig(agentName string, activate bool, ctx interface{}) (*LispDataplaneConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.LispDataplaneConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispDataplaneConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLispDataplaneConfigScope subscribes to types.LispDataplaneConfig from agentName/agentScope
+func SubscribeLispDataplaneConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*LispDataplaneConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.LispDataplaneConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispDataplaneConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLispDataplaneConfigPersistent subscribes to the persistent types.LispDataplaneConfig from agentName
+func SubscribeLispDataplaneConfigPersistent(agentName string, activate bool, ctx interface{}) (*LispDataplaneConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.LispDataplaneConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispDataplaneConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapLispDataplaneConfigHandler(op string, fn LispDataplaneConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.LispDataplaneConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispDataplaneConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *LispDataplaneConfigSubscription) SetCreateHandler(fn LispDataplaneConfigHandler) {
+	sub.CreateHandler = wrapLispDataplaneConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *LispDataplaneConfigSubscription) SetModifyHandler(fn LispDataplaneConfigHandler) {
+	sub.ModifyHandler = wrapLispDataplaneConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *LispDataplaneConfigSubscription) SetDeleteHandler(fn LispDataplaneConfigHandler) {
+	sub.DeleteHandler = wrapLispDataplaneConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *LispDataplaneConfigSubscription) Get(key string) (types.LispDataplaneConfig, error) {
+	var item types.LispDataplaneConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *LispDataplaneConfigSubscription) GetAll() map[string]types.LispDataplaneConfig {
+	result := make(map[string]types.LispDataplaneConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.LispDataplaneConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispDataplaneConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LispInfoStatusPublication is a pubsub.Publication of types.LispInfoStatus
+type LispInfoStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishLispInfoStatus creates a publication of types.LispInfoStatus
+func PublishLispInfoStatus(agentName string) (*LispInfoStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.LispInfoStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispInfoStatusPublication{Publication: pub}, nil
+}
+
+// PublishLispInfoStatusScope creates a scoped publication of types.LispInfoStatus
+func PublishLispInfoStatusScope(agentName string, agentScope string) (*LispInfoStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.LispInfoStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispInfoStatusPublication{Publication: pub}, nil
+}
+
+// PublishLispInfoStatusPersistent creates a persistent publication of types.LispInfoStatus
+func PublishLispInfoStatusPersistent(agentName string) (*LispInfoStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.LispInfoStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispInfoStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *LispInfoStatusPublication) Publish(key string, item types.LispInfoStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *LispInfoStatusPublication) Get(key string) (types.LispInfoStatus, error) {
+	var item types.LispInfoStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *LispInfoStatusPublication) GetAll() map[string]types.LispInfoStatus {
+	result := make(map[string]types.LispInfoStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.LispInfoStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispInfoStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LispInfoStatusHandler is called with the item for create, modify and delete
+type LispInfoStatusHandler func(ctx interface{}, key string, item types.LispInfoStatus)
+
+// LispInfoStatusSubscription is a pubsub.Subscription of types.LispInfoStatus
+type LispInfoStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeLispInfoStatus subscribes to types.LispInfoStatus from agentName
+func SubscribeLispInfoStatus(agentName string, activate bool, ctx interface{}) (*LispInfoStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.LispInfoStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispInfoStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLispInfoStatusScope subscribes to types.LispInfoStatus from agentName/agentScope
+func SubscribeLispInfoStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*LispInfoStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.LispInfoStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispInfoStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLispInfoStatusPersistent subscribes to the persistent types.LispInfoStatus from agentName
+func SubscribeLispInfoStatusPersistent(agentName string, activate bool, ctx interface{}) (*LispInfoStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.LispInfoStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispInfoStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapLispInfoStatusHandler(op string, fn LispInfoStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.LispInfoStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispInfoStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *LispInfoStatusSubscription) SetCreateHandler(fn LispInfoStatusHandler) {
+	sub.CreateHandler = wrapLispInfoStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *LispInfoStatusSubscription) SetModifyHandler(fn LispInfoStatusHandler) {
+	sub.ModifyHandler = wrapLispInfoStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *LispInfoStatusSubscription) SetDeleteHandler(fn LispInfoStatusHandler) {
+	sub.DeleteHandler = wrapLispInfoStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *LispInfoStatusSubscription) Get(key string) (types.LispInfoStatus, error) {
+	var item types.LispInfoStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *LispInfoStatusSubscription) GetAll() map[string]types.LispInfoStatus {
+	result := make(map[string]types.LispInfoStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.LispInfoStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispInfoStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LispMetricsPublication is a pubsub.Publication of types.LispMetrics
+type LispMetricsPublication struct {
+	*pubsub.Publication
+}
+
+// PublishLispMetrics creates a publication of types.LispMetrics
+func PublishLispMetrics(agentName string) (*LispMetricsPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.LispMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispMetricsPublication{Publication: pub}, nil
+}
+
+// PublishLispMetricsScope creates a scoped publication of types.LispMetrics
+func PublishLispMetricsScope(agentName string, agentScope string) (*LispMetricsPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.LispMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispMetricsPublication{Publication: pub}, nil
+}
+
+// PublishLispMetricsPersistent creates a persistent publication of types.LispMetrics
+func PublishLispMetricsPersistent(agentName string) (*LispMetricsPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.LispMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &LispMetricsPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *LispMetricsPublication) Publish(key string, item types.LispMetrics) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *LispMetricsPublication) Get(key string) (types.LispMetrics, error) {
+	var item types.LispMetrics
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *LispMetricsPublication) GetAll() map[string]types.LispMetrics {
+	result := make(map[string]types.LispMetrics)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.LispMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispMetricsPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// LispMetricsHandler is called with the item for create, modify and delete
+type LispMetricsHandler func(ctx interface{}, key string, item types.LispMetrics)
+
+// LispMetricsSubscription is a pubsub.Subscription of types.LispMetrics
+type LispMetricsSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeLispMetrics subscribes to types.LispMetrics from agentName
+func SubscribeLispMetrics(agentName string, activate bool, ctx interface{}) (*LispMetricsSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.LispMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispMetricsSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLispMetricsScope subscribes to types.LispMetrics from agentName/agentScope
+func SubscribeLispMetricsScope(agentName string, agentScope string, activate bool, ctx interface{}) (*LispMetricsSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.LispMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispMetricsSubscription{Subscription: sub}, nil
+}
+
+// SubscribeLispMetricsPersistent subscribes to the persistent types.LispMetrics from agentName
+func SubscribeLispMetricsPersistent(agentName string, activate bool, ctx interface{}) (*LispMetricsSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.LispMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LispMetricsSubscription{Subscription: sub}, nil
+}
+
+func wrapLispMetricsHandler(op string, fn LispMetricsHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.LispMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispMetricsSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *LispMetricsSubscription) SetCreateHandler(fn LispMetricsHandler) {
+	sub.CreateHandler = wrapLispMetricsHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *LispMetricsSubscription) SetModifyHandler(fn LispMetricsHandler) {
+	sub.ModifyHandler = wrapLispMetricsHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *LispMetricsSubscription) SetDeleteHandler(fn LispMetricsHandler) {
+	sub.DeleteHandler = wrapLispMetricsHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *LispMetricsSubscription) Get(key string) (types.LispMetrics, error) {
+	var item types.LispMetrics
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *LispMetricsSubscription) GetAll() map[string]types.LispMetrics {
+	result := make(map[string]types.LispMetrics)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.LispMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("LispMetricsSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkInstanceConfigPublication is a pubsub.Publication of types.NetworkInstanceConfig
+type NetworkInstanceConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishNetworkInstanceConfig creates a publication of types.NetworkInstanceConfig
+func PublishNetworkInstanceConfig(agentName string) (*NetworkInstanceConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.NetworkInstanceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceConfigPublication{Publication: pub}, nil
+}
+
+// PublishNetworkInstanceConfigScope creates a scoped publication of types.NetworkInstanceConfig
+func PublishNetworkInstanceConfigScope(agentName string, agentScope string) (*NetworkInstanceConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.NetworkInstanceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceConfigPublication{Publication: pub}, nil
+}
+
+// PublishNetworkInstanceConfigPersistent creates a persistent publication of types.NetworkInstanceConfig
+func PublishNetworkInstanceConfigPersistent(agentName string) (*NetworkInstanceConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.NetworkInstanceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *NetworkInstanceConfigPublication) Publish(key string, item types.NetworkInstanceConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *NetworkInstanceConfigPublication) Get(key string) (types.NetworkInstanceConfig, error) {
+	var item types.NetworkInstanceConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *NetworkInstanceConfigPublication) GetAll() map[string]types.NetworkInstanceConfig {
+	result := make(map[string]types.NetworkInstanceConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.NetworkInstanceConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkInstanceConfigHandler is called with the item for create, modify and delete
+type NetworkInstanceConfigHandler func(ctx interface{}, key string, item types.NetworkInstanceConfig)
+
+// NetworkInstanceConfigSubscription is a pubsub.Subscription of types.NetworkInstanceConfig
+type NetworkInstanceConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeNetworkInstanceConfig subscribes to types.NetworkInstanceConfig from agentName
+func SubscribeNetworkInstanceConfig(agentName string, activate bool, ctx interface{}) (*NetworkInstanceConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.NetworkInstanceConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkInstanceConfigScope subscribes to types.NetworkInstanceConfig from agentName/agentScope
+func SubscribeNetworkInstanceConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*NetworkInstanceConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.NetworkInstanceConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkInstanceConfigPersistent subscribes to the persistent types.NetworkInstanceConfig from agentName
+func SubscribeNetworkInstanceConfigPersistent(agentName string, activate bool, ctx interface{}) (*NetworkInstanceConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.NetworkInstanceConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapNetworkInstanceConfigHandler(op string, fn NetworkInstanceConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.NetworkInstanceConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *NetworkInstanceConfigSubscription) SetCreateHandler(fn NetworkInstanceConfigHandler) {
+	sub.CreateHandler = wrapNetworkInstanceConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *NetworkInstanceConfigSubscription) SetModifyHandler(fn NetworkInstanceConfigHandler) {
+	sub.ModifyHandler = wrapNetworkInstanceConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *NetworkInstanceConfigSubscription) SetDeleteHandler(fn NetworkInstanceConfigHandler) {
+	sub.DeleteHandler = wrapNetworkInstanceConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *NetworkInstanceConfigSubscription) Get(key string) (types.NetworkInstanceConfig, error) {
+	var item types.NetworkInstanceConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *NetworkInstanceConfigSubscription) GetAll() map[string]types.NetworkInstanceConfig {
+	result := make(map[string]types.NetworkInstanceConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.NetworkInstanceConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkInstanceMetricsPublication is a pubsub.Publication of types.NetworkInstanceMetrics
+type NetworkInstanceMetricsPublication struct {
+	*pubsub.Publication
+}
+
+// PublishNetworkInstanceMetrics creates a publication of types.NetworkInstanceMetrics
+func PublishNetworkInstanceMetrics(agentName string) (*NetworkInstanceMetricsPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.NetworkInstanceMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceMetricsPublication{Publication: pub}, nil
+}
+
+// PublishNetworkInstanceMetricsScope creates a scoped publication of types.NetworkInstanceMetrics
+func PublishNetworkInstanceMetricsScope(agentName string, agentScope string) (*NetworkInstanceMetricsPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.NetworkInstanceMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceMetricsPublication{Publication: pub}, nil
+}
+
+// PublishNetworkInstanceMetricsPersistent creates a persistent publication of types.NetworkInstanceMetrics
+func PublishNetworkInstanceMetricsPersistent(agentName string) (*NetworkInstanceMetricsPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.NetworkInstanceMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceMetricsPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *NetworkInstanceMetricsPublication) Publish(key string, item types.NetworkInstanceMetrics) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *NetworkInstanceMetricsPublication) Get(key string) (types.NetworkInstanceMetrics, error) {
+	var item types.NetworkInstanceMetrics
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *NetworkInstanceMetricsPublication) GetAll() map[string]types.NetworkInstanceMetrics {
+	result := make(map[string]types.NetworkInstanceMetrics)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.NetworkInstanceMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceMetricsPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkInstanceMetricsHandler is called with the item for create, modify and delete
+type NetworkInstanceMetricsHandler func(ctx interface{}, key string, item types.NetworkInstanceMetrics)
+
+// NetworkInstanceMetricsSubscription is a pubsub.Subscription of types.NetworkInstanceMetrics
+type NetworkInstanceMetricsSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeNetworkInstanceMetrics subscribes to types.NetworkInstanceMetrics from agentName
+func SubscribeNetworkInstanceMetrics(agentName string, activate bool, ctx interface{}) (*NetworkInstanceMetricsSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.NetworkInstanceMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceMetricsSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkInstanceMetricsScope subscribes to types.NetworkInstanceMetrics from agentName/agentScope
+func SubscribeNetworkInstanceMetricsScope(agentName string, agentScope string, activate bool, ctx interface{}) (*NetworkInstanceMetricsSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.NetworkInstanceMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceMetricsSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkInstanceMetricsPersistent subscribes to the persistent types.NetworkInstanceMetrics from agentName
+func SubscribeNetworkInstanceMetricsPersistent(agentName string, activate bool, ctx interface{}) (*NetworkInstanceMetricsSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.NetworkInstanceMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceMetricsSubscription{Subscription: sub}, nil
+}
+
+func wrapNetworkInstanceMetricsHandler(op string, fn NetworkInstanceMetricsHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.NetworkInstanceMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceMetricsSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *NetworkInstanceMetricsSubscription) SetCreateHandler(fn NetworkInstanceMetricsHandler) {
+	sub.CreateHandler = wrapNetworkInstanceMetricsHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *NetworkInstanceMetricsSubscription) SetModifyHandler(fn NetworkInstanceMetricsHandler) {
+	sub.ModifyHandler = wrapNetworkInstanceMetricsHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *NetworkInstanceMetricsSubscription) SetDeleteHandler(fn NetworkInstanceMetricsHandler) {
+	sub.DeleteHandler = wrapNetworkInstanceMetricsHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *NetworkInstanceMetricsSubscription) Get(key string) (types.NetworkInstanceMetrics, error) {
+	var item types.NetworkInstanceMetrics
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *NetworkInstanceMetricsSubscription) GetAll() map[string]types.NetworkInstanceMetrics {
+	result := make(map[string]types.NetworkInstanceMetrics)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.NetworkInstanceMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceMetricsSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkInstanceStatusPublication is a pubsub.Publication of types.NetworkInstanceStatus
+type NetworkInstanceStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishNetworkInstanceStatus creates a publication of types.NetworkInstanceStatus
+func PublishNetworkInstanceStatus(agentName string) (*NetworkInstanceStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.NetworkInstanceStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceStatusPublication{Publication: pub}, nil
+}
+
+// PublishNetworkInstanceStatusScope creates a scoped publication of types.NetworkInstanceStatus
+func PublishNetworkInstanceStatusScope(agentName string, agentScope string) (*NetworkInstanceStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.NetworkInstanceStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceStatusPublication{Publication: pub}, nil
+}
+
+// PublishNetworkInstanceStatusPersistent creates a persistent publication of types.NetworkInstanceStatus
+func PublishNetworkInstanceStatusPersistent(agentName string) (*NetworkInstanceStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.NetworkInstanceStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *NetworkInstanceStatusPublication) Publish(key string, item types.NetworkInstanceStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *NetworkInstanceStatusPublication) Get(key string) (types.NetworkInstanceStatus, error) {
+	var item types.NetworkInstanceStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *NetworkInstanceStatusPublication) GetAll() map[string]types.NetworkInstanceStatus {
+	result := make(map[string]types.NetworkInstanceStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.NetworkInstanceStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkInstanceStatusHandler is called with the item for create, modify and delete
+type NetworkInstanceStatusHandler func(ctx interface{}, key string, item types.NetworkInstanceStatus)
+
+// NetworkInstanceStatusSubscription is a pubsub.Subscription of types.NetworkInstanceStatus
+type NetworkInstanceStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeNetworkInstanceStatus subscribes to types.NetworkInstanceStatus from agentName
+func SubscribeNetworkInstanceStatus(agentName string, activate bool, ctx interface{}) (*NetworkInstanceStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.NetworkInstanceStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkInstanceStatusScope subscribes to types.NetworkInstanceStatus from agentName/agentScope
+func SubscribeNetworkInstanceStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*NetworkInstanceStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.NetworkInstanceStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkInstanceStatusPersistent subscribes to the persistent types.NetworkInstanceStatus from agentName
+func SubscribeNetworkInstanceStatusPersistent(agentName string, activate bool, ctx interface{}) (*NetworkInstanceStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.NetworkInstanceStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInstanceStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapNetworkInstanceStatusHandler(op string, fn NetworkInstanceStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.NetworkInstanceStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *NetworkInstanceStatusSubscription) SetCreateHandler(fn NetworkInstanceStatusHandler) {
+	sub.CreateHandler = wrapNetworkInstanceStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *NetworkInstanceStatusSubscription) SetModifyHandler(fn NetworkInstanceStatusHandler) {
+	sub.ModifyHandler = wrapNetworkInstanceStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *NetworkInstanceStatusSubscription) SetDeleteHandler(fn NetworkInstanceStatusHandler) {
+	sub.DeleteHandler = wrapNetworkInstanceStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *NetworkInstanceStatusSubscription) Get(key string) (types.NetworkInstanceStatus, error) {
+	var item types.NetworkInstanceStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *NetworkInstanceStatusSubscription) GetAll() map[string]types.NetworkInstanceStatus {
+	result := make(map[string]types.NetworkInstanceStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.NetworkInstanceStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkInstanceStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkMetricsPublication is a pubsub.Publication of types.NetworkMetrics
+type NetworkMetricsPublication struct {
+	*pubsub.Publication
+}
+
+// PublishNetworkMetrics creates a publication of types.NetworkMetrics
+func PublishNetworkMetrics(agentName string) (*NetworkMetricsPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.NetworkMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkMetricsPublication{Publication: pub}, nil
+}
+
+// PublishNetworkMetricsScope creates a scoped publication of types.NetworkMetrics
+func PublishNetworkMetricsScope(agentName string, agentScope string) (*NetworkMetricsPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.NetworkMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkMetricsPublication{Publication: pub}, nil
+}
+
+// PublishNetworkMetricsPersistent creates a persistent publication of types.NetworkMetrics
+func PublishNetworkMetricsPersistent(agentName string) (*NetworkMetricsPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.NetworkMetrics{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkMetricsPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *NetworkMetricsPublication) Publish(key string, item types.NetworkMetrics) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *NetworkMetricsPublication) Get(key string) (types.NetworkMetrics, error) {
+	var item types.NetworkMetrics
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *NetworkMetricsPublication) GetAll() map[string]types.NetworkMetrics {
+	result := make(map[string]types.NetworkMetrics)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.NetworkMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkMetricsPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkMetricsHandler is called with the item for create, modify and delete
+type NetworkMetricsHandler func(ctx interface{}, key string, item types.NetworkMetrics)
+
+// NetworkMetricsSubscription is a pubsub.Subscription of types.NetworkMetrics
+type NetworkMetricsSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeNetworkMetrics subscribes to types.NetworkMetrics from agentName
+func SubscribeNetworkMetrics(agentName string, activate bool, ctx interface{}) (*NetworkMetricsSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.NetworkMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkMetricsSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkMetricsScope subscribes to types.NetworkMetrics from agentName/agentScope
+func SubscribeNetworkMetricsScope(agentName string, agentScope string, activate bool, ctx interface{}) (*NetworkMetricsSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.NetworkMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkMetricsSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkMetricsPersistent subscribes to the persistent types.NetworkMetrics from agentName
+func SubscribeNetworkMetricsPersistent(agentName string, activate bool, ctx interface{}) (*NetworkMetricsSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.NetworkMetrics{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkMetricsSubscription{Subscription: sub}, nil
+}
+
+func wrapNetworkMetricsHandler(op string, fn NetworkMetricsHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.NetworkMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkMetricsSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *NetworkMetricsSubscription) SetCreateHandler(fn NetworkMetricsHandler) {
+	sub.CreateHandler = wrapNetworkMetricsHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *NetworkMetricsSubscription) SetModifyHandler(fn NetworkMetricsHandler) {
+	sub.ModifyHandler = wrapNetworkMetricsHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *NetworkMetricsSubscription) SetDeleteHandler(fn NetworkMetricsHandler) {
+	sub.DeleteHandler = wrapNetworkMetricsHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *NetworkMetricsSubscription) Get(key string) (types.NetworkMetrics, error) {
+	var item types.NetworkMetrics
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *NetworkMetricsSubscription) GetAll() map[string]types.NetworkMetrics {
+	result := make(map[string]types.NetworkMetrics)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.NetworkMetrics
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkMetricsSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkXObjectConfigPublication is a pubsub.Publication of types.NetworkXObjectConfig
+type NetworkXObjectConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishNetworkXObjectConfig creates a publication of types.NetworkXObjectConfig
+func PublishNetworkXObjectConfig(agentName string) (*NetworkXObjectConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.NetworkXObjectConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkXObjectConfigPublication{Publication: pub}, nil
+}
+
+// PublishNetworkXObjectConfigScope creates a scoped publication of types.NetworkXObjectConfig
+func PublishNetworkXObjectConfigScope(agentName string, agentScope string) (*NetworkXObjectConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.NetworkXObjectConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkXObjectConfigPublication{Publication: pub}, nil
+}
+
+// PublishNetworkXObjectConfigPersistent creates a persistent publication of types.NetworkXObjectConfig
+func PublishNetworkXObjectConfigPersistent(agentName string) (*NetworkXObjectConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.NetworkXObjectConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkXObjectConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *NetworkXObjectConfigPublication) Publish(key string, item types.NetworkXObjectConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *NetworkXObjectConfigPublication) Get(key string) (types.NetworkXObjectConfig, error) {
+	var item types.NetworkXObjectConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *NetworkXObjectConfigPublication) GetAll() map[string]types.NetworkXObjectConfig {
+	result := make(map[string]types.NetworkXObjectConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.NetworkXObjectConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkXObjectConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// NetworkXObjectConfigHandler is called with the item for create, modify and delete
+type NetworkXObjectConfigHandler func(ctx interface{}, key string, item types.NetworkXObjectConfig)
+
+// NetworkXObjectConfigSubscription is a pubsub.Subscription of types.NetworkXObjectConfig
+type NetworkXObjectConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeNetworkXObjectConfig subscribes to types.NetworkXObjectConfig from agentName
+func SubscribeNetworkXObjectConfig(agentName string, activate bool, ctx interface{}) (*NetworkXObjectConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.NetworkXObjectConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkXObjectConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkXObjectConfigScope subscribes to types.NetworkXObjectConfig from agentName/agentScope
+func SubscribeNetworkXObjectConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*NetworkXObjectConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.NetworkXObjectConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkXObjectConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeNetworkXObjectConfigPersistent subscribes to the persistent types.NetworkXObjectConfig from agentName
+func SubscribeNetworkXObjectConfigPersistent(agentName string, activate bool, ctx interface{}) (*NetworkXObjectConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.NetworkXObjectConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkXObjectConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapNetworkXObjectConfigHandler(op string, fn NetworkXObjectConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.NetworkXObjectConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkXObjectConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *NetworkXObjectConfigSubscription) SetCreateHandler(fn NetworkXObjectConfigHandler) {
+	sub.CreateHandler = wrapNetworkXObjectConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *NetworkXObjectConfigSubscription) SetModifyHandler(fn NetworkXObjectConfigHandler) {
+	sub.ModifyHandler = wrapNetworkXObjectConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *NetworkXObjectConfigSubscription) SetDeleteHandler(fn NetworkXObjectConfigHandler) {
+	sub.DeleteHandler = wrapNetworkXObjectConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *NetworkXObjectConfigSubscription) Get(key string) (types.NetworkXObjectConfig, error) {
+	var item types.NetworkXObjectConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *NetworkXObjectConfigSubscription) GetAll() map[string]types.NetworkXObjectConfig {
+	result := make(map[string]types.NetworkXObjectConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.NetworkXObjectConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("NetworkXObjectConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// UuidToNumPublication is a pubsub.Publication of types.UuidToNum
+type UuidToNumPublication struct {
+	*pubsub.Publication
+}
+
+// PublishUuidToNum creates a publication of types.UuidToNum
+func PublishUuidToNum(agentName string) (*UuidToNumPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.UuidToNum{})
+	if err != nil {
+		return nil, err
+	}
+	return &UuidToNumPublication{Publication: pub}, nil
+}
+
+// PublishUuidToNumScope creates a scoped publication of types.UuidToNum
+func PublishUuidToNumScope(agentName string, agentScope string) (*UuidToNumPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.UuidToNum{})
+	if err != nil {
+		return nil, err
+	}
+	return &UuidToNumPublication{Publication: pub}, nil
+}
+
+// PublishUuidToNumPersistent creates a persistent publication of types.UuidToNum
+func PublishUuidToNumPersistent(agentName string) (*UuidToNumPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.UuidToNum{})
+	if err != nil {
+		return nil, err
+	}
+	return &UuidToNumPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *UuidToNumPublication) Publish(key string, item types.UuidToNum) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *UuidToNumPublication) Get(key string) (types.UuidToNum, error) {
+	var item types.UuidToNum
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *UuidToNumPublication) GetAll() map[string]types.UuidToNum {
+	result := make(map[string]types.UuidToNum)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.UuidToNum
+		if err := decode(m, &item); err != nil {
+			log.Errorf("UuidToNumPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// UuidToNumHandler is called with the item for create, modify and delete
+type UuidToNumHandler func(ctx interface{}, key string, item types.UuidToNum)
+
+// UuidToNumSubscription is a pubsub.Subscription of types.UuidToNum
+type UuidToNumSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeUuidToNum subscribes to types.UuidToNum from agentName
+func SubscribeUuidToNum(agentName string, activate bool, ctx interface{}) (*UuidToNumSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.UuidToNum{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UuidToNumSubscription{Subscription: sub}, nil
+}
+
+// SubscribeUuidToNumScope subscribes to types.UuidToNum from agentName/agentScope
+func SubscribeUuidToNumScope(agentName string, agentScope string, activate bool, ctx interface{}) (*UuidToNumSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.UuidToNum{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UuidToNumSubscription{Subscription: sub}, nil
+}
+
+// SubscribeUuidToNumPersistent subscribes to the persistent types.UuidToNum from agentName
+func SubscribeUuidToNumPersistent(agentName string, activate bool, ctx interface{}) (*UuidToNumSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.UuidToNum{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UuidToNumSubscription{Subscription: sub}, nil
+}
+
+func wrapUuidToNumHandler(op string, fn UuidToNumHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.UuidToNum
+		if err := decode(m, &item); err != nil {
+			log.Errorf("UuidToNumSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *UuidToNumSubscription) SetCreateHandler(fn UuidToNumHandler) {
+	sub.CreateHandler = wrapUuidToNumHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *UuidToNumSubscription) SetModifyHandler(fn UuidToNumHandler) {
+	sub.ModifyHandler = wrapUuidToNumHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *UuidToNumSubscription) SetDeleteHandler(fn UuidToNumHandler) {
+	sub.DeleteHandler = wrapUuidToNumHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *UuidToNumSubscription) Get(key string) (types.UuidToNum, error) {
+	var item types.UuidToNum
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *UuidToNumSubscription) GetAll() map[string]types.UuidToNum {
+	result := make(map[string]types.UuidToNum)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.UuidToNum
+		if err := decode(m, &item); err != nil {
+			log.Errorf("UuidToNumSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// VerifyImageConfigPublication is a pubsub.Publication of types.VerifyImageConfig
+type VerifyImageConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishVerifyImageConfig creates a publication of types.VerifyImageConfig
+func PublishVerifyImageConfig(agentName string) (*VerifyImageConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.VerifyImageConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageConfigPublication{Publication: pub}, nil
+}
+
+// PublishVerifyImageConfigScope creates a scoped publication of types.VerifyImageConfig
+func PublishVerifyImageConfigScope(agentName string, agentScope string) (*VerifyImageConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.VerifyImageConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageConfigPublication{Publication: pub}, nil
+}
+
+// PublishVerifyImageConfigPersistent creates a persistent publication of types.VerifyImageConfig
+func PublishVerifyImageConfigPersistent(agentName string) (*VerifyImageConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.VerifyImageConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *VerifyImageConfigPublication) Publish(key string, item types.VerifyImageConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *VerifyImageConfigPublication) Get(key string) (types.VerifyImageConfig, error) {
+	var item types.VerifyImageConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *VerifyImageConfigPublication) GetAll() map[string]types.VerifyImageConfig {
+	result := make(map[string]types.VerifyImageConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.VerifyImageConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("VerifyImageConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// VerifyImageConfigHandler is called with the item for create, modify and delete
+type VerifyImageConfigHandler func(ctx interface{}, key string, item types.VerifyImageConfig)
+
+// VerifyImageConfigSubscription is a pubsub.Subscription of types.VerifyImageConfig
+type VerifyImageConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeVerifyImageConfig subscribes to types.VerifyImageConfig from agentName
+func SubscribeVerifyImageConfig(agentName string, activate bool, ctx interface{}) (*VerifyImageConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.VerifyImageConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeVerifyImageConfigScope subscribes to types.VerifyImageConfig from agentName/agentScope
+func SubscribeVerifyImageConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*VerifyImageConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.VerifyImageConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeVerifyImageConfigPersistent subscribes to the persistent types.VerifyImageConfig from agentName
+func SubscribeVerifyImageConfigPersistent(agentName string, activate bool, ctx interface{}) (*VerifyImageConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.VerifyImageConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapVerifyImageConfigHandler(op string, fn VerifyImageConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.VerifyImageConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("VerifyImageConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *VerifyImageConfigSubscription) SetCreateHandler(fn VerifyImageConfigHandler) {
+	sub.CreateHandler = wrapVerifyImageConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *VerifyImageConfigSubscription) SetModifyHandler(fn VerifyImageConfigHandler) {
+	sub.ModifyHandler = wrapVerifyImageConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *VerifyImageConfigSubscription) SetDeleteHandler(fn VerifyImageConfigHandler) {
+	sub.DeleteHandler = wrapVerifyImageConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *VerifyImageConfigSubscription) Get(key string) (types.VerifyImageConfig, error) {
+	var item types.VerifyImageConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *VerifyImageConfigSubscription) GetAll() map[string]types.VerifyImageConfig {
+	result := make(map[string]types.VerifyImageConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.VerifyImageConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("VerifyImageConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// VerifyImageStatusPublication is a pubsub.Publication of types.VerifyImageStatus
+type VerifyImageStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishVerifyImageStatus creates a publication of types.VerifyImageStatus
+func PublishVerifyImageStatus(agentName string) (*VerifyImageStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.VerifyImageStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageStatusPublication{Publication: pub}, nil
+}
+
+// PublishVerifyImageStatusScope creates a scoped publication of types.VerifyImageStatus
+func PublishVerifyImageStatusScope(agentName string, agentScope string) (*VerifyImageStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.VerifyImageStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageStatusPublication{Publication: pub}, nil
+}
+
+// PublishVerifyImageStatusPersistent creates a persistent publication of types.VerifyImageStatus
+func PublishVerifyImageStatusPersistent(agentName string) (*VerifyImageStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.VerifyImageStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *VerifyImageStatusPublication) Publish(key string, item types.VerifyImageStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *VerifyImageStatusPublication) Get(key string) (types.VerifyImageStatus, error) {
+	var item types.VerifyImageStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *VerifyImageStatusPublication) GetAll() map[string]types.VerifyImageStatus {
+	result := make(map[string]types.VerifyImageStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.VerifyImageStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("VerifyImageStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// VerifyImageStatusHandler is called with the item for create, modify and delete
+type VerifyImageStatusHandler func(ctx interface{}, key string, item types.VerifyImageStatus)
+
+// VerifyImageStatusSubscription is a pubsub.Subscription of types.VerifyImageStatus
+type VerifyImageStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeVerifyImageStatus subscribes to types.VerifyImageStatus from agentName
+func SubscribeVerifyImageStatus(agentName string, activate bool, ctx interface{}) (*VerifyImageStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.VerifyImageStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeVerifyImageStatusScope subscribes to types.VerifyImageStatus from agentName/agentScope
+func SubscribeVerifyImageStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*VerifyImageStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.VerifyImageStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeVerifyImageStatusPersistent subscribes to the persistent types.VerifyImageStatus from agentName
+func SubscribeVerifyImageStatusPersistent(agentName string, activate bool, ctx interface{}) (*VerifyImageStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.VerifyImageStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyImageStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapVerifyImageStatusHandler(op string, fn VerifyImageStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.VerifyImageStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("VerifyImageStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *VerifyImageStatusSubscription) SetCreateHandler(fn VerifyImageStatusHandler) {
+	sub.CreateHandler = wrapVerifyImageStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *VerifyImageStatusSubscription) SetModifyHandler(fn VerifyImageStatusHandler) {
+	sub.ModifyHandler = wrapVerifyImageStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *VerifyImageStatusSubscription) SetDeleteHandler(fn VerifyImageStatusHandler) {
+	sub.DeleteHandler = wrapVerifyImageStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *VerifyImageStatusSubscription) Get(key string) (types.VerifyImageStatus, error) {
+	var item types.VerifyImageStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *VerifyImageStatusSubscription) GetAll() map[string]types.VerifyImageStatus {
+	result := make(map[string]types.VerifyImageStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.VerifyImageStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("VerifyImageStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// ZbootConfigPublication is a pubsub.Publication of types.ZbootConfig
+type ZbootConfigPublication struct {
+	*pubsub.Publication
+}
+
+// PublishZbootConfig creates a publication of types.ZbootConfig
+func PublishZbootConfig(agentName string) (*ZbootConfigPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.ZbootConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootConfigPublication{Publication: pub}, nil
+}
+
+// PublishZbootConfigScope creates a scoped publication of types.ZbootConfig
+func PublishZbootConfigScope(agentName string, agentScope string) (*ZbootConfigPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.ZbootConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootConfigPublication{Publication: pub}, nil
+}
+
+// PublishZbootConfigPersistent creates a persistent publication of types.ZbootConfig
+func PublishZbootConfigPersistent(agentName string) (*ZbootConfigPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.ZbootConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootConfigPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *ZbootConfigPublication) Publish(key string, item types.ZbootConfig) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *ZbootConfigPublication) Get(key string) (types.ZbootConfig, error) {
+	var item types.ZbootConfig
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *ZbootConfigPublication) GetAll() map[string]types.ZbootConfig {
+	result := make(map[string]types.ZbootConfig)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.ZbootConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ZbootConfigPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// ZbootConfigHandler is called with the item for create, modify and delete
+type ZbootConfigHandler func(ctx interface{}, key string, item types.ZbootConfig)
+
+// ZbootConfigSubscription is a pubsub.Subscription of types.ZbootConfig
+type ZbootConfigSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeZbootConfig subscribes to types.ZbootConfig from agentName
+func SubscribeZbootConfig(agentName string, activate bool, ctx interface{}) (*ZbootConfigSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.ZbootConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeZbootConfigScope subscribes to types.ZbootConfig from agentName/agentScope
+func SubscribeZbootConfigScope(agentName string, agentScope string, activate bool, ctx interface{}) (*ZbootConfigSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.ZbootConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootConfigSubscription{Subscription: sub}, nil
+}
+
+// SubscribeZbootConfigPersistent subscribes to the persistent types.ZbootConfig from agentName
+func SubscribeZbootConfigPersistent(agentName string, activate bool, ctx interface{}) (*ZbootConfigSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.ZbootConfig{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootConfigSubscription{Subscription: sub}, nil
+}
+
+func wrapZbootConfigHandler(op string, fn ZbootConfigHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.ZbootConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ZbootConfigSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *ZbootConfigSubscription) SetCreateHandler(fn ZbootConfigHandler) {
+	sub.CreateHandler = wrapZbootConfigHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *ZbootConfigSubscription) SetModifyHandler(fn ZbootConfigHandler) {
+	sub.ModifyHandler = wrapZbootConfigHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *ZbootConfigSubscription) SetDeleteHandler(fn ZbootConfigHandler) {
+	sub.DeleteHandler = wrapZbootConfigHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *ZbootConfigSubscription) Get(key string) (types.ZbootConfig, error) {
+	var item types.ZbootConfig
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *ZbootConfigSubscription) GetAll() map[string]types.ZbootConfig {
+	result := make(map[string]types.ZbootConfig)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.ZbootConfig
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ZbootConfigSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// ZbootStatusPublication is a pubsub.Publication of types.ZbootStatus
+type ZbootStatusPublication struct {
+	*pubsub.Publication
+}
+
+// PublishZbootStatus creates a publication of types.ZbootStatus
+func PublishZbootStatus(agentName string) (*ZbootStatusPublication, error) {
+	pub, err := pubsub.Publish(agentName, types.ZbootStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootStatusPublication{Publication: pub}, nil
+}
+
+// PublishZbootStatusScope creates a scoped publication of types.ZbootStatus
+func PublishZbootStatusScope(agentName string, agentScope string) (*ZbootStatusPublication, error) {
+	pub, err := pubsub.PublishScope(agentName, agentScope, types.ZbootStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootStatusPublication{Publication: pub}, nil
+}
+
+// PublishZbootStatusPersistent creates a persistent publication of types.ZbootStatus
+func PublishZbootStatusPersistent(agentName string) (*ZbootStatusPublication, error) {
+	pub, err := pubsub.PublishPersistent(agentName, types.ZbootStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootStatusPublication{Publication: pub}, nil
+}
+
+// Publish the item under key
+func (pub *ZbootStatusPublication) Publish(key string, item types.ZbootStatus) error {
+	return pub.Publication.Publish(key, item)
+}
+
+// Get the item published under key
+func (pub *ZbootStatusPublication) Get(key string) (types.ZbootStatus, error) {
+	var item types.ZbootStatus
+	m, err := pub.Publication.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the published items
+func (pub *ZbootStatusPublication) GetAll() map[string]types.ZbootStatus {
+	result := make(map[string]types.ZbootStatus)
+	for key, m := range pub.Publication.GetAll() {
+		var item types.ZbootStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ZbootStatusPublication.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
+
+// ZbootStatusHandler is called with the item for create, modify and delete
+type ZbootStatusHandler func(ctx interface{}, key string, item types.ZbootStatus)
+
+// ZbootStatusSubscription is a pubsub.Subscription of types.ZbootStatus
+type ZbootStatusSubscription struct {
+	*pubsub.Subscription
+}
+
+// SubscribeZbootStatus subscribes to types.ZbootStatus from agentName
+func SubscribeZbootStatus(agentName string, activate bool, ctx interface{}) (*ZbootStatusSubscription, error) {
+	sub, err := pubsub.Subscribe(agentName, types.ZbootStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeZbootStatusScope subscribes to types.ZbootStatus from agentName/agentScope
+func SubscribeZbootStatusScope(agentName string, agentScope string, activate bool, ctx interface{}) (*ZbootStatusSubscription, error) {
+	sub, err := pubsub.SubscribeScope(agentName, agentScope, types.ZbootStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootStatusSubscription{Subscription: sub}, nil
+}
+
+// SubscribeZbootStatusPersistent subscribes to the persistent types.ZbootStatus from agentName
+func SubscribeZbootStatusPersistent(agentName string, activate bool, ctx interface{}) (*ZbootStatusSubscription, error) {
+	sub, err := pubsub.SubscribePersistent(agentName, types.ZbootStatus{}, activate, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ZbootStatusSubscription{Subscription: sub}, nil
+}
+
+func wrapZbootStatusHandler(op string, fn ZbootStatusHandler) pubsub.SubHandler {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx interface{}, key string, m interface{}) {
+		var item types.ZbootStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ZbootStatusSubscription %s key %s: %s; ignored\n",
+				op, key, err)
+			return
+		}
+		fn(ctx, key, item)
+	}
+}
+
+// SetCreateHandler sets a typed CreateHandler
+func (sub *ZbootStatusSubscription) SetCreateHandler(fn ZbootStatusHandler) {
+	sub.CreateHandler = wrapZbootStatusHandler("create", fn)
+}
+
+// SetModifyHandler sets a typed ModifyHandler
+func (sub *ZbootStatusSubscription) SetModifyHandler(fn ZbootStatusHandler) {
+	sub.ModifyHandler = wrapZbootStatusHandler("modify", fn)
+}
+
+// SetDeleteHandler sets a typed DeleteHandler
+func (sub *ZbootStatusSubscription) SetDeleteHandler(fn ZbootStatusHandler) {
+	sub.DeleteHandler = wrapZbootStatusHandler("delete", fn)
+}
+
+// Get the item for key
+func (sub *ZbootStatusSubscription) Get(key string) (types.ZbootStatus, error) {
+	var item types.ZbootStatus
+	m, err := sub.Subscription.Get(key)
+	if err != nil {
+		return item, err
+	}
+	err = decode(m, &item)
+	return item, err
+}
+
+// GetAll returns all of the items in the subscribed collection
+func (sub *ZbootStatusSubscription) GetAll() map[string]types.ZbootStatus {
+	result := make(map[string]types.ZbootStatus)
+	for key, m := range sub.Subscription.GetAll() {
+		var item types.ZbootStatus
+		if err := decode(m, &item); err != nil {
+			log.Errorf("ZbootStatusSubscription.GetAll key %s: %s\n", key, err)
+			continue
+		}
+		result[key] = item
+	}
+	return result
+}
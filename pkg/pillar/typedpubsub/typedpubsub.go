@@ -0,0 +1,45 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Typed wrappers around pubsub. For each topic in types there is a
+// XxxPublication and XxxSubscription with typed Publish/Get/GetAll and
+// typed handlers, so a mismatch between a topic and its type is caught
+// at compile time instead of by the cast package at runtime.
+// Usage:
+//  p1, err := typedpubsub.PublishDomainStatus(agentName)
+//  p1.Publish(key, status)
+//  status, err := p1.Get(key)
+//
+//  s1, err := typedpubsub.SubscribeDomainConfig("zedmanager", false, &ctx)
+//  s1.SetModifyHandler(func(ctx interface{}, key string,
+//      config types.DomainConfig) { ... })
+//  s1.Activate()
+//  ...
+//  case change := <-s1.C:
+//      s1.ProcessChange(change)
+//
+// The embedded pubsub.Publication and pubsub.Subscription remain
+// available hence agents can be migrated one topic at a time.
+// The wrappers are generated by generate.go; add new topics there.
+
+//go:generate go run generate.go
+
+package typedpubsub
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// decode converts an item from the untyped collection into the
+// typed output. Items are stored as generic json values by pubsub.
+func decode(in interface{}, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("json Marshal: %s", err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("json Unmarshal: %s", err)
+	}
+	return nil
+}
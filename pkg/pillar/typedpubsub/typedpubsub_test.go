@@ -0,0 +1,40 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package typedpubsub
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedHandler(t *testing.T) {
+	sub, err := SubscribeDomainConfig("zedmanager", false, nil)
+	assert.Nil(t, err)
+
+	var got []types.DomainConfig
+	sub.SetModifyHandler(func(ctx interface{}, key string,
+		config types.DomainConfig) {
+		got = append(got, config)
+	})
+	// pubsub hands the handlers generic json values
+	sub.ModifyHandler(nil, "key1", map[string]interface{}{
+		"DisplayName": "app1",
+		"AppNum":      3,
+	})
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "app1", got[0].DisplayName)
+	assert.Equal(t, 3, got[0].AppNum)
+
+	// A value which does not match the type is dropped instead of
+	// causing a fatal error
+	sub.ModifyHandler(nil, "key2", map[string]interface{}{
+		"AppNum": "not a number",
+	})
+	assert.Equal(t, 1, len(got))
+
+	sub.SetDeleteHandler(nil)
+	assert.Nil(t, sub.DeleteHandler)
+}
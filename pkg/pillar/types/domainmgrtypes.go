@@ -93,7 +93,8 @@ type DomainStatus struct {
 	PendingModify      bool
 	PendingDelete      bool
 	DomainName         string // Name of Xen domain
-	DomainId           int
+	DomainId           int    // Domain ID or process ID depending on Hypervisor
+	Hypervisor         string // Backend running the domain e.g., xen, kvm, rkt
 	BootTime           time.Time
 	DiskStatusList     []DiskStatus
 	VifList            []VifInfo
@@ -110,6 +111,7 @@ type DomainStatus struct {
 	IsContainer        bool   // Is this Domain for a Container?
 	ContainerImageID   string // SHA-512 of rkt container image
 	PodUUID            string // Pod UUID outputted by rkt
+	ConsoleFile        string // Guest console or container output
 }
 
 func (status DomainStatus) Key() string {
@@ -179,6 +181,7 @@ type DiskStatus struct {
 type ImageStatus struct {
 	Filename     string // Basename; used as key
 	FileLocation string // Local location of Image
+	ImageSha256  string // Blob it was copied from; referenced in the blob store
 	RefCount     uint
 	LastUse      time.Time // When RefCount dropped to zero
 	Size         uint64
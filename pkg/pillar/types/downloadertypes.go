@@ -10,20 +10,6 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// RktCredentials is a rkt based Container Credentials
-type RktCredentials struct {
-	User     string `json:"user"`
-	Password string `json:"password"`
-}
-
-// RktAuthInfo is a rkt based Container Authentication Info
-type RktAuthInfo struct {
-	RktKind     string         `json:"rktkind"`
-	RktVersion  string         `json:"rktversion"`
-	Registries  []string       `json:"registries"`
-	Credentials RktCredentials `json:"credentials"`
-}
-
 // The key/index to this is the Safename which is allocated by ZedManager.
 // That is the filename in which we store the corresponding json files.
 type DownloaderConfig struct {
@@ -78,10 +64,15 @@ type DownloaderStatus struct {
 	UseFreeMgmtPorts bool
 	ImageSha256      string // sha256 of immutable image
 	ContainerImageID string
-	State            SwState // DOWNLOADED etc
-	ReservedSpace    uint64  // Contribution to global ReservedSpace
-	Size             uint64  // Once DOWNLOADED; in bytes
-	Progress         uint    // In percent i.e., 0-100
+	ImageDigest      string   // OCI manifest digest if IsContainer
+	LayerDigests     []string // OCI layer digests for the verifier
+	State            SwState  // DOWNLOADED etc
+	ReservedSpace    uint64   // Contribution to global ReservedSpace
+	Size             uint64   // Once DOWNLOADED; in bytes
+	Progress         uint     // In percent i.e., 0-100
+	ResumedSize      uint64   // Bytes kept from an interrupted download
+	Throttled        bool     // A download bandwidth limit applies
+	WaitingForWindow bool     // All ports are outside their download windows
 	ModTime          time.Time
 	LastErr          string // Download error
 	LastErrTime      time.Time
@@ -127,6 +118,16 @@ type GlobalDownloadStatus struct {
 	UsedSpace      uint64 // Number of kbytes used in /var/tmp/zedmanager/downloads
 	ReservedSpace  uint64 // Reserved for ongoing downloads
 	RemainingSpace uint64 // MaxSpace - UsedSpace - ReservedSpace
+	MaxBandwidth   uint32 // In kbytes/sec for all downloads; zero means no limit
+	Ports          []DownloadPortStatus
+}
+
+// DownloadPortStatus is the download throttling in effect for a port
+type DownloadPortStatus struct {
+	IfName       string
+	MaxBandwidth uint32 // In kbytes/sec; zero means no limit
+	Windows      string
+	InWindow     bool
 }
 
 // DatastoreContext : datastore detail
@@ -5,8 +5,11 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	log "github.com/sirupsen/logrus"
@@ -31,12 +34,22 @@ type GlobalConfig struct {
 	FallbackIfCloudGoneTime uint32 // ... and shorter during update
 	MintimeUpdateSuccess    uint32 // time before zedagent declares success
 	StaleConfigTime         uint32 // On reboot use saved config if not stale
-	DownloadGCTime          uint32 // Garbage collect if no use
+	DownloadGCTime          uint32 // Garbage collect blob if no references
 	VdiskGCTime             uint32 // Garbage collect RW disk if no use
 
 	DownloadRetryTime   uint32 // Retry failed download after N sec
 	DomainBootRetryTime uint32 // Retry failed boot after N sec
 
+	DownloadChunks       uint32 // Concurrent ranged chunks for large objects
+	DownloadMaxBandwidth uint32 // In kbytes/sec for all downloads; zero means no limit
+
+	LogSpoolMaxSize uint32 // In Mbytes; logs kept while we can't send them
+	AppLogRateLimit uint32 // Console/stdout lines/sec per app instance
+
+	// Local Prometheus metrics endpoint; disabled unless both are set
+	MetricsExportPort      uint32 // TCP port
+	MetricsExportInterface string // Management port to listen on
+
 	// Control NIM testing behavior: In seconds
 	NetworkGeoRedoTime        uint32   // Periodic IP geolocation
 	NetworkGeoRetryTime       uint32   // Redo IP geolocation failure
@@ -64,12 +77,18 @@ type GlobalConfig struct {
 	AllowAppVnc           bool
 	DefaultLogLevel       string
 	DefaultRemoteLogLevel string
+	SyslogServer          string // Copy of the logs to udp, tcp or tls://host:port
 	// XXX add max space for downloads?
 	// XXX add LTE management port usage policy?
 
 	// Per agent settings of log levels; if set for an agent it
 	// overrides the Default*Level above
 	AgentSettings map[string]PerAgentSettings
+
+	// Per port download settings. The key is the port name, the ifname,
+	// or "free" or "metered" for all ports of that kind, tried in
+	// that order.
+	DownloadPortSettings map[string]PerPortDownloadSettings
 }
 
 type PerAgentSettings struct {
@@ -77,6 +96,70 @@ type PerAgentSettings struct {
 	RemoteLogLevel string // What we log to zedcloud
 }
 
+type PerPortDownloadSettings struct {
+	MaxBandwidth uint32 // In kbytes/sec; zero means no limit
+	Windows      string // UTC e.g., "22:00-06:00,12:00-13:00"; empty means always
+}
+
+// DownloadWindow is a time of day range in minutes after midnight UTC.
+// An End before Start means the window wraps past midnight.
+type DownloadWindow struct {
+	Start int
+	End   int
+}
+
+// ParseDownloadWindows parses a comma separated list of HH:MM-HH:MM
+func ParseDownloadWindows(str string) ([]DownloadWindow, error) {
+	var windows []DownloadWindow
+	if strings.TrimSpace(str) == "" {
+		return windows, nil
+	}
+	for _, w := range strings.Split(str, ",") {
+		times := strings.Split(strings.TrimSpace(w), "-")
+		if len(times) != 2 {
+			return nil, fmt.Errorf("bad download window %s", w)
+		}
+		start, err := parseTimeOfDay(times[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseTimeOfDay(times[1])
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, DownloadWindow{Start: start, End: end})
+	}
+	return windows, nil
+}
+
+func parseTimeOfDay(str string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(str))
+	if err != nil {
+		return 0, fmt.Errorf("bad time of day %s: %s", str, err)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// InDownloadWindows returns true if t is in one of the windows or if
+// there are no windows
+func InDownloadWindows(windows []DownloadWindow, t time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+	t = t.UTC()
+	now := t.Hour()*60 + t.Minute()
+	for _, w := range windows {
+		if w.Start <= w.End {
+			if now >= w.Start && now < w.End {
+				return true
+			}
+		} else if now >= w.Start || now < w.End {
+			return true
+		}
+	}
+	return false
+}
+
 // Default values until/unless we receive them from the cloud
 // We do a GET of config every 60 seconds,
 // PUT of metrics every 60 seconds,
@@ -84,7 +167,8 @@ type PerAgentSettings struct {
 // and during a post-update boot that time is reduced to 10 minutes.
 // On reboot if we can't get a config, then we use a saved one if the saved is
 // not older than 10 minutes.
-// A downloaded image which isn't used is garbage collected after 10 minutes.
+// A downloaded image which isn't referenced is garbage collected from the
+// blob store after 10 minutes.
 // If a instance has been removed its read/write vdisks are deleted after
 // one hour.
 var GlobalConfigDefaults = GlobalConfig{
@@ -109,6 +193,9 @@ var GlobalConfigDefaults = GlobalConfig{
 	VdiskGCTime:           3600,   // 1 hour
 	DownloadRetryTime:     600,    // 10 minutes
 	DomainBootRetryTime:   600,    // 10 minutes
+	DownloadChunks:        1,      // One stream per object
+	LogSpoolMaxSize:       64,     // 64 Mbytes compressed
+	AppLogRateLimit:       100,    // Lines/sec per app instance
 	DefaultLogLevel:       "info", // XXX Should we change to warning?
 	DefaultRemoteLogLevel: "info", // XXX Should we change to warning?
 }
@@ -164,6 +251,16 @@ func ApplyGlobalConfig(newgc GlobalConfig) GlobalConfig {
 	if newgc.DomainBootRetryTime == 0 {
 		newgc.DomainBootRetryTime = GlobalConfigDefaults.DomainBootRetryTime
 	}
+	if newgc.DownloadChunks == 0 {
+		newgc.DownloadChunks = GlobalConfigDefaults.DownloadChunks
+	}
+	if newgc.LogSpoolMaxSize == 0 {
+		newgc.LogSpoolMaxSize = GlobalConfigDefaults.LogSpoolMaxSize
+	}
+	if newgc.AppLogRateLimit == 0 {
+		newgc.AppLogRateLimit = GlobalConfigDefaults.AppLogRateLimit
+	}
+	// We allow newgc.DownloadMaxBandwidth to be zero meaning no limit
 	if newgc.DefaultLogLevel == "" {
 		newgc.DefaultLogLevel = GlobalConfigDefaults.DefaultLogLevel
 	}
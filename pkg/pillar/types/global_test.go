@@ -0,0 +1,83 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadWindows(t *testing.T) {
+	at := func(hour, min int) time.Time {
+		return time.Date(2019, 9, 1, hour, min, 0, 0, time.UTC)
+	}
+	testMatrix := map[string]struct {
+		windows   string
+		now       time.Time
+		inWindow  bool
+		expectErr bool
+	}{
+		"No windows": {
+			windows:  "",
+			now:      at(12, 0),
+			inWindow: true,
+		},
+		"Inside": {
+			windows:  "12:00-13:00",
+			now:      at(12, 30),
+			inWindow: true,
+		},
+		"At the end": {
+			windows:  "12:00-13:00",
+			now:      at(13, 0),
+			inWindow: false,
+		},
+		"Wrap before midnight": {
+			windows:  "22:00-06:00",
+			now:      at(23, 15),
+			inWindow: true,
+		},
+		"Wrap after midnight": {
+			windows:  "22:00-06:00",
+			now:      at(5, 59),
+			inWindow: true,
+		},
+		"Outside wrapped window": {
+			windows:  "22:00-06:00",
+			now:      at(12, 0),
+			inWindow: false,
+		},
+		"Second window": {
+			windows:  "22:00-06:00, 12:00-13:00",
+			now:      at(12, 0),
+			inWindow: true,
+		},
+		"Local time is converted": {
+			windows:  "12:00-13:00",
+			now:      at(12, 30).In(time.FixedZone("UTC+5", 5*3600)),
+			inWindow: true,
+		},
+		"Bad time": {
+			windows:   "25:00-06:00",
+			expectErr: true,
+		},
+		"Missing end": {
+			windows:   "22:00",
+			expectErr: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		windows, err := ParseDownloadWindows(test.windows)
+		if test.expectErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.inWindow, InDownloadWindows(windows, test.now))
+	}
+}
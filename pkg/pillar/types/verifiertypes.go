@@ -32,6 +32,7 @@ type VerifyImageConfig struct {
 	SignatureKey     string   //certificate containing public key
 	IsContainer      bool     // Is this Domain for a Container?
 	ContainerImageID string   // Container Image ID
+	LayerDigests     []string // OCI layer digests to verify for Container
 }
 
 func (config VerifyImageConfig) Key() string {
@@ -60,6 +61,7 @@ type VerifyImageStatus struct {
 	ContainerImageID string  // Container Image ID if IsContainer=true
 	ImageSha256      string  // sha256 of immutable image
 	State            SwState // DELIVERED; LastErr* set if failed
+	FileLocation     string  // In the blob store once DELIVERED
 	LastErr          string  // Verification error
 	LastErrTime      time.Time
 	Size             int64
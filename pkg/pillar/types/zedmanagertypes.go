@@ -63,6 +63,8 @@ type AppInstanceConfig struct {
 	PurgeCmd            AppInstanceOpsCmd
 	CloudInitUserData   string // base64-encoded
 	RemoteConsole       bool
+	HealthCheck         AppHealthCheck
+	Dependencies        []AppDependency
 }
 
 type AppInstanceOpsCmd struct {
@@ -70,6 +72,81 @@ type AppInstanceOpsCmd struct {
 	ApplyTime string // XXX not currently used
 }
 
+// AppHealthProbe is checked in addition to the domain liveness
+type AppHealthProbe uint8
+
+// The values match zapi.AppHealthProbe
+const (
+	AppProbeNone       AppHealthProbe = iota
+	AppProbeTCP                       // Connect to Port on the app IP
+	AppProbeHTTP                      // GET Path on Port
+	AppProbeGuestAgent                // Ping the qemu guest agent
+)
+
+// AppRestartPolicy is what zedmanager does when the app is unhealthy
+type AppRestartPolicy uint8
+
+const (
+	AppRestartNever AppRestartPolicy = iota
+	AppRestartOnFailure
+)
+
+// AppHealthCheck is the health policy of an app instance. The times are
+// in seconds.
+type AppHealthCheck struct {
+	Probe            AppHealthProbe
+	Port             uint16
+	Path             string
+	Interval         uint32
+	Timeout          uint32
+	FailureThreshold uint32 // Consecutive probe failures before unhealthy
+	GracePeriod      uint32 // After boot before probe failures count
+	RestartPolicy    AppRestartPolicy
+	MaxRetries       uint32 // Zero means no limit
+	BackoffInitial   uint32 // Delay before the first restart
+	BackoffMax       uint32 // The delay doubles up to this
+}
+
+// AppHealthState is the outcome of the health checks
+type AppHealthState uint8
+
+// The values match zapi.ZAppHealthState
+const (
+	AppHealthUnknown    AppHealthState = iota
+	AppHealthStarting                  // Booting or in the grace period
+	AppHealthHealthy                   // Domain running and probe passing
+	AppHealthUnhealthy                 // Domain down or probe failing
+	AppHealthRestarting                // Restart scheduled or in progress
+	AppHealthFailed                    // Gave up after MaxRetries
+)
+
+// AppHealthStatus is maintained by zedmanager
+type AppHealthStatus struct {
+	State               AppHealthState
+	LastProbeTime       time.Time
+	LastProbeError      string
+	ConsecutiveFailures uint32
+	RestartCount        uint32 // Since the last healthy period
+	LastRestartTime     time.Time
+	NextRestartTime     time.Time // Zero unless a restart is scheduled
+	HealthySince        time.Time
+}
+
+// AppStartCondition is what an app instance waits for in another one
+type AppStartCondition uint8
+
+// The values match zapi.AppStartCondition
+const (
+	AppStartAfterActivated AppStartCondition = iota // Booted
+	AppStartAfterHealthy                            // Booted and healthy
+)
+
+// AppDependency is another app instance which needs to be up first
+type AppDependency struct {
+	UUID      uuid.UUID
+	Condition AppStartCondition
+}
+
 // IoAdapter specifies that a group of ports should be assigned
 type IoAdapter struct {
 	Type IoType
@@ -107,10 +184,13 @@ type AppInstanceStatus struct {
 	UnderlayNetworkList []UnderlayNetworkConfig
 	BootTime            time.Time
 	IoAdapterList       []IoAdapter
+	Dependencies        []AppDependency // Kept after config is deleted
 	RestartCmd          AppInstanceOpsCmd
 	PurgeCmd            AppInstanceOpsCmd
 	RestartInprogress   Inprogress
 	PurgeInprogress     Inprogress
+	Health              AppHealthStatus
+	AwaitingDependency  string // Other app instances we wait for
 
 	// Container related state
 	IsContainer      bool
@@ -237,17 +317,18 @@ type StorageStatus struct {
 	Maxsizebytes       uint64 // Resize filesystem to this size if set
 	Format             string
 	Devtype            string
-	Target             string  // Default "" is interpreted as "disk"
-	State              SwState // DOWNLOADED etc
-	Progress           uint    // In percent i.e., 0-100
-	HasDownloaderRef   bool    // Reference against downloader to clean up
-	HasVerifierRef     bool    // Reference against verifier to clean up
-	IsContainer        bool    // Is the image a Container??
-	ContainerImageID   string  // Container Image ID if IsContainer=true
-	Vdev               string  // Allocated
-	ActiveFileLocation string  // Location of filestystem
-	FinalObjDir        string  // Installation dir; may differ from verified
-	Error              string  // Download or verify error
+	Target             string   // Default "" is interpreted as "disk"
+	State              SwState  // DOWNLOADED etc
+	Progress           uint     // In percent i.e., 0-100
+	HasDownloaderRef   bool     // Reference against downloader to clean up
+	HasVerifierRef     bool     // Reference against verifier to clean up
+	IsContainer        bool     // Is the image a Container??
+	ContainerImageID   string   // Container Image ID if IsContainer=true
+	LayerDigests       []string // OCI layer digests if IsContainer=true
+	Vdev               string   // Allocated
+	ActiveFileLocation string   // Location of filestystem
+	FinalObjDir        string   // Installation dir; may differ from verified
+	Error              string   // Download or verify error
 	ErrorSource        string
 	ErrorTime          time.Time
 }
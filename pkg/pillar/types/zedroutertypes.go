@@ -290,15 +290,91 @@ type NetworkPortConfig struct {
 	Name   string // New logical name set by controller/model
 	IsMgmt bool   // Used to talk to controller
 	Free   bool   // Higher priority to talk to controller since no cost
+	Vlan   VlanConfig
+	Bond   BondConfig
 	DhcpConfig
 	ProxyConfig
 }
 
+// VlanConfig is set for a port which is an 802.1Q sub-interface created
+// by the device
+type VlanConfig struct {
+	ParentIfName string // Carries the tagged traffic
+	VlanID       uint16 // Zero if the port is not a VLAN sub-interface
+}
+
+// BondMode values match zconfig.BondMode
+type BondMode uint8
+
+const (
+	BondModeActiveBackup BondMode = iota
+	BondMode8023ad                // LACP
+)
+
+// BondConfig is set for a port which is a bond interface created by the
+// device from other interfaces
+type BondConfig struct {
+	Members    []string // Interface names; empty if the port is not a bond
+	Mode       BondMode
+	MIIMonitor uint32 // Link monitoring interval in milliseconds
+	Primary    string // Preferred member for active-backup
+}
+
+// BondMemberStatus is the link state of a member of a bond
+type BondMemberStatus struct {
+	IfName string
+	Up     bool // Operational state of the link
+	Active bool // The active-backup slave or in the 802.3ad aggregator
+}
+
+// LowerIfNames are the interfaces which need to exist for the port to
+// work. The lower layers which are ports themselves are resolved e.g., a
+// VLAN on a bond results in the bond members.
+func (portConfig DevicePortConfig) LowerIfNames(port NetworkPortConfig) []string {
+	return portConfig.lowerIfNames(port, make(map[string]bool))
+}
+
+func (portConfig DevicePortConfig) lowerIfNames(port NetworkPortConfig,
+	visited map[string]bool) []string {
+
+	var lower []string
+	if port.Vlan.VlanID != 0 {
+		lower = []string{port.Vlan.ParentIfName}
+	} else if len(port.Bond.Members) != 0 {
+		lower = port.Bond.Members
+	} else {
+		return []string{port.IfName}
+	}
+	visited[port.IfName] = true
+	var ifnames []string
+	for _, ifname := range lower {
+		var lowerPort *NetworkPortConfig
+		for i := range portConfig.Ports {
+			if portConfig.Ports[i].IfName == ifname {
+				lowerPort = &portConfig.Ports[i]
+				break
+			}
+		}
+		// Stop at a loop in a bad config
+		if lowerPort == nil || visited[ifname] {
+			ifnames = append(ifnames, ifname)
+			continue
+		}
+		ifnames = append(ifnames,
+			portConfig.lowerIfNames(*lowerPort, visited)...)
+	}
+	return ifnames
+}
+
 type NetworkPortStatus struct {
 	IfName string
 	Name   string // New logical name set by controller/model
 	IsMgmt bool   // Used to talk to controller
 	Free   bool
+	Vlan   VlanConfig
+	Bond   BondConfig
+	// Link state of Bond.Members
+	BondMembers []BondMemberStatus
 	NetworkXObjectConfig
 	AddrInfoList []AddrInfo
 	ProxyConfig
@@ -341,6 +417,20 @@ func (status *DeviceNetworkStatus) GetPortByIfName(
 	return nil
 }
 
+// GetVlanPort returns the port which is the given VLAN on parentIfName
+func (status *DeviceNetworkStatus) GetVlanPort(parentIfName string,
+	vlanID uint16) *NetworkPortStatus {
+	for _, portStatus := range status.Ports {
+		if portStatus.Vlan.VlanID == vlanID &&
+			portStatus.Vlan.ParentIfName == parentIfName {
+			log.Infof("Found NetworkPortStatus for %s VLAN %d",
+				parentIfName, vlanID)
+			return &portStatus
+		}
+	}
+	return nil
+}
+
 func rotate(arr []string, amount int) []string {
 	if len(arr) == 0 {
 		return []string{}
@@ -594,10 +684,18 @@ func GetExistingInterfaceList(globalStatus DeviceNetworkStatus) []string {
 // Check if an interface/adapter name is a port owned by zedrouter
 func IsPort(globalStatus DeviceNetworkStatus, port string) bool {
 	for _, us := range globalStatus.Ports {
-		if us.Name != port && us.IfName != port {
-			continue
+		if us.Name == port || us.IfName == port {
+			return true
+		}
+		// The lower layers of a VLAN or bond are used by the port
+		if us.Vlan.VlanID != 0 && us.Vlan.ParentIfName == port {
+			return true
+		}
+		for _, m := range us.Bond.Members {
+			if m == port {
+				return true
+			}
 		}
-		return true
 	}
 	return false
 }
@@ -754,19 +852,21 @@ func (portConfig *DevicePortConfig) IsAnyPortInPciBack(
 	log.Infof("IsAnyPortInPciBack: aa init %t, %d bundles, %d ports",
 		aa.Initialized, len(aa.IoBundleList), len(portConfig.Ports))
 	for _, port := range portConfig.Ports {
-		// XXX this assumes that ioBundle.Name is the ifname known
-		// by the kernel/ifconfig
-		ioBundle := aa.LookupIoBundleNet(port.IfName)
-		if ioBundle == nil {
-			// It is not guaranteed that all Ports are part of Assignable Adapters
-			// If not found, the adaptor is not capable of being assigned at
-			// PCI level. So it cannot be in PCI back.
-			log.Infof("IsAnyPortInPciBack: ifname %s not found",
-				port.IfName)
-			continue
-		}
-		if ioBundle.IsPCIBack {
-			return true, port.IfName, ioBundle.UsedByUUID
+		for _, ifname := range portConfig.LowerIfNames(port) {
+			// XXX this assumes that ioBundle.Name is the ifname known
+			// by the kernel/ifconfig
+			ioBundle := aa.LookupIoBundleNet(ifname)
+			if ioBundle == nil {
+				// It is not guaranteed that all Ports are part of Assignable Adapters
+				// If not found, the adaptor is not capable of being assigned at
+				// PCI level. So it cannot be in PCI back.
+				log.Infof("IsAnyPortInPciBack: ifname %s not found",
+					ifname)
+				continue
+			}
+			if ioBundle.IsPCIBack {
+				return true, ifname, ioBundle.UsedByUUID
+			}
 		}
 	}
 	return false, "", uuid.UUID{}
@@ -881,6 +981,10 @@ type UnderlayNetworkStatus struct {
 	Assigned        bool   // Set to true once DHCP has assigned it to domU
 	HostName        string
 	ACLRules        IPTablesRuleList
+
+	// Set for a dual-stack network instance
+	BridgeIPv6Addr    string
+	AllocatedIPv6Addr string // EUI-64 address from SLAAC or DHCPv6
 }
 
 type NetworkType uint8
@@ -931,9 +1035,18 @@ type NetworkInstanceInfo struct {
 	// interface names for the Port
 	IfNameList []string // Recorded at time of activate
 
+	// VLAN sub-interface on the Port if VlanID is set. Created by
+	// zedrouter for a Switch unless it is a device port.
+	VlanIfName    string
+	VlanIfCreated bool
+
 	// Collection of address assignments; from MAC address to IP address
 	IPAssignments map[string]net.IP
 
+	// The same for the IPv6 subnet of a dual-stack network instance
+	BridgeIPv6Addr  string
+	IPv6Assignments map[string]net.IP
+
 	// Union of all ipsets fed to dnsmasq for the linux bridge
 	BridgeIPSets []string
 
@@ -942,6 +1055,9 @@ type NetworkInstanceInfo struct {
 
 	Ipv4Eid bool // Track if this is a CryptoEid with IPv4 EIDs
 
+	// Capture of all the traffic on the bridge of a HoneyPot
+	CaptureFile string
+
 	// Any errrors from provisioning the network
 	Error     string
 	ErrorTime time.Time
@@ -1019,12 +1135,21 @@ type NetworkInstanceMetrics struct {
 	NetworkMetrics NetworkMetrics
 	VpnMetrics     *VpnMetrics
 	LispMetrics    *LispMetrics
+	CaptureMetrics *CaptureMetrics
 }
 
 func (metrics NetworkInstanceMetrics) Key() string {
 	return metrics.UUIDandVersion.UUID.String()
 }
 
+// CaptureMetrics : frames written to the CaptureFile of a HoneyPot
+type CaptureMetrics struct {
+	Pkts      uint64
+	Bytes     uint64
+	Drops     uint64 // Dropped by the kernel before the capture got them
+	Rotations uint64 // Number of times the file was full
+}
+
 // Network metrics for overlay and underlay
 // Matches networkMetrics protobuf message
 type NetworkMetrics struct {
@@ -1112,6 +1237,9 @@ type NetworkInstanceConfig struct {
 	// Port - Port name specified in the Device Config.
 	Port string
 
+	// VlanID - 802.1Q tag on the Port for Switch and Local. Zero is untagged.
+	VlanID uint16
+
 	// IP configuration for the Application
 	IpType          AddressType
 	Subnet          net.IPNet
@@ -1122,6 +1250,12 @@ type NetworkInstanceConfig struct {
 	DhcpRange       IpRange
 	DnsNameToIPList []DnsNameToIP // Used for DNS and ACL ipset
 
+	// Optional IPv6 subnet which makes a Local network instance
+	// dual-stack. Gateway6 is the address of the bridge.
+	Subnet6     net.IPNet
+	Gateway6    net.IP
+	DnsServers6 []net.IP
+
 	HasEncap bool // Lisp/Vpn, for adjusting pMTU
 	// For other network services - Proxy / Lisp /StrongSwan etc..
 	OpaqueConfig string
@@ -1142,6 +1276,12 @@ func (config *NetworkInstanceConfig) IsIPv6() bool {
 	return false
 }
 
+// IsDualStack returns true if there is an IPv6 subnet in addition to
+// the IPv4 one
+func (config *NetworkInstanceConfig) IsDualStack() bool {
+	return config.Subnet6.IP != nil
+}
+
 type ChangeInProgressType int32
 
 const (
@@ -1208,6 +1348,7 @@ type IPTablesRule struct {
 	IsMarkingRule    bool // Rule does marking of packet for flow tracking.
 	IsPortMapRule    bool // Is this a port map rule?
 	IsLimitDropRule  bool // Is this a policer limit drop rule?
+	IsNftRule        bool // Applied using nftables instead of iptables
 }
 
 // IPTablesRuleList : list of iptables rules
@@ -290,3 +290,139 @@ func TestGetPortByIfName(t *testing.T) {
 		assert.Equal(t, *value, test.expectedValue)
 	}
 }
+
+func TestGetVlanPort(t *testing.T) {
+	deviceNetworkStatus := DeviceNetworkStatus{
+		Ports: []NetworkPortStatus{
+			{IfName: "eth0"},
+			{IfName: "eth0.100",
+				Vlan: VlanConfig{ParentIfName: "eth0", VlanID: 100}},
+			{IfName: "eth1.100",
+				Vlan: VlanConfig{ParentIfName: "eth1", VlanID: 100}},
+		},
+	}
+	testMatrix := map[string]struct {
+		parentIfName  string
+		vlanID        uint16
+		expectedValue string
+	}{
+		"Test VLAN 100 on eth0": {
+			parentIfName:  "eth0",
+			vlanID:        100,
+			expectedValue: "eth0.100",
+		},
+		"Test VLAN 100 on eth1": {
+			parentIfName:  "eth1",
+			vlanID:        100,
+			expectedValue: "eth1.100",
+		},
+		"Test VLAN 200 on eth0": {
+			parentIfName:  "eth0",
+			vlanID:        200,
+			expectedValue: "",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		value := deviceNetworkStatus.GetVlanPort(test.parentIfName,
+			test.vlanID)
+		if test.expectedValue == "" {
+			assert.Nil(t, value)
+		} else {
+			assert.Equal(t, test.expectedValue, value.IfName)
+		}
+	}
+}
+
+func TestLowerIfNames(t *testing.T) {
+	bond := NetworkPortConfig{IfName: "bond0",
+		Bond: BondConfig{Members: []string{"eth0", "eth1"}}}
+	vlanOnBond := NetworkPortConfig{IfName: "bond0.100",
+		Vlan: VlanConfig{ParentIfName: "bond0", VlanID: 100}}
+	testMatrix := map[string]struct {
+		ports         []NetworkPortConfig
+		port          NetworkPortConfig
+		expectedValue []string
+	}{
+		"Test plain port": {
+			port:          NetworkPortConfig{IfName: "eth0"},
+			expectedValue: []string{"eth0"},
+		},
+		"Test VLAN port": {
+			port: NetworkPortConfig{IfName: "eth0.100",
+				Vlan: VlanConfig{ParentIfName: "eth0", VlanID: 100}},
+			expectedValue: []string{"eth0"},
+		},
+		"Test bond port": {
+			port:          bond,
+			expectedValue: []string{"eth0", "eth1"},
+		},
+		"Test VLAN on bond port": {
+			ports:         []NetworkPortConfig{bond, vlanOnBond},
+			port:          vlanOnBond,
+			expectedValue: []string{"eth0", "eth1"},
+		},
+		"Test VLAN on bond not a port": {
+			ports:         []NetworkPortConfig{vlanOnBond},
+			port:          vlanOnBond,
+			expectedValue: []string{"bond0"},
+		},
+		"Test VLAN on plain port": {
+			ports: []NetworkPortConfig{{IfName: "eth0"}},
+			port: NetworkPortConfig{IfName: "eth0.100",
+				Vlan: VlanConfig{ParentIfName: "eth0", VlanID: 100}},
+			expectedValue: []string{"eth0"},
+		},
+		"Test loop": {
+			ports: []NetworkPortConfig{
+				{IfName: "bond0", Bond: BondConfig{
+					Members: []string{"bond0.100"}}},
+				vlanOnBond},
+			port:          vlanOnBond,
+			expectedValue: []string{"bond0.100"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		portConfig := DevicePortConfig{Ports: test.ports}
+		value := portConfig.LowerIfNames(test.port)
+		assert.Equal(t, test.expectedValue, value)
+	}
+}
+
+func TestIsPort(t *testing.T) {
+	deviceNetworkStatus := DeviceNetworkStatus{
+		Ports: []NetworkPortStatus{
+			{IfName: "eth0.100", Name: "vlan100",
+				Vlan: VlanConfig{ParentIfName: "eth0", VlanID: 100}},
+			{IfName: "bond0",
+				Bond: BondConfig{Members: []string{"eth1", "eth2"}}},
+		},
+	}
+	testMatrix := map[string]struct {
+		port          string
+		expectedValue bool
+	}{
+		"Test VLAN port name": {
+			port:          "vlan100",
+			expectedValue: true,
+		},
+		"Test VLAN parent": {
+			port:          "eth0",
+			expectedValue: true,
+		},
+		"Test bond member": {
+			port:          "eth2",
+			expectedValue: true,
+		},
+		"Test not a port": {
+			port:          "eth3",
+			expectedValue: false,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		value := IsPort(deviceNetworkStatus, test.port)
+		assert.Equal(t, test.expectedValue, value)
+	}
+}
@@ -5,23 +5,30 @@ package awsutil
 
 import (
 	"compress/gzip"
+	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"io"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
 	"sync/atomic"
 )
 
+const chunkSize int64 = 1024 * 1024
+
 // stats update
 type UpdateStats struct {
-	Name  string   // always the remote key
-	Size  int64    // complete size to upload/download
-	Asize int64    // current size uploaded/downloaded
-	List  []string //list of images at given path
+	Name         string   // always the remote key
+	Size         int64    // complete size to upload/download
+	Asize        int64    // current size uploaded/downloaded
+	List         []string //list of images at given path
+	ETag         string   // Validators of the downloaded object
+	LastModified string
+	Resumed      int64 // Bytes kept from an earlier partial download
 }
 
 type NotifChan chan UpdateStats
@@ -189,6 +196,82 @@ func (s *S3ctx) DownloadFile(fname, bname, bkey string, prgNotify NotifChan) err
 	return nil
 }
 
+// DownloadFileResume downloads the object continuing at offset if its
+// ETag is still etag. The object is fetched with a ranged GetObject
+// which fails if the object changes while we download.
+func (s *S3ctx) DownloadFileResume(fname, bname, bkey string, offset int64,
+	etag string, prgNotify NotifChan) (UpdateStats, error) {
+	stats := UpdateStats{Name: bkey}
+	if err := os.MkdirAll(filepath.Dir(fname), 0775); err != nil {
+		return stats, err
+	}
+	head, err := s.ss3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bname),
+		Key:    aws.String(bkey)})
+	if err != nil {
+		return stats, err
+	}
+	stats.Size = aws.Int64Value(head.ContentLength)
+	stats.ETag = aws.StringValue(head.ETag)
+	if head.LastModified != nil {
+		stats.LastModified = head.LastModified.UTC().Format(http.TimeFormat)
+	}
+	if etag == "" || etag != stats.ETag || offset > stats.Size {
+		offset = 0
+	}
+
+	fd, err := os.OpenFile(fname, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return stats, err
+	}
+	defer fd.Close()
+	if err := fd.Truncate(offset); err != nil {
+		return stats, err
+	}
+	if _, err := fd.Seek(offset, io.SeekStart); err != nil {
+		return stats, err
+	}
+	stats.Asize = offset
+	stats.Resumed = offset
+	if offset == stats.Size {
+		return stats, nil
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket:  aws.String(bname),
+		Key:     aws.String(bkey),
+		IfMatch: head.ETag}
+	if offset > 0 {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-", offset))
+	}
+	out, err := s.ss3.GetObject(input)
+	if err != nil {
+		return stats, err
+	}
+	defer out.Body.Close()
+	for {
+		written, err := io.CopyN(fd, out.Body, chunkSize)
+		if err != nil && err != io.EOF {
+			return stats, err
+		}
+		stats.Asize += written
+		if prgNotify != nil {
+			select {
+			case prgNotify <- stats:
+			default: //ignore we cannot write
+			}
+		}
+		if written != chunkSize {
+			break
+		}
+	}
+	if stats.Asize != stats.Size {
+		return stats, fmt.Errorf("short download %d of %d bytes",
+			stats.Asize, stats.Size)
+	}
+	return stats, nil
+}
+
 func (s *S3ctx) ListImages(bname string, prgNotify NotifChan) ([]string, error) {
 	var img []string
 	input := &s3.ListObjectsInput{
@@ -291,3 +374,34 @@ func (d *downloader) eachPage(page *s3.ListObjectsOutput, more bool) bool {
 
 	return true
 }
+
+// GetObjectInfo returns the size and the validators of the object
+func (s *S3ctx) GetObjectInfo(bname, bkey string) (int64, string, string, error) {
+	head, err := s.ss3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bname),
+		Key:    aws.String(bkey)})
+	if err != nil {
+		return 0, "", "", err
+	}
+	lastModified := ""
+	if head.LastModified != nil {
+		lastModified = head.LastModified.UTC().Format(http.TimeFormat)
+	}
+	return aws.Int64Value(head.ContentLength), aws.StringValue(head.ETag),
+		lastModified, nil
+}
+
+// GetObjectRange returns a reader for length bytes at offset. It fails
+// if the ETag of the object is no longer etag.
+func (s *S3ctx) GetObjectRange(bname, bkey string, offset, length int64,
+	etag string) (io.ReadCloser, error) {
+	out, err := s.ss3.GetObject(&s3.GetObjectInput{
+		Bucket:  aws.String(bname),
+		Key:     aws.String(bkey),
+		IfMatch: aws.String(etag),
+		Range:   aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
@@ -8,12 +8,27 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/Azure/azure-sdk-for-go/storage"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 )
 
+const chunkSize int64 = 1024 * 1024
+
+// stats update
+type UpdateStats struct {
+	Size         int64  // complete size to upload/download
+	Asize        int64  // current size uploaded/downloaded
+	ETag         string // Validators of the downloaded object
+	LastModified string
+	Resumed      int64 // Bytes kept from an earlier partial download
+}
+
+type NotifChan chan UpdateStats
+
 func NewClient(accountName, accountKey string, httpClient *http.Client) (storage.Client, error) {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -106,6 +121,92 @@ func DownloadAzureBlob(accountName, accountKey, containerName, remoteFile, local
 	return nil
 }
 
+// DownloadAzureBlobResume downloads the blob continuing at offset if its
+// ETag is still etag. The download fails if the blob changes meanwhile.
+func DownloadAzureBlobResume(accountName, accountKey, containerName, remoteFile, localFile string,
+	offset int64, etag string, prgNotify NotifChan, httpClient *http.Client) (UpdateStats, error) {
+	stats := UpdateStats{}
+	c, err := NewClient(accountName, accountKey, httpClient)
+	if err != nil {
+		return stats, err
+	}
+	blobClient := c.GetBlobService()
+	container := blobClient.GetContainerReference(containerName)
+	containerExists, _ := container.Exists()
+	if !containerExists {
+		return stats, fmt.Errorf("Container doesn't exist")
+	}
+	blob := container.GetBlobReference(remoteFile)
+	if err := blob.GetProperties(nil); err != nil {
+		return stats, err
+	}
+	stats.Size = blob.Properties.ContentLength
+	stats.ETag = blob.Properties.Etag
+	stats.LastModified = time.Time(blob.Properties.LastModified).UTC().Format(http.TimeFormat)
+	if etag == "" || etag != stats.ETag || offset > stats.Size {
+		offset = 0
+	}
+
+	tempLocalFile := localFile
+	index := strings.LastIndex(tempLocalFile, "/")
+	dir_err := os.MkdirAll(tempLocalFile[:index+1], 0755)
+	if dir_err != nil {
+		return stats, dir_err
+	}
+	file, err := os.OpenFile(localFile, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return stats, err
+	}
+	defer file.Close()
+	if err := file.Truncate(offset); err != nil {
+		return stats, err
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return stats, err
+	}
+	stats.Asize = offset
+	stats.Resumed = offset
+	if offset == stats.Size {
+		return stats, nil
+	}
+
+	options := &storage.GetBlobOptions{IfMatch: stats.ETag}
+	var readCloser io.ReadCloser
+	if offset > 0 {
+		readCloser, err = blob.GetRange(&storage.GetBlobRangeOptions{
+			Range:          &storage.BlobRange{Start: uint64(offset)},
+			GetBlobOptions: options,
+		})
+	} else {
+		readCloser, err = blob.Get(options)
+	}
+	if err != nil {
+		return stats, err
+	}
+	defer readCloser.Close()
+	for {
+		written, err := io.CopyN(file, readCloser, chunkSize)
+		if err != nil && err != io.EOF {
+			return stats, err
+		}
+		stats.Asize += written
+		if prgNotify != nil {
+			select {
+			case prgNotify <- stats:
+			default: //ignore we cannot write
+			}
+		}
+		if written != chunkSize {
+			break
+		}
+	}
+	if stats.Asize != stats.Size {
+		return stats, fmt.Errorf("short download %d of %d bytes",
+			stats.Asize, stats.Size)
+	}
+	return stats, nil
+}
+
 func UploadAzureBlob(accountName, accountKey, containerName, remoteFile, localFile string, httpClient *http.Client) error {
 	c, err := NewClient(accountName, accountKey, httpClient)
 	if err != nil {
@@ -155,3 +256,51 @@ func GetAzureBlobMetaData(accountName, accountKey, containerName, remoteFile str
 	stringHex := hex.EncodeToString(decodedString)
 	return blob.Properties.ContentLength, stringHex, nil
 }
+
+// GetAzureBlobInfo returns the size and the validators of the blob
+func GetAzureBlobInfo(accountName, accountKey, containerName, remoteFile string,
+	httpClient *http.Client) (int64, string, string, error) {
+	blob, err := getBlobReference(accountName, accountKey, containerName,
+		remoteFile, httpClient)
+	if err != nil {
+		return 0, "", "", err
+	}
+	if err := blob.GetProperties(nil); err != nil {
+		return 0, "", "", err
+	}
+	lastModified := time.Time(blob.Properties.LastModified).UTC().Format(http.TimeFormat)
+	return blob.Properties.ContentLength, blob.Properties.Etag, lastModified, nil
+}
+
+// GetAzureBlobRange returns a reader for length bytes at offset. It fails
+// if the ETag of the blob is no longer etag.
+func GetAzureBlobRange(accountName, accountKey, containerName, remoteFile string,
+	offset, length int64, etag string, httpClient *http.Client) (io.ReadCloser, error) {
+	blob, err := getBlobReference(accountName, accountKey, containerName,
+		remoteFile, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return blob.GetRange(&storage.GetBlobRangeOptions{
+		Range: &storage.BlobRange{
+			Start: uint64(offset),
+			End:   uint64(offset + length - 1),
+		},
+		GetBlobOptions: &storage.GetBlobOptions{IfMatch: etag},
+	})
+}
+
+func getBlobReference(accountName, accountKey, containerName, remoteFile string,
+	httpClient *http.Client) (*storage.Blob, error) {
+	c, err := NewClient(accountName, accountKey, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	blobClient := c.GetBlobService()
+	container := blobClient.GetContainerReference(containerName)
+	containerExists, _ := container.Exists()
+	if !containerExists {
+		return nil, fmt.Errorf("Container doesn't exist")
+	}
+	return container.GetBlobReference(remoteFile), nil
+}
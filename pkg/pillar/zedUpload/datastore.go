@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"sync"
 	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
 )
 
 //
@@ -41,6 +43,7 @@ const (
 	SyncAzureTr SyncTransportType = "azure"
 	SyncHttpTr  SyncTransportType = "http"
 	SyncSftpTr  SyncTransportType = "sftp"
+	SyncOciTr   SyncTransportType = "oci"
 )
 
 //
@@ -56,10 +59,27 @@ type DronaEndPoint interface {
 	WithSrcIpAndProxySelection(localAddr net.IP, proxy *url.URL) error
 	WithBindIntf(intf string) error
 	WithLogging(onoff bool) error
+	WithBandwidthLimiter(limiter *rateutil.Limiter) error
+}
+
+// limitedTransport applies the bandwidth limit of the DronaCtx to the
+// bodies of the responses
+type limitedTransport struct {
+	base    http.RoundTripper
+	limiter *rateutil.Limiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.Body != nil {
+		resp.Body = rateutil.NewReadCloser(resp.Body, t.limiter)
+	}
+	return resp, err
 }
 
 // use the specific ip as source address for this connection
-func httpClientSrcIP(localAddr net.IP, proxy *url.URL) *http.Client {
+func httpClientSrcIP(localAddr net.IP, proxy *url.URL,
+	limiter *rateutil.Limiter) *http.Client {
 	// You also need to do this to make it work and not give you a
 	// "mismatched local address type ip"
 	// This will make the ResolveIPAddr a TCPAddr without needing to
@@ -80,6 +100,12 @@ func httpClientSrcIP(localAddr net.IP, proxy *url.URL) *http.Client {
 			ExpectContinueTimeout: 1 * time.Second,
 		},
 	}
+	if limiter != nil {
+		webclient.Transport = &limitedTransport{
+			base:    webclient.Transport,
+			limiter: limiter,
+		}
+	}
 
 	return webclient
 }
@@ -111,6 +137,28 @@ type DronaCtx struct {
 
 	// Also open the quit channel so that we can bail
 	quitChan chan bool
+
+	// Bandwidth limit shared by all requests
+	limiter *rateutil.Limiter
+}
+
+// SetMaxBandwidth limits the bandwidth used by all the downloads in
+// bytes per second. Zero means no limit.
+func (ctx *DronaCtx) SetMaxBandwidth(rate int64) {
+	ctx.limiter.SetRate(rate)
+}
+
+// NewLimiter returns a limiter which is also subject to the limit set
+// with SetMaxBandwidth. Endpoints can share it using WithBandwidthLimiter.
+func (ctx *DronaCtx) NewLimiter(rate int64) *rateutil.Limiter {
+	return ctx.limiter.NewChild(rate)
+}
+
+func (ctx *DronaCtx) parentLimiter(limiter *rateutil.Limiter) *rateutil.Limiter {
+	if limiter == nil {
+		return ctx.limiter
+	}
+	return limiter
 }
 
 //Keep working till we are told otherwise
@@ -193,6 +241,7 @@ func (ctx *DronaCtx) NewSyncerDest(tr SyncTransportType, UrlOrRegion, PathOrBkt
 			syncEp.apiKey = auth.Password
 		}
 		syncEp.failPostTime = time.Now()
+		syncEp.limiter = ctx.limiter.NewChild(0)
 		return syncEp, nil
 	case SyncAzureTr:
 		syncEp := &AzureTransportMethod{transport: tr, aurl: UrlOrRegion, container: PathOrBkt, ctx: ctx}
@@ -202,6 +251,7 @@ func (ctx *DronaCtx) NewSyncerDest(tr SyncTransportType, UrlOrRegion, PathOrBkt
 			syncEp.acKey = auth.Password
 		}
 		syncEp.failPostTime = time.Now()
+		syncEp.limiter = ctx.limiter.NewChild(0)
 		return syncEp, nil
 	case SyncHttpTr:
 		syncEp := &HttpTransportMethod{transport: tr, hurl: UrlOrRegion, path: PathOrBkt, ctx: ctx}
@@ -209,6 +259,7 @@ func (ctx *DronaCtx) NewSyncerDest(tr SyncTransportType, UrlOrRegion, PathOrBkt
 			syncEp.authType = auth.AuthType
 		}
 		syncEp.failPostTime = time.Now()
+		syncEp.limiter = ctx.limiter.NewChild(0)
 		return syncEp, nil
 	case SyncSftpTr:
 		syncEp := &SftpTransportMethod{transport: tr, surl: UrlOrRegion, path: PathOrBkt, ctx: ctx}
@@ -219,6 +270,16 @@ func (ctx *DronaCtx) NewSyncerDest(tr SyncTransportType, UrlOrRegion, PathOrBkt
 			syncEp.keys = auth.Keys
 		}
 		syncEp.failPostTime = time.Now()
+		syncEp.limiter = ctx.limiter.NewChild(0)
+		return syncEp, nil
+	case SyncOciTr:
+		syncEp := &OciTransportMethod{transport: tr, registry: UrlOrRegion, path: PathOrBkt, ctx: ctx}
+		if auth != nil {
+			syncEp.uname = auth.Uname
+			syncEp.password = auth.Password
+		}
+		syncEp.failPostTime = time.Now()
+		syncEp.limiter = ctx.limiter.NewChild(0)
 		return syncEp, nil
 	default:
 	}
@@ -244,6 +305,7 @@ func NewDronaCtx(name string, noHandlers int) (*DronaCtx, error) {
 	dSync.reqChan = make(chan *DronaRequest, dSync.noHandlers)
 	dSync.respChan = make(chan *DronaRequest, dSync.noHandlers)
 	dSync.quitChan = make(chan bool)
+	dSync.limiter = rateutil.NewLimiter(0)
 
 	// Initialize syncer handlers and start listening
 	for i := 0; i < dSync.noHandlers; i++ {
@@ -6,6 +6,8 @@ package zedUpload
 import (
 	"fmt"
 	zedAWS "github.com/lf-edge/eve/pkg/pillar/zedUpload/awsutil"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -59,13 +61,13 @@ func (ep *AwsTransportMethod) Close() error {
 
 // use the specific ip as source address for this connection
 func (ep *AwsTransportMethod) WithSrcIpSelection(localAddr net.IP) error {
-	ep.hClient = httpClientSrcIP(localAddr, nil)
+	ep.hClient = httpClientSrcIP(localAddr, nil, ep.limiter)
 	return nil
 }
 
 func (ep *AwsTransportMethod) WithSrcIpAndProxySelection(localAddr net.IP,
 	proxy *url.URL) error {
-	ep.hClient = httpClientSrcIP(localAddr, proxy)
+	ep.hClient = httpClientSrcIP(localAddr, proxy, ep.limiter)
 	return nil
 }
 
@@ -73,7 +75,7 @@ func (ep *AwsTransportMethod) WithSrcIpAndProxySelection(localAddr net.IP,
 func (ep *AwsTransportMethod) WithBindIntf(intf string) error {
 	localAddr := getSrcIpFromInterface(intf)
 	if localAddr != nil {
-		ep.hClient = httpClientSrcIP(localAddr, nil)
+		ep.hClient = httpClientSrcIP(localAddr, nil, ep.limiter)
 		return nil
 	}
 	return fmt.Errorf("failed to get the address for intf")
@@ -83,6 +85,13 @@ func (ep *AwsTransportMethod) WithLogging(onoff bool) error {
 	return nil
 }
 
+// WithBandwidthLimiter makes the transfers also wait for a limiter
+// from DronaCtx.NewLimiter; nil means only the DronaCtx limit applies
+func (ep *AwsTransportMethod) WithBandwidthLimiter(limiter *rateutil.Limiter) error {
+	ep.limiter.SetParent(ep.ctx.parentLimiter(limiter))
+	return nil
+}
+
 // File upload to AWS S3 Datastore
 func (ep *AwsTransportMethod) processS3Upload(req *DronaRequest) (error, int) {
 	fInfo, err := os.Stat(req.objloc)
@@ -138,35 +147,62 @@ func (ep *AwsTransportMethod) processS3Download(req *DronaRequest) (error, int)
 		}
 	}
 
+	if req.chunks > 1 {
+		sc := zedAWS.NewAwsCtx(ep.token, pwd, ep.region, ep.hClient)
+		if sc == nil {
+			return fmt.Errorf("unable to create S3 context"), 0
+		}
+		size, etag, lastModified, err := sc.GetObjectInfo(ep.bucket, req.name)
+		if err == nil && req.useChunks(size, etag, lastModified) {
+			fetch := func(offset, length int64) (io.ReadCloser, string, error) {
+				body, err := sc.GetObjectRange(ep.bucket, req.name,
+					offset, length, etag)
+				return body, "", err
+			}
+			p, err := req.downloadChunks(size, etag, lastModified, fetch)
+			return err, int(p.asize)
+		}
+	}
+	rp := req.getResumePoint()
 	prgChan := make(zedAWS.NotifChan)
-	defer close(prgChan)
-	if req.ackback {
-		go func(req *DronaRequest, prgNotif zedAWS.NotifChan) {
-			ticker := time.NewTicker(StatsUpdateTicker)
-			var stats zedAWS.UpdateStats
-			var ok bool
-			for {
-				select {
-				case stats, ok = <-prgNotif:
-					if !ok {
-						return
-					}
-				case <-ticker.C:
+	done := make(chan struct{})
+	go func(req *DronaRequest, prgNotif zedAWS.NotifChan) {
+		defer close(done)
+		ticker := time.NewTicker(StatsUpdateTicker)
+		defer ticker.Stop()
+		var stats zedAWS.UpdateStats
+		var ok bool
+		for {
+			select {
+			case stats, ok = <-prgNotif:
+				if !ok {
+					return
+				}
+			case <-ticker.C:
+				req.saveProgress(awsProgress(stats))
+				if req.ackback {
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
-		}(req, prgChan)
-	}
+		}
+	}(req, prgChan)
 
 	sc := zedAWS.NewAwsCtx(ep.token, pwd, ep.region, ep.hClient)
 	if sc == nil {
+		close(prgChan)
+		<-done
 		return fmt.Errorf("unable to create S3 context"), 0
 	}
 
-	err := sc.DownloadFile(req.objloc, ep.bucket, req.name, prgChan)
+	stats, err := sc.DownloadFileResume(req.objloc, ep.bucket, req.name,
+		rp.offset, rp.etag, prgChan)
+	close(prgChan)
+	<-done
 	if err != nil {
+		req.saveProgress(awsProgress(stats))
 		return err, 0
 	}
+	req.clearProgress(stats.Resumed)
 	// check for download complete
 	st, err := os.Stat(req.objloc)
 	if err != nil {
@@ -177,6 +213,12 @@ func (ep *AwsTransportMethod) processS3Download(req *DronaRequest) (error, int)
 	return err, csize
 }
 
+func awsProgress(stats zedAWS.UpdateStats) downloadProgress {
+	return downloadProgress{asize: stats.Asize, osize: stats.Size,
+		resumed: stats.Resumed, etag: stats.ETag,
+		lastModified: stats.LastModified}
+}
+
 // File delete from AWS S3 Datastore
 func (ep *AwsTransportMethod) processS3Delete(req *DronaRequest) error {
 	var err error
@@ -287,4 +329,5 @@ type AwsTransportMethod struct {
 	failPostTime time.Time
 	ctx          *DronaCtx
 	hClient      *http.Client
+	limiter      *rateutil.Limiter
 }
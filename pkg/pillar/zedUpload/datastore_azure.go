@@ -6,6 +6,8 @@ package zedUpload
 import (
 	"fmt"
 	azure "github.com/lf-edge/eve/pkg/pillar/zedUpload/azureutil"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -55,13 +57,13 @@ func (ep *AzureTransportMethod) Close() error {
 
 // use the specific ip as source address for this connection
 func (ep *AzureTransportMethod) WithSrcIpSelection(localAddr net.IP) error {
-	ep.hClient = httpClientSrcIP(localAddr, nil)
+	ep.hClient = httpClientSrcIP(localAddr, nil, ep.limiter)
 	return nil
 }
 
 func (ep *AzureTransportMethod) WithSrcIpAndProxySelection(localAddr net.IP,
 	proxy *url.URL) error {
-	ep.hClient = httpClientSrcIP(localAddr, proxy)
+	ep.hClient = httpClientSrcIP(localAddr, proxy, ep.limiter)
 	return nil
 }
 
@@ -74,6 +76,13 @@ func (ep *AzureTransportMethod) WithLogging(onoff bool) error {
 	return nil
 }
 
+// WithBandwidthLimiter makes the transfers also wait for a limiter
+// from DronaCtx.NewLimiter; nil means only the DronaCtx limit applies
+func (ep *AzureTransportMethod) WithBandwidthLimiter(limiter *rateutil.Limiter) error {
+	ep.limiter.SetParent(ep.ctx.parentLimiter(limiter))
+	return nil
+}
+
 // File upload to Azure Blob Datastore
 func (ep *AzureTransportMethod) processAzureUpload(req *DronaRequest) error {
 	file := req.name
@@ -87,13 +96,61 @@ func (ep *AzureTransportMethod) processAzureUpload(req *DronaRequest) error {
 // File download from Azure Blob Datastore
 func (ep *AzureTransportMethod) processAzureDownload(req *DronaRequest) error {
 	file := req.name
-	err := azure.DownloadAzureBlob(ep.acName, ep.acKey, ep.container, file, req.objloc, ep.hClient)
+	if req.chunks > 1 {
+		size, etag, lastModified, err := azure.GetAzureBlobInfo(ep.acName,
+			ep.acKey, ep.container, file, ep.hClient)
+		if err == nil && req.useChunks(size, etag, lastModified) {
+			fetch := func(offset, length int64) (io.ReadCloser, string, error) {
+				body, err := azure.GetAzureBlobRange(ep.acName, ep.acKey,
+					ep.container, file, offset, length, etag, ep.hClient)
+				return body, "", err
+			}
+			_, err := req.downloadChunks(size, etag, lastModified, fetch)
+			return err
+		}
+	}
+	rp := req.getResumePoint()
+	prgChan := make(azure.NotifChan)
+	done := make(chan struct{})
+	go func(req *DronaRequest, prgNotif azure.NotifChan) {
+		defer close(done)
+		ticker := time.NewTicker(StatsUpdateTicker)
+		defer ticker.Stop()
+		var stats azure.UpdateStats
+		var ok bool
+		for {
+			select {
+			case stats, ok = <-prgNotif:
+				if !ok {
+					return
+				}
+			case <-ticker.C:
+				req.saveProgress(azureProgress(stats))
+				if req.ackback {
+					ep.ctx.postSize(req, stats.Size, stats.Asize)
+				}
+			}
+		}
+	}(req, prgChan)
+	stats, err := azure.DownloadAzureBlobResume(ep.acName, ep.acKey,
+		ep.container, file, req.objloc, rp.offset, rp.etag, prgChan,
+		ep.hClient)
+	close(prgChan)
+	<-done
 	if err != nil {
+		req.saveProgress(azureProgress(stats))
 		return err
 	}
+	req.clearProgress(stats.Resumed)
 	return nil
 }
 
+func azureProgress(stats azure.UpdateStats) downloadProgress {
+	return downloadProgress{asize: stats.Asize, osize: stats.Size,
+		resumed: stats.Resumed, etag: stats.ETag,
+		lastModified: stats.LastModified}
+}
+
 // File delete from Azure Blob Datastore
 func (ep *AzureTransportMethod) processAzureBlobDelete(req *DronaRequest) error {
 	err := azure.DeleteAzureBlob(ep.acName, ep.acKey, ep.container, req.name, ep.hClient)
@@ -156,4 +213,5 @@ type AzureTransportMethod struct {
 	failPostTime time.Time
 	ctx          *DronaCtx
 	hClient      *http.Client
+	limiter      *rateutil.Limiter
 }
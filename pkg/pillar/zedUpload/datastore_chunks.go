@@ -0,0 +1,319 @@
+// Copyright(c) 2019 Zededa, Inc.
+// All rights reserved.
+
+package zedUpload
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Parallel chunked downloads. A large object is split into ranges which
+// are downloaded concurrently into their place in the local file. The
+// sha256 of each complete chunk is what we wrote, kept in the meta file
+// so that a chunk which changed on disk is downloaded again when we
+// resume. It does not verify the transfer. That is done with the
+// Content-MD5 of the range if the server sends one; S3 doesn't and Azure
+// only does for ranges of up to 4 MB. The object as a whole is verified
+// by the verifier.
+
+const (
+	minChunkSize  = 16 * 1024 * 1024
+	chunkReadSize = 32 * 1024
+)
+
+var errChunkStopped = errors.New("stopped since another chunk failed")
+
+// SyncChunk is a range of the object
+type SyncChunk struct {
+	Offset int64
+	Size   int64
+	Sha256 string // Of what we wrote; set once the chunk is complete
+}
+
+// rangeFetcher returns a reader for length bytes at offset of the
+// object and the base64 Content-MD5 of the range if the server sends one.
+type rangeFetcher func(offset, length int64) (io.ReadCloser, string, error)
+
+// WithChunks sets the number of concurrent ranged chunks used for large
+// objects. Zero or one means a single stream.
+func (req *DronaRequest) WithChunks(chunks int) {
+	req.Lock()
+	defer req.Unlock()
+	req.chunks = chunks
+}
+
+// useChunks returns true if a chunked download makes sense. Without
+// validators we can't tell if the object changed between the ranges.
+func (req *DronaRequest) useChunks(size int64, etag, lastModified string) bool {
+	return req.chunks > 1 && size >= 2*minChunkSize &&
+		(etag != "" || lastModified != "")
+}
+
+func planChunks(size int64, count int) []SyncChunk {
+	chunkSize := (size + int64(count) - 1) / int64(count)
+	if chunkSize < minChunkSize {
+		chunkSize = minChunkSize
+	}
+	var chunks []SyncChunk
+	for offset := int64(0); offset < size; offset += chunkSize {
+		length := chunkSize
+		if offset+length > size {
+			length = size - offset
+		}
+		chunks = append(chunks, SyncChunk{Offset: offset, Size: length})
+	}
+	return chunks
+}
+
+// getChunkPlan returns the chunks of an earlier attempt if the object is
+// unchanged after checking the complete ones against their sha256.
+// A partial single stream download gives the complete chunks at the start.
+func (req *DronaRequest) getChunkPlan(size int64, etag, lastModified string) []SyncChunk {
+	plan := planChunks(size, req.chunks)
+	if req.metaloc == "" {
+		return plan
+	}
+	err, meta := ReadMetaFile(req.metaloc)
+	if err != nil || meta.name != req.name || meta.objectSize != size {
+		return plan
+	}
+	if etag != "" && meta.etag != etag {
+		return plan
+	}
+	if etag == "" && meta.lastModified != lastModified {
+		return plan
+	}
+	f, err := os.Open(req.objloc)
+	if err != nil {
+		return plan
+	}
+	defer f.Close()
+	if len(meta.chunkState) != 0 {
+		plan = meta.chunkState
+		for i := range plan {
+			if plan[i].Sha256 == "" {
+				continue
+			}
+			if chunkSha(f, plan[i]) != plan[i].Sha256 {
+				log.Warnf("chunk at %d of %s has a bad checksum\n",
+					plan[i].Offset, req.objloc)
+				plan[i].Sha256 = ""
+			}
+		}
+		return plan
+	}
+	for i := range plan {
+		if plan[i].Offset+plan[i].Size <= meta.asize {
+			plan[i].Sha256 = chunkSha(f, plan[i])
+		}
+	}
+	return plan
+}
+
+// chunkSha returns the sha256 of the chunk in the file or "" if the
+// file is too short
+func chunkSha(f *os.File, chunk SyncChunk) string {
+	h := sha256.New()
+	n, err := io.Copy(h, io.NewSectionReader(f, chunk.Offset, chunk.Size))
+	if err != nil || n != chunk.Size {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// completeSize is the part which is complete from the start. That is
+// what a single stream download can resume from.
+func completeSize(chunks []SyncChunk) int64 {
+	var size int64
+	for _, chunk := range chunks {
+		if chunk.Sha256 == "" || chunk.Offset != size {
+			break
+		}
+		size += chunk.Size
+	}
+	return size
+}
+
+// downloadChunks downloads the missing chunks of the object using
+// req.chunks workers and persists the progress in the meta file
+func (req *DronaRequest) downloadChunks(size int64, etag, lastModified string,
+	fetch rangeFetcher) (downloadProgress, error) {
+
+	p := downloadProgress{osize: size, etag: etag, lastModified: lastModified}
+	chunks := req.getChunkPlan(size, etag, lastModified)
+	if err := os.MkdirAll(filepath.Dir(req.objloc), 0755); err != nil {
+		return p, err
+	}
+	f, err := os.OpenFile(req.objloc, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return p, err
+	}
+	defer f.Close()
+	if err := f.Truncate(size); err != nil {
+		return p, err
+	}
+
+	var lock sync.Mutex // Protects chunks, total and firstErr
+	var total int64
+	var firstErr error
+	var stopped int32
+	work := make(chan int, len(chunks))
+	for i, chunk := range chunks {
+		if chunk.Sha256 != "" {
+			p.resumed += chunk.Size
+		} else {
+			work <- i
+		}
+	}
+	close(work)
+	total = p.resumed
+	workers := req.chunks
+	if workers > len(work) {
+		workers = len(work)
+	}
+	log.Infof("downloadChunks %s: %d chunks to download with %d workers, %d bytes resumed\n",
+		req.name, len(work), workers, p.resumed)
+
+	snapshot := func() (downloadProgress, int64) {
+		lock.Lock()
+		defer lock.Unlock()
+		s := p
+		s.chunks = make([]SyncChunk, len(chunks))
+		copy(s.chunks, chunks)
+		s.asize = completeSize(s.chunks)
+		return s, total
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				if atomic.LoadInt32(&stopped) != 0 {
+					return
+				}
+				sum, err := downloadChunk(f, chunks[i], fetch, &stopped,
+					func(n int64) {
+						lock.Lock()
+						total += n
+						lock.Unlock()
+					})
+				lock.Lock()
+				if err != nil {
+					if firstErr == nil || firstErr == errChunkStopped {
+						firstErr = err
+					}
+					atomic.StoreInt32(&stopped, 1)
+					lock.Unlock()
+					return
+				}
+				chunks[i].Sha256 = sum
+				lock.Unlock()
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	ticker := time.NewTicker(StatsUpdateTicker)
+	defer ticker.Stop()
+	for waiting := true; waiting; {
+		select {
+		case <-done:
+			waiting = false
+		case <-ticker.C:
+			s, downloaded := snapshot()
+			req.saveProgress(s)
+			if req.ackback {
+				req.syncEp.getContext().postSize(req, size, downloaded)
+			}
+		}
+	}
+
+	s, _ := snapshot()
+	if firstErr != nil {
+		req.saveProgress(s)
+		return s, firstErr
+	}
+	s.asize = size
+	req.clearProgress(s.resumed)
+	return s, nil
+}
+
+// downloadChunk writes the chunk in its place in the file and returns
+// its sha256. saveProgress syncs the file before the sha256 is persisted.
+func downloadChunk(f *os.File, chunk SyncChunk, fetch rangeFetcher,
+	stopped *int32, count func(int64)) (string, error) {
+
+	body, contentMD5, err := fetch(chunk.Offset, chunk.Size)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	h := sha256.New()
+	var m hash.Hash
+	if contentMD5 != "" {
+		m = md5.New()
+	}
+	buf := make([]byte, chunkReadSize)
+	offset := chunk.Offset
+	remaining := chunk.Size
+	for remaining > 0 {
+		if atomic.LoadInt32(stopped) != 0 {
+			return "", errChunkStopped
+		}
+		want := int64(len(buf))
+		if want > remaining {
+			want = remaining
+		}
+		n, err := body.Read(buf[:want])
+		if n > 0 {
+			if _, err := f.WriteAt(buf[:n], offset); err != nil {
+				return "", err
+			}
+			h.Write(buf[:n])
+			if m != nil {
+				m.Write(buf[:n])
+			}
+			offset += int64(n)
+			remaining -= int64(n)
+			count(int64(n))
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+	}
+	if remaining != 0 {
+		return "", fmt.Errorf("short chunk at %d: %d of %d bytes",
+			chunk.Offset, chunk.Size-remaining, chunk.Size)
+	}
+	if m != nil {
+		sum := base64.StdEncoding.EncodeToString(m.Sum(nil))
+		if sum != contentMD5 {
+			return "", fmt.Errorf("chunk at %d has Content-MD5 %s expected %s",
+				chunk.Offset, sum, contentMD5)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedUpload
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkedDownload(t *testing.T) {
+	content := make([]byte, 3*minChunkSize+12345)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+	modTime := time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC)
+	const etag = "\"v1\""
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				atomic.AddInt32(&requests, 1)
+			}
+			w.Header().Set("ETag", etag)
+			http.ServeContent(w, r, "image", modTime,
+				bytes.NewReader(content))
+		}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "zedUpload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	localFile := filepath.Join(dir, "image")
+	metaFile := localFile + ".meta"
+
+	dCtx, err := NewDronaCtx("test", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ep, err := dCtx.NewSyncerDest(SyncHttpTr, ts.URL, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test cases use the files from the previous one
+	testMatrix := []struct {
+		name             string
+		setup            func()
+		expectedRequests int32
+		expectedResumed  int64
+	}{
+		{
+			name:             "Fresh download",
+			setup:            func() {},
+			expectedRequests: 4,
+		},
+		{
+			name: "Resume with two complete chunks",
+			setup: func() {
+				chunks := planChunks(int64(len(content)), 4)
+				f, _ := os.Open(localFile)
+				chunks[0].Sha256 = chunkSha(f, chunks[0])
+				chunks[2].Sha256 = chunkSha(f, chunks[2])
+				f.Close()
+				req := &DronaRequest{operation: SyncOpDownload,
+					name: "image", objectSize: int64(len(content)),
+					etag: etag, chunkState: chunks}
+				assert.NoError(t, req.WriteMetaFile(metaFile))
+			},
+			expectedRequests: 2,
+			expectedResumed:  2 * minChunkSize,
+		},
+		{
+			name: "Resume with a corrupted chunk",
+			setup: func() {
+				chunks := planChunks(int64(len(content)), 4)
+				f, _ := os.OpenFile(localFile, os.O_RDWR, 0644)
+				for i := range chunks {
+					chunks[i].Sha256 = chunkSha(f, chunks[i])
+				}
+				f.WriteAt([]byte("garbage"), chunks[1].Offset+100)
+				f.Close()
+				req := &DronaRequest{operation: SyncOpDownload,
+					name: "image", objectSize: int64(len(content)),
+					etag: etag, chunkState: chunks}
+				assert.NoError(t, req.WriteMetaFile(metaFile))
+			},
+			expectedRequests: 1,
+			expectedResumed:  int64(len(content)) - minChunkSize,
+		},
+	}
+
+	for _, test := range testMatrix {
+		t.Logf("Running test case %s", test.name)
+		test.setup()
+		atomic.StoreInt32(&requests, 0)
+		req := ep.NewRequest(SyncOpDownload, "image", localFile, 0, false,
+			nil)
+		req.WithResume(metaFile)
+		req.WithChunks(4)
+		assert.NoError(t, ep.Action(req))
+		assert.Equal(t, test.expectedRequests, atomic.LoadInt32(&requests))
+		assert.Equal(t, test.expectedResumed, req.GetResumedSize())
+		b, err := ioutil.ReadFile(localFile)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Equal(content, b))
+		_, err = os.Stat(metaFile)
+		assert.True(t, os.IsNotExist(err))
+	}
+}
@@ -6,6 +6,8 @@ package zedUpload
 import (
 	"fmt"
 	zedHttp "github.com/lf-edge/eve/pkg/pillar/zedUpload/httputil"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -22,6 +24,7 @@ type HttpTransportMethod struct {
 	failPostTime time.Time
 	ctx          *DronaCtx
 	hClient      *http.Client
+	limiter      *rateutil.Limiter
 }
 
 func (ep *HttpTransportMethod) Action(req *DronaRequest) error {
@@ -64,13 +67,13 @@ func (ep *HttpTransportMethod) Close() error {
 
 // use the specific ip as source address for this connection
 func (ep *HttpTransportMethod) WithSrcIpSelection(localAddr net.IP) error {
-	ep.hClient = httpClientSrcIP(localAddr, nil)
+	ep.hClient = httpClientSrcIP(localAddr, nil, ep.limiter)
 	return nil
 }
 
 func (ep *HttpTransportMethod) WithSrcIpAndProxySelection(localAddr net.IP,
 	proxy *url.URL) error {
-	ep.hClient = httpClientSrcIP(localAddr, proxy)
+	ep.hClient = httpClientSrcIP(localAddr, proxy, ep.limiter)
 	return nil
 }
 
@@ -83,6 +86,13 @@ func (ep *HttpTransportMethod) WithLogging(onoff bool) error {
 	return nil
 }
 
+// WithBandwidthLimiter makes the transfers also wait for a limiter
+// from DronaCtx.NewLimiter; nil means only the DronaCtx limit applies
+func (ep *HttpTransportMethod) WithBandwidthLimiter(limiter *rateutil.Limiter) error {
+	ep.limiter.SetParent(ep.ctx.parentLimiter(limiter))
+	return nil
+}
+
 // File upload to HTTP Datastore
 func (ep *HttpTransportMethod) processHttpUpload(req *DronaRequest) (error, int) {
 	postUrl := ep.hurl + "/" + ep.path
@@ -115,32 +125,64 @@ func (ep *HttpTransportMethod) processHttpDownload(req *DronaRequest) (error, in
 	if ep.hurl != "" {
 		file = ep.hurl + "/" + ep.path + "/" + req.name
 	}
+	if req.chunks > 1 {
+		info, err := zedHttp.GetObjectInfo(file, ep.hClient)
+		if err == nil && info.AcceptRanges &&
+			req.useChunks(info.Size, info.ETag, info.LastModified) {
+			validator := info.ETag
+			if validator == "" {
+				validator = info.LastModified
+			}
+			fetch := func(offset, length int64) (io.ReadCloser, string, error) {
+				return zedHttp.GetRange(file, offset, length, validator,
+					ep.hClient)
+			}
+			p, err := req.downloadChunks(info.Size, info.ETag,
+				info.LastModified, fetch)
+			return err, int(p.asize)
+		}
+	}
+	rp := req.getResumePoint()
 	prgChan := make(zedHttp.NotifChan)
-	defer close(prgChan)
-	if req.ackback {
-		go func(req *DronaRequest, prgNotif zedHttp.NotifChan) {
-			ticker := time.NewTicker(StatsUpdateTicker)
-			var stats zedHttp.UpdateStats
-			var ok bool
-			for {
-				select {
-				case stats, ok = <-prgNotif:
-					if !ok {
-						return
-					}
-				case <-ticker.C:
+	done := make(chan struct{})
+	go func(req *DronaRequest, prgNotif zedHttp.NotifChan) {
+		defer close(done)
+		ticker := time.NewTicker(StatsUpdateTicker)
+		defer ticker.Stop()
+		var stats zedHttp.UpdateStats
+		var ok bool
+		for {
+			select {
+			case stats, ok = <-prgNotif:
+				if !ok {
+					return
+				}
+			case <-ticker.C:
+				req.saveProgress(httpProgress(stats))
+				if req.ackback {
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
-		}(req, prgChan)
-	}
-	resp := zedHttp.ExecCmd("get", file, "", req.objloc, prgChan, ep.hClient)
+		}
+	}(req, prgChan)
+	resp := zedHttp.ExecGetResume(file, req.objloc, rp.offset, rp.etag,
+		rp.lastModified, prgChan, ep.hClient)
+	close(prgChan)
+	<-done
 	if resp.Error != nil {
+		req.saveProgress(httpProgress(resp))
 		return resp.Error, resp.BodyLength
 	}
+	req.clearProgress(resp.Resumed)
 	return resp.Error, resp.BodyLength
 }
 
+func httpProgress(stats zedHttp.UpdateStats) downloadProgress {
+	return downloadProgress{asize: stats.Asize, osize: stats.Size,
+		resumed: stats.Resumed, etag: stats.ETag,
+		lastModified: stats.LastModified}
+}
+
 // File delete from HTTP Datastore
 func (ep *HttpTransportMethod) processHttpDelete(req *DronaRequest) error {
 	return nil
@@ -0,0 +1,160 @@
+// Copyright(c) 2019 Zededa, Inc.
+// All rights reserved.
+
+package zedUpload
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	zedOCI "github.com/lf-edge/eve/pkg/pillar/zedUpload/ociutil"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
+)
+
+// OciTransportMethod pulls images from an OCI/docker registry.
+// The request name is the image reference i.e., repo:tag or repo@digest
+// and the object location is the OCI image layout directory.
+type OciTransportMethod struct {
+	transport SyncTransportType
+	registry  string // scheme and host
+	path      string // Prefix for the repository
+
+	//Auth
+	uname    string
+	password string
+
+	failPostTime time.Time
+	ctx          *DronaCtx
+	hClient      *http.Client
+	limiter      *rateutil.Limiter
+}
+
+func (ep *OciTransportMethod) Action(req *DronaRequest) error {
+	var err error
+	var size int64
+
+	switch req.operation {
+	case SyncOpDownload:
+		size, err = ep.processOciDownload(req)
+	default:
+		err = fmt.Errorf("Unknown OCI datastore operation")
+	}
+
+	req.asize = size
+	if err != nil {
+		req.status = fmt.Sprintf("%v", err)
+	}
+	return err
+}
+
+func (ep *OciTransportMethod) Open() error {
+	return nil
+}
+
+func (ep *OciTransportMethod) Close() error {
+	return nil
+}
+
+// use the specific ip as source address for this connection
+func (ep *OciTransportMethod) WithSrcIpSelection(localAddr net.IP) error {
+	ep.hClient = httpClientSrcIP(localAddr, nil, ep.limiter)
+	return nil
+}
+
+func (ep *OciTransportMethod) WithSrcIpAndProxySelection(localAddr net.IP,
+	proxy *url.URL) error {
+	ep.hClient = httpClientSrcIP(localAddr, proxy, ep.limiter)
+	return nil
+}
+
+// bind to specific interface for this connection
+func (ep *OciTransportMethod) WithBindIntf(intf string) error {
+	localAddr := getSrcIpFromInterface(intf)
+	if localAddr != nil {
+		ep.hClient = httpClientSrcIP(localAddr, nil, ep.limiter)
+		return nil
+	}
+	return fmt.Errorf("failed to get the address for intf")
+}
+
+func (ep *OciTransportMethod) WithLogging(onoff bool) error {
+	return nil
+}
+
+// WithBandwidthLimiter makes the transfers also wait for a limiter
+// from DronaCtx.NewLimiter; nil means only the DronaCtx limit applies
+func (ep *OciTransportMethod) WithBandwidthLimiter(limiter *rateutil.Limiter) error {
+	ep.limiter.SetParent(ep.ctx.parentLimiter(limiter))
+	return nil
+}
+
+// Image pull from the registry into an OCI image layout
+func (ep *OciTransportMethod) processOciDownload(req *DronaRequest) (int64, error) {
+	// The name can be a complete reference if there is no registry
+	var parts []string
+	for _, p := range []string{ep.registry, ep.path, req.name} {
+		if p != "" {
+			parts = append(parts, strings.Trim(p, "/"))
+		}
+	}
+	registry, repo, reference, err := zedOCI.ParseReference(
+		strings.Join(parts, "/"))
+	if err != nil {
+		return 0, err
+	}
+	prgChan := make(zedOCI.NotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go func(req *DronaRequest, prgNotif zedOCI.NotifChan) {
+			ticker := time.NewTicker(StatsUpdateTicker)
+			defer ticker.Stop()
+			var stats zedOCI.UpdateStats
+			var ok bool
+			for {
+				select {
+				case stats, ok = <-prgNotif:
+					if !ok {
+						return
+					}
+				case <-ticker.C:
+					ep.ctx.postSize(req, stats.Size, stats.Asize)
+				}
+			}
+		}(req, prgChan)
+	}
+	oc := zedOCI.NewOciCtx(registry, repo, ep.uname, ep.password, ep.hClient)
+	result, err := oc.Pull(reference, req.objloc, prgChan)
+	if err != nil {
+		return 0, err
+	}
+	req.Lock()
+	req.imageDigest = result.ManifestDigest
+	req.layerDigests = result.LayerDigests
+	req.Unlock()
+	return result.Size, nil
+}
+
+func (ep *OciTransportMethod) getContext() *DronaCtx {
+	return ep.ctx
+}
+
+func (ep *OciTransportMethod) NewRequest(opType SyncOpType, objname, objloc string, sizelimit int64, ackback bool, reply chan *DronaRequest) *DronaRequest {
+	dR := &DronaRequest{}
+	dR.syncEp = ep
+	dR.operation = opType
+	dR.name = objname
+	dR.ackback = ackback
+
+	dR.localName = objname
+	dR.objloc = objloc
+
+	// limit for this download
+	dR.sizelimit = sizelimit
+	dR.result = reply
+
+	return dR
+}
@@ -8,10 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"os"
 	"sync"
 	"time"
+
+	log "github.com/sirupsen/logrus"
 )
 
 var (
@@ -55,6 +56,26 @@ type DronaRequest struct {
 	// Filled by Drona, uploaded blob MD5sum
 	remoteFileMD5 string
 
+	// Filled by Drona, OCI manifest and layer digests
+	imageDigest  string
+	layerDigests []string
+
+	// Optional, meta file to persist the progress for resuming
+	metaloc string
+
+	// Filled by Drona, validators of the downloaded object
+	etag         string
+	lastModified string
+
+	// Filled by Drona, bytes kept from an earlier partial download
+	resumedSize int64
+
+	// Optional, number of concurrent ranged chunks for large objects
+	chunks int
+
+	// Filled by Drona, the chunks of a chunked download
+	chunkState []SyncChunk
+
 	// Status of Download, we convert here to string because this
 	// field is going to be json marshalled
 	status string
@@ -148,6 +169,28 @@ func (req *DronaRequest) GetRemoteFileMD5() string {
 	return req.remoteFileMD5
 }
 
+// Return the digest of the OCI image manifest
+func (req *DronaRequest) GetImageDigest() string {
+	req.Lock()
+	defer req.Unlock()
+	return req.imageDigest
+}
+
+// Return the digests of the OCI image layers
+func (req *DronaRequest) GetLayerDigests() []string {
+	req.Lock()
+	defer req.Unlock()
+	return req.layerDigests
+}
+
+// Return the number of bytes which were not downloaded again since
+// they were kept from an earlier partial download
+func (req *DronaRequest) GetResumedSize() int64 {
+	req.Lock()
+	defer req.Unlock()
+	return req.resumedSize
+}
+
 // Update the actual size
 func (req *DronaRequest) updateAsize(size int64) {
 	req.Lock()
@@ -195,6 +238,16 @@ type SyncMetaFile struct {
 
 	EndTime   string
 	StartTime string
+
+	// For resuming a download; the complete size and the validators
+	// of the object when Asize bytes were downloaded
+	ObjectSize   int64
+	ETag         string
+	LastModified string
+
+	// For a chunked download the chunks; Asize is the part which is
+	// complete from the start
+	Chunks []SyncChunk
 }
 
 // helper function to write the metafile for the transcation that is
@@ -204,28 +257,32 @@ func (req *DronaRequest) WriteMetaFile(metaloc string) error {
 	if metaloc != "" {
 		f, err := os.OpenFile(metaloc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 		if err != nil {
-			log.Printf("file create failed %s - %v", metaloc, err)
+			log.Errorf("file create failed %s - %v\n", metaloc, err)
 			internalerr = err
 		} else {
 			defer f.Close()
 
 			wreq := &SyncMetaFile{
-				Operation: req.operation,
-				Name:      req.name,
-				Asize:     req.asize,
-				Status:    req.status,
-				EndTime:   req.endTime.Format(time.RFC3339),
-				StartTime: req.startTime.Format(time.RFC3339)}
+				Operation:    req.operation,
+				Name:         req.name,
+				Asize:        req.asize,
+				Status:       req.status,
+				EndTime:      req.endTime.Format(time.RFC3339),
+				StartTime:    req.startTime.Format(time.RFC3339),
+				ObjectSize:   req.objectSize,
+				ETag:         req.etag,
+				LastModified: req.lastModified,
+				Chunks:       req.chunkState}
 
 			b, err := json.MarshalIndent(wreq, "", "    ")
 			if err != nil {
 				internalerr = err
-				log.Printf("metadata %s write failed %v", req.name, err)
+				log.Errorf("metadata %s write failed %v\n", req.name, err)
 				b = []byte(fmt.Sprintf("Error on marshaling data into bytes %v - %v\n", req, err))
 			}
 			if _, err := f.Write(b); err != nil {
 				internalerr = err
-				log.Printf("error writing file %s, %v", metaloc, err)
+				log.Errorf("error writing file %s, %v\n", metaloc, err)
 			}
 		}
 	} else {
@@ -250,9 +307,13 @@ func ReadMetaFile(metaloc string) (error, *DronaRequest) {
 	}
 
 	dnResp := DronaRequest{operation: rreq.Operation,
-		name:   rreq.Name,
-		asize:  rreq.Asize,
-		status: rreq.Status}
+		name:         rreq.Name,
+		asize:        rreq.Asize,
+		status:       rreq.Status,
+		objectSize:   rreq.ObjectSize,
+		etag:         rreq.ETag,
+		lastModified: rreq.LastModified,
+		chunkState:   rreq.Chunks}
 
 	return nil, &dnResp
 }
@@ -0,0 +1,127 @@
+// Copyright(c) 2019 Zededa, Inc.
+// All rights reserved.
+
+package zedUpload
+
+import (
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Resuming interrupted downloads. While downloading the transports
+// persist the progress and the validators (ETag, Last-Modified) of the
+// object in the meta file of the request. A later request for the same
+// object and local file continues from there if the object is unchanged.
+
+// downloadProgress is what the transports report from their util packages
+type downloadProgress struct {
+	asize        int64 // On disk
+	osize        int64
+	resumed      int64
+	etag         string
+	lastModified string
+	chunks       []SyncChunk // For chunked downloads
+}
+
+// resumePoint is where to continue a download
+type resumePoint struct {
+	offset       int64
+	etag         string
+	lastModified string
+}
+
+// WithResume sets the meta file used to persist the progress of a
+// download. Without it a download always starts from zero.
+func (req *DronaRequest) WithResume(metaloc string) {
+	req.Lock()
+	defer req.Unlock()
+	req.metaloc = metaloc
+}
+
+// getResumePoint determines from the meta file and the local file how
+// much we already have
+func (req *DronaRequest) getResumePoint() resumePoint {
+	rp := resumePoint{}
+	if req.metaloc == "" || req.operation != SyncOpDownload {
+		return rp
+	}
+	err, meta := ReadMetaFile(req.metaloc)
+	if err != nil {
+		return rp
+	}
+	if meta.operation != SyncOpDownload || meta.name != req.name {
+		log.Warnf("meta file %s is for a different request %s\n",
+			req.metaloc, meta.name)
+		return rp
+	}
+	if meta.etag == "" && meta.lastModified == "" {
+		return rp
+	}
+	info, err := os.Stat(req.objloc)
+	if err != nil {
+		return rp
+	}
+	// The meta file might be ahead of the data on disk after a crash
+	rp.offset = meta.asize
+	if info.Size() < rp.offset {
+		rp.offset = info.Size()
+	}
+	rp.etag = meta.etag
+	rp.lastModified = meta.lastModified
+	return rp
+}
+
+// saveProgress writes the progress to the meta file
+func (req *DronaRequest) saveProgress(p downloadProgress) {
+	req.Lock()
+	req.resumedSize = p.resumed
+	// Without validators there is nothing to resume from. This also
+	// keeps the meta file of the previous attempt until we have a
+	// response.
+	if req.metaloc == "" || (p.etag == "" && p.lastModified == "") {
+		req.Unlock()
+		return
+	}
+	req.asize = p.asize
+	req.objectSize = p.osize
+	req.etag = p.etag
+	req.lastModified = p.lastModified
+	req.chunkState = p.chunks
+	req.Unlock()
+
+	// What we persist as downloaded must be on disk since it is used to
+	// resume. The transports don't sync the data themselves.
+	if err := syncFile(req.objloc); err != nil {
+		log.Errorf("saveProgress %s sync failed: %v\n", req.name, err)
+		return
+	}
+	req.RLock()
+	defer req.RUnlock()
+	if err := req.WriteMetaFile(req.metaloc); err != nil {
+		log.Errorf("saveProgress %s failed: %v\n", req.name, err)
+	}
+}
+
+// syncFile flushes what was written to the file through any descriptor
+func syncFile(filename string) error {
+	f, err := os.OpenFile(filename, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
+
+// clearProgress removes the meta file once the download is complete
+func (req *DronaRequest) clearProgress(resumed int64) {
+	req.Lock()
+	req.resumedSize = resumed
+	req.Unlock()
+	if req.metaloc == "" {
+		return
+	}
+	if err := os.Remove(req.metaloc); err != nil && !os.IsNotExist(err) {
+		log.Errorf("clearProgress %s failed: %v\n", req.metaloc, err)
+	}
+}
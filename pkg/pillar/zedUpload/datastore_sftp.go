@@ -5,6 +5,7 @@ package zedUpload
 
 import (
 	"fmt"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
 	"github.com/lf-edge/eve/pkg/pillar/zedUpload/sftputil"
 	"net"
 	"net/url"
@@ -39,7 +40,8 @@ type SftpTransportMethod struct {
 
 	failPostTime time.Time
 
-	ctx *DronaCtx
+	ctx     *DronaCtx
+	limiter *rateutil.Limiter
 }
 
 //
@@ -101,6 +103,13 @@ func (ep *SftpTransportMethod) WithLogging(onoff bool) error {
 	return nil
 }
 
+// WithBandwidthLimiter makes the transfers also wait for a limiter
+// from DronaCtx.NewLimiter; nil means only the DronaCtx limit applies
+func (ep *SftpTransportMethod) WithBandwidthLimiter(limiter *rateutil.Limiter) error {
+	ep.limiter.SetParent(ep.ctx.parentLimiter(limiter))
+	return nil
+}
+
 // File upload to SFTP Datastore
 func (ep *SftpTransportMethod) processSftpUpload(req *DronaRequest) (error, int) {
 	file := req.name
@@ -165,7 +174,7 @@ func (ep *SftpTransportMethod) processSftpDownload(req *DronaRequest) (error, in
 		}(req, prgChan)
 	}
 
-	resp := sftp.ExecCmd("fetch", ep.surl, ep.uname, ep.passwd, file, req.objloc, prgChan)
+	resp := sftp.ExecCmdWithLimiter("fetch", ep.surl, ep.uname, ep.passwd, file, req.objloc, ep.limiter, prgChan)
 	return resp.Error, int(resp.Asize)
 }
 
@@ -15,6 +15,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 )
 
@@ -27,8 +28,11 @@ type UpdateStats struct {
 	Asize         int64    // current size uploaded/downloaded
 	List          []string //list of images at given path
 	Error         error
-	BodyLength    int   // Body legth in http response
-	ContentLength int64 // Content length in http response
+	BodyLength    int    // Body legth in http response
+	ContentLength int64  // Content length in http response
+	ETag          string // Validators of the downloaded object
+	LastModified  string
+	Resumed       int64 // Bytes kept from an earlier partial download
 }
 
 type NotifChan chan UpdateStats
@@ -112,65 +116,7 @@ func ExecCmd(cmd, host, remoteFile, localFile string, prgNotify NotifChan, clien
 		}
 		return stats
 	case "get":
-		req, err := http.NewRequest(http.MethodGet, host, nil)
-		if err != nil {
-			stats.Error = fmt.Errorf("request failed for get %s: %s",
-				host, err)
-			return stats
-		}
-		req.Header.Set("User-Agent", userAgent)
-		req.Header.Set("Content-Type", "application/octet-stream")
-
-		resp, err := client.Do(req)
-		if err != nil {
-			stats.Error = fmt.Errorf("get failed for get %s: %s",
-				host, err)
-			return stats
-		}
-		if resp.StatusCode != 200 {
-			stats.Error = fmt.Errorf("bad response code for %s: %d",
-				host, resp.StatusCode)
-			return stats
-		}
-		tempLocalFile := localFile
-		index := strings.LastIndex(tempLocalFile, "/")
-		dir_err := os.MkdirAll(tempLocalFile[:index+1], 0755)
-		if dir_err != nil {
-			stats.Error = dir_err
-			return stats
-		}
-		local, fileErr := os.Create(localFile)
-		if fileErr != nil {
-			stats.Error = fileErr
-			return stats
-		}
-		defer local.Close()
-		defer resp.Body.Close()
-		chunkSize := SingleMB
-		var written, copiedSize int64
-		var copyErr error
-		stats.Size = int64(resp.ContentLength)
-		for {
-			if written, copyErr = io.CopyN(local, resp.Body, chunkSize); copyErr != nil && copyErr != io.EOF {
-				stats.Error = copyErr
-				return stats
-			}
-			copiedSize += written
-			if written != chunkSize {
-				// Must have reached EOF
-				copyErr = nil
-				break
-			}
-			stats.Asize = copiedSize
-			if prgNotify != nil {
-				select {
-				case prgNotify <- stats:
-				default: //ignore we cannot write
-				}
-			}
-		}
-		stats.BodyLength = int(resp.ContentLength)
-		return stats
+		return execGet(host, localFile, 0, "", "", prgNotify, client)
 	case "post":
 		file, err := os.Open(localFile)
 		if err != nil {
@@ -243,3 +189,248 @@ func ExecCmd(cmd, host, remoteFile, localFile string, prgNotify NotifChan, clien
 		return stats
 	}
 }
+
+// ExecGetResume downloads host to localFile continuing at offset if the
+// object is unchanged. The etag or lastModified from the earlier partial
+// download are sent in If-Range; if the server doesn't honor the range
+// the download starts from zero.
+func ExecGetResume(host, localFile string, offset int64, etag, lastModified string,
+	prgNotify NotifChan, client *http.Client) UpdateStats {
+	if client == nil {
+		client = getHttpClient()
+	}
+	return execGet(host, localFile, offset, etag, lastModified, prgNotify, client)
+}
+
+func execGet(host, localFile string, offset int64, etag, lastModified string,
+	prgNotify NotifChan, client *http.Client) UpdateStats {
+	stats := UpdateStats{}
+	req, err := http.NewRequest(http.MethodGet, host, nil)
+	if err != nil {
+		stats.Error = fmt.Errorf("request failed for get %s: %s",
+			host, err)
+		return stats
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	validator := etag
+	if validator == "" {
+		validator = lastModified
+	}
+	if offset > 0 && validator != "" {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		req.Header.Set("If-Range", validator)
+	} else {
+		offset = 0
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		stats.Error = fmt.Errorf("get failed for get %s: %s",
+			host, err)
+		return stats
+	}
+	defer resp.Body.Close()
+	stats.ETag = resp.Header.Get("ETag")
+	stats.LastModified = resp.Header.Get("Last-Modified")
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Complete object; either no range or it has changed
+		offset = 0
+	case http.StatusPartialContent:
+		start, _, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil || start != offset {
+			stats.Error = fmt.Errorf("bad range for %s: %s",
+				host, resp.Header.Get("Content-Range"))
+			return stats
+		}
+		// The validators apply to the object we already have
+		if stats.ETag == "" {
+			stats.ETag = etag
+		}
+		if stats.LastModified == "" {
+			stats.LastModified = lastModified
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// We might have all of it already
+		_, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil || size != offset {
+			stats.Error = fmt.Errorf("bad response code for %s: %d",
+				host, resp.StatusCode)
+			return stats
+		}
+		stats.ETag = etag
+		stats.LastModified = lastModified
+		stats.Size = size
+		stats.Asize = size
+		stats.Resumed = size
+		stats.BodyLength = int(size)
+		return stats
+	default:
+		stats.Error = fmt.Errorf("bad response code for %s: %d",
+			host, resp.StatusCode)
+		return stats
+	}
+	tempLocalFile := localFile
+	index := strings.LastIndex(tempLocalFile, "/")
+	dir_err := os.MkdirAll(tempLocalFile[:index+1], 0755)
+	if dir_err != nil {
+		stats.Error = dir_err
+		return stats
+	}
+	local, fileErr := os.OpenFile(localFile, os.O_WRONLY|os.O_CREATE, 0644)
+	if fileErr != nil {
+		stats.Error = fileErr
+		return stats
+	}
+	defer local.Close()
+	if err := local.Truncate(offset); err != nil {
+		stats.Error = err
+		return stats
+	}
+	if _, err := local.Seek(offset, io.SeekStart); err != nil {
+		stats.Error = err
+		return stats
+	}
+	chunkSize := SingleMB
+	var written int64
+	var copyErr error
+	copiedSize := offset
+	stats.Resumed = offset
+	stats.Asize = offset
+	stats.Size = resp.ContentLength
+	if resp.ContentLength >= 0 {
+		stats.Size += offset
+	}
+	for {
+		if written, copyErr = io.CopyN(local, resp.Body, chunkSize); copyErr != nil && copyErr != io.EOF {
+			stats.Error = copyErr
+			return stats
+		}
+		copiedSize += written
+		if written != chunkSize {
+			// Must have reached EOF
+			copyErr = nil
+			break
+		}
+		stats.Asize = copiedSize
+		if prgNotify != nil {
+			select {
+			case prgNotify <- stats:
+			default: //ignore we cannot write
+			}
+		}
+	}
+	stats.Asize = copiedSize
+	stats.BodyLength = int(copiedSize)
+	return stats
+}
+
+// parseContentRange returns the start and the complete size from
+// "bytes start-end/size" or "bytes */size"
+func parseContentRange(contentRange string) (int64, int64, error) {
+	var start, size int64 = -1, -1
+	s := strings.TrimPrefix(contentRange, "bytes ")
+	slash := strings.Index(s, "/")
+	if s == contentRange || slash == -1 {
+		return start, size, fmt.Errorf("bad Content-Range %s", contentRange)
+	}
+	if s[slash+1:] != "*" {
+		n, err := strconv.ParseInt(s[slash+1:], 10, 64)
+		if err != nil {
+			return start, size, err
+		}
+		size = n
+	}
+	if s[:slash] != "*" {
+		dash := strings.Index(s, "-")
+		if dash == -1 {
+			return start, size, fmt.Errorf("bad Content-Range %s", contentRange)
+		}
+		n, err := strconv.ParseInt(s[:dash], 10, 64)
+		if err != nil {
+			return start, size, err
+		}
+		start = n
+	}
+	return start, size, nil
+}
+
+// ObjectInfo is what a HEAD request tells about the object
+type ObjectInfo struct {
+	Size         int64
+	ETag         string
+	LastModified string
+	AcceptRanges bool
+}
+
+// GetObjectInfo returns the size, the validators and if the server
+// accepts range requests for the object
+func GetObjectInfo(host string, client *http.Client) (ObjectInfo, error) {
+	info := ObjectInfo{}
+	if client == nil {
+		client = getHttpClient()
+	}
+	req, err := http.NewRequest(http.MethodHead, host, nil)
+	if err != nil {
+		return info, fmt.Errorf("request failed for head %s: %s",
+			host, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return info, fmt.Errorf("head failed for %s: %s", host, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return info, fmt.Errorf("bad response code for head %s: %d",
+			host, resp.StatusCode)
+	}
+	info.Size = resp.ContentLength
+	info.ETag = resp.Header.Get("ETag")
+	info.LastModified = resp.Header.Get("Last-Modified")
+	info.AcceptRanges = resp.Header.Get("Accept-Ranges") == "bytes"
+	return info, nil
+}
+
+// GetRange returns a reader for length bytes at offset and the
+// Content-MD5 of the range if any. The validator is sent in If-Range
+// hence we fail if the object changed.
+func GetRange(host string, offset, length int64, validator string,
+	client *http.Client) (io.ReadCloser, string, error) {
+	if client == nil {
+		client = getHttpClient()
+	}
+	req, err := http.NewRequest(http.MethodGet, host, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("request failed for get %s: %s",
+			host, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset,
+		offset+length-1))
+	if validator != "" {
+		req.Header.Set("If-Range", validator)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("get failed for get %s: %s",
+			host, err)
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return nil, "", fmt.Errorf("object %s changed", host)
+		}
+		return nil, "", fmt.Errorf("bad response code for %s: %d",
+			host, resp.StatusCode)
+	}
+	start, _, err := parseContentRange(resp.Header.Get("Content-Range"))
+	if err != nil || start != offset {
+		resp.Body.Close()
+		return nil, "", fmt.Errorf("bad range for %s: %s",
+			host, resp.Header.Get("Content-Range"))
+	}
+	return resp.Body, resp.Header.Get("Content-MD5"), nil
+}
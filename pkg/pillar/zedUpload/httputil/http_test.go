@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecGetResume(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789abcdef"), 200000)
+	modTime := time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC)
+	const etag = "\"v1\""
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", etag)
+			http.ServeContent(w, r, "image", modTime,
+				bytes.NewReader(content))
+		}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "httputil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testMatrix := map[string]struct {
+		partial         int64 // Bytes in the local file
+		offset          int64
+		etag            string
+		lastModified    string
+		expectedResumed int64
+	}{
+		"No partial file": {},
+		"Resume with ETag": {
+			partial:         3000000,
+			offset:          3000000,
+			etag:            etag,
+			expectedResumed: 3000000,
+		},
+		"Resume with Last-Modified": {
+			partial:         1000,
+			offset:          1000,
+			lastModified:    modTime.Format(http.TimeFormat),
+			expectedResumed: 1000,
+		},
+		"Changed object": {
+			partial: 1000,
+			offset:  1000,
+			etag:    "\"v0\"",
+		},
+		"No validators": {
+			partial: 1000,
+			offset:  1000,
+		},
+		"Local file longer than offset": {
+			partial:         5000,
+			offset:          1000,
+			etag:            etag,
+			expectedResumed: 1000,
+		},
+		"Already complete": {
+			partial:         int64(len(content)),
+			offset:          int64(len(content)),
+			etag:            etag,
+			expectedResumed: int64(len(content)),
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		localFile := filepath.Join(dir, "image")
+		os.Remove(localFile)
+		if test.partial != 0 {
+			err := ioutil.WriteFile(localFile, content[:test.partial], 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		stats := ExecGetResume(ts.URL, localFile, test.offset, test.etag,
+			test.lastModified, nil, nil)
+		assert.NoError(t, stats.Error)
+		assert.Equal(t, test.expectedResumed, stats.Resumed)
+		assert.Equal(t, int64(len(content)), stats.Asize)
+		assert.Equal(t, etag, stats.ETag)
+		b, err := ioutil.ReadFile(localFile)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Equal(content, b))
+	}
+}
@@ -0,0 +1,645 @@
+// Copyright(c) 2019 Zededa, Inc.
+// All rights reserved.
+
+// Minimal OCI distribution (docker registry v2) client which pulls an
+// image into an OCI image layout directory while verifying the digests
+// of the manifest and all the blobs.
+
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	SingleMB int64 = 1024 * 1024
+
+	MediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeOCIIndex       = "application/vnd.oci.image.index.v1+json"
+	MediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeDockerList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// Docker Hub is addressed as docker.io but served elsewhere
+	dockerHub         = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+	maxManifestSize   = 4 * SingleMB
+)
+
+type UpdateStats struct {
+	Size  int64 // complete size to download
+	Asize int64 // current size downloaded
+}
+
+type NotifChan chan UpdateStats
+
+// Descriptor refers to a blob or manifest
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Platform    *Platform         `json:"platform,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Platform in a manifest list/index
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+// Manifest is an OCI or docker v2 schema 2 image manifest
+type Manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType,omitempty"`
+	Config        Descriptor   `json:"config"`
+	Layers        []Descriptor `json:"layers"`
+}
+
+// Index is an OCI index or docker manifest list
+type Index struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType,omitempty"`
+	Manifests     []Descriptor `json:"manifests"`
+}
+
+// PullResult describes what was pulled
+type PullResult struct {
+	ManifestDigest string
+	ConfigDigest   string
+	LayerDigests   []string
+	Size           int64 // Total of manifest, config and layers
+}
+
+// Ctx holds the registry connection and credentials
+type Ctx struct {
+	registry string // scheme and host
+	repo     string
+	user     string
+	password string
+	token    string // Bearer token once obtained
+	client   *http.Client
+}
+
+// ParseReference splits a reference such as
+// docker://registry.example.com/repo/image:tag into the registry URL,
+// the repository and the tag or digest
+func ParseReference(ref string) (string, string, string, error) {
+	scheme := "https"
+	for _, prefix := range []string{"docker://", "oci://", "https://"} {
+		ref = strings.TrimPrefix(ref, prefix)
+	}
+	if strings.HasPrefix(ref, "http://") {
+		scheme = "http"
+		ref = strings.TrimPrefix(ref, "http://")
+	}
+	host := dockerHub
+	slash := strings.Index(ref, "/")
+	if slash != -1 && strings.ContainsAny(ref[:slash], ".:") {
+		host = ref[:slash]
+		ref = ref[slash+1:]
+	}
+	if ref == "" {
+		return "", "", "", fmt.Errorf("no repository in reference")
+	}
+	repo, reference := ref, "latest"
+	if at := strings.Index(ref, "@"); at != -1 {
+		repo, reference = ref[:at], ref[at+1:]
+	} else if colon := strings.LastIndex(ref, ":"); colon != -1 &&
+		!strings.Contains(ref[colon:], "/") {
+		repo, reference = ref[:colon], ref[colon+1:]
+	}
+	if host == dockerHub {
+		host = dockerHubRegistry
+		if !strings.Contains(repo, "/") {
+			repo = "library/" + repo
+		}
+	}
+	return scheme + "://" + host, repo, reference, nil
+}
+
+// NewOciCtx returns a context for the repository in the registry
+func NewOciCtx(registry, repo, user, password string, client *http.Client) *Ctx {
+	if client == nil {
+		client = &http.Client{}
+	}
+	return &Ctx{registry: strings.TrimSuffix(registry, "/"), repo: repo,
+		user: user, password: password, client: client}
+}
+
+// do sends the request and handles a token or basic auth challenge
+func (ctx *Ctx) do(req *http.Request) (*http.Response, error) {
+	if ctx.token != "" {
+		req.Header.Set("Authorization", "Bearer "+ctx.token)
+	}
+	resp, err := ctx.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+	challenge := resp.Header.Get("Www-Authenticate")
+	resp.Body.Close()
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "bearer":
+		token, err := ctx.getToken(params)
+		if err != nil {
+			return nil, err
+		}
+		ctx.token = token
+		req.Header.Set("Authorization", "Bearer "+ctx.token)
+	case "basic":
+		if ctx.user == "" {
+			return nil, fmt.Errorf("registry %s requires credentials",
+				ctx.registry)
+		}
+		req.SetBasicAuth(ctx.user, ctx.password)
+	default:
+		return nil, fmt.Errorf("unsupported auth challenge %s", challenge)
+	}
+	return ctx.client.Do(req)
+}
+
+// parseChallenge parses e.g.,
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io"
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+	parts := strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+	if len(parts) != 2 {
+		return parts[0], params
+	}
+	rest := parts[1]
+	for rest != "" {
+		eq := strings.Index(rest, "=")
+		if eq == -1 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+		var val string
+		if strings.HasPrefix(rest, "\"") {
+			end := strings.Index(rest[1:], "\"")
+			if end == -1 {
+				val, rest = rest[1:], ""
+			} else {
+				val, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if comma := strings.Index(rest, ","); comma != -1 {
+			val, rest = rest[:comma], rest[comma:]
+		} else {
+			val, rest = rest, ""
+		}
+		params[key] = val
+		rest = strings.TrimLeft(rest, ", ")
+	}
+	return parts[0], params
+}
+
+func (ctx *Ctx) getToken(params map[string]string) (string, error) {
+	realm, ok := params["realm"]
+	if !ok {
+		return "", fmt.Errorf("no realm in auth challenge")
+	}
+	u, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	if service, ok := params["service"]; ok {
+		q.Set("service", service)
+	}
+	scope, ok := params["scope"]
+	if !ok {
+		scope = fmt.Sprintf("repository:%s:pull", ctx.repo)
+	}
+	q.Set("scope", scope)
+	u.RawQuery = q.Encode()
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if ctx.user != "" {
+		req.SetBasicAuth(ctx.user, ctx.password)
+	}
+	resp, err := ctx.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request to %s failed: %s",
+			u.Host, resp.Status)
+	}
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("token decode failed: %s", err)
+	}
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+	if tokenResp.AccessToken != "" {
+		return tokenResp.AccessToken, nil
+	}
+	return "", fmt.Errorf("no token from %s", u.Host)
+}
+
+// GetManifest fetches a manifest or index and verifies its digest.
+// Returns the body, media type and digest
+func (ctx *Ctx) GetManifest(reference string) ([]byte, string, string, error) {
+	u := fmt.Sprintf("%s/v2/%s/manifests/%s", ctx.registry, ctx.repo,
+		reference)
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, "", "", err
+	}
+	req.Header.Set("Accept", strings.Join([]string{MediaTypeOCIManifest,
+		MediaTypeOCIIndex, MediaTypeDockerManifest, MediaTypeDockerList},
+		", "))
+	resp, err := ctx.do(req)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("get manifest %s failed: %s",
+			reference, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", "", fmt.Errorf("get manifest %s failed: %s",
+			reference, resp.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, "", "", err
+	}
+	digest := digestBytes(body)
+	if strings.HasPrefix(reference, "sha256:") && reference != digest {
+		return nil, "", "", fmt.Errorf("manifest digest mismatch %s vs %s",
+			digest, reference)
+	}
+	if hdr := resp.Header.Get("Docker-Content-Digest"); hdr != "" &&
+		strings.HasPrefix(hdr, "sha256:") && hdr != digest {
+		return nil, "", "", fmt.Errorf("manifest digest mismatch %s vs header %s",
+			digest, hdr)
+	}
+	mediaType := resp.Header.Get("Content-Type")
+	if i := strings.Index(mediaType, ";"); i != -1 {
+		mediaType = mediaType[:i]
+	}
+	return body, mediaType, digest, nil
+}
+
+// resolveManifest returns the image manifest for our platform, and the
+// body of the index if the reference was to an index
+func (ctx *Ctx) resolveManifest(reference string) (Manifest, []byte, string, []byte, error) {
+	var manifest Manifest
+	var indexBody []byte
+	body, mediaType, digest, err := ctx.GetManifest(reference)
+	if err != nil {
+		return manifest, nil, "", nil, err
+	}
+	if mediaType == MediaTypeOCIIndex || mediaType == MediaTypeDockerList {
+		var index Index
+		if err := json.Unmarshal(body, &index); err != nil {
+			return manifest, nil, "", nil, err
+		}
+		desc, err := selectPlatform(index)
+		if err != nil {
+			return manifest, nil, "", nil, err
+		}
+		indexBody = body
+		body, mediaType, digest, err = ctx.GetManifest(desc.Digest)
+		if err != nil {
+			return manifest, nil, "", nil, err
+		}
+	}
+	if mediaType != MediaTypeOCIManifest && mediaType != MediaTypeDockerManifest {
+		return manifest, nil, "", nil, fmt.Errorf("unsupported manifest type %s",
+			mediaType)
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return manifest, nil, "", nil, err
+	}
+	if manifest.MediaType == "" {
+		manifest.MediaType = mediaType
+	}
+	return manifest, body, digest, indexBody, nil
+}
+
+func selectPlatform(index Index) (Descriptor, error) {
+	for _, m := range index.Manifests {
+		if m.Platform != nil && m.Platform.OS == runtime.GOOS &&
+			m.Platform.Architecture == runtime.GOARCH {
+			return m, nil
+		}
+	}
+	return Descriptor{}, fmt.Errorf("no manifest for %s/%s",
+		runtime.GOOS, runtime.GOARCH)
+}
+
+// Pull fetches the image into the OCI image layout in dir.
+// Blobs already present with the right digest are not fetched again.
+// When the reference is to an index the index is kept as a blob so that
+// the image can later be verified against the index digest.
+func (ctx *Ctx) Pull(reference string, dir string, prgNotify NotifChan) (PullResult, error) {
+	var result PullResult
+	manifest, body, digest, indexBody, err := ctx.resolveManifest(reference)
+	if err != nil {
+		return result, err
+	}
+	result.ManifestDigest = digest
+	result.ConfigDigest = manifest.Config.Digest
+	stats := UpdateStats{Size: int64(len(body)) + manifest.Config.Size}
+	for _, l := range manifest.Layers {
+		stats.Size += l.Size
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "blobs", "sha256"), 0755); err != nil {
+		return result, err
+	}
+	blobs := append([]Descriptor{manifest.Config}, manifest.Layers...)
+	for _, desc := range blobs {
+		if err := ctx.fetchBlob(desc, dir, &stats, prgNotify); err != nil {
+			return result, err
+		}
+		if desc.Digest != manifest.Config.Digest {
+			result.LayerDigests = append(result.LayerDigests, desc.Digest)
+		}
+	}
+	if err := writeBlob(dir, digest, body); err != nil {
+		return result, err
+	}
+	if indexBody != nil {
+		if err := writeBlob(dir, digestBytes(indexBody), indexBody); err != nil {
+			return result, err
+		}
+	}
+	stats.Asize += int64(len(body))
+	notify(prgNotify, stats)
+	if err := writeLayout(dir, Descriptor{MediaType: manifest.MediaType,
+		Digest: digest, Size: int64(len(body)),
+		Annotations: map[string]string{
+			"org.opencontainers.image.ref.name": reference,
+		}}); err != nil {
+		return result, err
+	}
+	result.Size = stats.Size
+	return result, nil
+}
+
+// fetchBlob downloads a blob verifying the size and digest
+func (ctx *Ctx) fetchBlob(desc Descriptor, dir string, stats *UpdateStats,
+	prgNotify NotifChan) error {
+
+	blobFile, err := BlobPath(dir, desc.Digest)
+	if err != nil {
+		return err
+	}
+	if err := VerifyBlob(dir, desc.Digest); err == nil {
+		stats.Asize += desc.Size
+		notify(prgNotify, *stats)
+		return nil
+	}
+	u := fmt.Sprintf("%s/v2/%s/blobs/%s", ctx.registry, ctx.repo, desc.Digest)
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := ctx.do(req)
+	if err != nil {
+		return fmt.Errorf("get blob %s failed: %s", desc.Digest, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get blob %s failed: %s", desc.Digest, resp.Status)
+	}
+	tmpFile := blobFile + ".tmp"
+	local, err := os.Create(tmpFile)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile)
+	defer local.Close()
+	h := sha256.New()
+	w := io.MultiWriter(local, h)
+	var copiedSize int64
+	for {
+		written, copyErr := io.CopyN(w, resp.Body, SingleMB)
+		copiedSize += written
+		stats.Asize += written
+		notify(prgNotify, *stats)
+		if copyErr == io.EOF {
+			break
+		}
+		if copyErr != nil {
+			return fmt.Errorf("get blob %s failed: %s", desc.Digest,
+				copyErr)
+		}
+		if copiedSize > desc.Size {
+			return fmt.Errorf("blob %s larger than %d", desc.Digest,
+				desc.Size)
+		}
+	}
+	if copiedSize != desc.Size {
+		return fmt.Errorf("blob %s size %d expected %d", desc.Digest,
+			copiedSize, desc.Size)
+	}
+	got := "sha256:" + hex.EncodeToString(h.Sum(nil))
+	if got != desc.Digest {
+		return fmt.Errorf("blob digest mismatch %s vs %s", got, desc.Digest)
+	}
+	if err := local.Sync(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, blobFile)
+}
+
+func notify(prgNotify NotifChan, stats UpdateStats) {
+	if prgNotify != nil {
+		select {
+		case prgNotify <- stats:
+		default: //ignore we cannot write
+		}
+	}
+}
+
+func digestBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// BlobPath returns the file for the digest in the OCI layout
+func BlobPath(dir string, digest string) (string, error) {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 || parts[0] != "sha256" || len(parts[1]) != 64 ||
+		strings.ContainsAny(parts[1], "/.") {
+		return "", fmt.Errorf("unsupported digest %s", digest)
+	}
+	return filepath.Join(dir, "blobs", parts[0], parts[1]), nil
+}
+
+// VerifyBlob checks the sha256 of a blob in the OCI layout
+func VerifyBlob(dir string, digest string) error {
+	blobFile, err := BlobPath(dir, digest)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(blobFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	got := "sha256:" + hex.EncodeToString(h.Sum(nil))
+	if got != digest {
+		return fmt.Errorf("blob digest mismatch %s vs %s", got, digest)
+	}
+	return nil
+}
+
+func writeBlob(dir string, digest string, b []byte) error {
+	blobFile, err := BlobPath(dir, digest)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(blobFile, b, 0644)
+}
+
+func writeLayout(dir string, manifest Descriptor) error {
+	layout := []byte(`{"imageLayoutVersion": "1.0.0"}`)
+	if err := ioutil.WriteFile(filepath.Join(dir, "oci-layout"), layout,
+		0644); err != nil {
+		return err
+	}
+	index := Index{SchemaVersion: 2, Manifests: []Descriptor{manifest}}
+	b, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "index.json"), b, 0644)
+}
+
+// ReadLayout returns the image manifest referenced by the index.json in
+// the OCI layout
+func ReadLayout(dir string) (Manifest, string, error) {
+	var manifest Manifest
+	b, err := ioutil.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		return manifest, "", err
+	}
+	var index Index
+	if err := json.Unmarshal(b, &index); err != nil {
+		return manifest, "", err
+	}
+	if len(index.Manifests) == 0 {
+		return manifest, "", fmt.Errorf("no manifest in %s", dir)
+	}
+	digest := index.Manifests[0].Digest
+	if err := VerifyBlob(dir, digest); err != nil {
+		return manifest, "", err
+	}
+	blobFile, _ := BlobPath(dir, digest)
+	b, err = ioutil.ReadFile(blobFile)
+	if err != nil {
+		return manifest, "", err
+	}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return manifest, "", err
+	}
+	return manifest, digest, nil
+}
+
+// VerifyLayout checks the image in the OCI layout before it is used.
+// The expected sha256 from the controller is either that of the image
+// manifest or that of an index which refers to the image manifest; when
+// the controller did not send one only the layout itself is checked.
+// The layers of the manifest must be the layerDigests recorded by Pull,
+// if any, and the config and every layer are checked against their
+// digest.
+func VerifyLayout(dir string, expected string, layerDigests []string) (Manifest, error) {
+	manifest, digest, err := ReadLayout(dir)
+	if err != nil {
+		return manifest, err
+	}
+	if expected != "" {
+		expected = strings.ToLower(expected)
+		if !strings.HasPrefix(expected, "sha256:") {
+			expected = "sha256:" + expected
+		}
+		if digest != expected {
+			if err := verifyIndex(dir, expected, digest); err != nil {
+				return manifest, err
+			}
+		}
+	}
+	if layerDigests != nil {
+		if err := matchLayers(manifest, layerDigests); err != nil {
+			return manifest, err
+		}
+	}
+	if err := VerifyBlob(dir, manifest.Config.Digest); err != nil {
+		return manifest, err
+	}
+	for _, l := range manifest.Layers {
+		if err := VerifyBlob(dir, l.Digest); err != nil {
+			return manifest, err
+		}
+	}
+	return manifest, nil
+}
+
+// matchLayers checks that the manifest has exactly the layers which
+// were pulled, in order
+func matchLayers(manifest Manifest, layerDigests []string) error {
+	if len(manifest.Layers) != len(layerDigests) {
+		return fmt.Errorf("manifest has %d layers, pulled %d",
+			len(manifest.Layers), len(layerDigests))
+	}
+	for i, l := range manifest.Layers {
+		if l.Digest != layerDigests[i] {
+			return fmt.Errorf("manifest layer %d is %s, pulled %s",
+				i, l.Digest, layerDigests[i])
+		}
+	}
+	return nil
+}
+
+// verifyIndex checks that the index blob with the expected digest refers
+// to the manifest digest
+func verifyIndex(dir string, expected string, digest string) error {
+	if err := VerifyBlob(dir, expected); err != nil {
+		return fmt.Errorf("manifest digest %s does not match %s: %s",
+			digest, expected, err)
+	}
+	blobFile, _ := BlobPath(dir, expected)
+	b, err := ioutil.ReadFile(blobFile)
+	if err != nil {
+		return err
+	}
+	var index Index
+	if err := json.Unmarshal(b, &index); err != nil {
+		return fmt.Errorf("manifest digest %s does not match %s: %s",
+			digest, expected, err)
+	}
+	for _, m := range index.Manifests {
+		if m.Digest == digest {
+			return nil
+		}
+	}
+	return fmt.Errorf("manifest digest %s does not match %s",
+		digest, expected)
+}
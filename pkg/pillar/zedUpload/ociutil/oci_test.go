@@ -0,0 +1,207 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReference(t *testing.T) {
+	testMatrix := map[string]struct {
+		ref       string
+		registry  string
+		repo      string
+		reference string
+		expectErr bool
+	}{
+		"Docker hub official image": {
+			ref:       "alpine",
+			registry:  "https://registry-1.docker.io",
+			repo:      "library/alpine",
+			reference: "latest",
+		},
+		"Docker hub with tag": {
+			ref:       "docker://docker.io/lfedge/eve:5.0",
+			registry:  "https://registry-1.docker.io",
+			repo:      "lfedge/eve",
+			reference: "5.0",
+		},
+		"Registry with port and digest": {
+			ref:       "http://localhost:5000/app/nginx@sha256:abcd",
+			registry:  "http://localhost:5000",
+			repo:      "app/nginx",
+			reference: "sha256:abcd",
+		},
+		"Registry without tag": {
+			ref:       "oci://quay.io/coreos/etcd",
+			registry:  "https://quay.io",
+			repo:      "coreos/etcd",
+			reference: "latest",
+		},
+		"No repository": {
+			ref:       "quay.io/",
+			expectErr: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		registry, repo, reference, err := ParseReference(test.ref)
+		if test.expectErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.registry, registry)
+		assert.Equal(t, test.repo, repo)
+		assert.Equal(t, test.reference, reference)
+	}
+}
+
+// writeTestLayout creates an OCI layout with one layer. Returns the
+// digests of the manifest and of an index referring to it
+func writeTestLayout(t *testing.T, dir string) (string, string) {
+	if err := os.MkdirAll(filepath.Join(dir, "blobs", "sha256"), 0755); err != nil {
+		t.Fatalf("MkdirAll failed: %s", err)
+	}
+	addBlob := func(b []byte) Descriptor {
+		digest := digestBytes(b)
+		if err := writeBlob(dir, digest, b); err != nil {
+			t.Fatalf("writeBlob failed: %s", err)
+		}
+		return Descriptor{Digest: digest, Size: int64(len(b))}
+	}
+	addJSONBlob := func(item interface{}) Descriptor {
+		b, err := json.Marshal(item)
+		if err != nil {
+			t.Fatalf("Marshal failed: %s", err)
+		}
+		return addBlob(b)
+	}
+	manifest := Manifest{SchemaVersion: 2, MediaType: MediaTypeOCIManifest,
+		Config: addBlob([]byte(`{"architecture":"amd64"}`)),
+		Layers: []Descriptor{addBlob([]byte("layer"))}}
+	desc := addJSONBlob(manifest)
+	desc.MediaType = MediaTypeOCIManifest
+	index := addJSONBlob(Index{SchemaVersion: 2, Manifests: []Descriptor{desc}})
+	if err := writeLayout(dir, desc); err != nil {
+		t.Fatalf("writeLayout failed: %s", err)
+	}
+	return desc.Digest, index.Digest
+}
+
+func TestVerifyLayout(t *testing.T) {
+	otherIndex := digestBytes([]byte(`{"schemaVersion":2,"manifests":[]}`))
+	layer := digestBytes([]byte("layer"))
+	testMatrix := map[string]struct {
+		expected     func(manifest, index string) string
+		layerDigests []string
+		corrupt      func(t *testing.T, dir string, manifest string)
+		expectErr    bool
+	}{
+		"Manifest digest": {
+			expected: func(manifest, index string) string { return manifest },
+		},
+		"Manifest sha256 without prefix": {
+			expected: func(manifest, index string) string {
+				return strings.ToUpper(strings.TrimPrefix(manifest, "sha256:"))
+			},
+		},
+		"Index digest": {
+			expected: func(manifest, index string) string { return index },
+		},
+		"Mismatch": {
+			expected: func(manifest, index string) string {
+				return digestBytes([]byte("other"))
+			},
+			expectErr: true,
+		},
+		"Index not referring to the manifest": {
+			expected: func(manifest, index string) string { return otherIndex },
+			corrupt: func(t *testing.T, dir string, manifest string) {
+				err := writeBlob(dir, otherIndex,
+					[]byte(`{"schemaVersion":2,"manifests":[]}`))
+				assert.NoError(t, err)
+			},
+			expectErr: true,
+		},
+		"No sha256": {
+			expected:     func(manifest, index string) string { return "" },
+			layerDigests: []string{layer},
+		},
+		"No sha256 corrupted layer": {
+			expected:     func(manifest, index string) string { return "" },
+			layerDigests: []string{layer},
+			corrupt: func(t *testing.T, dir string, manifest string) {
+				err := ioutil.WriteFile(filepath.Join(dir, "blobs", "sha256",
+					strings.TrimPrefix(layer, "sha256:")),
+					[]byte("tampered"), 0644)
+				assert.NoError(t, err)
+			},
+			expectErr: true,
+		},
+		"Pulled layers": {
+			expected:     func(manifest, index string) string { return manifest },
+			layerDigests: []string{layer},
+		},
+		"Other pulled layer": {
+			expected:     func(manifest, index string) string { return manifest },
+			layerDigests: []string{digestBytes([]byte("other"))},
+			expectErr:    true,
+		},
+		"Extra pulled layer": {
+			expected:     func(manifest, index string) string { return manifest },
+			layerDigests: []string{layer, digestBytes([]byte("other"))},
+			expectErr:    true,
+		},
+		"Corrupted layer": {
+			expected: func(manifest, index string) string { return manifest },
+			corrupt: func(t *testing.T, dir string, manifest string) {
+				err := ioutil.WriteFile(filepath.Join(dir, "blobs", "sha256",
+					strings.TrimPrefix(digestBytes([]byte("layer")), "sha256:")),
+					[]byte("tampered"), 0644)
+				assert.NoError(t, err)
+			},
+			expectErr: true,
+		},
+		"Corrupted manifest": {
+			expected: func(manifest, index string) string { return manifest },
+			corrupt: func(t *testing.T, dir string, manifest string) {
+				blobFile, err := BlobPath(dir, manifest)
+				assert.NoError(t, err)
+				err = ioutil.WriteFile(blobFile, []byte("{}"), 0644)
+				assert.NoError(t, err)
+			},
+			expectErr: true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dir, err := ioutil.TempDir("", "ocilayout")
+		if err != nil {
+			t.Fatalf("TempDir failed: %s", err)
+		}
+		manifest, index := writeTestLayout(t, dir)
+		if test.corrupt != nil {
+			test.corrupt(t, dir, manifest)
+		}
+		m, err := VerifyLayout(dir, test.expected(manifest, index),
+			test.layerDigests)
+		if test.expectErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(m.Layers))
+		}
+		os.RemoveAll(dir)
+	}
+}
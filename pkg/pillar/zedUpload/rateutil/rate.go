@@ -0,0 +1,154 @@
+// Copyright(c) 2019 Zededa, Inc.
+// All rights reserved.
+
+// Package rateutil limits the bandwidth used by the transfers. The
+// readers which share a Limiter share its rate. A Limiter with a parent
+// also waits for the parent hence e.g., a per port limit can be combined
+// with a global one.
+package rateutil
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	maxRead = 32 * 1024       // Smooths the rate for large reads
+	maxWait = 1 * time.Second // Check for rate changes at least this often
+)
+
+// Limiter is a token bucket with a burst of one second worth of bytes
+type Limiter struct {
+	sync.Mutex
+	rate   int64 // Bytes per second; zero means no limit
+	tokens int64 // Negative when we are in debt
+	last   time.Time
+	paused bool // Nothing is transferred while paused
+	parent *Limiter
+}
+
+func NewLimiter(rate int64) *Limiter {
+	return &Limiter{rate: rate, last: time.Now()}
+}
+
+// NewChild returns a limiter which also waits for l
+func (l *Limiter) NewChild(rate int64) *Limiter {
+	child := NewLimiter(rate)
+	child.parent = l
+	return child
+}
+
+// SetParent changes the limiter we also wait for
+func (l *Limiter) SetParent(parent *Limiter) {
+	l.Lock()
+	defer l.Unlock()
+	l.parent = parent
+}
+
+// SetPaused blocks the readers until the limiter is no longer paused
+func (l *Limiter) SetPaused(paused bool) {
+	l.Lock()
+	defer l.Unlock()
+	l.paused = paused
+}
+
+func (l *Limiter) Paused() bool {
+	l.Lock()
+	defer l.Unlock()
+	return l.paused
+}
+
+// SetRate changes the rate of the limiter and the readers using it
+func (l *Limiter) SetRate(rate int64) {
+	l.Lock()
+	defer l.Unlock()
+	l.rate = rate
+	if l.tokens > rate {
+		l.tokens = rate
+	}
+}
+
+func (l *Limiter) Rate() int64 {
+	l.Lock()
+	defer l.Unlock()
+	return l.rate
+}
+
+// WaitN blocks until n bytes may be transferred. We take what we need
+// as soon as there are tokens and then the callers wait until the debt
+// is paid.
+func (l *Limiter) WaitN(n int) {
+	if l == nil {
+		return
+	}
+	for {
+		l.Lock()
+		if l.paused {
+			l.Unlock()
+			time.Sleep(maxWait)
+			continue
+		}
+		if l.rate == 0 {
+			parent := l.parent
+			l.Unlock()
+			parent.WaitN(n)
+			return
+		}
+		now := time.Now()
+		l.tokens += int64(now.Sub(l.last).Seconds() * float64(l.rate))
+		if l.tokens > l.rate {
+			l.tokens = l.rate
+		}
+		l.last = now
+		if l.tokens > 0 {
+			l.tokens -= int64(n)
+			parent := l.parent
+			l.Unlock()
+			parent.WaitN(n)
+			return
+		}
+		wait := time.Duration(float64(1-l.tokens) / float64(l.rate) *
+			float64(time.Second))
+		l.Unlock()
+		if wait > maxWait {
+			wait = maxWait
+		}
+		time.Sleep(wait)
+	}
+}
+
+type reader struct {
+	r io.Reader
+	l *Limiter
+}
+
+// NewReader returns a reader which doesn't exceed the rate of l
+func NewReader(r io.Reader, l *Limiter) io.Reader {
+	if l == nil {
+		return r
+	}
+	return &reader{r: r, l: l}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(p) > maxRead {
+		p = p[:maxRead]
+	}
+	n, err := r.r.Read(p)
+	r.l.WaitN(n)
+	return n, err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// NewReadCloser is NewReader for e.g., a http.Response Body
+func NewReadCloser(rc io.ReadCloser, l *Limiter) io.ReadCloser {
+	if l == nil {
+		return rc
+	}
+	return readCloser{Reader: NewReader(rc, l), Closer: rc}
+}
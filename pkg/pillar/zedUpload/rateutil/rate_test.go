@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rateutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReader(t *testing.T) {
+	testMatrix := map[string]struct {
+		rate        int64
+		parentRate  int64
+		size        int
+		minDuration time.Duration
+		maxDuration time.Duration
+	}{
+		"No limit": {
+			rate:        0,
+			size:        4 * 1024 * 1024,
+			maxDuration: 500 * time.Millisecond,
+		},
+		"Half a second": {
+			rate:        1024 * 1024,
+			size:        512 * 1024,
+			minDuration: 400 * time.Millisecond,
+			maxDuration: 1500 * time.Millisecond,
+		},
+		"Limited by the parent": {
+			rate:        0,
+			parentRate:  1024 * 1024,
+			size:        512 * 1024,
+			minDuration: 400 * time.Millisecond,
+			maxDuration: 1500 * time.Millisecond,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		l := NewLimiter(test.parentRate).NewChild(test.rate)
+		r := NewReader(bytes.NewReader(make([]byte, test.size)), l)
+		start := time.Now()
+		b, err := ioutil.ReadAll(r)
+		elapsed := time.Since(start)
+		assert.NoError(t, err)
+		assert.Equal(t, test.size, len(b))
+		assert.True(t, elapsed >= test.minDuration,
+			"took %v expected at least %v", elapsed, test.minDuration)
+		assert.True(t, elapsed <= test.maxDuration,
+			"took %v expected at most %v", elapsed, test.maxDuration)
+	}
+}
+
+func TestPaused(t *testing.T) {
+	l := NewLimiter(0)
+	l.SetPaused(true)
+	done := make(chan struct{})
+	go func() {
+		l.WaitN(1024)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("WaitN returned while paused")
+	case <-time.After(200 * time.Millisecond):
+	}
+	l.SetPaused(false)
+	select {
+	case <-done:
+	case <-time.After(2 * maxWait):
+		t.Fatal("WaitN did not return after resume")
+	}
+}
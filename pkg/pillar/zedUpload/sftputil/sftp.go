@@ -5,6 +5,7 @@ package sftp
 
 import (
 	"fmt"
+	"github.com/lf-edge/eve/pkg/pillar/zedUpload/rateutil"
 	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 	"io"
@@ -67,6 +68,12 @@ func getSftpClient(host, user, pass string) (*sftp.Client, error) {
 }
 
 func ExecCmd(cmd, host, user, pass, remoteFile, localFile string, prgNotify NotifChan) UpdateStats {
+	return ExecCmdWithLimiter(cmd, host, user, pass, remoteFile, localFile, nil, prgNotify)
+}
+
+// ExecCmdWithLimiter is ExecCmd with the fetch subject to the limiter
+func ExecCmdWithLimiter(cmd, host, user, pass, remoteFile, localFile string,
+	limiter *rateutil.Limiter, prgNotify NotifChan) UpdateStats {
 	var list []string
 	stats := UpdateStats{}
 	client, err := getSftpClient(host, user, pass)
@@ -124,11 +131,12 @@ func ExecCmd(cmd, host, user, pass, remoteFile, localFile string, prgNotify Noti
 		}
 		defer fl.Close()
 
+		src := rateutil.NewReader(fr, limiter)
 		chunkSize := SingleMB
 		var written, copiedSize int64
 		stats.Size = fi.Size()
 		for {
-			if written, err = io.CopyN(fl, fr, chunkSize); err != nil && err != io.EOF {
+			if written, err = io.CopyN(fl, src, chunkSize); err != nil && err != io.EOF {
 				stats.Error = err
 				return stats
 			}
@@ -18,6 +18,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cmd/ledmanager"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/logmanager"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/nim"
+	"github.com/lf-edge/eve/pkg/pillar/cmd/pubsubcli"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/tpmmgr"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/vaultmgr"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/verifier"
@@ -65,6 +66,8 @@ func main() {
 		zedrouter.Run()
 	case "ipcmonitor":
 		ipcmonitor.Run()
+	case "pubsub":
+		pubsubcli.Run()
 	case "baseosmgr":
 		baseosmgr.Run()
 	case "wstunnelclient":
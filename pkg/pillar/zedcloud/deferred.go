@@ -7,9 +7,12 @@ package zedcloud
 
 import (
 	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	log "github.com/sirupsen/logrus"
-	"time"
 )
 
 // Example usage:
@@ -22,13 +25,19 @@ import (
 // After failure call
 // 	zedcloud.SetDeferred(key, buf, size, url, zedcloudCtx)
 // or AddDeferred to build a queue for each key
+//
+// To keep the items across a restart use InitDeferredPersistent
+// instead of InitDeferred and call ReplayDeferred once the
+// ZedCloudContext is set up.
 
 type deferredItem struct {
-	buf         *bytes.Buffer
+	data        []byte
 	size        int64
 	url         string
-	zedcloudCtx ZedCloudContext
+	zedcloudCtx *ZedCloudContext // nil until ReplayDeferred if loaded
 	return400   bool
+	seq         uint64    // Order in which the items were queued
+	queued      time.Time // Expired after maxAge
 }
 
 type deferredItemList struct {
@@ -38,10 +47,25 @@ type deferredItemList struct {
 const longTime1 = time.Hour * 24
 const longTime2 = time.Hour * 48
 
+// DeferredMetrics are reported by the agents using the deferred queue
+type DeferredMetrics struct {
+	QueuedItems  uint64
+	QueuedBytes  uint64
+	DroppedItems uint64 // Evicted due to the size limit or expired
+	DroppedBytes uint64
+}
+
 // Some day we might return this; right now only for the defaultCtx
 type DeferredContext struct {
 	deferredItems map[string]deferredItemList
 	ticker        flextimer.FlexTickerHandle
+	dirname       string        // Persist the items here if set
+	maxBytes      int64         // Limit on the queued bytes if non-zero
+	maxAge        time.Duration // Limit on the age of items if non-zero
+	seq           uint64
+	lock          sync.Mutex // Callers might be in different goroutines
+	metricsLock   sync.Mutex // Protects metrics
+	metrics       DeferredMetrics
 }
 
 // From first InitDeferred
@@ -56,6 +80,23 @@ func InitDeferred() <-chan time.Time {
 	return defaultCtx.ticker.C
 }
 
+// InitDeferredPersistent is like InitDeferred but the items are also
+// kept in dirname and loaded from there. The queue is bounded by maxBytes
+// and maxAge; the oldest items are dropped first.
+func InitDeferredPersistent(dirname string, maxBytes int64,
+	maxAge time.Duration) <-chan time.Time {
+
+	if defaultCtx != nil {
+		log.Fatal("InitDeferredPersistent called twice")
+	}
+	defaultCtx = initImpl()
+	defaultCtx.dirname = dirname
+	defaultCtx.maxBytes = maxBytes
+	defaultCtx.maxAge = maxAge
+	defaultCtx.loadItems()
+	return defaultCtx.ticker.C
+}
+
 func initImpl() *DeferredContext {
 	ctx := new(DeferredContext)
 	ctx.deferredItems = make(map[string]deferredItemList)
@@ -66,6 +107,59 @@ func initImpl() *DeferredContext {
 	return ctx
 }
 
+// ReplayDeferred sends the items loaded by InitDeferredPersistent using
+// zedcloudCtx from now on
+func ReplayDeferred(zedcloudCtx ZedCloudContext) {
+	if defaultCtx == nil {
+		log.Fatal("ReplayDeferred no defaultCtx")
+	}
+	defaultCtx.lock.Lock()
+	defer defaultCtx.lock.Unlock()
+	defaultCtx.replayDeferred(zedcloudCtx)
+}
+
+func (ctx *DeferredContext) replayDeferred(zedcloudCtx ZedCloudContext) {
+
+	count := 0
+	for key, l := range ctx.deferredItems {
+		for i := range l.list {
+			if l.list[i].zedcloudCtx == nil {
+				l.list[i].zedcloudCtx = &zedcloudCtx
+				count++
+			}
+		}
+		ctx.deferredItems[key] = l
+	}
+	log.Infof("ReplayDeferred %d items map %d\n", count,
+		len(ctx.deferredItems))
+	if count != 0 {
+		startTimer(ctx)
+	}
+}
+
+// GetDeferredMetrics returns the size of the queue and what was dropped
+func GetDeferredMetrics() DeferredMetrics {
+	if defaultCtx == nil {
+		return DeferredMetrics{}
+	}
+	defaultCtx.metricsLock.Lock()
+	defer defaultCtx.metricsLock.Unlock()
+	return defaultCtx.metrics
+}
+
+// CastDeferredMetrics converts what we get from pubsub
+func CastDeferredMetrics(in interface{}) DeferredMetrics {
+	b, err := json.Marshal(in)
+	if err != nil {
+		log.Fatal(err, "json Marshal in CastDeferredMetrics")
+	}
+	var output DeferredMetrics
+	if err := json.Unmarshal(b, &output); err != nil {
+		log.Fatal(err, "json Unmarshal in CastDeferredMetrics")
+	}
+	return output
+}
+
 // Try to send all deferred items. Give up if any one fails
 // Stop timer if map becomes empty
 // Returns true when there are no more deferred items
@@ -74,6 +168,8 @@ func HandleDeferred(event time.Time, spacing time.Duration) bool {
 	if defaultCtx == nil {
 		log.Fatal("HandleDeferred no defaultCtx")
 	}
+	defaultCtx.lock.Lock()
+	defer defaultCtx.lock.Unlock()
 	return defaultCtx.handleDeferred(event, spacing)
 }
 
@@ -82,23 +178,30 @@ func (ctx *DeferredContext) handleDeferred(event time.Time,
 
 	log.Infof("HandleDeferred(%v, %v) map %d\n",
 		event, spacing, len(ctx.deferredItems))
+	ctx.expireItems()
 	iteration := 0 // Do some load spreading
 	for key, l := range ctx.deferredItems {
 		log.Infof("Trying to send for %s items %d\n", key, len(l.list))
 		failed := false
-		for i, item := range l.list {
-			if item.buf == nil {
-				continue
+		for len(l.list) != 0 {
+			item := l.list[0]
+			if item.zedcloudCtx == nil {
+				log.Infof("HandleDeferred: for %s waiting for replay\n",
+					key)
+				failed = true
+				break
 			}
-			if item.buf.Len() == 0 {
+			if len(item.data) == 0 {
 				log.Errorf("Zero length defered item for %s",
 					key)
+				ctx.removeItem(&l)
 				continue
 			}
 			log.Infof("Trying to send for %s item %d data size %d\n",
-				key, i, item.size)
-			resp, _, _, err := SendOnAllIntf(item.zedcloudCtx, item.url,
-				item.size, item.buf, iteration, item.return400)
+				key, item.seq, item.size)
+			resp, _, _, err := SendOnAllIntf(*item.zedcloudCtx, item.url,
+				item.size, bytes.NewBuffer(item.data), iteration,
+				item.return400)
 			if item.return400 && resp != nil &&
 				resp.StatusCode == 400 {
 				log.Infof("HandleDeferred: for %s ignore code %d\n",
@@ -109,9 +212,11 @@ func (ctx *DeferredContext) handleDeferred(event time.Time,
 				failed = true
 				break
 			}
-			item.buf = nil
+			ctx.removeItem(&l)
 		}
 		if failed {
+			// Keep what is left in order
+			ctx.deferredItems[key] = l
 			break
 		} else {
 			delete(ctx.deferredItems, key)
@@ -136,6 +241,8 @@ func HasDeferred(key string) bool {
 	if defaultCtx == nil {
 		log.Fatal("HasDeferred no defaultCtx")
 	}
+	defaultCtx.lock.Lock()
+	defer defaultCtx.lock.Unlock()
 	return defaultCtx.hasDeferred(key)
 }
 
@@ -151,19 +258,24 @@ func RemoveDeferred(key string) {
 	if defaultCtx == nil {
 		log.Fatal("RemoveDeferred no defaultCtx")
 	}
+	defaultCtx.lock.Lock()
+	defer defaultCtx.lock.Unlock()
 	defaultCtx.removeDeferred(key)
 }
 
 func (ctx *DeferredContext) removeDeferred(key string) {
 
 	log.Debugf("RemoveDeferred(%s) map %d\n", key, len(ctx.deferredItems))
-	_, ok := ctx.deferredItems[key]
+	l, ok := ctx.deferredItems[key]
 	if !ok {
 		// Normal case
 		log.Debugf("removeDeferred: Non-existing key %s\n", key)
 		return
 	}
 	log.Debugf("Deleting key %s\n", key)
+	for len(l.list) != 0 {
+		ctx.removeItem(&l)
+	}
 	delete(ctx.deferredItems, key)
 
 	if len(ctx.deferredItems) == 0 {
@@ -178,6 +290,8 @@ func SetDeferred(key string, buf *bytes.Buffer, size int64, url string,
 	if defaultCtx == nil {
 		log.Fatal("SetDeferred no defaultCtx")
 	}
+	defaultCtx.lock.Lock()
+	defer defaultCtx.lock.Unlock()
 	defaultCtx.setDeferred(key, buf, size, url, zedcloudCtx, return400)
 }
 
@@ -189,22 +303,19 @@ func (ctx *DeferredContext) setDeferred(key string, buf *bytes.Buffer,
 	if len(ctx.deferredItems) == 0 {
 		startTimer(ctx)
 	}
-	_, ok := ctx.deferredItems[key]
+	l, ok := ctx.deferredItems[key]
 	if ok {
 		log.Debugf("Replacing key %s\n", key)
+		for len(l.list) != 0 {
+			ctx.removeItem(&l)
+		}
 	} else {
 		log.Debugf("Adding key %s\n", key)
 	}
-	item := deferredItem{
-		buf:         buf,
-		size:        size,
-		url:         url,
-		zedcloudCtx: zedcloudCtx,
-		return400:   return400,
-	}
-	l := deferredItemList{}
-	l.list = append(l.list, item)
+	l.list = append(l.list, ctx.newItem(key, buf, size, url,
+		zedcloudCtx, return400))
 	ctx.deferredItems[key] = l
+	ctx.evictItems()
 }
 
 // Add to slice for this key
@@ -214,6 +325,8 @@ func AddDeferred(key string, buf *bytes.Buffer, size int64, url string,
 	if defaultCtx == nil {
 		log.Fatal("SetDeferred no defaultCtx")
 	}
+	defaultCtx.lock.Lock()
+	defer defaultCtx.lock.Unlock()
 	defaultCtx.addDeferred(key, buf, size, url, zedcloudCtx, return400)
 }
 
@@ -231,15 +344,126 @@ func (ctx *DeferredContext) addDeferred(key string, buf *bytes.Buffer,
 	} else {
 		log.Debugf("Adding key %s\n", key)
 	}
+	l.list = append(l.list, ctx.newItem(key, buf, size, url,
+		zedcloudCtx, return400))
+	ctx.deferredItems[key] = l
+	ctx.evictItems()
+}
+
+// newItem copies what is left in buf since a failed send might have
+// consumed some of it, and persists the item
+func (ctx *DeferredContext) newItem(key string, buf *bytes.Buffer,
+	size int64, url string, zedcloudCtx ZedCloudContext,
+	return400 bool) deferredItem {
+
+	var data []byte
+	if buf != nil {
+		data = make([]byte, buf.Len())
+		copy(data, buf.Bytes())
+	}
+	ctx.seq++
 	item := deferredItem{
-		buf:         buf,
+		data:        data,
 		size:        size,
 		url:         url,
-		zedcloudCtx: zedcloudCtx,
+		zedcloudCtx: &zedcloudCtx,
 		return400:   return400,
+		seq:         ctx.seq,
+		queued:      time.Now(),
+	}
+	ctx.writeItem(key, item)
+	ctx.metricsLock.Lock()
+	ctx.metrics.QueuedItems++
+	ctx.metrics.QueuedBytes += uint64(len(data))
+	ctx.metricsLock.Unlock()
+	return item
+}
+
+// removeItem removes the first item of the list
+func (ctx *DeferredContext) removeItem(l *deferredItemList) {
+	item := l.list[0]
+	l.list = l.list[1:]
+	ctx.deleteItem(item)
+	ctx.metricsLock.Lock()
+	ctx.metrics.QueuedItems--
+	ctx.metrics.QueuedBytes -= uint64(len(item.data))
+	ctx.metricsLock.Unlock()
+}
+
+// dropOldest removes the oldest item of all the keys and counts it as
+// dropped. Returns false if there are no items.
+func (ctx *DeferredContext) dropOldest() bool {
+	oldestKey := ""
+	var oldestSeq uint64
+	for key, l := range ctx.deferredItems {
+		if len(l.list) == 0 {
+			continue
+		}
+		if oldestKey == "" || l.list[0].seq < oldestSeq {
+			oldestKey = key
+			oldestSeq = l.list[0].seq
+		}
+	}
+	if oldestKey == "" {
+		return false
+	}
+	l := ctx.deferredItems[oldestKey]
+	log.Warnf("Dropping deferred item %d for %s size %d\n",
+		oldestSeq, oldestKey, len(l.list[0].data))
+	ctx.countDropped(l.list[0])
+	ctx.removeItem(&l)
+	if len(l.list) == 0 {
+		delete(ctx.deferredItems, oldestKey)
+	} else {
+		ctx.deferredItems[oldestKey] = l
+	}
+	return true
+}
+
+func (ctx *DeferredContext) countDropped(item deferredItem) {
+	ctx.metricsLock.Lock()
+	ctx.metrics.DroppedItems++
+	ctx.metrics.DroppedBytes += uint64(len(item.data))
+	ctx.metricsLock.Unlock()
+}
+
+// evictItems drops the oldest items until we are within maxBytes
+func (ctx *DeferredContext) evictItems() {
+	if ctx.maxBytes == 0 {
+		return
+	}
+	for {
+		ctx.metricsLock.Lock()
+		queued := ctx.metrics.QueuedBytes
+		ctx.metricsLock.Unlock()
+		if queued <= uint64(ctx.maxBytes) || !ctx.dropOldest() {
+			break
+		}
+	}
+	if len(ctx.deferredItems) == 0 {
+		stopTimer(ctx)
+	}
+}
+
+// expireItems drops the items older than maxAge. Items are queued in
+// order hence those for a key expire from the front.
+func (ctx *DeferredContext) expireItems() {
+	if ctx.maxAge == 0 {
+		return
+	}
+	for key, l := range ctx.deferredItems {
+		for len(l.list) != 0 && time.Since(l.list[0].queued) > ctx.maxAge {
+			log.Warnf("Expiring deferred item %d for %s queued %v\n",
+				l.list[0].seq, key, l.list[0].queued)
+			ctx.countDropped(l.list[0])
+			ctx.removeItem(&l)
+		}
+		if len(l.list) == 0 {
+			delete(ctx.deferredItems, key)
+		} else {
+			ctx.deferredItems[key] = l
+		}
 	}
-	l.list = append(l.list, item)
-	ctx.deferredItems[key] = l
 }
 
 // Try every minute backoff to every 15 minutes
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedcloud
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDeferred(dirname string, maxBytes int64,
+	maxAge time.Duration) *DeferredContext {
+
+	ctx := initImpl()
+	ctx.dirname = dirname
+	ctx.maxBytes = maxBytes
+	ctx.maxAge = maxAge
+	ctx.loadItems()
+	return ctx
+}
+
+// Returns the data of the items for the key
+func deferredData(ctx *DeferredContext, key string) []string {
+	var data []string
+	for _, item := range ctx.deferredItems[key].list {
+		data = append(data, string(item.data))
+	}
+	return data
+}
+
+func TestDeferredPersist(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "deferred")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirname)
+
+	type add struct {
+		key     string
+		data    string
+		replace bool
+	}
+	testMatrix := map[string]struct {
+		maxBytes      int64
+		maxAge        time.Duration
+		adds          []add
+		age           time.Duration // Age of the items before reload
+		expected      map[string][]string
+		expectDropped uint64
+	}{
+		"Per key order": {
+			adds: []add{
+				{key: "a", data: "a1"},
+				{key: "b", data: "b1"},
+				{key: "a", data: "a2"},
+				{key: "a", data: "a3"},
+			},
+			expected: map[string][]string{
+				"a": {"a1", "a2", "a3"},
+				"b": {"b1"},
+			},
+		},
+		"Replace": {
+			adds: []add{
+				{key: "a", data: "a1"},
+				{key: "a", data: "a2"},
+				{key: "a", data: "a3", replace: true},
+			},
+			expected: map[string][]string{
+				"a": {"a3"},
+			},
+		},
+		"Evict oldest": {
+			maxBytes: 6,
+			adds: []add{
+				{key: "a", data: "a1"},
+				{key: "b", data: "b1"},
+				{key: "a", data: "a2"},
+				{key: "b", data: "b2"},
+			},
+			expected: map[string][]string{
+				"a": {"a2"},
+				"b": {"b1", "b2"},
+			},
+			expectDropped: 1,
+		},
+		"Expired": {
+			maxAge: time.Hour,
+			adds: []add{
+				{key: "a", data: "a1"},
+				{key: "b", data: "b1"},
+			},
+			age:           2 * time.Hour,
+			expected:      map[string][]string{},
+			expectDropped: 2,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		os.RemoveAll(dirname)
+		ctx := newTestDeferred(dirname, test.maxBytes, test.maxAge)
+		for _, a := range test.adds {
+			buf := bytes.NewBufferString(a.data)
+			if a.replace {
+				ctx.setDeferred(a.key, buf, int64(buf.Len()),
+					"url", ZedCloudContext{}, false)
+			} else {
+				ctx.addDeferred(a.key, buf, int64(buf.Len()),
+					"url", ZedCloudContext{}, false)
+			}
+		}
+		if test.age != 0 {
+			for key, l := range ctx.deferredItems {
+				for _, item := range l.list {
+					item.queued = item.queued.Add(-test.age)
+					ctx.writeItem(key, item)
+				}
+			}
+		}
+		dropped := ctx.metrics.DroppedItems
+
+		// As after a restart
+		ctx = newTestDeferred(dirname, test.maxBytes, test.maxAge)
+		assert.Equal(t, len(test.expected), len(ctx.deferredItems))
+		for key, expected := range test.expected {
+			assert.Equal(t, expected, deferredData(ctx, key))
+			for _, item := range ctx.deferredItems[key].list {
+				assert.Nil(t, item.zedcloudCtx)
+			}
+		}
+		dropped += ctx.metrics.DroppedItems
+		assert.Equal(t, test.expectDropped, dropped)
+
+		ctx.replayDeferred(ZedCloudContext{})
+		for key := range test.expected {
+			for _, item := range ctx.deferredItems[key].list {
+				assert.NotNil(t, item.zedcloudCtx)
+			}
+		}
+	}
+}
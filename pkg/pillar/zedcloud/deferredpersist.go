@@ -0,0 +1,122 @@
+// Copyright (c) 2019 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Persistence of the deferred items. Each item is a file named by its
+// sequence number hence the order in which they were queued is kept
+// across a restart.
+
+package zedcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	log "github.com/sirupsen/logrus"
+)
+
+// What we write for each item
+type persistedItem struct {
+	Key       string
+	URL       string
+	Size      int64
+	Return400 bool
+	Queued    time.Time
+	Data      []byte
+}
+
+func (ctx *DeferredContext) itemFilename(seq uint64) string {
+	return filepath.Join(ctx.dirname, fmt.Sprintf("%016d.json", seq))
+}
+
+func (ctx *DeferredContext) writeItem(key string, item deferredItem) {
+	if ctx.dirname == "" {
+		return
+	}
+	p := persistedItem{
+		Key:       key,
+		URL:       item.url,
+		Size:      item.size,
+		Return400: item.return400,
+		Queued:    item.queued,
+		Data:      item.data,
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		log.Fatal(err, "json Marshal in writeItem")
+	}
+	if err := pubsub.WriteRename(ctx.itemFilename(item.seq), b); err != nil {
+		// We still have it in memory
+		log.Errorf("writeItem(%s): %s\n", key, err)
+	}
+}
+
+func (ctx *DeferredContext) deleteItem(item deferredItem) {
+	if ctx.dirname == "" {
+		return
+	}
+	filename := ctx.itemFilename(item.seq)
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		log.Errorf("deleteItem: %s\n", err)
+	}
+}
+
+// loadItems recreates the queue from the files in dirname. The items
+// can't be sent until ReplayDeferred provides a ZedCloudContext.
+func (ctx *DeferredContext) loadItems() {
+	if err := os.MkdirAll(ctx.dirname, 0700); err != nil {
+		log.Fatal(err)
+	}
+	// ReadDir sorts by name hence by sequence number
+	locations, err := ioutil.ReadDir(ctx.dirname)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, location := range locations {
+		filename := filepath.Join(ctx.dirname, location.Name())
+		seq, err := strconv.ParseUint(strings.TrimSuffix(location.Name(),
+			".json"), 10, 64)
+		if err != nil || !strings.HasSuffix(location.Name(), ".json") {
+			// E.g., a temporary file from WriteRename
+			log.Warnf("loadItems: removing %s\n", filename)
+			os.RemoveAll(filename)
+			continue
+		}
+		var p persistedItem
+		b, err := ioutil.ReadFile(filename)
+		if err == nil {
+			err = json.Unmarshal(b, &p)
+		}
+		if err != nil {
+			log.Errorf("loadItems: removing %s: %s\n", filename, err)
+			os.Remove(filename)
+			continue
+		}
+		if seq > ctx.seq {
+			ctx.seq = seq
+		}
+		item := deferredItem{
+			data:      p.Data,
+			size:      p.Size,
+			url:       p.URL,
+			return400: p.Return400,
+			seq:       seq,
+			queued:    p.Queued,
+		}
+		l := ctx.deferredItems[p.Key]
+		l.list = append(l.list, item)
+		ctx.deferredItems[p.Key] = l
+		ctx.metrics.QueuedItems++
+		ctx.metrics.QueuedBytes += uint64(len(item.data))
+	}
+	log.Infof("loadItems(%s) %d items for %d keys\n", ctx.dirname,
+		ctx.metrics.QueuedItems, len(ctx.deferredItems))
+	ctx.expireItems()
+	ctx.evictItems()
+}